@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package autofix
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// Mode selects how an Applier handles each proposed Fix.
+type Mode int
+
+const (
+	// Show prints each fix's diff without writing anything.
+	Show Mode = iota
+	// Confirm prints each fix's diff and prompts before writing it.
+	Confirm
+	// Apply writes every fix without prompting.
+	Apply
+)
+
+// Applier runs a batch of tools.Fix proposals in one of three modes:
+// Show (dry-run diff only), Confirm (prompt per fix), or Apply (write
+// unconditionally). Every write is recorded in a Journal first, so a
+// caller can roll the whole batch back if a later fix fails partway
+// through.
+type Applier struct {
+	mode    Mode
+	journal *Journal
+	confirm func(tools.Fix) bool
+}
+
+// NewApplier creates an Applier in the given mode. Confirm mode prompts
+// on stdin.
+func NewApplier(mode Mode) *Applier {
+	return &Applier{
+		mode:    mode,
+		journal: NewJournal(),
+		confirm: promptStdin,
+	}
+}
+
+// Journal returns the rollback journal accumulated so far.
+func (a *Applier) Journal() *Journal {
+	return a.journal
+}
+
+// Run processes every fix according to the Applier's mode.
+func (a *Applier) Run(fixes []tools.Fix) error {
+	for _, fix := range fixes {
+		switch a.mode {
+		case Show:
+			fmt.Print(UnifiedDiff(fix))
+		case Confirm:
+			fmt.Print(UnifiedDiff(fix))
+			if !a.confirm(fix) {
+				continue
+			}
+			if err := a.apply(fix); err != nil {
+				return err
+			}
+		case Apply:
+			if err := a.apply(fix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply replaces fix.Range in fix.File with fix.After, recording the
+// file's prior content in the journal and writing atomically so a
+// crash mid-write can't leave a half-written file behind.
+func (a *Applier) apply(fix tools.Fix) error {
+	data, err := os.ReadFile(fix.File)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", fix.File, err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	if fix.Range.StartLine < 1 || fix.Range.EndLine > len(lines) || fix.Range.StartLine > fix.Range.EndLine {
+		return fmt.Errorf("fix range %d-%d out of bounds for %s (%d lines)", fix.Range.StartLine, fix.Range.EndLine, fix.File, len(lines))
+	}
+
+	a.journal.record(fix.File, data)
+
+	replacement := strings.Split(fix.After, "\n")
+	newLines := make([]string, 0, len(lines)-(fix.Range.EndLine-fix.Range.StartLine+1)+len(replacement))
+	newLines = append(newLines, lines[:fix.Range.StartLine-1]...)
+	newLines = append(newLines, replacement...)
+	newLines = append(newLines, lines[fix.Range.EndLine:]...)
+
+	return writeAtomic(fix.File, []byte(strings.Join(newLines, "\n")))
+}
+
+// writeAtomic writes data to path via a temp file and rename, so a
+// reader never observes a partially-written file.
+func writeAtomic(path string, data []byte) error {
+	tmp := path + ".gzh-autofix.tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
+
+// promptStdin is the default Confirm-mode prompt.
+func promptStdin(fix tools.Fix) bool {
+	fmt.Printf("Apply fix to %s? [y/N] ", fix.File)
+
+	reader := bufio.NewReader(os.Stdin)
+	line, _ := reader.ReadString('\n')
+	line = strings.TrimSpace(strings.ToLower(line))
+
+	return line == "y" || line == "yes"
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package autofix turns a QualityTool's proposed Fixes into reviewable
+// diffs and applies them, for tools that implement tools.FixProposer
+// instead of only rewriting files in place via their own --fix/-F flag.
+package autofix
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// UnifiedDiff renders fix as a unified diff. It's line-based rather than
+// a true minimal-hunk diff (no common-line suppression within the
+// changed range) - fixes are expected to cover small, targeted ranges
+// where that distinction rarely matters in practice.
+func UnifiedDiff(fix tools.Fix) string {
+	before := strings.Split(fix.Before, "\n")
+	after := strings.Split(fix.After, "\n")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n", fix.File)
+	fmt.Fprintf(&b, "+++ b/%s\n", fix.File)
+	fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", fix.Range.StartLine, len(before), fix.Range.StartLine, len(after))
+
+	for _, line := range before {
+		fmt.Fprintf(&b, "-%s\n", line)
+	}
+	for _, line := range after {
+		fmt.Fprintf(&b, "+%s\n", line)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package autofix
+
+import (
+	"fmt"
+	"os"
+)
+
+// Journal records the pre-fix content of every file an Applier has
+// written, in write order, so a failed or aborted batch can be rolled
+// back.
+type Journal struct {
+	entries []journalEntry
+}
+
+type journalEntry struct {
+	file     string
+	original []byte
+}
+
+// NewJournal creates an empty Journal.
+func NewJournal() *Journal {
+	return &Journal{}
+}
+
+// record saves a file's pre-fix content before it's overwritten.
+func (j *Journal) record(file string, original []byte) {
+	j.entries = append(j.entries, journalEntry{file: file, original: original})
+}
+
+// Rollback restores every recorded file to its pre-fix content, most
+// recently written first.
+func (j *Journal) Rollback() error {
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		entry := j.entries[i]
+		if err := os.WriteFile(entry.file, entry.original, 0o644); err != nil {
+			return fmt.Errorf("failed to roll back %s: %w", entry.file, err)
+		}
+	}
+	return nil
+}
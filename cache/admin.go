@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ToolCacheStat summarizes the cache footprint of a single tool (across all
+// files, tool versions, and option combinations).
+type ToolCacheStat struct {
+	// Tool is the tool name (CacheKey.ToolName)
+	Tool string
+
+	// Entries is the number of cache entries for this tool
+	Entries int64
+
+	// SizeBytes is the total size on disk of this tool's cache entries
+	SizeBytes int64
+}
+
+// GCPolicy bounds a GC pass along three independent dimensions. A
+// non-positive field leaves that dimension unconstrained, except MaxBytes,
+// which falls back to the manager's configured maxSize (preserving GC's
+// historical default of enforcing the configured cache size when the
+// caller doesn't override it).
+type GCPolicy struct {
+	// MaxAge removes entries whose CreatedAt is older than this, regardless
+	// of size/entry-count pressure.
+	MaxAge time.Duration
+
+	// MaxBytes evicts oldest-accessed entries until total size is at or
+	// below this many bytes.
+	MaxBytes int64
+
+	// MaxEntries evicts oldest-accessed entries until the entry count is at
+	// or below this.
+	MaxEntries int
+}
+
+// GC enforces policy by first removing entries older than policy.MaxAge,
+// then evicting the least recently accessed of what remains until both
+// policy.MaxBytes and policy.MaxEntries are satisfied. Returns the number
+// of entries evicted.
+func (cm *CacheManager) GC(policy GCPolicy) (int, error) {
+	if !cm.enabled {
+		return 0, nil
+	}
+
+	maxBytes := policy.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = cm.maxSize
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	paths, err := cm.storage.List()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+
+	type entry struct {
+		path         string
+		lastAccessed time.Time
+		sizeBytes    int64
+	}
+
+	var cutoff time.Time
+	if policy.MaxAge > 0 {
+		cutoff = time.Now().Add(-policy.MaxAge)
+	}
+
+	evicted := 0
+	var entries []entry
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
+		if err != nil {
+			continue
+		}
+
+		var cached CachedResult
+		if err := json.Unmarshal(data, &cached); err != nil {
+			_ = cm.storage.DeletePath(path)
+			evicted++
+			continue
+		}
+
+		if !cutoff.IsZero() && cached.Metadata.CreatedAt.Before(cutoff) {
+			_ = cm.storage.DeletePath(path)
+			evicted++
+			continue
+		}
+
+		entries = append(entries, entry{
+			path:         path,
+			lastAccessed: cached.Metadata.LastAccessed,
+			sizeBytes:    cached.Metadata.SizeBytes,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastAccessed.Before(entries[j].lastAccessed)
+	})
+
+	size, err := cm.storage.Size()
+	if err != nil {
+		return evicted, fmt.Errorf("failed to compute cache size: %w", err)
+	}
+
+	remaining := len(entries)
+	for _, e := range entries {
+		overBytes := maxBytes > 0 && size > maxBytes
+		overEntries := policy.MaxEntries > 0 && remaining > policy.MaxEntries
+		if !overBytes && !overEntries {
+			break
+		}
+
+		if err := cm.storage.DeletePath(e.path); err != nil {
+			continue
+		}
+		evicted++
+		remaining--
+		size -= e.sizeBytes
+	}
+
+	return evicted, nil
+}
+
+// Prune removes cache entries older than maxAge, plus any entries that
+// match toolFilter (and, if set, versionFilter) regardless of age - used
+// to invalidate all cached results for a tool after upgrading it. A zero
+// maxAge skips age-based pruning. Returns the number of entries removed.
+func (cm *CacheManager) Prune(maxAge time.Duration, toolFilter, versionFilter string) (int, error) {
+	if !cm.enabled {
+		return 0, nil
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	paths, err := cm.storage.List()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+
+	var cutoff time.Time
+	if maxAge > 0 {
+		cutoff = time.Now().Add(-maxAge)
+	}
+
+	pruned := 0
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
+		if err != nil {
+			continue
+		}
+
+		var cached CachedResult
+		if err := json.Unmarshal(data, &cached); err != nil {
+			_ = cm.storage.DeletePath(path)
+			pruned++
+			continue
+		}
+
+		matchesTool := toolFilter != "" && cached.Key.ToolName == toolFilter &&
+			(versionFilter == "" || cached.Key.ToolVersion == versionFilter)
+		matchesAge := !cutoff.IsZero() && cached.Metadata.CreatedAt.Before(cutoff)
+
+		if matchesTool || matchesAge {
+			if err := cm.storage.DeletePath(path); err != nil {
+				continue
+			}
+			pruned++
+		}
+	}
+
+	return pruned, nil
+}
+
+// Recompress rewrites every cache entry not already compressed with
+// algo/level to use it, leaving entries that already match untouched.
+// Backs the cache-prune command's --cache-recompress flag, for picking
+// up a new CacheConfig.Compression default on entries written under the
+// old one instead of waiting for them to naturally age out and get
+// rewritten on their next Get. Returns the number of entries rewritten.
+func (cm *CacheManager) Recompress(algo CompressionAlgorithm, level int) (int, error) {
+	if !cm.enabled {
+		return 0, nil
+	}
+
+	target, err := NewCompressor(algo, level)
+	if err != nil {
+		return 0, err
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	paths, err := cm.storage.List()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+
+	rewritten := 0
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
+		if err != nil {
+			continue
+		}
+
+		var cached CachedResult
+		if err := json.Unmarshal(data, &cached); err != nil {
+			continue
+		}
+
+		if cached.Metadata.Compression.Algorithm == string(target.Algorithm()) &&
+			cached.Metadata.Compression.Level == target.Level() {
+			continue
+		}
+
+		result, err := decompressResult(cached.Metadata.Compression, cached.ResultData)
+		if err != nil {
+			continue
+		}
+
+		resultData, err := compressResult(target, result)
+		if err != nil {
+			continue
+		}
+
+		cached.ResultData = resultData
+		cached.Metadata.Compression = CompressionInfo{
+			Algorithm: string(target.Algorithm()),
+			Level:     target.Level(),
+		}
+
+		newData, err := json.MarshalIndent(cached, "", "  ")
+		if err != nil {
+			continue
+		}
+		cached.Metadata.SizeBytes = int64(len(newData))
+		newData, _ = json.MarshalIndent(cached, "", "  ")
+
+		if err := cm.storage.Write(cached.Key.String(), cached.Key.ToolName, newData); err != nil {
+			continue
+		}
+		rewritten++
+	}
+
+	return rewritten, nil
+}
+
+// TopTools returns the n tools with the largest cache footprint, largest
+// first.
+func (cm *CacheManager) TopTools(n int) ([]ToolCacheStat, error) {
+	if !cm.enabled {
+		return nil, nil
+	}
+
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	paths, err := cm.storage.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+
+	byTool := make(map[string]*ToolCacheStat)
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
+		if err != nil {
+			continue
+		}
+
+		var cached CachedResult
+		if err := json.Unmarshal(data, &cached); err != nil {
+			continue
+		}
+
+		stat, ok := byTool[cached.Key.ToolName]
+		if !ok {
+			stat = &ToolCacheStat{Tool: cached.Key.ToolName}
+			byTool[cached.Key.ToolName] = stat
+		}
+		stat.Entries++
+		stat.SizeBytes += cached.Metadata.SizeBytes
+	}
+
+	stats := make([]ToolCacheStat, 0, len(byTool))
+	for _, stat := range byTool {
+		stats = append(stats, *stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].SizeBytes > stats[j].SizeBytes
+	})
+
+	if n > 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ExportArchive writes every cache entry (result + metadata) to w as a
+// gzip-compressed tar archive, one file per entry keyed by its storage
+// key. The resulting archive is small and portable enough to hand to a
+// CI cache-upload step (GitLab's cache:paths, actions/cache) and later
+// restore with ImportArchive on a different, ephemeral runner. Returns
+// the number of entries written.
+func (cm *CacheManager) ExportArchive(w io.Writer) (int, error) {
+	if !cm.enabled {
+		return 0, nil
+	}
+
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	paths, err := cm.storage.List()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	defer func() {
+		_ = gz.Close()
+	}()
+
+	tw := tar.NewWriter(gz)
+	defer func() {
+		_ = tw.Close()
+	}()
+
+	count := 0
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
+		if err != nil {
+			continue
+		}
+
+		hdr := &tar.Header{
+			Name: path,
+			Mode: 0o644,
+			Size: int64(len(data)),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return count, fmt.Errorf("failed to write archive header for %s: %w", path, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return count, fmt.Errorf("failed to write archive entry for %s: %w", path, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// ImportArchive reads a gzip-compressed tar archive written by
+// ExportArchive and merges its entries into storage. Merging, not
+// replacing, matters for CI: several parallel jobs may export overlapping
+// caches, and a later import must not clobber fresher local results with
+// stale ones. An archived entry is only written when it is newer than
+// any entry already on disk for the same key (newer LastAccessed wins).
+//
+// shouldKeep, if non-nil, is consulted for every entry before the
+// mtime comparison and can reject entries outright - e.g. because the
+// recorded tool version no longer matches what's installed on this
+// runner. Returns the number of entries actually written.
+func (cm *CacheManager) ImportArchive(r io.Reader, shouldKeep func(CachedResult) bool) (int, error) {
+	if !cm.enabled {
+		return 0, nil
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open cache archive: %w", err)
+	}
+	defer func() {
+		_ = gz.Close()
+	}()
+
+	tr := tar.NewReader(gz)
+
+	imported := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return imported, fmt.Errorf("failed to read cache archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return imported, fmt.Errorf("failed to read archive entry %s: %w", hdr.Name, err)
+		}
+
+		var cached CachedResult
+		if err := json.Unmarshal(data, &cached); err != nil {
+			// Corrupted entry: skip it rather than failing the whole import.
+			continue
+		}
+
+		if shouldKeep != nil && !shouldKeep(cached) {
+			continue
+		}
+
+		key := cached.Key.String()
+
+		if existing, err := cm.storage.Read(key, cached.Key.ToolName); err == nil {
+			var existingCached CachedResult
+			if err := json.Unmarshal(existing, &existingCached); err == nil {
+				if !cached.Metadata.LastAccessed.After(existingCached.Metadata.LastAccessed) {
+					continue // local entry is at least as fresh, keep it
+				}
+			}
+		}
+
+		if err := cm.storage.Write(key, cached.Key.ToolName, data); err != nil {
+			return imported, fmt.Errorf("failed to write imported entry %s: %w", key, err)
+		}
+		imported++
+	}
+
+	return imported, nil
+}
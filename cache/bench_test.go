@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// representativeToolOutput builds a tools.Result shaped like a real
+// ruff/pylint run on a medium-sized project: n issues with repetitive
+// messages and file paths, the kind of payload Compressor sees in
+// practice rather than synthetic random bytes.
+func representativeToolOutput(n int) *tools.Result {
+	issues := make([]tools.Issue, n)
+	for i := range issues {
+		issues[i] = tools.Issue{
+			File:     "src/pkg" + strconv.Itoa(i%20) + "/module" + strconv.Itoa(i%7) + ".py",
+			Line:     (i % 400) + 1,
+			Column:   (i % 80) + 1,
+			Severity: "warning",
+			Rule:     "E501",
+			Message:  "Line too long (88 > 79 characters)",
+		}
+	}
+
+	return &tools.Result{
+		Tool:           "ruff",
+		Language:       "Python",
+		Success:        true,
+		FilesProcessed: 20,
+		Duration:       "1.2s",
+		Issues:         issues,
+	}
+}
+
+// benchmarkCompressor runs Compress/Decompress over a representative
+// payload, reporting both throughput (via b.SetBytes) and the
+// compressed size (via b.ReportMetric) so `go test -bench` output
+// compares algorithms on speed and ratio side by side.
+func benchmarkCompressor(b *testing.B, algo CompressionAlgorithm) {
+	b.Helper()
+
+	compressor, err := NewCompressor(algo, 0)
+	if err != nil {
+		b.Fatalf("NewCompressor(%s): %v", algo, err)
+	}
+
+	payload, err := compressResult(noneCompressor{}, representativeToolOutput(2000))
+	if err != nil {
+		b.Fatalf("failed to build payload: %v", err)
+	}
+
+	compressed, err := compressor.Compress(payload)
+	if err != nil {
+		b.Fatalf("Compress: %v", err)
+	}
+	b.ReportMetric(float64(len(payload))/float64(len(compressed)), "ratio")
+
+	b.ResetTimer()
+	b.SetBytes(int64(len(payload)))
+	for i := 0; i < b.N; i++ {
+		if _, err := compressor.Compress(payload); err != nil {
+			b.Fatalf("Compress: %v", err)
+		}
+	}
+}
+
+func BenchmarkCompress_None(b *testing.B) { benchmarkCompressor(b, CompressionNone) }
+func BenchmarkCompress_Gzip(b *testing.B) { benchmarkCompressor(b, CompressionGzip) }
+func BenchmarkCompress_Zstd(b *testing.B) { benchmarkCompressor(b, CompressionZstd) }
+
+// benchmarkDecompressor measures decompression throughput for algo
+// against the same representative payload used above.
+func benchmarkDecompressor(b *testing.B, algo CompressionAlgorithm) {
+	b.Helper()
+
+	compressor, err := NewCompressor(algo, 0)
+	if err != nil {
+		b.Fatalf("NewCompressor(%s): %v", algo, err)
+	}
+
+	payload, err := compressResult(noneCompressor{}, representativeToolOutput(2000))
+	if err != nil {
+		b.Fatalf("failed to build payload: %v", err)
+	}
+
+	compressed, err := compressor.Compress(payload)
+	if err != nil {
+		b.Fatalf("Compress: %v", err)
+	}
+
+	b.ResetTimer()
+	b.SetBytes(int64(len(payload)))
+	for i := 0; i < b.N; i++ {
+		if _, err := compressor.Decompress(compressed); err != nil {
+			b.Fatalf("Decompress: %v", err)
+		}
+	}
+}
+
+func BenchmarkDecompress_None(b *testing.B) { benchmarkDecompressor(b, CompressionNone) }
+func BenchmarkDecompress_Gzip(b *testing.B) { benchmarkDecompressor(b, CompressionGzip) }
+func BenchmarkDecompress_Zstd(b *testing.B) { benchmarkDecompressor(b, CompressionZstd) }
+
+// TestCompressors_SizeComparison prints each algorithm's compressed size
+// for the representative payload as a regular test (go test -v), so the
+// ratio is visible without needing -bench.
+func TestCompressors_SizeComparison(t *testing.T) {
+	payload, err := compressResult(noneCompressor{}, representativeToolOutput(2000))
+	if err != nil {
+		t.Fatalf("failed to build payload: %v", err)
+	}
+
+	for _, algo := range []CompressionAlgorithm{CompressionNone, CompressionGzip, CompressionZstd} {
+		compressor, err := NewCompressor(algo, 0)
+		if err != nil {
+			t.Fatalf("NewCompressor(%s): %v", algo, err)
+		}
+
+		compressed, err := compressor.Compress(payload)
+		if err != nil {
+			t.Fatalf("%s: Compress: %v", algo, err)
+		}
+
+		decompressed, err := compressor.Decompress(compressed)
+		if err != nil {
+			t.Fatalf("%s: Decompress: %v", algo, err)
+		}
+		if string(decompressed) != string(payload) {
+			t.Fatalf("%s: round-trip mismatch", algo)
+		}
+
+		t.Logf("%s: %d -> %d bytes (%.1fx)", algo, len(payload), len(compressed),
+			float64(len(payload))/float64(len(compressed)))
+	}
+}
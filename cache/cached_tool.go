@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// CachedTool wraps a tools.QualityTool so Execute memoizes results per
+// file: each input file is looked up in manager by its own CacheKey
+// (content hash + tool name/version + config hash + options hash, see
+// GenerateKey) before the wrapped tool ever runs, so only cache misses
+// reach BuildCommand. This lives in the cache package rather than tools
+// itself because GenerateKey (and Manager) already depend on tools -
+// tools importing cache back would be a cycle.
+type CachedTool struct {
+	tools.QualityTool
+	manager Manager
+}
+
+// NewCachedTool wraps inner with manager-backed per-file memoization.
+func NewCachedTool(inner tools.QualityTool, manager Manager) *CachedTool {
+	return &CachedTool{QualityTool: inner, manager: manager}
+}
+
+// Execute runs the wrapped tool only on files whose cache entry is
+// missing or stale, then splices cached issues for the remaining files
+// back into the merged result.
+func (c *CachedTool) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+	if !c.manager.Enabled() || len(files) == 0 {
+		return c.QualityTool.Execute(ctx, files, options)
+	}
+
+	keys := make(map[string]CacheKey, len(files))
+	var misses []string
+	cachedIssues := make([]tools.Issue, 0)
+
+	for _, file := range files {
+		key, err := GenerateKey(file, c.QualityTool, options)
+		if err != nil {
+			// Can't hash this file (e.g. it vanished) - let the real
+			// tool deal with it rather than silently dropping it.
+			misses = append(misses, file)
+			continue
+		}
+		keys[file] = key
+
+		cached, err := c.manager.Get(key)
+		if err != nil {
+			misses = append(misses, file)
+			continue
+		}
+		cachedIssues = append(cachedIssues, cached.Result.Issues...)
+	}
+
+	if len(misses) == 0 {
+		return &tools.Result{
+			Tool:           c.QualityTool.Name(),
+			Language:       c.QualityTool.Language(),
+			Success:        true,
+			FilesProcessed: len(files),
+			Issues:         cachedIssues,
+			Cached:         true,
+		}, nil
+	}
+
+	fresh, err := c.QualityTool.Execute(ctx, misses, options)
+	if err != nil {
+		return fresh, err
+	}
+
+	if fresh.Success {
+		c.storePerFile(misses, fresh.Issues, keys)
+		c.indexConfigFiles(misses, keys, options.ProjectRoot)
+	}
+
+	merged := *fresh
+	merged.FilesProcessed = len(files)
+	merged.Issues = append(append([]tools.Issue{}, cachedIssues...), fresh.Issues...)
+
+	return &merged, nil
+}
+
+// Peek looks up every file's cache entry without running the wrapped
+// tool, the same lookup Execute does before deciding whether it needs to
+// run anything. It reports a hit only when every file in files is
+// cached, so a caller (e.g. ParallelExecutor, deciding whether to report
+// this task as "worker busy" before running it) can tell a fast, fully
+// cached task apart from one that's about to actually invoke the tool.
+func (c *CachedTool) Peek(files []string, options tools.ExecuteOptions) (*tools.Result, bool) {
+	if !c.manager.Enabled() || len(files) == 0 {
+		return nil, false
+	}
+
+	cachedIssues := make([]tools.Issue, 0)
+	for _, file := range files {
+		key, err := GenerateKey(file, c.QualityTool, options)
+		if err != nil {
+			return nil, false
+		}
+
+		cached, err := c.manager.Get(key)
+		if err != nil {
+			return nil, false
+		}
+		cachedIssues = append(cachedIssues, cached.Result.Issues...)
+	}
+
+	return &tools.Result{
+		Tool:           c.QualityTool.Name(),
+		Language:       c.QualityTool.Language(),
+		Success:        true,
+		FilesProcessed: len(files),
+		Issues:         cachedIssues,
+		Cached:         true,
+	}, true
+}
+
+// storePerFile splits a multi-file tool run's issues out by file and
+// writes one cache entry per missed file, so a later run that only
+// touches a subset of these files can reuse the rest without re-running
+// the tool on them too.
+func (c *CachedTool) storePerFile(files []string, issues []tools.Issue, keys map[string]CacheKey) {
+	byFile := make(map[string][]tools.Issue, len(files))
+	for _, issue := range issues {
+		byFile[issue.File] = append(byFile[issue.File], issue)
+	}
+
+	for _, file := range files {
+		key, ok := keys[file]
+		if !ok {
+			continue
+		}
+
+		_ = c.manager.Set(key, &tools.Result{
+			Tool:     c.QualityTool.Name(),
+			Language: c.QualityTool.Language(),
+			Success:  true,
+			Issues:   byFile[file],
+			Duration: "0s",
+		})
+	}
+}
+
+// indexConfigFiles registers each of files' freshly-written entries
+// against every config file the wrapped tool reads, so a later edit to
+// one of those files (see CacheManager.InvalidateByConfigFile) can
+// invalidate exactly these entries instead of waiting for ConfigHash to
+// naturally miss on the next run.
+func (c *CachedTool) indexConfigFiles(files []string, keys map[string]CacheKey, projectRoot string) {
+	configFiles := c.QualityTool.FindConfigFiles(projectRoot)
+	if len(configFiles) == 0 {
+		return
+	}
+
+	for _, file := range files {
+		key, ok := keys[file]
+		if !ok {
+			continue
+		}
+		for _, configFile := range configFiles {
+			c.manager.IndexConfigFile(configFile, key)
+		}
+	}
+}
+
+// Ensure CachedTool implements QualityTool.
+var _ tools.QualityTool = (*CachedTool)(nil)
@@ -0,0 +1,177 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// countingTool is a mockTool that counts Execute calls and returns one
+// Issue per file, so tests can tell whether CachedTool actually skipped
+// re-running it on a cache hit.
+type countingTool struct {
+	mockTool
+	executions int
+}
+
+func (c *countingTool) Execute(_ context.Context, files []string, _ tools.ExecuteOptions) (*tools.Result, error) {
+	c.executions++
+
+	issues := make([]tools.Issue, 0, len(files))
+	for _, f := range files {
+		issues = append(issues, tools.Issue{File: f, Line: 1, Severity: "warning", Rule: "r", Message: "m"})
+	}
+
+	return &tools.Result{
+		Tool:           c.name,
+		Language:       "Go",
+		Success:        true,
+		FilesProcessed: len(files),
+		Issues:         issues,
+		Duration:       "1ms",
+	}, nil
+}
+
+func newTestCacheManager(t *testing.T) Manager {
+	t.Helper()
+
+	manager, err := NewCacheManager(filepath.Join(t.TempDir(), "cache"), 100*1024*1024, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	t.Cleanup(func() { manager.Close() })
+
+	return manager
+}
+
+func TestCachedTool_Execute_MissThenHitSkipsWrappedTool(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "main.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	inner := &countingTool{mockTool: mockTool{name: "gofumpt", version: "v0.7.0"}}
+	cached := NewCachedTool(inner, newTestCacheManager(t))
+
+	options := tools.ExecuteOptions{ProjectRoot: tmpDir}
+
+	result, err := cached.Execute(context.Background(), []string{testFile}, options)
+	if err != nil {
+		t.Fatalf("Execute (miss) failed: %v", err)
+	}
+	if result.Cached {
+		t.Error("first run should not be reported as Cached")
+	}
+	if inner.executions != 1 {
+		t.Fatalf("expected 1 execution after a cache miss, got %d", inner.executions)
+	}
+
+	result, err = cached.Execute(context.Background(), []string{testFile}, options)
+	if err != nil {
+		t.Fatalf("Execute (hit) failed: %v", err)
+	}
+	if !result.Cached {
+		t.Error("second run should be reported as Cached")
+	}
+	if inner.executions != 1 {
+		t.Fatalf("expected wrapped tool to stay at 1 execution on a cache hit, got %d", inner.executions)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].File != testFile {
+		t.Fatalf("expected cached issue for %s, got %+v", testFile, result.Issues)
+	}
+}
+
+func TestCachedTool_Execute_FileChangeInvalidatesThatFileOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	fileA := filepath.Join(tmpDir, "a.go")
+	fileB := filepath.Join(tmpDir, "b.go")
+	for _, f := range []string{fileA, fileB} {
+		if err := os.WriteFile(f, []byte("package main\n"), 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+	}
+
+	inner := &countingTool{mockTool: mockTool{name: "gofumpt", version: "v0.7.0"}}
+	cached := NewCachedTool(inner, newTestCacheManager(t))
+	options := tools.ExecuteOptions{ProjectRoot: tmpDir}
+
+	if _, err := cached.Execute(context.Background(), []string{fileA, fileB}, options); err != nil {
+		t.Fatalf("initial Execute failed: %v", err)
+	}
+	if inner.executions != 1 {
+		t.Fatalf("expected 1 execution, got %d", inner.executions)
+	}
+
+	if err := os.WriteFile(fileA, []byte("package main\n\nfunc main() {}\n"), 0644); err != nil {
+		t.Fatalf("rewrite failed: %v", err)
+	}
+
+	result, err := cached.Execute(context.Background(), []string{fileA, fileB}, options)
+	if err != nil {
+		t.Fatalf("second Execute failed: %v", err)
+	}
+	if result.Cached {
+		t.Error("a run with at least one miss should not be reported as fully Cached")
+	}
+	if inner.executions != 2 {
+		t.Fatalf("expected the wrapped tool to re-run once more for the changed file, got %d executions", inner.executions)
+	}
+	if len(result.Issues) != 2 {
+		t.Fatalf("expected both files' issues merged back, got %+v", result.Issues)
+	}
+}
+
+func TestCachedTool_Peek_ReportsHitOnlyWhenEveryFileCached(t *testing.T) {
+	tmpDir := t.TempDir()
+	fileA := filepath.Join(tmpDir, "a.go")
+	fileB := filepath.Join(tmpDir, "b.go")
+	// Distinct content so a.go and b.go get distinct cache keys - CacheKey
+	// is content-addressed and deliberately doesn't include FilePath (see
+	// CacheKey.String()), so same-content files would otherwise collide
+	// on the same entry and defeat this test's per-file hit/miss checks.
+	if err := os.WriteFile(fileA, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	if err := os.WriteFile(fileB, []byte("package main\n\nvar _ = 1\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	inner := &countingTool{mockTool: mockTool{name: "gofumpt", version: "v0.7.0"}}
+	cached := NewCachedTool(inner, newTestCacheManager(t))
+	options := tools.ExecuteOptions{ProjectRoot: tmpDir}
+
+	if _, ok := cached.Peek([]string{fileA, fileB}, options); ok {
+		t.Error("Peek should miss before anything has been cached")
+	}
+
+	if _, err := cached.Execute(context.Background(), []string{fileA}, options); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	if _, ok := cached.Peek([]string{fileA, fileB}, options); ok {
+		t.Error("Peek should still miss while b.go hasn't been cached")
+	}
+
+	if _, err := cached.Execute(context.Background(), []string{fileB}, options); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	result, ok := cached.Peek([]string{fileA, fileB}, options)
+	if !ok {
+		t.Fatal("Peek should hit once both files are cached")
+	}
+	if !result.Cached || len(result.Issues) != 2 {
+		t.Fatalf("expected a fully cached result with 2 issues, got %+v", result)
+	}
+	if inner.executions != 2 {
+		t.Fatalf("Peek must never invoke the wrapped tool, got %d executions", inner.executions)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package chunker splits byte streams into content-defined chunks and
+// reassembles them from a content-addressed blob store. Cutting chunk
+// boundaries based on a rolling hash of the content, rather than at fixed
+// offsets, means inserting or deleting bytes near the start of a large
+// payload only reshuffles the chunks touching the edit - everything after
+// stays byte-identical to a previous Split of the same payload, which is
+// what lets a content-addressed store (see cache.ChunkStore) collapse
+// repeated chunks across many cache entries instead of only deduping
+// byte-identical whole payloads the way cache.PackStorage does.
+package chunker
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"iter"
+)
+
+const (
+	// MinSize is the smallest chunk Split will emit, except for the
+	// final chunk of an input shorter than MinSize itself. It bounds how
+	// much per-chunk overhead (a manifest entry, a blob-store lookup) a
+	// pathological input can incur.
+	MinSize = 16 * 1024
+
+	// MaxSize is the largest chunk Split will emit regardless of what
+	// the rolling hash says, so one unusually uniform stretch of input
+	// can't produce a single giant chunk that never matches anything.
+	MaxSize = 256 * 1024
+
+	// avgSize is the chunk size Split targets on average; splitMask is
+	// derived from it so P(cut) ~= 1/avgSize at each rolling-hash step
+	// once MinSize has been passed.
+	avgSize   = 64 * 1024
+	splitBits = 16 // log2(avgSize)
+	splitMask = (uint64(1) << splitBits) - 1
+)
+
+// Chunk is one content-defined slice of a Split input. Hash is the
+// SHA-256 of Data, so identical chunks produced from different inputs
+// (or different runs of the same input) hash identically and collapse to
+// one blob in a content-addressed store.
+type Chunk struct {
+	Hash string
+	Data []byte
+}
+
+// gearTable maps each byte value to a fixed pseudo-random 64-bit
+// constant, the FastCDC/Gear-hash trick for a rolling hash that's cheap
+// to update one byte at a time: hash = (hash << 1) + gearTable[b]. It's
+// seeded with a fixed constant (not crypto/rand) so Split's cut points
+// are reproducible across processes and builds - a prerequisite for
+// cross-run dedup to actually collapse chunks rather than reslicing
+// every input differently.
+var gearTable = buildGearTable()
+
+func buildGearTable() [256]uint64 {
+	var table [256]uint64
+	state := uint64(0x9E3779B97F4A7C15)
+	for i := range table {
+		state ^= state << 13
+		state ^= state >> 7
+		state ^= state << 17
+		table[i] = state
+	}
+	return table
+}
+
+// Split reads r to EOF and yields its content-defined chunks in order.
+// Iteration stops early if the consumer's yield returns false, or if r
+// returns an error other than io.EOF - the latter simply ends the
+// sequence without surfacing the error, matching the rest of this
+// package's iter.Seq-based API; callers that need to observe a read
+// error should use BuildManifest instead, which does.
+func Split(r io.Reader) iter.Seq[Chunk] {
+	return func(yield func(Chunk) bool) {
+		data, err := io.ReadAll(r)
+		if err != nil || len(data) == 0 {
+			return
+		}
+
+		start := 0
+		var rolling uint64
+		for i, b := range data {
+			rolling = (rolling << 1) + gearTable[b]
+
+			size := i - start + 1
+			if size < MinSize {
+				continue
+			}
+			if size >= MaxSize || rolling&splitMask == 0 {
+				if !yield(newChunk(data[start : i+1])) {
+					return
+				}
+				start = i + 1
+				rolling = 0
+			}
+		}
+
+		if start < len(data) {
+			yield(newChunk(data[start:]))
+		}
+	}
+}
+
+func newChunk(data []byte) Chunk {
+	sum := sha256.Sum256(data)
+	return Chunk{
+		Hash: hex.EncodeToString(sum[:]),
+		Data: append([]byte(nil), data...),
+	}
+}
+
+// ManifestEntry records one chunk's identity and length, in the order
+// its chunk must be concatenated to reconstruct the original bytes. It's
+// what a caller keeps in place of the bytes themselves (see
+// cache.CachedResult.Chunks).
+type ManifestEntry struct {
+	Hash string `json:"hash"`
+	Len  int    `json:"len"`
+}
+
+// BlobStore is the content-addressed backend BuildManifest writes chunks
+// to and Assemble reads them back from. cache.ChunkStore implements this
+// on top of a cache.Storage backend (PackStorage in particular, whose
+// own content-hash dedup on Write means storing the same chunk twice is
+// already cheap, but ChunkStore also tracks unique-vs-logical byte counts
+// for Manager.Stats).
+type BlobStore interface {
+	// GetBlob returns the bytes previously stored under hash.
+	GetBlob(hash string) ([]byte, error)
+
+	// PutBlob stores data under hash if it isn't already present. Safe
+	// to call redundantly - implementations are expected to treat a
+	// repeat PutBlob for a hash they already have as a cheap no-op.
+	PutBlob(hash string, data []byte) error
+}
+
+// BuildManifest splits r into chunks via Split, writing each one to store
+// and returning the manifest Assemble needs to reconstruct r's bytes.
+func BuildManifest(r io.Reader, store BlobStore) ([]ManifestEntry, error) {
+	var manifest []ManifestEntry
+
+	for chunk := range Split(r) {
+		if err := store.PutBlob(chunk.Hash, chunk.Data); err != nil {
+			return nil, fmt.Errorf("failed to store chunk %s: %w", chunk.Hash, err)
+		}
+		manifest = append(manifest, ManifestEntry{Hash: chunk.Hash, Len: len(chunk.Data)})
+	}
+
+	return manifest, nil
+}
+
+// Assemble reconstructs the bytes BuildManifest's source produced, by
+// reading each of manifest's chunks from store in order.
+func Assemble(manifest []ManifestEntry, store BlobStore) (io.Reader, error) {
+	var buf bytes.Buffer
+
+	for _, entry := range manifest {
+		data, err := store.GetBlob(entry.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("missing chunk %s: %w", entry.Hash, err)
+		}
+		if len(data) != entry.Len {
+			return nil, fmt.Errorf("chunk %s: stored length %d does not match manifest length %d", entry.Hash, len(data), entry.Len)
+		}
+		buf.Write(data)
+	}
+
+	return &buf, nil
+}
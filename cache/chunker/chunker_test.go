@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package chunker
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+// memBlobStore is an in-memory BlobStore for tests, tracking how many
+// times PutBlob actually stored a new blob so tests can assert on dedup.
+type memBlobStore struct {
+	blobs map[string][]byte
+	puts  int
+}
+
+func newMemBlobStore() *memBlobStore {
+	return &memBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *memBlobStore) GetBlob(hash string) ([]byte, error) {
+	data, ok := s.blobs[hash]
+	if !ok {
+		return nil, fmt.Errorf("no blob for %s", hash)
+	}
+	return data, nil
+}
+
+func (s *memBlobStore) PutBlob(hash string, data []byte) error {
+	if _, ok := s.blobs[hash]; ok {
+		return nil
+	}
+	s.blobs[hash] = append([]byte(nil), data...)
+	s.puts++
+	return nil
+}
+
+func repeatingInput(n int) []byte {
+	pattern := []byte("the quick brown fox jumps over the lazy dog; ")
+	data := make([]byte, 0, n)
+	for len(data) < n {
+		data = append(data, pattern...)
+	}
+	return data[:n]
+}
+
+func TestSplit_EmptyInputYieldsNoChunks(t *testing.T) {
+	count := 0
+	for range Split(bytes.NewReader(nil)) {
+		count++
+	}
+	if count != 0 {
+		t.Errorf("expected no chunks for empty input, got %d", count)
+	}
+}
+
+func TestSplit_SmallInputYieldsOneChunk(t *testing.T) {
+	data := []byte("hello world")
+	var chunks []Chunk
+	for c := range Split(bytes.NewReader(data)) {
+		chunks = append(chunks, c)
+	}
+
+	if len(chunks) != 1 {
+		t.Fatalf("expected 1 chunk for small input, got %d", len(chunks))
+	}
+	if !bytes.Equal(chunks[0].Data, data) {
+		t.Errorf("chunk data = %q, want %q", chunks[0].Data, data)
+	}
+}
+
+func TestSplit_ChunksRespectMinAndMaxSize(t *testing.T) {
+	data := repeatingInput(2 * MaxSize)
+	var chunks []Chunk
+	for c := range Split(bytes.NewReader(data)) {
+		chunks = append(chunks, c)
+	}
+
+	if len(chunks) < 2 {
+		t.Fatalf("expected multiple chunks for a %d-byte input, got %d", len(data), len(chunks))
+	}
+
+	for i, c := range chunks {
+		isLast := i == len(chunks)-1
+		if len(c.Data) > MaxSize {
+			t.Errorf("chunk %d size %d exceeds MaxSize %d", i, len(c.Data), MaxSize)
+		}
+		if !isLast && len(c.Data) < MinSize {
+			t.Errorf("non-final chunk %d size %d is below MinSize %d", i, len(c.Data), MinSize)
+		}
+	}
+}
+
+func TestSplit_IsDeterministicAcrossRuns(t *testing.T) {
+	data := repeatingInput(3 * avgSize)
+
+	var first, second []Chunk
+	for c := range Split(bytes.NewReader(data)) {
+		first = append(first, c)
+	}
+	for c := range Split(bytes.NewReader(data)) {
+		second = append(second, c)
+	}
+
+	if len(first) != len(second) {
+		t.Fatalf("chunk count differs across runs: %d vs %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i].Hash != second[i].Hash {
+			t.Errorf("chunk %d hash differs across runs: %s vs %s", i, first[i].Hash, second[i].Hash)
+		}
+	}
+}
+
+func TestSplit_InsertionOnlyReshufflesNearbyChunks(t *testing.T) {
+	// Pseudo-random rather than repeatingInput: a periodic input gives the
+	// rolling hash a periodic value, so cuts only ever fall at MaxSize and
+	// there are no content-defined boundaries to resynchronize on.
+	original := make([]byte, 4*avgSize)
+	rand.New(rand.NewSource(1)).Read(original)
+
+	var before []Chunk
+	for c := range Split(bytes.NewReader(original)) {
+		before = append(before, c)
+	}
+
+	edited := append([]byte(nil), original[:avgSize]...)
+	edited = append(edited, []byte("INSERTED-CONTENT-THAT-WASNT-THERE-BEFORE")...)
+	edited = append(edited, original[avgSize:]...)
+
+	var after []Chunk
+	for c := range Split(bytes.NewReader(edited)) {
+		after = append(after, c)
+	}
+
+	beforeHashes := make(map[string]bool, len(before))
+	for _, c := range before {
+		beforeHashes[c.Hash] = true
+	}
+
+	matched := 0
+	for _, c := range after {
+		if beforeHashes[c.Hash] {
+			matched++
+		}
+	}
+
+	if matched == 0 {
+		t.Error("expected at least one chunk to survive an insertion elsewhere in the input")
+	}
+}
+
+func TestBuildManifestAssemble_RoundTrips(t *testing.T) {
+	data := repeatingInput(5 * avgSize)
+	store := newMemBlobStore()
+
+	manifest, err := BuildManifest(bytes.NewReader(data), store)
+	if err != nil {
+		t.Fatalf("BuildManifest failed: %v", err)
+	}
+
+	reader, err := Assemble(manifest, store)
+	if err != nil {
+		t.Fatalf("Assemble failed: %v", err)
+	}
+
+	reassembled, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to read assembled data: %v", err)
+	}
+
+	if !bytes.Equal(reassembled, data) {
+		t.Error("Assemble(BuildManifest(data)) did not round-trip to the original bytes")
+	}
+}
+
+func TestBuildManifest_DedupsRepeatedChunksAcrossInputs(t *testing.T) {
+	shared := repeatingInput(3 * avgSize)
+	store := newMemBlobStore()
+
+	first, err := BuildManifest(bytes.NewReader(shared), store)
+	if err != nil {
+		t.Fatalf("BuildManifest (first) failed: %v", err)
+	}
+	putsAfterFirst := store.puts
+
+	second, err := BuildManifest(bytes.NewReader(shared), store)
+	if err != nil {
+		t.Fatalf("BuildManifest (second) failed: %v", err)
+	}
+
+	if store.puts != putsAfterFirst {
+		t.Errorf("second BuildManifest of identical content wrote %d new blobs, want 0", store.puts-putsAfterFirst)
+	}
+	if len(first) != len(second) {
+		t.Fatalf("manifest length differs for identical input: %d vs %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i].Hash != second[i].Hash {
+			t.Errorf("manifest entry %d hash differs for identical input", i)
+		}
+	}
+}
+
+func TestAssemble_MissingChunkErrors(t *testing.T) {
+	store := newMemBlobStore()
+	manifest := []ManifestEntry{{Hash: "deadbeef", Len: 4}}
+
+	if _, err := Assemble(manifest, store); err == nil {
+		t.Error("expected an error when a manifest chunk is missing from the store")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/Gizzahub/gzh-cli-quality/cache/chunker"
+)
+
+// chunkStoreTool is the fixed Storage "tool" bucket chunk blobs are
+// written under, keeping them out of the way of any real tool's entries
+// (CacheKey.ToolName never collides with it since GenerateKey only ever
+// produces real tool names).
+const chunkStoreTool = "chunks"
+
+// ChunkStore adapts a Storage backend into a chunker.BlobStore, keyed by
+// chunk hash rather than CacheKey. Built on PackStorage in particular,
+// this gets the pack-file backend's own content-hash dedup on Write for
+// free - two entries' manifests referencing the same chunk hash both
+// resolve to the one blob PackStorage already wrote - but ChunkStore
+// works against any Storage implementation.
+//
+// It also tracks unique vs. logical bytes across every PutBlob this
+// process has made, for Manager.Stats' dedup-ratio fields. The
+// bookkeeping is in-memory only and resets with the process - an
+// approximation documented on UniqueBytes/LogicalBytes rather than a
+// persisted ledger, since the actual space savings are already visible
+// in Storage.Size regardless of whether this process remembers having
+// produced them.
+type ChunkStore struct {
+	storage Storage
+
+	mu    sync.Mutex
+	known map[string]struct{}
+
+	uniqueBytes  atomic.Int64
+	logicalBytes atomic.Int64
+}
+
+// NewChunkStore creates a ChunkStore that stores chunk blobs in storage.
+func NewChunkStore(storage Storage) *ChunkStore {
+	return &ChunkStore{
+		storage: storage,
+		known:   make(map[string]struct{}),
+	}
+}
+
+// GetBlob implements chunker.BlobStore.
+func (cs *ChunkStore) GetBlob(hash string) ([]byte, error) {
+	return cs.storage.Read(hash, chunkStoreTool)
+}
+
+// PutBlob implements chunker.BlobStore, skipping the underlying Write
+// (and leaving Storage's own dedup out of it entirely) once this process
+// has already seen hash, since BuildManifest calls PutBlob once per
+// chunk occurrence including repeats within and across entries.
+func (cs *ChunkStore) PutBlob(hash string, data []byte) error {
+	cs.mu.Lock()
+	_, seen := cs.known[hash]
+	if !seen {
+		cs.known[hash] = struct{}{}
+	}
+	cs.mu.Unlock()
+
+	cs.logicalBytes.Add(int64(len(data)))
+	if seen {
+		return nil
+	}
+
+	cs.uniqueBytes.Add(int64(len(data)))
+	return cs.storage.Write(hash, chunkStoreTool, data)
+}
+
+// UniqueBytes returns the total size of the distinct chunks this process
+// has written (or already knew about) - the bytes the chunk store
+// actually holds once, as opposed to LogicalBytes.
+func (cs *ChunkStore) UniqueBytes() int64 { return cs.uniqueBytes.Load() }
+
+// LogicalBytes returns the sum of every chunk PutBlob has been asked to
+// store, including ones that turned out to already be known - i.e. the
+// size cache entries would occupy without chunk-level dedup.
+func (cs *ChunkStore) LogicalBytes() int64 { return cs.logicalBytes.Load() }
+
+var _ chunker.BlobStore = (*ChunkStore)(nil)
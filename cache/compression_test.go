@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import "testing"
+
+func TestNewCompressor_RoundTripsForEveryAlgorithm(t *testing.T) {
+	data := []byte(`{"issues":[{"file":"a.py","message":"line too long"}]}`)
+
+	for _, algo := range []CompressionAlgorithm{CompressionNone, CompressionGzip, CompressionZstd} {
+		compressor, err := NewCompressor(algo, 0)
+		if err != nil {
+			t.Fatalf("NewCompressor(%s): %v", algo, err)
+		}
+		if compressor.Algorithm() != algo {
+			t.Fatalf("Algorithm() = %s, want %s", compressor.Algorithm(), algo)
+		}
+
+		compressed, err := compressor.Compress(data)
+		if err != nil {
+			t.Fatalf("%s: Compress: %v", algo, err)
+		}
+
+		decompressed, err := compressor.Decompress(compressed)
+		if err != nil {
+			t.Fatalf("%s: Decompress: %v", algo, err)
+		}
+
+		if string(decompressed) != string(data) {
+			t.Fatalf("%s: round-trip = %q, want %q", algo, decompressed, data)
+		}
+	}
+}
+
+func TestNewCompressor_EmptyAlgorithmMeansNone(t *testing.T) {
+	compressor, err := NewCompressor("", 0)
+	if err != nil {
+		t.Fatalf("NewCompressor(\"\"): %v", err)
+	}
+	if compressor.Algorithm() != CompressionNone {
+		t.Fatalf("Algorithm() = %s, want %s", compressor.Algorithm(), CompressionNone)
+	}
+
+	data := []byte("hello world")
+	compressed, err := compressor.Compress(data)
+	if err != nil {
+		t.Fatalf("Compress: %v", err)
+	}
+	if string(compressed) != string(data) {
+		t.Fatalf("none compressor must pass data through unchanged, got %q", compressed)
+	}
+}
+
+func TestNewCompressor_UnknownAlgorithmErrors(t *testing.T) {
+	if _, err := NewCompressor("lz4", 0); err == nil {
+		t.Fatal("expected an error for an unknown compression algorithm")
+	}
+}
+
+func TestZstdCompressor_SmallerThanInputForRepetitiveData(t *testing.T) {
+	compressor, err := NewCompressor(CompressionZstd, 0)
+	if err != nil {
+		t.Fatalf("NewCompressor(zstd): %v", err)
+	}
+
+	data := make([]byte, 0, 4096)
+	for i := 0; i < 200; i++ {
+		data = append(data, []byte(`{"file":"a.py","line":10,"message":"line too long"},`)...)
+	}
+
+	compressed, err := compressor.Compress(data)
+	if err != nil {
+		t.Fatalf("Compress: %v", err)
+	}
+
+	if len(compressed) >= len(data) {
+		t.Fatalf("expected compressed size (%d) to be smaller than input (%d)", len(compressed), len(data))
+	}
+}
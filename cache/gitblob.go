@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// gitBlobHash returns the blob SHA Git already computed and stored in its
+// index for filePath under projectRoot, if filePath is tracked and the
+// working tree copy matches the index exactly. That lets GenerateKey reuse
+// a hash Git has already done the work for instead of reading and SHA256-ing
+// the file ourselves. It returns ok=false (never an error) for anything
+// that isn't a clean, tracked file - untracked files, a dirty working tree,
+// or projectRoot not being a Git repository - so callers can fall back to
+// hashFile unconditionally.
+func gitBlobHash(projectRoot, filePath string) (hash string, ok bool) {
+	rel, err := filepath.Rel(projectRoot, filePath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	rel = filepath.ToSlash(rel)
+
+	statusCmd := exec.Command("git", "status", "--porcelain", "--untracked-files=no", "--", rel)
+	statusCmd.Dir = projectRoot
+	statusOut, err := statusCmd.Output()
+	if err != nil || strings.TrimSpace(string(statusOut)) != "" {
+		// Not a git repo, the file isn't tracked, or the working copy
+		// differs from the index - the index blob SHA wouldn't reflect
+		// the file's actual content.
+		return "", false
+	}
+
+	lsCmd := exec.Command("git", "ls-files", "-s", "--", rel)
+	lsCmd.Dir = projectRoot
+	lsOut, err := lsCmd.Output()
+	if err != nil {
+		return "", false
+	}
+
+	// Format: "<mode> <blob-sha> <stage>\t<path>"
+	fields := strings.Fields(string(lsOut))
+	if len(fields) < 2 {
+		return "", false
+	}
+
+	return fields[1], true
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func initTestRepo(t *testing.T) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = tmpDir
+		if err := cmd.Run(); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+
+	run("init")
+	run("config", "user.name", "Test User")
+	run("config", "user.email", "test@example.com")
+
+	return tmpDir
+}
+
+func commitFile(t *testing.T, repoDir, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{{"add", name}, {"commit", "-m", "add " + name}} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		if err := cmd.Run(); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+}
+
+func TestGitBlobHash_TrackedAndClean(t *testing.T) {
+	repoDir := initTestRepo(t)
+	commitFile(t, repoDir, "main.go", "package main\n")
+
+	hash, ok := gitBlobHash(repoDir, filepath.Join(repoDir, "main.go"))
+	if !ok {
+		t.Fatal("expected gitBlobHash to succeed for a clean tracked file")
+	}
+	if hash == "" {
+		t.Error("hash should not be empty")
+	}
+
+	expected, err := exec.Command("git", "-C", repoDir, "hash-object", "main.go").Output()
+	if err != nil {
+		t.Fatalf("git hash-object failed: %v", err)
+	}
+	if got, want := hash, trimNewline(string(expected)); got != want {
+		t.Errorf("hash = %s, want %s", got, want)
+	}
+}
+
+func TestGitBlobHash_DirtyWorkingTree(t *testing.T) {
+	repoDir := initTestRepo(t)
+	commitFile(t, repoDir, "main.go", "package main\n")
+
+	if err := os.WriteFile(filepath.Join(repoDir, "main.go"), []byte("package main\n\n// edited\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := gitBlobHash(repoDir, filepath.Join(repoDir, "main.go")); ok {
+		t.Error("expected gitBlobHash to fall back for an uncommitted edit")
+	}
+}
+
+func TestGitBlobHash_UntrackedFile(t *testing.T) {
+	repoDir := initTestRepo(t)
+	commitFile(t, repoDir, "main.go", "package main\n")
+
+	untracked := filepath.Join(repoDir, "new.go")
+	if err := os.WriteFile(untracked, []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := gitBlobHash(repoDir, untracked); ok {
+		t.Error("expected gitBlobHash to fall back for an untracked file")
+	}
+}
+
+func TestGitBlobHash_NotAGitRepo(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "main.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := gitBlobHash(tmpDir, testFile); ok {
+		t.Error("expected gitBlobHash to fall back outside a git repository")
+	}
+}
+
+func trimNewline(s string) string {
+	for len(s) > 0 && (s[len(s)-1] == '\n' || s[len(s)-1] == '\r') {
+		s = s[:len(s)-1]
+	}
+	return s
+}
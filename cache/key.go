@@ -9,26 +9,46 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
 
+// cacheRelevantEnvVars lists the ExecuteOptions.Env names hashOptions
+// folds into the options hash. Tool output can depend on env vars like
+// GOFLAGS (go vet's build flags) or NODE_ENV (eslint/prettier dev-only
+// rules) without those ever showing up in a config file GenerateKey
+// already hashes, so they need to be part of the cache key too - but
+// hashing every key a user happens to put in a tool's Env map would make
+// the key unstable (and would fold unrelated secrets into cache
+// metadata) for no benefit, so only this fixed set is considered.
+var cacheRelevantEnvVars = []string{
+	"GOFLAGS",
+	"GOOS",
+	"GOARCH",
+	"CGO_ENABLED",
+	"NODE_ENV",
+	"PYTHONPATH",
+	"RUSTFLAGS",
+}
+
 // GenerateKey generates a cache key for a file and tool combination.
 func GenerateKey(filePath string, tool tools.QualityTool, options tools.ExecuteOptions) (CacheKey, error) {
 	// 1. Calculate file hash
-	fileHash, err := hashFile(filePath)
+	fileHash, err := contentHash(options.ProjectRoot, filePath)
 	if err != nil {
 		return CacheKey{}, fmt.Errorf("failed to hash file %s: %w", filePath, err)
 	}
 
-	// 2. Get tool version
+	// 2. Get tool version. A lookup failure must force a miss rather than
+	// collapse onto a shared "unknown" bucket - that would let a result
+	// produced by one broken tool install get served back once the tool
+	// is fixed and genuinely versioned, or vice versa.
 	toolVersion, err := tool.GetVersion()
 	if err != nil {
-		// If version cannot be determined, use "unknown"
-		// This will cause cache misses, which is safe
-		toolVersion = "unknown"
+		return CacheKey{}, fmt.Errorf("failed to determine %s version: %w", tool.Name(), err)
 	}
 
 	// 3. Calculate config hash
@@ -38,6 +58,23 @@ func GenerateKey(filePath string, tool tools.QualityTool, options tools.ExecuteO
 		return CacheKey{}, fmt.Errorf("failed to hash config files: %w", err)
 	}
 
+	// 3b. Calculate policy hash: project-wide files that aren't the
+	// tool's own config but still change what a "correct" result looks
+	// like (.editorconfig's indent_size, .gitattributes' linguist-*).
+	policyFiles := findPolicyFiles(options.ProjectRoot, filePath)
+	if pf, ok := tool.(tools.PolicyFiles); ok {
+		for _, extra := range pf.PolicyFiles(options.ProjectRoot) {
+			if !filepath.IsAbs(extra) {
+				extra = filepath.Join(options.ProjectRoot, extra)
+			}
+			policyFiles = append(policyFiles, extra)
+		}
+	}
+	policyHash, err := hashFiles(policyFiles)
+	if err != nil {
+		return CacheKey{}, fmt.Errorf("failed to hash policy files: %w", err)
+	}
+
 	// 4. Calculate options hash
 	optionsHash := hashOptions(options)
 
@@ -53,10 +90,59 @@ func GenerateKey(filePath string, tool tools.QualityTool, options tools.ExecuteO
 		ToolName:    tool.Name(),
 		ToolVersion: toolVersion,
 		ConfigHash:  configHash,
+		PolicyHash:  policyHash,
 		OptionsHash: optionsHash,
+		Platform:    runtime.GOOS + "/" + runtime.GOARCH,
 	}, nil
 }
 
+// findPolicyFiles returns every .editorconfig and .gitattributes found by
+// walking up from filePath's directory to projectRoot (inclusive), the
+// same stacking order detector.gitattributesMatcher applies - a nested
+// file's .editorconfig/.gitattributes is just as load-bearing for that
+// file's expected result as the project root's.
+func findPolicyFiles(projectRoot, filePath string) []string {
+	var files []string
+
+	absFilePath, err := filepath.Abs(filePath)
+	if err != nil {
+		absFilePath = filePath
+	}
+	absProjectRoot, err := filepath.Abs(projectRoot)
+	if err != nil {
+		absProjectRoot = projectRoot
+	}
+
+	dir := filepath.Dir(absFilePath)
+	for {
+		for _, name := range []string{".editorconfig", ".gitattributes"} {
+			files = append(files, filepath.Join(dir, name))
+		}
+
+		if dir == absProjectRoot || !strings.HasPrefix(dir, absProjectRoot+string(filepath.Separator)) {
+			break
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return files
+}
+
+// contentHash returns a hash identifying filePath's content: the blob SHA
+// Git already computed for it, if filePath is tracked under projectRoot
+// and clean, or a SHA256 of its content otherwise.
+func contentHash(projectRoot, filePath string) (string, error) {
+	if hash, ok := gitBlobHash(projectRoot, filePath); ok {
+		return hash, nil
+	}
+	return hashFile(filePath)
+}
+
 // hashFile calculates SHA256 hash of a file's content.
 func hashFile(filePath string) (string, error) {
 	content, err := os.ReadFile(filePath)
@@ -84,15 +170,19 @@ func hashFiles(filePaths []string) (string, error) {
 
 	// Hash each file's content
 	for _, path := range sortedPaths {
+		hasher.Write([]byte(path))
+
 		content, err := os.ReadFile(path)
 		if err != nil {
-			// If config file doesn't exist, skip it
-			// This is common (e.g., .prettierrc may not exist)
+			// Config file doesn't exist - common (e.g. .prettierrc is
+			// optional). Write a marker distinct from any real content
+			// so that differs from a same-named file that does exist
+			// but happens to be empty, and so two different absent
+			// files don't collapse onto the same digest as each other.
+			hasher.Write([]byte("<absent>"))
 			continue
 		}
 
-		// Write file path and content to hasher
-		hasher.Write([]byte(path))
 		hasher.Write(content)
 	}
 
@@ -133,16 +223,73 @@ func hashOptions(options tools.ExecuteOptions) string {
 		hasher.Write([]byte(strings.Join(sortedArgs, ",")))
 	}
 
-	// Env variables (sorted by key)
-	if len(options.Env) > 0 {
-		var keys []string
-		for k := range options.Env {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
+	// BaseRef (incremental "since <ref>" runs must not collide with full
+	// scans or with diffs against a different ref)
+	if options.BaseRef != "" {
+		hasher.Write([]byte("base-ref:"))
+		hasher.Write([]byte(options.BaseRef))
+	}
+
+	// Shard/TotalShards (a shard's result is only valid for that exact
+	// partitioning - caching it under a key that ignores sharding would
+	// serve shard 0's result to every other shard)
+	if options.TotalShards > 1 {
+		fmt.Fprintf(hasher, "shard:%d/%d", options.Shard, options.TotalShards)
+	}
+
+	// CompileFlags (sorted for determinism; changes what ClangTidyTool
+	// resolves includes/defines against)
+	if len(options.CompileFlags) > 0 {
+		sortedFlags := make([]string, len(options.CompileFlags))
+		copy(sortedFlags, options.CompileFlags)
+		sort.Strings(sortedFlags)
+
+		hasher.Write([]byte("compile-flags:"))
+		hasher.Write([]byte(strings.Join(sortedFlags, ",")))
+	}
+
+	// BuildOutput (BufTool's build mode produces a different artifact
+	// than its lint mode, so it must not share a cache entry with it)
+	if options.BuildOutput != "" {
+		hasher.Write([]byte("build-output:"))
+		hasher.Write([]byte(options.BuildOutput))
+	}
+
+	// SQLDialect/SQLTemplater (SqlfluffTool's output differs by dialect
+	// and templater, whether auto-detected or overridden)
+	if options.SQLDialect != "" {
+		hasher.Write([]byte("sql-dialect:"))
+		hasher.Write([]byte(options.SQLDialect))
+	}
+	if options.SQLTemplater != "" {
+		hasher.Write([]byte("sql-templater:"))
+		hasher.Write([]byte(options.SQLTemplater))
+	}
+
+	// LocalPrefixes (GoimportsTool/GciTool group imports differently
+	// depending on which prefixes are "local", whether auto-detected or
+	// overridden)
+	if len(options.LocalPrefixes) > 0 {
+		sortedPrefixes := make([]string, len(options.LocalPrefixes))
+		copy(sortedPrefixes, options.LocalPrefixes)
+		sort.Strings(sortedPrefixes)
+
+		hasher.Write([]byte("local-prefixes:"))
+		hasher.Write([]byte(strings.Join(sortedPrefixes, ",")))
+	}
 
-		for _, k := range keys {
-			fmt.Fprintf(hasher, "env:%s=%s", k, options.Env[k])
+	// Env variables: only the whitelist in cacheRelevantEnvVars, not
+	// every key in options.Env - a tool-specific env map can carry
+	// entries (PATH overrides, credentials) that don't change output in
+	// a way worth invalidating over, or that we'd rather not fold into
+	// a cache key at all. A whitelisted name absent from options.Env
+	// still gets a distinct "absent" marker so "not set" never hashes
+	// the same as "set to the empty string".
+	for _, name := range cacheRelevantEnvVars {
+		if v, ok := options.Env[name]; ok {
+			fmt.Fprintf(hasher, "env:%s=%s", name, v)
+		} else {
+			fmt.Fprintf(hasher, "env:%s=<absent>", name)
 		}
 	}
 
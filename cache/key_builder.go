@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// KeyBuilder derives one structural CacheKey per tools.Task by walking
+// every semantic input that affects the task's output - tool name,
+// version and binary content, resolved config file contents, every input
+// file's content, and the task's effective ExecuteOptions - and folding
+// them into a single SHA256 digest, in the spirit of a structural hasher
+// like mitchellh/hashstructure. Unlike GenerateKey (one CacheKey per
+// file), ForTask produces one key per task so ExecutionPlanner can
+// short-circuit a whole task whose files are untouched even across a
+// `git checkout` or plain mtime churn that never changed file bytes.
+//
+// KeyBuilder memoizes per-file content hashes across ForTask calls, so a
+// plan with several tasks sharing input files (e.g. a FORMAT and a LINT
+// tool both running on the same Go files) hashes each file only once.
+type KeyBuilder struct {
+	mu         sync.Mutex
+	fileHashes map[string]string // absolute/relative path, as given -> content hash
+}
+
+// NewKeyBuilder creates a KeyBuilder with an empty memoization cache.
+// Typical usage is one KeyBuilder per ExecutionPlan.
+func NewKeyBuilder() *KeyBuilder {
+	return &KeyBuilder{fileHashes: make(map[string]string)}
+}
+
+// ForTask derives a structural CacheKey for task.
+func (b *KeyBuilder) ForTask(task tools.Task) (CacheKey, error) {
+	toolVersion, err := task.Tool.GetVersion()
+	if err != nil {
+		// If version cannot be determined, return an error so the task
+		// gets no key at all - this forces a cache miss, which is safe.
+		return CacheKey{}, fmt.Errorf("failed to determine %s version: %w", task.Tool.Name(), err)
+	}
+
+	binaryHash, err := b.hashToolBinary(task.Tool)
+	if err != nil {
+		binaryHash = "unknown"
+	}
+
+	configHash, err := hashFiles(task.Tool.FindConfigFiles(task.Options.ProjectRoot))
+	if err != nil {
+		return CacheKey{}, fmt.Errorf("failed to hash config files: %w", err)
+	}
+
+	fileHashes, err := b.hashFilesParallel(task.Options.ProjectRoot, task.Files)
+	if err != nil {
+		return CacheKey{}, fmt.Errorf("failed to hash task files: %w", err)
+	}
+
+	optionsHash := hashOptions(task.Options)
+
+	sortedFiles := append([]string{}, task.Files...)
+	sort.Strings(sortedFiles)
+
+	digest := sha256.New()
+	fmt.Fprintf(digest, "tool:%s\n", task.Tool.Name())
+	fmt.Fprintf(digest, "version:%s\n", toolVersion)
+	fmt.Fprintf(digest, "binary:%s\n", binaryHash)
+	fmt.Fprintf(digest, "config:%s\n", configHash)
+	fmt.Fprintf(digest, "options:%s\n", optionsHash)
+	for _, file := range sortedFiles {
+		fmt.Fprintf(digest, "file:%s=%s\n", file, fileHashes[file])
+	}
+
+	return CacheKey{
+		FilePath:    strings.Join(sortedFiles, "|"),
+		FileHash:    hex.EncodeToString(digest.Sum(nil)),
+		ToolName:    task.Tool.Name(),
+		ToolVersion: toolVersion,
+		ConfigHash:  configHash,
+		OptionsHash: optionsHash,
+		Platform:    runtime.GOOS + "/" + runtime.GOARCH,
+	}, nil
+}
+
+// hashFilesParallel hashes every file in files (content, not mtime) using
+// contentHash, skipping any file already memoized from an earlier
+// ForTask call and hashing the rest concurrently.
+func (b *KeyBuilder) hashFilesParallel(projectRoot string, files []string) (map[string]string, error) {
+	hashes := make(map[string]string, len(files))
+
+	var toHash []string
+	b.mu.Lock()
+	for _, file := range files {
+		if hash, ok := b.fileHashes[file]; ok {
+			hashes[file] = hash
+			continue
+		}
+		toHash = append(toHash, file)
+	}
+	b.mu.Unlock()
+
+	if len(toHash) == 0 {
+		return hashes, nil
+	}
+
+	type hashResult struct {
+		file string
+		hash string
+		err  error
+	}
+
+	results := make(chan hashResult, len(toHash))
+	var wg sync.WaitGroup
+	for _, file := range toHash {
+		wg.Add(1)
+		go func(file string) {
+			defer wg.Done()
+			hash, err := contentHash(projectRoot, file)
+			results <- hashResult{file: file, hash: hash, err: err}
+		}(file)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var firstErr error
+	fresh := make(map[string]string, len(toHash))
+	for result := range results {
+		if result.err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to hash file %s: %w", result.file, result.err)
+			}
+			continue
+		}
+		fresh[result.file] = result.hash
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	b.mu.Lock()
+	for file, hash := range fresh {
+		b.fileHashes[file] = hash
+		hashes[file] = hash
+	}
+	b.mu.Unlock()
+
+	return hashes, nil
+}
+
+// hashToolBinary hashes the content of task.Tool's resolved executable,
+// so a rebuilt/upgraded binary that still reports the same --version
+// still misses cache.
+func (b *KeyBuilder) hashToolBinary(tool tools.QualityTool) (string, error) {
+	path, err := exec.LookPath(tool.Name())
+	if err != nil {
+		return "", err
+	}
+	return hashFile(path)
+}
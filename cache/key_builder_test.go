@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+func TestKeyBuilder_ForTask(t *testing.T) {
+	tmpDir := t.TempDir()
+	file1 := filepath.Join(tmpDir, "file1.go")
+	file2 := filepath.Join(tmpDir, "file2.go")
+
+	if err := os.WriteFile(file1, []byte("package main"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file2, []byte("package test"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := &mockTool{name: "cat", version: "v1.0.0"}
+	task := tools.Task{
+		Tool:    tool,
+		Files:   []string{file1, file2},
+		Options: tools.ExecuteOptions{ProjectRoot: tmpDir},
+	}
+
+	builder := NewKeyBuilder()
+
+	key, err := builder.ForTask(task)
+	if err != nil {
+		t.Fatalf("ForTask failed: %v", err)
+	}
+
+	if key.FileHash == "" {
+		t.Error("FileHash is empty")
+	}
+	if key.ToolName != "cat" {
+		t.Errorf("ToolName = %s, want cat", key.ToolName)
+	}
+	if key.ToolVersion != "v1.0.0" {
+		t.Errorf("ToolVersion = %s, want v1.0.0", key.ToolVersion)
+	}
+}
+
+func TestKeyBuilder_ForTask_Deterministic(t *testing.T) {
+	tmpDir := t.TempDir()
+	file1 := filepath.Join(tmpDir, "file1.go")
+	if err := os.WriteFile(file1, []byte("package main"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := &mockTool{name: "cat", version: "v1.0.0"}
+	task := tools.Task{
+		Tool:    tool,
+		Files:   []string{file1},
+		Options: tools.ExecuteOptions{ProjectRoot: tmpDir},
+	}
+
+	builder := NewKeyBuilder()
+
+	key1, err := builder.ForTask(task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key2, err := builder.ForTask(task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key1.FileHash != key2.FileHash {
+		t.Error("ForTask should be deterministic for identical tasks")
+	}
+}
+
+func TestKeyBuilder_ForTask_DifferentFileContentChangesKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	file1 := filepath.Join(tmpDir, "file1.go")
+	if err := os.WriteFile(file1, []byte("package main"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := &mockTool{name: "cat", version: "v1.0.0"}
+	task := tools.Task{
+		Tool:    tool,
+		Files:   []string{file1},
+		Options: tools.ExecuteOptions{ProjectRoot: tmpDir},
+	}
+
+	builder := NewKeyBuilder()
+
+	key1, err := builder.ForTask(task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file1, []byte("package changed"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A fresh builder, since the first one memoized file1's old hash.
+	key2, err := NewKeyBuilder().ForTask(task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key1.FileHash == key2.FileHash {
+		t.Error("expected different structural hash after file content changed")
+	}
+}
+
+func TestKeyBuilder_MemoizesFileHashesAcrossTasks(t *testing.T) {
+	tmpDir := t.TempDir()
+	shared := filepath.Join(tmpDir, "shared.go")
+	if err := os.WriteFile(shared, []byte("package main"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	builder := NewKeyBuilder()
+
+	task1 := tools.Task{
+		Tool:    &mockTool{name: "cat", version: "v1.0.0"},
+		Files:   []string{shared},
+		Options: tools.ExecuteOptions{ProjectRoot: tmpDir},
+	}
+	if _, err := builder.ForTask(task1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Mutate the file on disk after it was memoized; a second tool's task
+	// over the same file should reuse the memoized hash rather than
+	// re-reading the now-different content.
+	if err := os.WriteFile(shared, []byte("package mutated"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	builder.mu.Lock()
+	memoizedHash, ok := builder.fileHashes[shared]
+	builder.mu.Unlock()
+	if !ok {
+		t.Fatal("expected shared.go's hash to be memoized after the first ForTask call")
+	}
+
+	task2 := tools.Task{
+		Tool:    &mockTool{name: "cat", version: "v2.0.0"},
+		Files:   []string{shared},
+		Options: tools.ExecuteOptions{ProjectRoot: tmpDir},
+	}
+	if _, err := builder.ForTask(task2); err != nil {
+		t.Fatal(err)
+	}
+
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	if builder.fileHashes[shared] != memoizedHash {
+		t.Error("expected memoized file hash to remain unchanged across tasks")
+	}
+}
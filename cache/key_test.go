@@ -5,6 +5,7 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -23,10 +24,11 @@ func (m *mockTool) Name() string                              { return m.name }
 func (m *mockTool) Language() string                          { return "Go" }
 func (m *mockTool) Type() tools.ToolType                      { return tools.FORMAT }
 func (m *mockTool) IsAvailable() bool                         { return true }
-func (m *mockTool) Install() error                            { return nil }
+func (m *mockTool) Install(ctx context.Context) error          { return nil }
 func (m *mockTool) GetVersion() (string, error)               { return m.version, nil }
-func (m *mockTool) Upgrade() error                            { return nil }
+func (m *mockTool) Upgrade(ctx context.Context) error          { return nil }
 func (m *mockTool) FindConfigFiles(root string) []string      { return m.configs }
+func (m *mockTool) SupportedExtensions() []string             { return []string{".go"} }
 func (m *mockTool) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
 	return nil, nil
 }
@@ -136,6 +138,140 @@ func TestGenerateKey_DifferentOptions(t *testing.T) {
 	}
 }
 
+// versionFailureTool is a mockTool whose GetVersion always fails, as if
+// the binary disappeared or stopped responding to every version flag.
+type versionFailureTool struct {
+	mockTool
+}
+
+func (v *versionFailureTool) GetVersion() (string, error) {
+	return "", fmt.Errorf("version lookup failed")
+}
+
+func TestGenerateKey_VersionLookupFailureForcesMiss(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := &versionFailureTool{mockTool: mockTool{name: "gofumpt"}}
+
+	_, err := GenerateKey(testFile, tool, tools.ExecuteOptions{ProjectRoot: tmpDir})
+	if err == nil {
+		t.Fatal("expected GenerateKey to fail when GetVersion fails, got nil error")
+	}
+}
+
+func TestGenerateKey_AbsentConfigFilesDistinguishedByName(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither .eslintrc.json nor .eslintrc.yaml exists, but they're
+	// different absent files - the resulting ConfigHash must not
+	// collapse to the same value as a different absent-file set would.
+	toolA := &mockTool{name: "eslint", version: "8.0.0", configs: []string{filepath.Join(tmpDir, ".eslintrc.json")}}
+	toolB := &mockTool{name: "eslint", version: "8.0.0", configs: []string{filepath.Join(tmpDir, ".eslintrc.yaml")}}
+
+	keyA, err := GenerateKey(testFile, toolA, tools.ExecuteOptions{ProjectRoot: tmpDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyB, err := GenerateKey(testFile, toolB, tools.ExecuteOptions{ProjectRoot: tmpDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if keyA.ConfigHash == keyB.ConfigHash {
+		t.Error("ConfigHash should differ when different (absent) config file names are declared")
+	}
+}
+
+func TestGenerateKey_PolicyHashChangesWithEditorconfigAndGitattributes(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	options := tools.ExecuteOptions{ProjectRoot: tmpDir}
+
+	before, err := GenerateKey(testFile, tool, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpDir, ".editorconfig"), []byte("[*]\nindent_size = 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	afterEditorconfig, err := GenerateKey(testFile, tool, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.PolicyHash == afterEditorconfig.PolicyHash {
+		t.Error("PolicyHash should change when .editorconfig appears")
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("*.go linguist-generated\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	afterGitattributes, err := GenerateKey(testFile, tool, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if afterEditorconfig.PolicyHash == afterGitattributes.PolicyHash {
+		t.Error("PolicyHash should change when .gitattributes appears")
+	}
+}
+
+// policyFilesTool is a mockTool that also declares extra PolicyFiles.
+type policyFilesTool struct {
+	mockTool
+	extra []string
+}
+
+func (p *policyFilesTool) PolicyFiles(root string) []string { return p.extra }
+
+func TestGenerateKey_ToolDeclaredPolicyFilesAffectPolicyHash(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	extraPolicy := filepath.Join(tmpDir, ".prettierrc-policy")
+	if err := os.WriteFile(extraPolicy, []byte("quote: single\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := &policyFilesTool{mockTool: mockTool{name: "prettier", version: "3.0.0"}, extra: []string{".prettierrc-policy"}}
+	options := tools.ExecuteOptions{ProjectRoot: tmpDir}
+
+	key1, err := GenerateKey(testFile, tool, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(extraPolicy, []byte("quote: double\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	key2, err := GenerateKey(testFile, tool, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key1.PolicyHash == key2.PolicyHash {
+		t.Error("PolicyHash should change when a tool-declared policy file's content changes")
+	}
+}
+
 func TestCacheKey_String(t *testing.T) {
 	key := CacheKey{
 		FilePath:    "/path/to/file.go",
@@ -155,6 +291,23 @@ func TestCacheKey_String(t *testing.T) {
 	}
 }
 
+func TestCacheKey_String_IncludesPolicyHashWhenSet(t *testing.T) {
+	key := CacheKey{
+		FilePath:    "/path/to/file.go",
+		FileHash:    "a1b2c3d4e5f6g7h8",
+		ToolName:    "gofumpt",
+		ToolVersion: "v0.7.0",
+		ConfigHash:  "i9j0k1l2m3n4o5p6",
+		PolicyHash:  "y5z6a7b8c9d0e1f2",
+		OptionsHash: "q7r8s9t0u1v2w3x4",
+	}
+
+	expected := "gofumpt-v0.7.0-a1b2c3d4-i9j0k1l2-q7r8s9t0-y5z6a7b8"
+	if str := key.String(); str != expected {
+		t.Errorf("String() = %s, want %s", str, expected)
+	}
+}
+
 func TestValidateKey(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -328,6 +481,60 @@ func TestHashOptions(t *testing.T) {
 			opt2: tools.ExecuteOptions{ExtraArgs: []string{"--color", "--verbose"}},
 			same: true, // Sorted internally
 		},
+		{
+			name: "different shard index",
+			opt1: tools.ExecuteOptions{Shard: 0, TotalShards: 4},
+			opt2: tools.ExecuteOptions{Shard: 1, TotalShards: 4},
+			same: false,
+		},
+		{
+			name: "shard ignored when sharding disabled",
+			opt1: tools.ExecuteOptions{Shard: 0, TotalShards: 0},
+			opt2: tools.ExecuteOptions{Shard: 1, TotalShards: 0},
+			same: true,
+		},
+		{
+			name: "different sql dialect",
+			opt1: tools.ExecuteOptions{SQLDialect: "postgres"},
+			opt2: tools.ExecuteOptions{SQLDialect: "bigquery"},
+			same: false,
+		},
+		{
+			name: "different sql templater",
+			opt1: tools.ExecuteOptions{SQLTemplater: "dbt"},
+			opt2: tools.ExecuteOptions{SQLTemplater: "jinja"},
+			same: false,
+		},
+		{
+			name: "different build output",
+			opt1: tools.ExecuteOptions{BuildOutput: "schema.bin"},
+			opt2: tools.ExecuteOptions{BuildOutput: ""},
+			same: false,
+		},
+		{
+			name: "different compile flags",
+			opt1: tools.ExecuteOptions{CompileFlags: []string{"-std=c++20"}},
+			opt2: tools.ExecuteOptions{CompileFlags: []string{"-std=c++17"}},
+			same: false,
+		},
+		{
+			name: "different whitelisted env var",
+			opt1: tools.ExecuteOptions{Env: map[string]string{"GOFLAGS": "-mod=mod"}},
+			opt2: tools.ExecuteOptions{Env: map[string]string{"GOFLAGS": "-mod=readonly"}},
+			same: false,
+		},
+		{
+			name: "non-whitelisted env var ignored",
+			opt1: tools.ExecuteOptions{Env: map[string]string{"MY_SECRET_TOKEN": "abc"}},
+			opt2: tools.ExecuteOptions{Env: map[string]string{"MY_SECRET_TOKEN": "xyz"}},
+			same: true,
+		},
+		{
+			name: "unset whitelisted env var differs from empty string",
+			opt1: tools.ExecuteOptions{Env: map[string]string{}},
+			opt2: tools.ExecuteOptions{Env: map[string]string{"NODE_ENV": ""}},
+			same: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -4,13 +4,17 @@
 package cache
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/Gizzahub/gzh-cli-quality/cache/chunker"
+	"github.com/Gizzahub/gzh-cli-quality/redact"
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
 
@@ -20,26 +24,103 @@ type CacheManager struct {
 	enabled    bool
 	maxSize    int64
 	maxAge     time.Duration
+	maxEntries int64
+	tracker    *UpdateTracker
+	compressor Compressor
+	chunkStore *ChunkStore
 	hitCount   atomic.Int64
 	missCount  atomic.Int64
 	mu         sync.RWMutex
+
+	indexMu     sync.Mutex
+	configIndex map[string][]configIndexEntry
+}
+
+// configIndexEntry is one cache entry's storage address, enough to
+// delete it directly without re-deriving its CacheKey.
+type configIndexEntry struct {
+	keyString string
+	toolName  string
 }
 
-// NewCacheManager creates a new cache manager.
+// NewCacheManager creates a new cache manager. basePath is either a plain
+// filesystem directory (the historical behavior) or a Storage URL understood
+// by NewStorage (mem://, s3://, gs://, azblob://), so CI jobs can share a
+// warm cache across ephemeral runners by pointing every job at the same
+// bucket instead of a per-runner disk.
 func NewCacheManager(basePath string, maxSize int64, maxAge time.Duration) (*CacheManager, error) {
-	storage, err := NewFilesystemStorage(basePath)
+	storage, err := NewStorage(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	compressor, err := NewCompressor(DefaultCompression, 0)
 	if err != nil {
 		return nil, err
 	}
 
 	return &CacheManager{
-		storage: storage,
-		enabled: true,
-		maxSize: maxSize,
-		maxAge:  maxAge,
+		storage:    storage,
+		enabled:    true,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		compressor: compressor,
 	}, nil
 }
 
+// SetMaxEntries installs an entry-count cap Cleanup enforces alongside
+// maxAge/maxSize, evicting the least-recently-accessed entries once the
+// total count exceeds it. <= 0 disables the cap (the default).
+func (cm *CacheManager) SetMaxEntries(maxEntries int64) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.maxEntries = maxEntries
+}
+
+// SetCompression installs the compressor new Set calls use going
+// forward; entries already on disk keep whatever algorithm wrote them
+// (see CacheMetadata.Compression) until Recompress rewrites them. This
+// backs CacheConfig.Compression, so changing it in config doesn't
+// require touching NewCacheManager's signature.
+func (cm *CacheManager) SetCompression(algo CompressionAlgorithm, level int) error {
+	compressor, err := NewCompressor(algo, level)
+	if err != nil {
+		return err
+	}
+
+	cm.mu.Lock()
+	cm.compressor = compressor
+	cm.mu.Unlock()
+	return nil
+}
+
+// EnableChunking turns on content-defined chunking for Set calls going
+// forward, storing Result behind a manifest into cm's own storage (under
+// the chunkStoreTool bucket) instead of an inline compressed blob. Entries
+// already on disk keep whatever representation they were written with -
+// Get tells the two apart by whether CachedResult.Chunks is set. Calling
+// this more than once is a no-op; there's only ever one ChunkStore per
+// Manager since its dedup bookkeeping (see ChunkStore.UniqueBytes) is
+// only meaningful in aggregate.
+func (cm *CacheManager) EnableChunking() {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if cm.chunkStore == nil {
+		cm.chunkStore = NewChunkStore(cm.storage)
+	}
+}
+
+// SetUpdateTracker installs tracker as cm's freshness oracle: every Get
+// consults tracker.DirtySince before trusting an on-disk entry, evicting
+// it if a filesystem change newer than the entry's CreatedAt might
+// affect its input file. Passing nil disables the check, the same
+// lazy/age-only behavior as before this existed.
+func (cm *CacheManager) SetUpdateTracker(tracker *UpdateTracker) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.tracker = tracker
+}
+
 // NewDisabledCacheManager creates a cache manager with caching disabled.
 func NewDisabledCacheManager() *CacheManager {
 	return &CacheManager{
@@ -59,7 +140,7 @@ func (cm *CacheManager) Get(key CacheKey) (*CachedResult, error) {
 	}
 
 	// Read from storage
-	data, err := cm.storage.Read(key.String())
+	data, err := cm.storage.Read(key.String(), key.ToolName)
 	if err != nil {
 		cm.missCount.Add(1)
 		return nil, err
@@ -72,6 +153,24 @@ func (cm *CacheManager) Get(key CacheKey) (*CachedResult, error) {
 		return nil, fmt.Errorf("failed to deserialize cached result: %w", err)
 	}
 
+	cm.mu.RLock()
+	chunkStore := cm.chunkStore
+	cm.mu.RUnlock()
+	if chunkStore == nil {
+		// Chunking isn't enabled on this Manager, but an entry written
+		// while it was (or by a process that had it on) still needs its
+		// chunks read back - a throwaway ChunkStore over the same
+		// storage can do that without tracking dedup stats for it.
+		chunkStore = NewChunkStore(cm.storage)
+	}
+
+	result, err := hydrateResult(cached, chunkStore)
+	if err != nil {
+		cm.missCount.Add(1)
+		return nil, fmt.Errorf("failed to decode cached result: %w", err)
+	}
+	cached.Result = result
+
 	// Check if expired
 	if cm.maxAge > 0 && time.Since(cached.Metadata.CreatedAt) > cm.maxAge {
 		cm.missCount.Add(1)
@@ -80,13 +179,25 @@ func (cm *CacheManager) Get(key CacheKey) (*CachedResult, error) {
 		return nil, fmt.Errorf("cache entry expired")
 	}
 
+	// Check if a filesystem change observed since this entry was
+	// created might invalidate it, per the update tracker's Bloom
+	// filters, before relying solely on age/lazy invalidation.
+	cm.mu.RLock()
+	tracker := cm.tracker
+	cm.mu.RUnlock()
+	if tracker != nil && tracker.DirtySince(cached.Key.FilePath, cached.Metadata.CreatedAt) {
+		cm.missCount.Add(1)
+		_ = cm.Invalidate(key)
+		return nil, fmt.Errorf("cache entry invalidated by tracked filesystem change")
+	}
+
 	// Update access metadata
 	cached.Metadata.LastAccessed = time.Now()
 	cached.Metadata.AccessCount++
 
 	// Write updated metadata back (synchronously to avoid race conditions in tests)
 	updatedData, _ := json.MarshalIndent(cached, "", "  ")
-	_ = cm.storage.Write(key.String(), updatedData)
+	_ = cm.storage.Write(key.String(), key.ToolName, updatedData)
 
 	cm.hitCount.Add(1)
 	return &cached, nil
@@ -108,11 +219,17 @@ func (cm *CacheManager) Set(key CacheKey, result *tools.Result) error {
 		return nil
 	}
 
-	// Create cached result
+	redacted := redactResult(result)
+
+	cm.mu.RLock()
+	compressor := cm.compressor
+	chunkStore := cm.chunkStore
+	cm.mu.RUnlock()
+
 	cached := CachedResult{
 		Version: "1.0",
 		Key:     key,
-		Result:  result,
+		Result:  redacted,
 		Metadata: CacheMetadata{
 			CreatedAt:    time.Now(),
 			LastAccessed: time.Now(),
@@ -120,6 +237,29 @@ func (cm *CacheManager) Set(key CacheKey, result *tools.Result) error {
 		},
 	}
 
+	if chunkStore != nil {
+		encoded, err := json.Marshal(redacted)
+		if err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+
+		manifest, err := chunker.BuildManifest(bytes.NewReader(encoded), chunkStore)
+		if err != nil {
+			return fmt.Errorf("failed to chunk result: %w", err)
+		}
+		cached.Chunks = manifest
+	} else {
+		resultData, err := compressResult(compressor, redacted)
+		if err != nil {
+			return fmt.Errorf("failed to compress result: %w", err)
+		}
+		cached.ResultData = resultData
+		cached.Metadata.Compression = CompressionInfo{
+			Algorithm: string(compressor.Algorithm()),
+			Level:     compressor.Level(),
+		}
+	}
+
 	// Serialize
 	data, err := json.MarshalIndent(cached, "", "  ")
 	if err != nil {
@@ -133,7 +273,7 @@ func (cm *CacheManager) Set(key CacheKey, result *tools.Result) error {
 	data, _ = json.MarshalIndent(cached, "", "  ")
 
 	// Write to storage
-	if err := cm.storage.Write(key.String(), data); err != nil {
+	if err := cm.storage.Write(key.String(), key.ToolName, data); err != nil {
 		return fmt.Errorf("failed to write to cache: %w", err)
 	}
 
@@ -148,13 +288,176 @@ func (cm *CacheManager) Set(key CacheKey, result *tools.Result) error {
 	return nil
 }
 
+// redactResult returns a shallow copy of result with secret-bearing
+// fields scrubbed through the redact package before it's written to
+// disk. It never mutates result itself, since the caller's *tools.Result
+// is also used to print output to the console.
+func redactResult(result *tools.Result) *tools.Result {
+	copied := *result
+	copied.Output = redact.Redact(result.Output)
+
+	if len(result.Issues) > 0 {
+		copied.Issues = make([]tools.Issue, len(result.Issues))
+		for i, issue := range result.Issues {
+			issue.Message = redact.Redact(issue.Message)
+			issue.Suggestion = redact.Redact(issue.Suggestion)
+			issue.Rendered = redact.Redact(issue.Rendered)
+			copied.Issues[i] = issue
+		}
+	}
+
+	return &copied
+}
+
+// compressResult JSON-encodes result and runs it through compressor,
+// producing the bytes that go into CachedResult.ResultData.
+func compressResult(compressor Compressor, result *tools.Result) ([]byte, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result: %w", err)
+	}
+	return compressor.Compress(data)
+}
+
+// decompressResult reverses compressResult, using info to pick the same
+// algorithm/level the entry was written with rather than the manager's
+// current default - a prerequisite for Recompress to be able to change
+// the default without breaking reads of entries it hasn't rewritten yet.
+func decompressResult(info CompressionInfo, data []byte) (*tools.Result, error) {
+	compressor, err := NewCompressor(CompressionAlgorithm(info.Algorithm), info.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := compressor.Decompress(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var result tools.Result
+	if err := json.Unmarshal(decoded, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode result: %w", err)
+	}
+	return &result, nil
+}
+
+// hydrateResult decodes cached.Result from whichever representation Set
+// wrote it in: chunked (cached.Chunks, reassembled via chunker.Assemble
+// against chunkStore) or inline-compressed (cached.ResultData, via
+// decompressResult). chunkStore is only consulted in the former case.
+func hydrateResult(cached CachedResult, chunkStore *ChunkStore) (*tools.Result, error) {
+	if len(cached.Chunks) == 0 {
+		return decompressResult(cached.Metadata.Compression, cached.ResultData)
+	}
+
+	reader, err := chunker.Assemble(cached.Chunks, chunkStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassemble chunked result: %w", err)
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reassembled result: %w", err)
+	}
+
+	var result tools.Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode chunked result: %w", err)
+	}
+	return &result, nil
+}
+
 // Invalidate removes a cache entry.
 func (cm *CacheManager) Invalidate(key CacheKey) error {
 	if !cm.enabled {
 		return nil
 	}
 
-	return cm.storage.Delete(key.String())
+	return cm.storage.Delete(key.String(), key.ToolName)
+}
+
+// IndexConfigFile records that key's entry was built using configPath as
+// one of its ConfigHash inputs. CachedTool calls this once per path
+// tool.FindConfigFiles returned, right after a successful Set, so
+// `quality watch` can invalidate exactly the entries a config-file edit
+// affects instead of rehashing (or discarding) the whole cache.
+func (cm *CacheManager) IndexConfigFile(configPath string, key CacheKey) {
+	if !cm.enabled {
+		return
+	}
+
+	cm.indexMu.Lock()
+	defer cm.indexMu.Unlock()
+
+	if cm.configIndex == nil {
+		cm.configIndex = make(map[string][]configIndexEntry)
+	}
+	cm.configIndex[configPath] = append(cm.configIndex[configPath], configIndexEntry{
+		keyString: key.String(),
+		toolName:  key.ToolName,
+	})
+}
+
+// InvalidateByConfigFile removes every cache entry previously indexed
+// against configPath via IndexConfigFile, then forgets the index entry
+// itself - those entries will be re-indexed the next time they're
+// written, with whatever ConfigHash configPath's new content produces.
+func (cm *CacheManager) InvalidateByConfigFile(configPath string) error {
+	if !cm.enabled {
+		return nil
+	}
+
+	cm.indexMu.Lock()
+	entries := cm.configIndex[configPath]
+	delete(cm.configIndex, configPath)
+	cm.indexMu.Unlock()
+
+	var firstErr error
+	for _, e := range entries {
+		if err := cm.storage.Delete(e.keyString, e.toolName); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// InvalidateByTool removes every cache entry for toolName at toolVersion,
+// e.g. after `quality watch` notices the resolved tool binary's mtime
+// changed underneath it - a rebuilt/upgraded linter can change its output
+// for files whose source and config are both untouched. This isn't served
+// by an index: a tool binary changing is rare enough that a full scan via
+// List (the same approach Cleanup already uses) is cheaper than
+// maintaining a second reverse map just for it.
+func (cm *CacheManager) InvalidateByTool(toolName, toolVersion string) error {
+	if !cm.enabled {
+		return nil
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	paths, err := cm.storage.List()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
+		if err != nil {
+			continue
+		}
+
+		var cached CachedResult
+		if err := json.Unmarshal(data, &cached); err != nil {
+			continue
+		}
+
+		if cached.Key.ToolName == toolName && cached.Key.ToolVersion == toolVersion {
+			_ = cm.storage.DeletePath(path)
+		}
+	}
+
+	return nil
 }
 
 // InvalidateAll removes all cache entries.
@@ -166,15 +469,19 @@ func (cm *CacheManager) InvalidateAll() error {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
-	keys, err := cm.storage.List()
+	paths, err := cm.storage.List()
 	if err != nil {
 		return err
 	}
 
-	for _, key := range keys {
-		_ = cm.storage.Delete(key)
+	for _, path := range paths {
+		_ = cm.storage.DeletePath(path)
 	}
 
+	cm.indexMu.Lock()
+	cm.configIndex = nil
+	cm.indexMu.Unlock()
+
 	// Reset counters
 	cm.hitCount.Store(0)
 	cm.missCount.Store(0)
@@ -191,7 +498,7 @@ func (cm *CacheManager) Stats() CacheStats {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
 
-	keys, _ := cm.storage.List()
+	paths, _ := cm.storage.List()
 	size, _ := cm.storage.Size()
 
 	hits := cm.hitCount.Load()
@@ -204,17 +511,18 @@ func (cm *CacheManager) Stats() CacheStats {
 	}
 
 	stats := CacheStats{
-		Entries:   int64(len(keys)),
-		SizeBytes: size,
-		HitCount:  hits,
-		MissCount: misses,
-		HitRate:   hitRate,
+		Entries:      int64(len(paths)),
+		SizeBytes:    size,
+		HitCount:     hits,
+		MissCount:    misses,
+		HitRate:      hitRate,
+		DiskHitCount: hits,
 	}
 
 	// Find oldest and newest entries
 	var oldest, newest time.Time
-	for _, key := range keys {
-		data, err := cm.storage.Read(key)
+	for _, path := range paths {
+		data, err := cm.storage.ReadPath(path)
 		if err != nil {
 			continue
 		}
@@ -236,6 +544,11 @@ func (cm *CacheManager) Stats() CacheStats {
 	stats.OldestEntry = oldest
 	stats.NewestEntry = newest
 
+	if cm.chunkStore != nil {
+		stats.UniqueChunkBytes = cm.chunkStore.UniqueBytes()
+		stats.LogicalChunkBytes = cm.chunkStore.LogicalBytes()
+	}
+
 	return stats
 }
 
@@ -251,10 +564,10 @@ func (cm *CacheManager) Cleanup() error {
 	// 1. Delete entries older than maxAge
 	if cm.maxAge > 0 {
 		cutoffTime := time.Now().Add(-cm.maxAge)
-		keys, _ := cm.storage.List()
+		paths, _ := cm.storage.List()
 
-		for _, key := range keys {
-			data, err := cm.storage.Read(key)
+		for _, path := range paths {
+			data, err := cm.storage.ReadPath(path)
 			if err != nil {
 				continue
 			}
@@ -262,42 +575,48 @@ func (cm *CacheManager) Cleanup() error {
 			var cached CachedResult
 			if err := json.Unmarshal(data, &cached); err != nil {
 				// Corrupted entry: delete it
-				_ = cm.storage.Delete(key)
+				_ = cm.storage.DeletePath(path)
 				continue
 			}
 
 			if cached.Metadata.CreatedAt.Before(cutoffTime) {
-				_ = cm.storage.Delete(key)
+				_ = cm.storage.DeletePath(path)
 			}
 		}
 	}
 
-	// 2. If still over size limit, delete least recently accessed
+	// 2. If still over the size or entry-count limit, delete least
+	// recently accessed entries until both are satisfied.
 	size, _ := cm.storage.Size()
-	if cm.maxSize > 0 && size > cm.maxSize {
+	paths, _ := cm.storage.List()
+	count := int64(len(paths))
+
+	overSize := cm.maxSize > 0 && size > cm.maxSize
+	overCount := cm.maxEntries > 0 && count > cm.maxEntries
+	if overSize || overCount {
 		// Get all entries with metadata
 		type entry struct {
-			key          string
+			path         string
 			lastAccessed time.Time
 		}
 
 		var entries []entry
-		keys, _ := cm.storage.List()
 
-		for _, key := range keys {
-			data, err := cm.storage.Read(key)
+		for _, path := range paths {
+			data, err := cm.storage.ReadPath(path)
 			if err != nil {
 				continue
 			}
 
 			var cached CachedResult
 			if err := json.Unmarshal(data, &cached); err != nil {
-				_ = cm.storage.Delete(key)
+				_ = cm.storage.DeletePath(path)
+				count--
 				continue
 			}
 
 			entries = append(entries, entry{
-				key:          key,
+				path:         path,
 				lastAccessed: cached.Metadata.LastAccessed,
 			})
 		}
@@ -307,13 +626,16 @@ func (cm *CacheManager) Cleanup() error {
 			return entries[i].lastAccessed.Before(entries[j].lastAccessed)
 		})
 
-		// Delete oldest entries until under limit
+		// Delete oldest entries until under both limits
 		for _, e := range entries {
-			if size <= cm.maxSize {
+			underSize := cm.maxSize <= 0 || size <= cm.maxSize
+			underCount := cm.maxEntries <= 0 || count <= cm.maxEntries
+			if underSize && underCount {
 				break
 			}
 
-			_ = cm.storage.Delete(e.key)
+			_ = cm.storage.DeletePath(e.path)
+			count--
 			size, _ = cm.storage.Size()
 		}
 	}
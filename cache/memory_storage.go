@@ -0,0 +1,206 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// DefaultMemoryStorageMaxBytes is the size cap used by NewStorage when a
+// mem:// URL carries no explicit size, large enough for a single CI job's
+// worth of lint results without risking the container's memory limit.
+const DefaultMemoryStorageMaxBytes = 64 * 1024 * 1024
+
+// MemoryStorage implements Storage entirely in-process, for tests and for
+// short-lived CI containers where there's no shared volume to mount a
+// FilesystemStorage on and no warm cache worth persisting past the job.
+// Entries are evicted least-recently-used once total size exceeds maxBytes.
+type MemoryStorage struct {
+	mu       sync.RWMutex
+	maxBytes int64
+	curBytes int64
+	order    *list.List // front = most recently used
+	entries  map[string]*list.Element
+}
+
+// memoryEntry is the value stored in MemoryStorage.order/entries, keyed by
+// the storage-relative path (tool/key, mirroring FilesystemStorage's
+// List() locations) rather than the raw cache key, so eviction and List
+// walk the same identifier space.
+type memoryEntry struct {
+	path string
+	data []byte
+}
+
+// NewMemoryStorage creates an in-memory storage backend that evicts the
+// least-recently-used entry once the total size of stored data would
+// exceed maxBytes. maxBytes <= 0 disables the cap.
+func NewMemoryStorage(maxBytes int64) *MemoryStorage {
+	return &MemoryStorage{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryStorage) pathFor(key, tool string) string {
+	return sanitizeToolName(tool) + "/" + key
+}
+
+// Read reads data from storage.
+func (m *MemoryStorage) Read(key, tool string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := m.pathFor(key, tool)
+	elem, ok := m.entries[path]
+	if !ok {
+		return nil, fmt.Errorf("cache miss: %s not found", key)
+	}
+
+	m.order.MoveToFront(elem)
+
+	data := elem.Value.(*memoryEntry).data
+	out := make([]byte, len(data))
+	copy(out, data)
+	return out, nil
+}
+
+// Write writes data to storage, evicting least-recently-used entries until
+// the new entry fits within maxBytes.
+func (m *MemoryStorage) Write(key, tool string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := m.pathFor(key, tool)
+	stored := make([]byte, len(data))
+	copy(stored, data)
+
+	if elem, ok := m.entries[path]; ok {
+		m.curBytes -= int64(len(elem.Value.(*memoryEntry).data))
+		elem.Value.(*memoryEntry).data = stored
+		m.order.MoveToFront(elem)
+		m.curBytes += int64(len(stored))
+	} else {
+		elem := m.order.PushFront(&memoryEntry{path: path, data: stored})
+		m.entries[path] = elem
+		m.curBytes += int64(len(stored))
+	}
+
+	m.evictLocked()
+
+	return nil
+}
+
+// evictLocked drops least-recently-used entries until curBytes fits within
+// maxBytes. Callers must hold m.mu.
+func (m *MemoryStorage) evictLocked() {
+	if m.maxBytes <= 0 {
+		return
+	}
+
+	for m.curBytes > m.maxBytes {
+		oldest := m.order.Back()
+		if oldest == nil {
+			return
+		}
+
+		entry := oldest.Value.(*memoryEntry)
+		m.curBytes -= int64(len(entry.data))
+		delete(m.entries, entry.path)
+		m.order.Remove(oldest)
+	}
+}
+
+// Delete deletes data from storage.
+func (m *MemoryStorage) Delete(key, tool string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := m.pathFor(key, tool)
+	elem, ok := m.entries[path]
+	if !ok {
+		return nil
+	}
+
+	m.curBytes -= int64(len(elem.Value.(*memoryEntry).data))
+	delete(m.entries, path)
+	m.order.Remove(elem)
+
+	return nil
+}
+
+// List returns the storage-relative location of every entry.
+func (m *MemoryStorage) List() ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	paths := make([]string, 0, len(m.entries))
+	for e := m.order.Front(); e != nil; e = e.Next() {
+		paths = append(paths, e.Value.(*memoryEntry).path)
+	}
+
+	return paths, nil
+}
+
+// ReadPath reads the entry at a location previously returned by List.
+func (m *MemoryStorage) ReadPath(path string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[path]
+	if !ok {
+		return nil, fmt.Errorf("cache miss: %s not found", path)
+	}
+
+	m.order.MoveToFront(elem)
+
+	data := elem.Value.(*memoryEntry).data
+	out := make([]byte, len(data))
+	copy(out, data)
+	return out, nil
+}
+
+// DeletePath deletes the entry at a location previously returned by List.
+func (m *MemoryStorage) DeletePath(path string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[path]
+	if !ok {
+		return nil
+	}
+
+	m.curBytes -= int64(len(elem.Value.(*memoryEntry).data))
+	delete(m.entries, path)
+	m.order.Remove(elem)
+
+	return nil
+}
+
+// Size returns the total size of storage in bytes.
+func (m *MemoryStorage) Size() (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.curBytes, nil
+}
+
+// CleanupCorrupted removes entries that aren't valid JSON. A
+// process-local map can't suffer the on-disk corruption FilesystemStorage
+// guards against, but a malformed Write (e.g. from a caller bypassing the
+// cache manager) is still worth catching the same way other backends do.
+func (m *MemoryStorage) CleanupCorrupted() (int, error) {
+	return CleanupCorruptedEntries(m)
+}
+
+// Close is a no-op: there's nothing outside the process to release.
+func (m *MemoryStorage) Close() error {
+	return nil
+}
+
+// Ensure MemoryStorage implements Storage.
+var _ Storage = (*MemoryStorage)(nil)
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import "testing"
+
+func TestMemoryStorage_ReadWrite(t *testing.T) {
+	storage := NewMemoryStorage(0)
+
+	key := "test-key"
+	data := []byte("test data")
+
+	if err := storage.Write(key, "gofumpt", data); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	readData, err := storage.Read(key, "gofumpt")
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+
+	if string(readData) != string(data) {
+		t.Errorf("Read data = %s, want %s", readData, data)
+	}
+}
+
+func TestMemoryStorage_ReadMiss(t *testing.T) {
+	storage := NewMemoryStorage(0)
+
+	if _, err := storage.Read("missing", "gofumpt"); err == nil {
+		t.Error("Expected error reading missing key")
+	}
+}
+
+func TestMemoryStorage_Delete(t *testing.T) {
+	storage := NewMemoryStorage(0)
+
+	storage.Write("key", "gofumpt", []byte("data"))
+
+	if err := storage.Delete("key", "gofumpt"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if _, err := storage.Read("key", "gofumpt"); err == nil {
+		t.Error("Expected error reading deleted key")
+	}
+
+	if err := storage.Delete("nonexistent", "gofumpt"); err != nil {
+		t.Errorf("Delete non-existent key should not error: %v", err)
+	}
+}
+
+func TestMemoryStorage_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Cap fits exactly two 4-byte entries.
+	storage := NewMemoryStorage(8)
+
+	storage.Write("a", "gofumpt", []byte("aaaa"))
+	storage.Write("b", "gofumpt", []byte("bbbb"))
+
+	// Touch "a" so "b" becomes the least-recently-used entry.
+	if _, err := storage.Read("a", "gofumpt"); err != nil {
+		t.Fatal(err)
+	}
+
+	storage.Write("c", "gofumpt", []byte("cccc"))
+
+	if _, err := storage.Read("b", "gofumpt"); err == nil {
+		t.Error("expected b to be evicted as least-recently-used")
+	}
+	if _, err := storage.Read("a", "gofumpt"); err != nil {
+		t.Errorf("expected a to survive eviction: %v", err)
+	}
+	if _, err := storage.Read("c", "gofumpt"); err != nil {
+		t.Errorf("expected c to survive eviction: %v", err)
+	}
+}
+
+func TestMemoryStorage_Size(t *testing.T) {
+	storage := NewMemoryStorage(0)
+
+	storage.Write("a", "gofumpt", []byte("1234"))
+	storage.Write("b", "gofumpt", []byte("12345678"))
+
+	size, err := storage.Size()
+	if err != nil {
+		t.Fatalf("Size failed: %v", err)
+	}
+	if size != 12 {
+		t.Errorf("Size = %d, want 12", size)
+	}
+}
+
+func TestMemoryStorage_ListAndReadPath(t *testing.T) {
+	storage := NewMemoryStorage(0)
+
+	keys := []string{"key1", "key2", "key3"}
+	for _, key := range keys {
+		if err := storage.Write(key, "gofumpt", []byte("data")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths, err := storage.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(paths) != len(keys) {
+		t.Errorf("List returned %d entries, want %d", len(paths), len(keys))
+	}
+
+	for _, path := range paths {
+		if _, err := storage.ReadPath(path); err != nil {
+			t.Errorf("ReadPath(%s) failed: %v", path, err)
+		}
+	}
+
+	if err := storage.DeletePath(paths[0]); err != nil {
+		t.Fatalf("DeletePath failed: %v", err)
+	}
+	if _, err := storage.ReadPath(paths[0]); err == nil {
+		t.Error("expected error reading deleted path")
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/azureblob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcerrors"
+)
+
+// ObjectStorage implements Storage over an object-storage bucket (S3, GCS,
+// or Azure Blob) via gocloud.dev/blob, so a single URL scheme (s3://, gs://,
+// azblob://) is enough to let CI runners share a warm cache without every
+// ephemeral runner rebuilding it from scratch.
+type ObjectStorage struct {
+	bucket *blob.Bucket
+}
+
+// NewObjectStorage opens the bucket addressed by url (e.g.
+// "s3://my-bucket?region=us-east-1", "gs://my-bucket",
+// "azblob://my-container"). The underlying driver is selected by url's
+// scheme via the blank-imported gocloud.dev/blob/* subpackages.
+func NewObjectStorage(ctx context.Context, url string) (*ObjectStorage, error) {
+	bucket, err := blob.OpenBucket(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object storage bucket %s: %w", url, err)
+	}
+
+	return &ObjectStorage{bucket: bucket}, nil
+}
+
+func (o *ObjectStorage) objectKey(key, tool string) string {
+	return path.Join("results", sanitizeToolName(tool), key+".json")
+}
+
+// Read reads data from storage.
+func (o *ObjectStorage) Read(key, tool string) ([]byte, error) {
+	data, err := o.bucket.ReadAll(context.Background(), o.objectKey(key, tool))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, fmt.Errorf("cache miss: %s not found", key)
+		}
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+// Write writes data to storage.
+func (o *ObjectStorage) Write(key, tool string, data []byte) error {
+	ctx := context.Background()
+
+	w, err := o.bucket.NewWriter(ctx, o.objectKey(key, tool), nil)
+	if err != nil {
+		return fmt.Errorf("failed to open object writer: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to finalize object: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes data from storage.
+func (o *ObjectStorage) Delete(key, tool string) error {
+	ctx := context.Background()
+
+	if err := o.bucket.Delete(ctx, o.objectKey(key, tool)); err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// List returns the storage-root-relative location of every entry under
+// "results/", mirroring FilesystemStorage's layout.
+func (o *ObjectStorage) List() ([]string, error) {
+	ctx := context.Background()
+
+	var paths []string
+
+	iter := o.bucket.List(&blob.ListOptions{Prefix: "results/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		paths = append(paths, strings.TrimPrefix(obj.Key, ""))
+	}
+
+	return paths, nil
+}
+
+// ReadPath reads the entry at a location previously returned by List.
+func (o *ObjectStorage) ReadPath(path string) ([]byte, error) {
+	data, err := o.bucket.ReadAll(context.Background(), path)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, fmt.Errorf("cache miss: %s not found", path)
+		}
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+// DeletePath deletes the entry at a location previously returned by List.
+func (o *ObjectStorage) DeletePath(path string) error {
+	ctx := context.Background()
+
+	if err := o.bucket.Delete(ctx, path); err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// Size returns the total size of storage in bytes. Object storage has no
+// cheap aggregate size query, so this walks every entry under "results/".
+func (o *ObjectStorage) Size() (int64, error) {
+	ctx := context.Background()
+
+	var total int64
+
+	iter := o.bucket.List(&blob.ListOptions{Prefix: "results/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		total += obj.Size
+	}
+
+	return total, nil
+}
+
+// CleanupCorrupted removes entries that can't be read back or aren't
+// valid JSON, walking the bucket the same way Size does since object
+// storage exposes no cheaper corruption check.
+func (o *ObjectStorage) CleanupCorrupted() (int, error) {
+	return CleanupCorruptedEntries(o)
+}
+
+// Close closes the underlying bucket.
+func (o *ObjectStorage) Close() error {
+	return o.bucket.Close()
+}
+
+// Ensure ObjectStorage implements Storage.
+var _ Storage = (*ObjectStorage)(nil)
@@ -0,0 +1,578 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DefaultPackTargetBytes is the pack file size Write buffers entries up to
+// before flushing, in restic's ballpark of a few MiB per pack - large
+// enough that most workspaces produce a handful of packs rather than one
+// file per cache entry, small enough that a single Repack rewrite doesn't
+// hold an enormous buffer in memory.
+const DefaultPackTargetBytes = 8 * 1024 * 1024
+
+// packBlobLocation is where a unique content blob lives once its pack has
+// been flushed to disk.
+type packBlobLocation struct {
+	PackID string `json:"pack_id"`
+	Offset int64  `json:"offset"`
+	Length int64  `json:"length"`
+}
+
+// packIndexEntry is the persisted form of one Write, mapping the caller's
+// opaque key back to the (deduplicated) blob holding its bytes.
+type packIndexEntry struct {
+	packBlobLocation
+	ContentHash string `json:"content_hash"`
+	Tool        string `json:"tool"`
+	Key         string `json:"key"`
+}
+
+// pendingBlob is a unique content blob buffered in memory, not yet
+// written to a pack file.
+type pendingBlob struct {
+	data []byte
+}
+
+// PackStorage implements Storage by grouping many small entries into
+// append-only pack files with a separate index, in the style of restic's
+// repository layout, instead of FilesystemStorage's one-file-per-entry
+// model. Entries are content-addressed: identical results across many
+// files (very common for formatters whose answer is usually "no changes")
+// are stored once and referenced by every key that produced them.
+//
+// Layout under basePath:
+//
+//	packs/<hash[:2]>/<hash>   - pack files (content hash of their bytes)
+//	index/<hash>.json         - CacheKey.String() -> packIndexEntry, hash
+//	                            is the SHA-256 of the key string
+//
+// Writes buffer in memory until the current pack reaches
+// DefaultPackTargetBytes or Flush/Close is called, then the pack is
+// written atomically and its entries' index files are updated.
+type PackStorage struct {
+	basePath   string
+	targetSize int64
+
+	mu            sync.Mutex
+	pendingBlobs  map[string]pendingBlob    // contentHash -> blob, not yet flushed
+	pendingOrder  []string                  // contentHash, insertion order, for deterministic pack layout
+	pendingIndex  map[string]packIndexEntry // key -> entry, not yet persisted (blob may also be pending)
+	pendingSize   int64
+	contentBlobs  map[string]packBlobLocation // contentHash -> location, across all flushed packs
+	keyLocation   map[string]packIndexEntry   // key -> entry, persisted to index/*.json
+	keyIndexPaths map[string]string           // key -> index/<hash>.json relative path, for List/DeletePath
+}
+
+// NewPackStorage creates a pack-file storage backend rooted at basePath,
+// loading its existing index (if any) so a process restart doesn't lose
+// track of previously flushed packs.
+func NewPackStorage(basePath string) (*PackStorage, error) {
+	if err := os.MkdirAll(filepath.Join(basePath, "packs"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create pack storage directory: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Join(basePath, "index"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create pack storage index directory: %w", err)
+	}
+
+	ps := &PackStorage{
+		basePath:      basePath,
+		targetSize:    DefaultPackTargetBytes,
+		pendingBlobs:  make(map[string]pendingBlob),
+		pendingIndex:  make(map[string]packIndexEntry),
+		contentBlobs:  make(map[string]packBlobLocation),
+		keyLocation:   make(map[string]packIndexEntry),
+		keyIndexPaths: make(map[string]string),
+	}
+
+	if err := ps.loadIndex(); err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (ps *PackStorage) loadIndex() error {
+	indexDir := filepath.Join(ps.basePath, "index")
+	entries, err := os.ReadDir(indexDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read pack storage index: %w", err)
+	}
+
+	for _, dirEntry := range entries {
+		if dirEntry.IsDir() || filepath.Ext(dirEntry.Name()) != ".json" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(indexDir, dirEntry.Name()))
+		if err != nil {
+			continue // Skip unreadable index entries rather than fail to start.
+		}
+
+		var entry packIndexEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			continue
+		}
+
+		ps.keyLocation[entry.Key] = entry
+		ps.keyIndexPaths[entry.Key] = filepath.Join("index", dirEntry.Name())
+		ps.contentBlobs[entry.ContentHash] = entry.packBlobLocation
+	}
+
+	return nil
+}
+
+func indexFileName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + ".json"
+}
+
+// Read reads data from storage.
+func (ps *PackStorage) Read(key, tool string) ([]byte, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if pending, ok := ps.pendingIndex[key]; ok {
+		blob := ps.pendingBlobs[pending.ContentHash]
+		out := make([]byte, len(blob.data))
+		copy(out, blob.data)
+		return out, nil
+	}
+
+	entry, ok := ps.keyLocation[key]
+	if !ok {
+		return nil, fmt.Errorf("cache miss: %s not found", key)
+	}
+
+	return ps.readBlob(entry.packBlobLocation)
+}
+
+// readBlob reads the [Offset, Offset+Length) slice of pack loc.PackID.
+// Packs are read with ReadAt at the stored offset rather than a real
+// mmap, so this stays portable across platforms without an OS-specific
+// build; the access pattern (seek once, read once) is the same either way.
+func (ps *PackStorage) readBlob(loc packBlobLocation) ([]byte, error) {
+	packPath := ps.packPath(loc.PackID)
+
+	f, err := os.Open(packPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pack %s: %w", loc.PackID, err)
+	}
+	defer f.Close()
+
+	buf := make([]byte, loc.Length)
+	if _, err := f.ReadAt(buf, loc.Offset); err != nil {
+		return nil, fmt.Errorf("failed to read pack %s at offset %d: %w", loc.PackID, loc.Offset, err)
+	}
+
+	return buf, nil
+}
+
+// Write writes data to storage. Identical bytes written under different
+// keys (the common case for "no issues found" results) are deduplicated:
+// only the first occurrence is buffered for the next pack, and later
+// writes just point their key at the same content hash.
+func (ps *PackStorage) Write(key, tool string, data []byte) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	sum := sha256.Sum256(data)
+	contentHash := hex.EncodeToString(sum[:])
+
+	if loc, ok := ps.contentBlobs[contentHash]; ok {
+		return ps.persistIndexEntryLocked(key, tool, contentHash, loc)
+	}
+
+	entry := packIndexEntry{ContentHash: contentHash, Tool: tool, Key: key}
+	ps.pendingIndex[key] = entry
+
+	if _, buffered := ps.pendingBlobs[contentHash]; !buffered {
+		stored := make([]byte, len(data))
+		copy(stored, data)
+		ps.pendingBlobs[contentHash] = pendingBlob{data: stored}
+		ps.pendingOrder = append(ps.pendingOrder, contentHash)
+		ps.pendingSize += int64(len(stored))
+	}
+
+	if ps.pendingSize >= ps.targetSize {
+		return ps.flushLocked()
+	}
+
+	return nil
+}
+
+// Flush writes any buffered entries to a pack file immediately, without
+// waiting for the pack to reach its target size. Callers that are about
+// to read their own just-written entries via ReadPath (which only sees
+// persisted index files) should call this first.
+func (ps *PackStorage) Flush() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.flushLocked()
+}
+
+func (ps *PackStorage) flushLocked() error {
+	if len(ps.pendingOrder) == 0 {
+		return nil
+	}
+
+	var buf []byte
+	offsets := make(map[string]packBlobLocation, len(ps.pendingOrder))
+	var offset int64
+	for _, contentHash := range ps.pendingOrder {
+		blob := ps.pendingBlobs[contentHash]
+		offsets[contentHash] = packBlobLocation{Offset: offset, Length: int64(len(blob.data))}
+		buf = append(buf, blob.data...)
+		offset += int64(len(blob.data))
+	}
+
+	sum := sha256.Sum256(buf)
+	packID := hex.EncodeToString(sum[:])
+
+	packPath := ps.packPath(packID)
+	if err := os.MkdirAll(filepath.Dir(packPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create pack shard directory: %w", err)
+	}
+
+	tempPath := packPath + ".tmp"
+	if err := os.WriteFile(tempPath, buf, 0o644); err != nil {
+		return fmt.Errorf("failed to write pack file: %w", err)
+	}
+	if err := os.Rename(tempPath, packPath); err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("failed to rename pack file: %w", err)
+	}
+
+	for contentHash, rel := range offsets {
+		loc := packBlobLocation{PackID: packID, Offset: rel.Offset, Length: rel.Length}
+		ps.contentBlobs[contentHash] = loc
+	}
+
+	for key, entry := range ps.pendingIndex {
+		loc := ps.contentBlobs[entry.ContentHash]
+		if err := ps.persistIndexEntryLocked(key, entry.Tool, entry.ContentHash, loc); err != nil {
+			return err
+		}
+	}
+
+	ps.pendingBlobs = make(map[string]pendingBlob)
+	ps.pendingOrder = nil
+	ps.pendingIndex = make(map[string]packIndexEntry)
+	ps.pendingSize = 0
+
+	return nil
+}
+
+func (ps *PackStorage) persistIndexEntryLocked(key, tool, contentHash string, loc packBlobLocation) error {
+	entry := packIndexEntry{packBlobLocation: loc, ContentHash: contentHash, Tool: tool, Key: key}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to serialize pack index entry: %w", err)
+	}
+
+	relPath := filepath.Join("index", indexFileName(key))
+	fullPath := filepath.Join(ps.basePath, relPath)
+
+	tempPath := fullPath + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write pack index entry: %w", err)
+	}
+	if err := os.Rename(tempPath, fullPath); err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("failed to rename pack index entry: %w", err)
+	}
+
+	ps.keyLocation[key] = entry
+	ps.keyIndexPaths[key] = relPath
+	delete(ps.pendingIndex, key)
+
+	return nil
+}
+
+func (ps *PackStorage) packPath(packID string) string {
+	shard := "00"
+	if len(packID) >= 2 {
+		shard = packID[:2]
+	}
+	return filepath.Join(ps.basePath, "packs", shard, packID)
+}
+
+// Delete deletes data from storage. The blob itself is left in its pack -
+// other keys may still reference it - and is only reclaimed by Repack.
+func (ps *PackStorage) Delete(key, tool string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	delete(ps.pendingIndex, key)
+
+	relPath, ok := ps.keyIndexPaths[key]
+	if !ok {
+		return nil // Already gone.
+	}
+
+	if err := os.Remove(filepath.Join(ps.basePath, relPath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete pack index entry: %w", err)
+	}
+
+	delete(ps.keyLocation, key)
+	delete(ps.keyIndexPaths, key)
+
+	return nil
+}
+
+// List returns every stored entry's location relative to the storage
+// root, i.e. its index/<hash>.json path, usable with ReadPath/DeletePath.
+func (ps *PackStorage) List() ([]string, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if err := ps.flushLocked(); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(ps.keyIndexPaths))
+	for _, p := range ps.keyIndexPaths {
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// ReadPath reads the entry at a location previously returned by List.
+func (ps *PackStorage) ReadPath(path string) ([]byte, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	data, err := os.ReadFile(filepath.Join(ps.basePath, path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pack index entry: %w", err)
+	}
+
+	var entry packIndexEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse pack index entry: %w", err)
+	}
+
+	return ps.readBlob(entry.packBlobLocation)
+}
+
+// DeletePath deletes the entry at a location previously returned by List.
+func (ps *PackStorage) DeletePath(path string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	data, err := os.ReadFile(filepath.Join(ps.basePath, path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read pack index entry: %w", err)
+	}
+
+	var entry packIndexEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return fmt.Errorf("failed to parse pack index entry: %w", err)
+	}
+
+	if err := os.Remove(filepath.Join(ps.basePath, path)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete pack index entry: %w", err)
+	}
+
+	delete(ps.keyLocation, entry.Key)
+	delete(ps.keyIndexPaths, entry.Key)
+
+	return nil
+}
+
+// Size returns the total size of storage in bytes: every pack file on
+// disk plus whatever is currently buffered in memory awaiting a flush.
+func (ps *PackStorage) Size() (int64, error) {
+	ps.mu.Lock()
+	pending := ps.pendingSize
+	ps.mu.Unlock()
+
+	var total int64
+	err := filepath.Walk(filepath.Join(ps.basePath, "packs"), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() && filepath.Ext(path) != ".tmp" {
+			total += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to calculate pack storage size: %w", err)
+	}
+
+	return total + pending, nil
+}
+
+// CleanupCorrupted removes index entries whose pack blob can't be read
+// back or isn't valid JSON - e.g. after a pack file was truncated or lost
+// outside of Write/Flush's own bookkeeping.
+func (ps *PackStorage) CleanupCorrupted() (int, error) {
+	return CleanupCorruptedEntries(ps)
+}
+
+// Close flushes any buffered entries and closes the storage backend.
+func (ps *PackStorage) Close() error {
+	return ps.Flush()
+}
+
+// PackRepackStats summarizes a Repack run.
+type PackRepackStats struct {
+	// LivePacks is the number of fresh pack files written.
+	LivePacks int
+	// LiveBytes is the total size of the fresh packs.
+	LiveBytes int64
+	// ReclaimedBytes is how much smaller the repacked storage is than
+	// the packs it replaced.
+	ReclaimedBytes int64
+}
+
+// Repack walks the index, keeping only blobs still referenced by a live
+// index entry, and rewrites them into fresh packs - reclaiming the space
+// held by blobs whose only referencing keys were deleted (superseded by
+// a newer tool/config version and evicted via Delete/DeletePath) since
+// the packs holding them were written. It lives on PackStorage rather
+// than the generic Manager interface because compaction is specific to
+// this backend's on-disk layout; FilesystemStorage and MemoryStorage have
+// no equivalent concept of a stale pack to reclaim.
+func (ps *PackStorage) Repack() (PackRepackStats, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if err := ps.flushLocked(); err != nil {
+		return PackRepackStats{}, err
+	}
+
+	oldPacksDir := filepath.Join(ps.basePath, "packs")
+	oldSize, err := dirSize(oldPacksDir)
+	if err != nil {
+		return PackRepackStats{}, fmt.Errorf("failed to measure existing packs: %w", err)
+	}
+
+	liveContentHashes := make(map[string]struct{}, len(ps.keyLocation))
+	for _, entry := range ps.keyLocation {
+		liveContentHashes[entry.ContentHash] = struct{}{}
+	}
+
+	staging := filepath.Join(ps.basePath, "packs.repack")
+	if err := os.RemoveAll(staging); err != nil {
+		return PackRepackStats{}, fmt.Errorf("failed to clear repack staging directory: %w", err)
+	}
+	if err := os.MkdirAll(staging, 0o755); err != nil {
+		return PackRepackStats{}, fmt.Errorf("failed to create repack staging directory: %w", err)
+	}
+
+	newContentBlobs := make(map[string]packBlobLocation, len(liveContentHashes))
+	var buf []byte
+	var bufContents []string
+	stats := PackRepackStats{}
+
+	flushStaged := func() error {
+		if len(buf) == 0 {
+			return nil
+		}
+		sum := sha256.Sum256(buf)
+		packID := hex.EncodeToString(sum[:])
+		shard := packID[:2]
+		if err := os.MkdirAll(filepath.Join(staging, shard), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(staging, shard, packID), buf, 0o644); err != nil {
+			return err
+		}
+
+		// Offset/Length were already recorded relative to buf when each
+		// blob was appended; only PackID was unknown until now.
+		for _, contentHash := range bufContents {
+			loc := newContentBlobs[contentHash]
+			loc.PackID = packID
+			newContentBlobs[contentHash] = loc
+		}
+
+		stats.LivePacks++
+		stats.LiveBytes += int64(len(buf))
+		buf = nil
+		bufContents = nil
+		return nil
+	}
+
+	for contentHash := range liveContentHashes {
+		loc, ok := ps.contentBlobs[contentHash]
+		if !ok {
+			continue // Referenced key but blob missing; nothing to repack for it.
+		}
+		data, err := ps.readBlob(loc)
+		if err != nil {
+			return PackRepackStats{}, fmt.Errorf("failed to read live blob for repack: %w", err)
+		}
+
+		newContentBlobs[contentHash] = packBlobLocation{Offset: int64(len(buf)), Length: int64(len(data))}
+		buf = append(buf, data...)
+		bufContents = append(bufContents, contentHash)
+
+		if int64(len(buf)) >= ps.targetSize {
+			if err := flushStaged(); err != nil {
+				return PackRepackStats{}, fmt.Errorf("failed to write repacked pack: %w", err)
+			}
+		}
+	}
+	if err := flushStaged(); err != nil {
+		return PackRepackStats{}, fmt.Errorf("failed to write repacked pack: %w", err)
+	}
+
+	if err := os.RemoveAll(oldPacksDir); err != nil {
+		return PackRepackStats{}, fmt.Errorf("failed to remove old packs directory: %w", err)
+	}
+	if err := os.Rename(staging, oldPacksDir); err != nil {
+		return PackRepackStats{}, fmt.Errorf("failed to install repacked packs: %w", err)
+	}
+
+	ps.contentBlobs = newContentBlobs
+	for key, entry := range ps.keyLocation {
+		entry.packBlobLocation = newContentBlobs[entry.ContentHash]
+		ps.keyLocation[key] = entry
+		_ = ps.persistIndexEntryLocked(key, entry.Tool, entry.ContentHash, entry.packBlobLocation)
+	}
+
+	stats.ReclaimedBytes = oldSize - stats.LiveBytes
+	return stats, nil
+}
+
+func dirSize(root string) (int64, error) {
+	var total int64
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total, err
+}
+
+// Ensure PackStorage implements Storage.
+var _ Storage = (*PackStorage)(nil)
@@ -0,0 +1,195 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import "testing"
+
+func TestPackStorage_ReadWrite(t *testing.T) {
+	storage, err := NewPackStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+
+	key := "test-key"
+	data := []byte("test data")
+
+	if err := storage.Write(key, "gofumpt", data); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	readData, err := storage.Read(key, "gofumpt")
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+
+	if string(readData) != string(data) {
+		t.Errorf("Read data = %s, want %s", readData, data)
+	}
+}
+
+func TestPackStorage_ReadOwnWriteBeforeFlush(t *testing.T) {
+	storage, err := NewPackStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+
+	if err := storage.Write("pending-key", "golangci-lint", []byte("buffered")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	data, err := storage.Read("pending-key", "golangci-lint")
+	if err != nil {
+		t.Fatalf("Read of not-yet-flushed entry failed: %v", err)
+	}
+	if string(data) != "buffered" {
+		t.Errorf("Read data = %s, want %q", data, "buffered")
+	}
+}
+
+func TestPackStorage_ReadMiss(t *testing.T) {
+	storage, err := NewPackStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+
+	if _, err := storage.Read("missing", "gofumpt"); err == nil {
+		t.Error("Expected error reading missing key")
+	}
+}
+
+func TestPackStorage_DedupesIdenticalContentAcrossKeys(t *testing.T) {
+	storage, err := NewPackStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+
+	payload := []byte(`{"success":true,"issues":[]}`)
+	if err := storage.Write("file-a.go", "gofumpt", payload); err != nil {
+		t.Fatalf("Write a failed: %v", err)
+	}
+	if err := storage.Write("file-b.go", "gofumpt", payload); err != nil {
+		t.Fatalf("Write b failed: %v", err)
+	}
+	if err := storage.Flush(); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	paths, err := storage.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("List returned %d entries, want 2", len(paths))
+	}
+
+	if len(storage.contentBlobs) != 1 {
+		t.Errorf("contentBlobs has %d unique entries, want 1 (identical payloads should dedup)", len(storage.contentBlobs))
+	}
+
+	dataA, err := storage.Read("file-a.go", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read a failed: %v", err)
+	}
+	dataB, err := storage.Read("file-b.go", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read b failed: %v", err)
+	}
+	if string(dataA) != string(payload) || string(dataB) != string(payload) {
+		t.Error("both keys should read back the deduplicated payload")
+	}
+}
+
+func TestPackStorage_Delete(t *testing.T) {
+	storage, err := NewPackStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+
+	if err := storage.Write("key", "gofumpt", []byte("data")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := storage.Flush(); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	if err := storage.Delete("key", "gofumpt"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if _, err := storage.Read("key", "gofumpt"); err == nil {
+		t.Error("Expected error reading deleted key")
+	}
+
+	if err := storage.Delete("nonexistent", "gofumpt"); err != nil {
+		t.Errorf("Delete non-existent key should not error: %v", err)
+	}
+}
+
+func TestPackStorage_SurvivesReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	storage, err := NewPackStorage(dir)
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+	if err := storage.Write("key", "gofumpt", []byte("persisted")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := storage.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	reopened, err := NewPackStorage(dir)
+	if err != nil {
+		t.Fatalf("reopen NewPackStorage failed: %v", err)
+	}
+
+	data, err := reopened.Read("key", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read after reopen failed: %v", err)
+	}
+	if string(data) != "persisted" {
+		t.Errorf("Read data = %s, want %q", data, "persisted")
+	}
+}
+
+func TestPackStorage_RepackReclaimsDeletedEntries(t *testing.T) {
+	storage, err := NewPackStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPackStorage failed: %v", err)
+	}
+
+	if err := storage.Write("stale-key", "gofumpt", []byte("stale data that will be deleted")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := storage.Write("live-key", "gofumpt", []byte("live data that stays")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if err := storage.Flush(); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if err := storage.Delete("stale-key", "gofumpt"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	stats, err := storage.Repack()
+	if err != nil {
+		t.Fatalf("Repack failed: %v", err)
+	}
+	if stats.LivePacks != 1 {
+		t.Errorf("LivePacks = %d, want 1", stats.LivePacks)
+	}
+
+	data, err := storage.Read("live-key", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read live-key after repack failed: %v", err)
+	}
+	if string(data) != "live data that stays" {
+		t.Errorf("Read data = %s, want survivor payload", data)
+	}
+
+	if _, err := storage.Read("stale-key", "gofumpt"); err == nil {
+		t.Error("expected stale-key to stay gone after repack")
+	}
+}
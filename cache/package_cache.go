@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// packageCacheToolName is the synthetic CacheKey.ToolName PackageCache
+// stores entries under, distinct from any real tool name so a
+// package-level hit can never be confused with a per-file CachedTool
+// entry sharing the same manager.
+const packageCacheToolName = "test-package"
+
+// packageCacheToolVersion fills CacheKey.ToolVersion, which ValidateKey
+// requires on every key Manager.Get/Set accept.
+const packageCacheToolVersion = "1"
+
+// PackageCache adapts a Manager to tools.TestPackageCache, letting
+// GoTestTool/PytestTool skip re-running a package whose hash already
+// passed on a previous run. It lives in the cache package rather than
+// tools, for the same reason CachedTool does: tools importing cache back
+// would be a cycle.
+type PackageCache struct {
+	manager Manager
+}
+
+// NewPackageCache wraps manager as a tools.TestPackageCache.
+func NewPackageCache(manager Manager) *PackageCache {
+	return &PackageCache{manager: manager}
+}
+
+// Get reports whether pkg last passed at hash.
+func (c *PackageCache) Get(pkg, hash string) (passed, found bool) {
+	if !c.manager.Enabled() {
+		return false, false
+	}
+
+	cached, err := c.manager.Get(CacheKey{FilePath: pkg, FileHash: hash, ToolName: packageCacheToolName, ToolVersion: packageCacheToolVersion})
+	if err != nil {
+		return false, false
+	}
+
+	return cached.Result.Success, true
+}
+
+// Put records that pkg passed at hash.
+func (c *PackageCache) Put(pkg, hash string) {
+	if !c.manager.Enabled() {
+		return
+	}
+
+	_ = c.manager.Set(CacheKey{FilePath: pkg, FileHash: hash, ToolName: packageCacheToolName, ToolVersion: packageCacheToolVersion}, &tools.Result{
+		Tool:    packageCacheToolName,
+		Success: true,
+	})
+}
+
+// Ensure PackageCache implements tools.TestPackageCache.
+var _ tools.TestPackageCache = (*PackageCache)(nil)
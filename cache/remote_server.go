@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// hexHashPattern matches the hex-encoded SHA256 hashes RemoteStorage uses
+// to address both CAS blobs and AC entries, rejecting anything that
+// could otherwise be used to escape basePath via "../" or similar.
+var hexHashPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// RemoteCacheServer is the reference implementation of the CAS/AC
+// protocol RemoteStorage speaks as an HTTP client, backed by a plain
+// directory on disk. It's meant for self-hosting a small team/CI cache,
+// not as a production-grade cache server: there's no GC of orphaned CAS
+// blobs, no multi-tenant isolation, and auth is a single shared bearer
+// token.
+type RemoteCacheServer struct {
+	basePath string
+	token    string
+}
+
+// NewRemoteCacheServer creates a RemoteCacheServer storing blobs under
+// basePath/cas and action-cache entries under basePath/ac. token, if
+// non-empty, is required as a bearer token on every request.
+func NewRemoteCacheServer(basePath, token string) (*RemoteCacheServer, error) {
+	if err := os.MkdirAll(filepath.Join(basePath, "cas"), 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(basePath, "ac"), 0o755); err != nil {
+		return nil, err
+	}
+
+	return &RemoteCacheServer{basePath: basePath, token: token}, nil
+}
+
+// ServeHTTP implements http.Handler, routing GET/PUT/HEAD on /cas/<hash>
+// and GET/PUT/DELETE on /ac/<hash>.
+func (s *RemoteCacheServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/cas/"):
+		s.serveCAS(w, r, strings.TrimPrefix(r.URL.Path, "/cas/"))
+	case strings.HasPrefix(r.URL.Path, "/ac/"):
+		s.serveAC(w, r, strings.TrimPrefix(r.URL.Path, "/ac/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *RemoteCacheServer) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+	return r.Header.Get("Authorization") == "Bearer "+s.token
+}
+
+func (s *RemoteCacheServer) serveCAS(w http.ResponseWriter, r *http.Request, hash string) {
+	if !hexHashPattern.MatchString(hash) {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+	path := filepath.Join(s.basePath, "cas", hash[:2], hash)
+
+	switch r.Method {
+	case http.MethodGet:
+		serveFile(w, path)
+	case http.MethodHead:
+		headFile(w, path)
+	case http.MethodPut:
+		writeFileAtomic(w, r, path)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *RemoteCacheServer) serveAC(w http.ResponseWriter, r *http.Request, hash string) {
+	if !hexHashPattern.MatchString(hash) {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+	path := filepath.Join(s.basePath, "ac", hash[:2], hash+".json")
+
+	switch r.Method {
+	case http.MethodGet:
+		serveFile(w, path)
+	case http.MethodPut:
+		writeFileAtomic(w, r, path)
+	case http.MethodDelete:
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func serveFile(w http.ResponseWriter, path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(data)
+}
+
+func headFile(w http.ResponseWriter, path string) {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeFileAtomic(w http.ResponseWriter, r *http.Request, path string) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0o644); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		_ = os.Remove(tempPath)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
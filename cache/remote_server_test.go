@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRemoteCacheServer_RoundtripsThroughRemoteStorageClient(t *testing.T) {
+	server, err := NewRemoteCacheServer(t.TempDir(), "")
+	if err != nil {
+		t.Fatalf("NewRemoteCacheServer failed: %v", err)
+	}
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	rs := NewRemoteStorage(RemoteStorageConfig{BaseURL: httpServer.URL})
+
+	if err := rs.Write("key", "gofumpt", []byte("served from disk")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	data, err := rs.Read("key", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if string(data) != "served from disk" {
+		t.Errorf("Read data = %s, want %q", data, "served from disk")
+	}
+}
+
+func TestRemoteCacheServer_RequiresBearerToken(t *testing.T) {
+	server, err := NewRemoteCacheServer(t.TempDir(), "secret-token")
+	if err != nil {
+		t.Fatalf("NewRemoteCacheServer failed: %v", err)
+	}
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	unauth := NewRemoteStorage(RemoteStorageConfig{BaseURL: httpServer.URL})
+	if err := unauth.Write("key", "gofumpt", []byte("data")); err == nil {
+		t.Error("expected write without a token to be rejected")
+	}
+
+	authed := NewRemoteStorage(RemoteStorageConfig{BaseURL: httpServer.URL, Token: "secret-token"})
+	if err := authed.Write("key", "gofumpt", []byte("data")); err != nil {
+		t.Errorf("write with correct token should succeed: %v", err)
+	}
+}
+
+func TestRemoteCacheServer_RejectsMalformedHash(t *testing.T) {
+	server, err := NewRemoteCacheServer(t.TempDir(), "")
+	if err != nil {
+		t.Fatalf("NewRemoteCacheServer failed: %v", err)
+	}
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	resp, err := httpServer.Client().Get(httpServer.URL + "/cas/../../etc/passwd")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 200 {
+		t.Error("path-traversal-shaped hash must not be served")
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RemoteStorage is a Storage backed by a remote HTTP cache server,
+// speaking the same CAS/AC split as Bazel's remote cache protocol: a
+// content-addressed blob store under "/cas/<hash>" (GET/PUT/HEAD) plus a
+// small action-cache mapping each caller key to a CAS digest under
+// "/ac/<hash>" (GET/PUT). Routing every Write's bytes through the CAS
+// means identical results written under different keys - very common for
+// "no issues found" - share one uploaded blob.
+//
+// RemoteStorage has no enumeration endpoint in the wire protocol, so
+// List/Stats-style maintenance can't run against it directly: it's meant
+// to sit behind TwoTierStorage, with the local tier doing Cleanup/Stats
+// and RemoteStorage only ever reached through Read/Write/Delete.
+type RemoteStorage struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// RemoteStorageConfig configures NewRemoteStorage.
+type RemoteStorageConfig struct {
+	// BaseURL is the cache server's root, e.g. "https://cache.example.com".
+	BaseURL string
+
+	// Token is an optional bearer token sent as "Authorization: Bearer
+	// <token>" on every request.
+	Token string
+
+	// Timeout bounds each HTTP request. Zero uses a 30s default.
+	Timeout time.Duration
+}
+
+// NewRemoteStorage creates a Storage that talks to a cache server
+// speaking the CAS/AC protocol described on RemoteStorage.
+func NewRemoteStorage(cfg RemoteStorageConfig) *RemoteStorage {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &RemoteStorage{
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		token:   cfg.Token,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// acDigest is the JSON body stored at an AC entry, pointing at the CAS
+// blob holding the actual cached bytes.
+type acDigest struct {
+	ContentHash string `json:"content_hash"`
+	Size        int64  `json:"size"`
+}
+
+func actionKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func (rs *RemoteStorage) acURL(hash string) string {
+	return fmt.Sprintf("%s/ac/%s", rs.baseURL, hash)
+}
+
+func (rs *RemoteStorage) casURL(hash string) string {
+	return fmt.Sprintf("%s/cas/%s", rs.baseURL, hash)
+}
+
+func (rs *RemoteStorage) newRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if rs.token != "" {
+		req.Header.Set("Authorization", "Bearer "+rs.token)
+	}
+	return req, nil
+}
+
+func (rs *RemoteStorage) getJSON(url string, out interface{}) (bool, error) {
+	req, err := rs.newRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("remote storage GET %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("remote storage GET %s returned status %d", url, resp.StatusCode)
+	}
+
+	return true, json.NewDecoder(resp.Body).Decode(out)
+}
+
+// Read fetches the AC pointer for key, then the CAS blob it names.
+func (rs *RemoteStorage) Read(key, tool string) ([]byte, error) {
+	var digest acDigest
+	found, err := rs.getJSON(rs.acURL(actionKeyHash(key)), &digest)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("cache miss: %s not found", key)
+	}
+
+	req, err := rs.newRequest(http.MethodGet, rs.casURL(digest.ContentHash), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote storage CAS GET failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("cache miss: %s references missing CAS blob %s", key, digest.ContentHash)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote storage CAS GET returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote storage response: %w", err)
+	}
+
+	return data, nil
+}
+
+// Write uploads data's content to the CAS (skipping the upload entirely
+// if a HEAD shows the server already has it) and points key's AC entry
+// at it.
+func (rs *RemoteStorage) Write(key, tool string, data []byte) error {
+	sum := sha256.Sum256(data)
+	contentHash := hex.EncodeToString(sum[:])
+
+	has, err := rs.hasBlob(contentHash)
+	if err != nil {
+		return err
+	}
+	if !has {
+		req, err := rs.newRequest(http.MethodPut, rs.casURL(contentHash), bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("failed to build CAS PUT request: %w", err)
+		}
+		req.ContentLength = int64(len(data))
+
+		resp, err := rs.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("remote storage CAS PUT failed: %w", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+			return fmt.Errorf("remote storage CAS PUT returned status %d", resp.StatusCode)
+		}
+	}
+
+	digestBody, err := json.Marshal(acDigest{ContentHash: contentHash, Size: int64(len(data))})
+	if err != nil {
+		return fmt.Errorf("failed to encode AC digest: %w", err)
+	}
+
+	req, err := rs.newRequest(http.MethodPut, rs.acURL(actionKeyHash(key)), bytes.NewReader(digestBody))
+	if err != nil {
+		return fmt.Errorf("failed to build AC PUT request: %w", err)
+	}
+	req.ContentLength = int64(len(digestBody))
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote storage AC PUT failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("remote storage AC PUT returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (rs *RemoteStorage) hasBlob(contentHash string) (bool, error) {
+	req, err := rs.newRequest(http.MethodHead, rs.casURL(contentHash), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to build CAS HEAD request: %w", err)
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("remote storage CAS HEAD failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("remote storage CAS HEAD returned status %d", resp.StatusCode)
+	}
+}
+
+// Delete removes key's AC entry. The CAS blob it pointed at is left in
+// place - other keys may reference the same content - and is only ever
+// reclaimed server-side, which is outside this client's protocol.
+func (rs *RemoteStorage) Delete(key, tool string) error {
+	req, err := rs.newRequest(http.MethodDelete, rs.acURL(actionKeyHash(key)), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build AC DELETE request: %w", err)
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote storage AC DELETE failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("remote storage AC DELETE returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// List always returns no entries: the CAS/AC protocol has no enumeration
+// endpoint, so a bare RemoteStorage can't support the maintenance
+// operations (Cleanup, Stats, InvalidateAll) that walk List's output.
+// Those should run against the local tier of a TwoTierStorage instead.
+func (rs *RemoteStorage) List() ([]string, error) {
+	return nil, nil
+}
+
+// ReadPath reads the AC entry at path (an "ac/<hash>" location as used
+// internally by Read), for symmetry with List/ReadPath's contract even
+// though List never actually returns anything to pass here.
+func (rs *RemoteStorage) ReadPath(path string) ([]byte, error) {
+	hash := strings.TrimPrefix(path, "ac/")
+
+	var digest acDigest
+	found, err := rs.getJSON(rs.acURL(hash), &digest)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("cache miss: %s not found", path)
+	}
+
+	req, err := rs.newRequest(http.MethodGet, rs.casURL(digest.ContentHash), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote storage CAS GET failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote storage CAS GET returned status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// DeletePath deletes the AC entry at path, mirroring ReadPath.
+func (rs *RemoteStorage) DeletePath(path string) error {
+	hash := strings.TrimPrefix(path, "ac/")
+
+	req, err := rs.newRequest(http.MethodDelete, rs.acURL(hash), nil)
+	if err != nil {
+		return fmt.Errorf("failed to build AC DELETE request: %w", err)
+	}
+
+	resp, err := rs.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote storage AC DELETE failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("remote storage AC DELETE returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Size always reports 0: the wire protocol exposes no storage-size
+// endpoint. See List's doc comment - maintenance accounting belongs to
+// the local tier.
+func (rs *RemoteStorage) Size() (int64, error) {
+	return 0, nil
+}
+
+// CleanupCorrupted always reports zero removed: List's doc comment
+// explains why maintenance against a bare RemoteStorage can't work -
+// run it against the local tier of a TwoTierStorage instead.
+func (rs *RemoteStorage) CleanupCorrupted() (int, error) {
+	return 0, nil
+}
+
+// Close is a no-op; RemoteStorage holds no resources beyond its
+// *http.Client, which needs no explicit shutdown.
+func (rs *RemoteStorage) Close() error {
+	return nil
+}
+
+// Ensure RemoteStorage implements Storage.
+var _ Storage = (*RemoteStorage)(nil)
@@ -0,0 +1,259 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// newCASACServer is a minimal in-process server implementing the CAS/AC
+// protocol RemoteStorage speaks, for tests.
+func newCASACServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	cas := map[string][]byte{}
+	ac := map[string][]byte{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cas/", func(w http.ResponseWriter, r *http.Request) {
+		hash := r.URL.Path[len("/cas/"):]
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			cas[hash] = data
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			data, ok := cas[hash]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		case http.MethodHead:
+			if _, ok := cas[hash]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/ac/", func(w http.ResponseWriter, r *http.Request) {
+		hash := r.URL.Path[len("/ac/"):]
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			ac[hash] = data
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			data, ok := ac[hash]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		case http.MethodDelete:
+			delete(ac, hash)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestRemoteStorage_WriteThenRead(t *testing.T) {
+	server := newCASACServer(t)
+	defer server.Close()
+
+	rs := NewRemoteStorage(RemoteStorageConfig{BaseURL: server.URL})
+
+	if err := rs.Write("key-a", "gofumpt", []byte("hello")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	data, err := rs.Read("key-a", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if string(data) != "hello" {
+		t.Errorf("Read data = %s, want %q", data, "hello")
+	}
+}
+
+func TestRemoteStorage_ReadMiss(t *testing.T) {
+	server := newCASACServer(t)
+	defer server.Close()
+
+	rs := NewRemoteStorage(RemoteStorageConfig{BaseURL: server.URL})
+
+	if _, err := rs.Read("missing", "gofumpt"); err == nil {
+		t.Error("expected error reading missing key")
+	}
+}
+
+func TestRemoteStorage_DedupesContentAcrossKeysInCAS(t *testing.T) {
+	var mu sync.Mutex
+	var casPuts int
+
+	cas := map[string][]byte{}
+	ac := map[string][]byte{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cas/", func(w http.ResponseWriter, r *http.Request) {
+		hash := r.URL.Path[len("/cas/"):]
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			casPuts++
+			data, _ := io.ReadAll(r.Body)
+			cas[hash] = data
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodHead:
+			if _, ok := cas[hash]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	mux.HandleFunc("/ac/", func(w http.ResponseWriter, r *http.Request) {
+		hash := r.URL.Path[len("/ac/"):]
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			ac[hash] = data
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			data, ok := ac[hash]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	rs := NewRemoteStorage(RemoteStorageConfig{BaseURL: server.URL})
+
+	payload := []byte("identical result")
+	if err := rs.Write("key-a", "gofumpt", payload); err != nil {
+		t.Fatalf("Write a failed: %v", err)
+	}
+	if err := rs.Write("key-b", "gofumpt", payload); err != nil {
+		t.Fatalf("Write b failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if casPuts != 1 {
+		t.Errorf("CAS PUTs = %d, want 1 (identical content should upload once)", casPuts)
+	}
+}
+
+func TestRemoteStorage_DigestJSONRoundtrip(t *testing.T) {
+	var d acDigest
+	data, _ := json.Marshal(acDigest{ContentHash: "abc", Size: 5})
+	if err := json.Unmarshal(data, &d); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if d.ContentHash != "abc" || d.Size != 5 {
+		t.Errorf("roundtrip mismatch: %+v", d)
+	}
+}
+
+func TestTwoTierStorage_FallsBackToRemoteOnLocalMiss(t *testing.T) {
+	server := newCASACServer(t)
+	defer server.Close()
+
+	remote := NewRemoteStorage(RemoteStorageConfig{BaseURL: server.URL})
+	if err := remote.Write("shared-key", "gofumpt", []byte("from remote")); err != nil {
+		t.Fatalf("remote Write failed: %v", err)
+	}
+
+	local, err := NewFilesystemStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStorage failed: %v", err)
+	}
+
+	ts := NewTwoTierStorage(local, remote, TwoTierStorageConfig{})
+	defer ts.Close()
+
+	data, err := ts.Read("shared-key", "gofumpt")
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if string(data) != "from remote" {
+		t.Errorf("Read data = %s, want %q", data, "from remote")
+	}
+
+	// Second read should now be served from local without needing remote.
+	if _, err := local.Read("shared-key", "gofumpt"); err != nil {
+		t.Errorf("expected remote hit to be cached locally, local Read failed: %v", err)
+	}
+}
+
+func TestTwoTierStorage_ReadOnlySkipsRemoteWrite(t *testing.T) {
+	server := newCASACServer(t)
+	defer server.Close()
+
+	remote := NewRemoteStorage(RemoteStorageConfig{BaseURL: server.URL})
+	local, err := NewFilesystemStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStorage failed: %v", err)
+	}
+
+	ts := NewTwoTierStorage(local, remote, TwoTierStorageConfig{ReadOnly: true})
+
+	if err := ts.Write("key", "gofumpt", []byte("local only")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	ts.Close() // Waits for the (empty) push queue to drain.
+
+	if _, err := remote.Read("key", "gofumpt"); err == nil {
+		t.Error("ReadOnly TwoTierStorage must not push writes to remote")
+	}
+}
+
+func TestTwoTierStorage_ToolOptOutSkipsRemote(t *testing.T) {
+	server := newCASACServer(t)
+	defer server.Close()
+
+	remote := NewRemoteStorage(RemoteStorageConfig{BaseURL: server.URL})
+	local, err := NewFilesystemStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStorage failed: %v", err)
+	}
+
+	ts := NewTwoTierStorage(local, remote, TwoTierStorageConfig{ToolOptOut: []string{"secret-tool"}})
+
+	if err := ts.Write("key", "secret-tool", []byte("sensitive")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	ts.Close()
+
+	if _, err := remote.Read("key", "secret-tool"); err == nil {
+		t.Error("opted-out tool's results must not reach remote")
+	}
+}
@@ -4,10 +4,14 @@
 package cache
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 )
 
 // FilesystemStorage implements Storage interface using the filesystem.
@@ -29,11 +33,11 @@ func NewFilesystemStorage(basePath string) (*FilesystemStorage, error) {
 }
 
 // Read reads data from storage.
-func (fs *FilesystemStorage) Read(key string) ([]byte, error) {
+func (fs *FilesystemStorage) Read(key, tool string) ([]byte, error) {
 	fs.mu.RLock()
 	defer fs.mu.RUnlock()
 
-	path := fs.keyToPath(key)
+	path := fs.keyToPath(key, tool)
 
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -47,11 +51,11 @@ func (fs *FilesystemStorage) Read(key string) ([]byte, error) {
 }
 
 // Write writes data to storage using atomic write.
-func (fs *FilesystemStorage) Write(key string, data []byte) error {
+func (fs *FilesystemStorage) Write(key, tool string, data []byte) error {
 	fs.mu.Lock()
 	defer fs.mu.Unlock()
 
-	path := fs.keyToPath(key)
+	path := fs.keyToPath(key, tool)
 
 	// Create directory if needed
 	dir := filepath.Dir(path)
@@ -78,11 +82,11 @@ func (fs *FilesystemStorage) Write(key string, data []byte) error {
 }
 
 // Delete deletes data from storage.
-func (fs *FilesystemStorage) Delete(key string) error {
+func (fs *FilesystemStorage) Delete(key, tool string) error {
 	fs.mu.Lock()
 	defer fs.mu.Unlock()
 
-	path := fs.keyToPath(key)
+	path := fs.keyToPath(key, tool)
 
 	if err := os.Remove(path); err != nil {
 		if os.IsNotExist(err) {
@@ -99,12 +103,14 @@ func (fs *FilesystemStorage) Delete(key string) error {
 	return nil
 }
 
-// List returns all keys in storage.
+// List returns the storage-root-relative location of every entry. A
+// content-addressed key can't be recovered from its hash, so these
+// locations are meant for ReadPath/DeletePath, not for Read/Write/Delete.
 func (fs *FilesystemStorage) List() ([]string, error) {
 	fs.mu.RLock()
 	defer fs.mu.RUnlock()
 
-	var keys []string
+	var paths []string
 
 	// Walk the cache directory
 	err := filepath.Walk(fs.basePath, func(path string, info os.FileInfo, err error) error {
@@ -122,13 +128,12 @@ func (fs *FilesystemStorage) List() ([]string, error) {
 			return nil
 		}
 
-		// Convert path back to key
 		relPath, err := filepath.Rel(fs.basePath, path)
 		if err != nil {
 			return err
 		}
 
-		keys = append(keys, relPath)
+		paths = append(paths, relPath)
 		return nil
 	})
 
@@ -136,7 +141,41 @@ func (fs *FilesystemStorage) List() ([]string, error) {
 		return nil, fmt.Errorf("failed to list cache entries: %w", err)
 	}
 
-	return keys, nil
+	return paths, nil
+}
+
+// ReadPath reads the entry at a storage-root-relative location returned by List.
+func (fs *FilesystemStorage) ReadPath(path string) ([]byte, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	data, err := os.ReadFile(filepath.Join(fs.basePath, path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("cache miss: %s not found", path)
+		}
+		return nil, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	return data, nil
+}
+
+// DeletePath deletes the entry at a storage-root-relative location returned by List.
+func (fs *FilesystemStorage) DeletePath(path string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	full := filepath.Join(fs.basePath, path)
+	if err := os.Remove(full); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete cache file: %w", err)
+	}
+
+	_ = os.Remove(filepath.Dir(full)) // Ignore errors (directory may not be empty)
+
+	return nil
 }
 
 // Size returns the total size of storage in bytes.
@@ -171,50 +210,70 @@ func (fs *FilesystemStorage) Close() error {
 	return nil
 }
 
-// keyToPath converts a cache key to a filesystem path.
-// Format: basePath/results/{tool}/{key[:2]}/{key}.json
-// The first 2 chars of key are used for sharding to avoid too many files in one directory.
-func (fs *FilesystemStorage) keyToPath(key string) string {
-	// Extract tool name from key (format: tool-version-...)
-	// Split by dash and take first part
-	tool := "unknown"
-	if idx := filepath.ToSlash(key); idx != "" {
-		parts := splitByDash(key)
-		if len(parts) > 0 {
-			tool = parts[0]
-		}
+// keyToPath converts a cache key to a content-addressed filesystem path.
+// Format: basePath/results/{tool}/{hash[:2]}/{hash[2:4]}/{hash}.json, where
+// hash is the SHA-256 of key. Hashing (rather than sharding on key's own
+// raw bytes) gives uniform directory fanout regardless of key distribution
+// and guarantees a filesystem-safe name even if key contains slashes or
+// other unsafe characters. tool is taken as given by the caller instead of
+// parsed back out of key, since CacheKey already carries it separately.
+func (fs *FilesystemStorage) keyToPath(key, tool string) string {
+	sum := sha256.Sum256([]byte(key))
+	hash := hex.EncodeToString(sum[:])
+
+	return filepath.Join(fs.basePath, "results", sanitizeToolName(tool), hash[:2], hash[2:4], hash+".json")
+}
+
+// sanitizeToolName makes tool safe to use as a single path segment, so a
+// tool name can never escape basePath/results or collide with a sharding
+// directory.
+func sanitizeToolName(tool string) string {
+	if tool == "" {
+		return "unknown"
 	}
 
-	// Use first 2 characters for sharding
-	shard := "00"
-	if len(key) >= 2 {
-		shard = key[:2]
+	sanitized := strings.NewReplacer("/", "_", "\\", "_").Replace(tool)
+	if sanitized == "." || sanitized == ".." {
+		return "_"
 	}
 
-	return filepath.Join(fs.basePath, "results", tool, shard, key+".json")
+	return sanitized
 }
 
-// splitByDash splits a string by dash character.
-func splitByDash(s string) []string {
-	var parts []string
-	var current string
+// Stats reports entry count, total size, and the oldest/newest mtime among
+// entries on disk. It walks the raw files directly rather than parsing each
+// CachedResult, so it stays correct even over entries CacheManager.Stats
+// would otherwise skip as corrupted, and works with no Manager at all on
+// top - useful for `cache gc --dry-run`-style observability of the backend
+// itself.
+func (fs *FilesystemStorage) Stats() (entries int, bytes int64, oldest, newest time.Time) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
 
-	for _, ch := range s {
-		if ch == '-' {
-			if current != "" {
-				parts = append(parts, current)
-				current = ""
-			}
-		} else {
-			current += string(ch)
+	_ = filepath.Walk(fs.basePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
 		}
-	}
 
-	if current != "" {
-		parts = append(parts, current)
-	}
+		if info.IsDir() || filepath.Ext(path) == ".tmp" {
+			return nil
+		}
+
+		entries++
+		bytes += info.Size()
 
-	return parts
+		mtime := info.ModTime()
+		if oldest.IsZero() || mtime.Before(oldest) {
+			oldest = mtime
+		}
+		if newest.IsZero() || mtime.After(newest) {
+			newest = mtime
+		}
+
+		return nil
+	})
+
+	return entries, bytes, oldest, newest
 }
 
 // CleanupCorrupted removes corrupted cache entries.
@@ -261,3 +320,35 @@ func (fs *FilesystemStorage) CleanupCorrupted() (int, error) {
 
 	return count, nil
 }
+
+// CleanupCorruptedEntries implements Storage.CleanupCorrupted generically
+// for a backend with no cheaper way to detect corruption than reading
+// every entry back: it walks s.List, removing (via s.DeletePath) any entry
+// ReadPath fails on or whose content isn't valid, minimally-structured
+// JSON. FilesystemStorage does this itself with a direct os.ReadFile walk
+// instead, since it can skip the List/ReadPath round-trip.
+func CleanupCorruptedEntries(s Storage) (int, error) {
+	paths, err := s.List()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	count := 0
+	for _, path := range paths {
+		data, err := s.ReadPath(path)
+		if err != nil {
+			if delErr := s.DeletePath(path); delErr == nil {
+				count++
+			}
+			continue
+		}
+
+		if len(data) == 0 || (data[0] != '{' && data[0] != '[') {
+			if delErr := s.DeletePath(path); delErr == nil {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
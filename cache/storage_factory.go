@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// NewStorage dispatches on url's scheme to build a Storage backend:
+//
+//   - "" or "file://path"     -> FilesystemStorage rooted at path
+//   - "mem://" or "memory://" -> MemoryStorage, capped at DefaultMemoryStorageMaxBytes
+//   - "s3://", "gs://", "azblob://" -> ObjectStorage via gocloud.dev/blob
+//   - "pack://path"           -> PackStorage rooted at path
+//
+// This lets --cache-dir (and QUALITY_CACHE_* config) point at a plain
+// filesystem path, as before, or at a URL naming a backend shared across
+// ephemeral CI runners.
+//
+// If QUALITY_CACHE_REMOTE_URL is set, the resolved backend is wrapped in
+// TwoTierStorage so a local miss falls back to that shared cache server
+// and new local results are pushed upstream, the same team/CI reuse
+// NewParallelExecutorWithCache's Store chain already gets from
+// StoreConfig.BuildChain, but for CacheManager's Storage-based path.
+func NewStorage(url string) (Storage, error) {
+	local, err := newLocalStorage(url)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteURL := os.Getenv("QUALITY_CACHE_REMOTE_URL")
+	if remoteURL == "" {
+		return local, nil
+	}
+
+	remote := NewRemoteStorage(RemoteStorageConfig{
+		BaseURL: remoteURL,
+		Token:   os.Getenv("QUALITY_CACHE_REMOTE_TOKEN"),
+	})
+
+	var toolOptOut []string
+	if v := os.Getenv("QUALITY_CACHE_REMOTE_TOOL_OPTOUT"); v != "" {
+		toolOptOut = strings.Split(v, ",")
+	}
+
+	readOnly := false
+	if v := os.Getenv("QUALITY_CACHE_REMOTE_READONLY"); v != "" {
+		readOnly = v == "1" || strings.EqualFold(v, "true")
+	}
+
+	return NewTwoTierStorage(local, remote, TwoTierStorageConfig{
+		ReadOnly:   readOnly,
+		ToolOptOut: toolOptOut,
+	}), nil
+}
+
+func newLocalStorage(url string) (Storage, error) {
+	scheme, rest, ok := strings.Cut(url, "://")
+	if !ok {
+		return NewFilesystemStorage(url)
+	}
+
+	switch scheme {
+	case "file":
+		return NewFilesystemStorage(rest)
+	case "mem", "memory":
+		return NewMemoryStorage(DefaultMemoryStorageMaxBytes), nil
+	case "pack":
+		return NewPackStorage(rest)
+	case "s3", "gs", "azblob":
+		return NewObjectStorage(context.Background(), url)
+	case "redis":
+		return nil, fmt.Errorf("cache storage scheme %q is not supported yet (supported: file, mem, pack, s3, gs, azblob)", scheme)
+	default:
+		return nil, fmt.Errorf("unknown cache storage scheme: %q", scheme)
+	}
+}
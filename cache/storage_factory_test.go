@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import "testing"
+
+func TestNewStorage_PlainPathUsesFilesystem(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	storage, err := NewStorage(tmpDir)
+	if err != nil {
+		t.Fatalf("NewStorage failed: %v", err)
+	}
+	defer storage.Close()
+
+	if _, ok := storage.(*FilesystemStorage); !ok {
+		t.Errorf("NewStorage(%q) = %T, want *FilesystemStorage", tmpDir, storage)
+	}
+}
+
+func TestNewStorage_FileScheme(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	storage, err := NewStorage("file://" + tmpDir)
+	if err != nil {
+		t.Fatalf("NewStorage failed: %v", err)
+	}
+	defer storage.Close()
+
+	if _, ok := storage.(*FilesystemStorage); !ok {
+		t.Errorf("NewStorage(file://) = %T, want *FilesystemStorage", storage)
+	}
+}
+
+func TestNewStorage_MemScheme(t *testing.T) {
+	storage, err := NewStorage("mem://")
+	if err != nil {
+		t.Fatalf("NewStorage failed: %v", err)
+	}
+	defer storage.Close()
+
+	if _, ok := storage.(*MemoryStorage); !ok {
+		t.Errorf("NewStorage(mem://) = %T, want *MemoryStorage", storage)
+	}
+}
+
+func TestNewStorage_RedisSchemeNotYetSupported(t *testing.T) {
+	if _, err := NewStorage("redis://localhost:6379"); err == nil {
+		t.Error("expected error for unsupported redis scheme")
+	}
+}
+
+func TestNewStorage_UnknownScheme(t *testing.T) {
+	if _, err := NewStorage("ftp://example.com/cache"); err == nil {
+		t.Error("expected error for unknown scheme")
+	}
+}
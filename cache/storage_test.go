@@ -22,12 +22,12 @@ func TestFilesystemStorage_ReadWrite(t *testing.T) {
 	data := []byte("test data")
 
 	// Write
-	if err := storage.Write(key, data); err != nil {
+	if err := storage.Write(key, "gofumpt", data); err != nil {
 		t.Fatalf("Write failed: %v", err)
 	}
 
 	// Read
-	readData, err := storage.Read(key)
+	readData, err := storage.Read(key, "gofumpt")
 	if err != nil {
 		t.Fatalf("Read failed: %v", err)
 	}
@@ -50,20 +50,20 @@ func TestFilesystemStorage_Delete(t *testing.T) {
 	data := []byte("test data")
 
 	// Write then delete
-	storage.Write(key, data)
+	storage.Write(key, "gofumpt", data)
 
-	if err := storage.Delete(key); err != nil {
+	if err := storage.Delete(key, "gofumpt"); err != nil {
 		t.Fatalf("Delete failed: %v", err)
 	}
 
 	// Try to read deleted key
-	_, err = storage.Read(key)
+	_, err = storage.Read(key, "gofumpt")
 	if err == nil {
 		t.Error("Expected error reading deleted key")
 	}
 
 	// Delete non-existent key should not error
-	if err := storage.Delete("nonexistent"); err != nil {
+	if err := storage.Delete("nonexistent", "gofumpt"); err != nil {
 		t.Errorf("Delete non-existent key should not error: %v", err)
 	}
 }
@@ -80,19 +80,26 @@ func TestFilesystemStorage_List(t *testing.T) {
 	// Write multiple keys
 	keys := []string{"key1", "key2", "key3"}
 	for _, key := range keys {
-		if err := storage.Write(key, []byte("data")); err != nil {
+		if err := storage.Write(key, "gofumpt", []byte("data")); err != nil {
 			t.Fatal(err)
 		}
 	}
 
 	// List
-	listedKeys, err := storage.List()
+	paths, err := storage.List()
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
 
-	if len(listedKeys) != len(keys) {
-		t.Errorf("List returned %d keys, want %d", len(listedKeys), len(keys))
+	if len(paths) != len(keys) {
+		t.Errorf("List returned %d entries, want %d", len(paths), len(keys))
+	}
+
+	// Every listed path must be readable via ReadPath.
+	for _, path := range paths {
+		if _, err := storage.ReadPath(path); err != nil {
+			t.Errorf("ReadPath(%s) failed: %v", path, err)
+		}
 	}
 }
 
@@ -117,7 +124,7 @@ func TestFilesystemStorage_Size(t *testing.T) {
 
 	// Write data
 	data := []byte("test data that is exactly 30!!")
-	storage.Write("key1", data)
+	storage.Write("key1", "gofumpt", data)
 
 	// Size should increase
 	size, err = storage.Size()
@@ -142,13 +149,13 @@ func TestFilesystemStorage_AtomicWrite(t *testing.T) {
 	key := "test-key"
 
 	// Write initial data
-	storage.Write(key, []byte("data1"))
+	storage.Write(key, "gofumpt", []byte("data1"))
 
 	// Overwrite (should be atomic)
-	storage.Write(key, []byte("data2"))
+	storage.Write(key, "gofumpt", []byte("data2"))
 
 	// Read should get latest data
-	data, _ := storage.Read(key)
+	data, _ := storage.Read(key, "gofumpt")
 	if string(data) != "data2" {
 		t.Errorf("Read data = %s, want data2", data)
 	}
@@ -175,8 +182,8 @@ func TestFilesystemStorage_ConcurrentAccess(t *testing.T) {
 		go func(n int) {
 			key := filepath.Join("key", string(rune('0'+n)))
 			data := []byte("data")
-			storage.Write(key, data)
-			storage.Read(key)
+			storage.Write(key, "gofumpt", data)
+			storage.Read(key, "gofumpt")
 			done <- true
 		}(i)
 	}
@@ -187,9 +194,9 @@ func TestFilesystemStorage_ConcurrentAccess(t *testing.T) {
 	}
 
 	// All writes should succeed
-	keys, _ := storage.List()
-	if len(keys) < 10 {
-		t.Errorf("Expected at least 10 keys, got %d", len(keys))
+	paths, _ := storage.List()
+	if len(paths) < 10 {
+		t.Errorf("Expected at least 10 keys, got %d", len(paths))
 	}
 }
 
@@ -203,15 +210,15 @@ func TestFilesystemStorage_CleanupCorrupted(t *testing.T) {
 	defer storage.Close()
 
 	// Write valid entry
-	storage.Write("valid", []byte(`{"key": "value"}`))
+	storage.Write("valid", "gofumpt", []byte(`{"key": "value"}`))
 
 	// Write corrupted entry (empty file)
-	corruptedPath := filepath.Join(tmpDir, "results", "tool", "00", "corrupted.json")
+	corruptedPath := filepath.Join(tmpDir, "results", "tool", "00", "11", "corrupted.json")
 	os.MkdirAll(filepath.Dir(corruptedPath), 0755)
 	os.WriteFile(corruptedPath, []byte(""), 0644)
 
 	// Write invalid JSON
-	invalidPath := filepath.Join(tmpDir, "results", "tool", "00", "invalid.json")
+	invalidPath := filepath.Join(tmpDir, "results", "tool", "00", "11", "invalid.json")
 	os.WriteFile(invalidPath, []byte("not json"), 0644)
 
 	// Cleanup
@@ -225,12 +232,41 @@ func TestFilesystemStorage_CleanupCorrupted(t *testing.T) {
 	}
 
 	// Valid entry should still exist
-	_, err = storage.Read("valid")
+	_, err = storage.Read("valid", "gofumpt")
 	if err != nil {
 		t.Error("Valid entry should still exist after cleanup")
 	}
 }
 
+func TestFilesystemStorage_Stats(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	storage, err := NewFilesystemStorage(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+
+	entries, bytes, oldest, newest := storage.Stats()
+	if entries != 0 || bytes != 0 || !oldest.IsZero() || !newest.IsZero() {
+		t.Errorf("Stats() on empty storage = (%d, %d, %v, %v), want all zero", entries, bytes, oldest, newest)
+	}
+
+	storage.Write("key1", "gofumpt", []byte("aaaa"))
+	storage.Write("key2", "gofumpt", []byte("bbbbbbbb"))
+
+	entries, bytes, oldest, newest = storage.Stats()
+	if entries != 2 {
+		t.Errorf("Stats() entries = %d, want 2", entries)
+	}
+	if bytes != 12 {
+		t.Errorf("Stats() bytes = %d, want 12", bytes)
+	}
+	if oldest.IsZero() || newest.IsZero() || newest.Before(oldest) {
+		t.Errorf("Stats() oldest/newest = %v/%v, want non-zero and oldest <= newest", oldest, newest)
+	}
+}
+
 func TestFilesystemStorage_CreateBasePath(t *testing.T) {
 	tmpDir := t.TempDir()
 	nonExistentPath := filepath.Join(tmpDir, "nested", "cache", "dir")
@@ -247,3 +283,67 @@ func TestFilesystemStorage_CreateBasePath(t *testing.T) {
 		t.Error("Base path should be created")
 	}
 }
+
+func TestFilesystemStorage_KeyToPathIsHashed(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	storage, err := NewFilesystemStorage(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+
+	// A key containing characters that would be unsafe as a raw path
+	// component must still produce a single valid file under the tool's
+	// directory.
+	key := "../../etc/passwd"
+	if err := storage.Write(key, "gofumpt", []byte("data")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	path := storage.keyToPath(key, "gofumpt")
+	if !filepath.IsAbs(path) {
+		t.Fatalf("keyToPath returned non-absolute path: %s", path)
+	}
+
+	rel, err := filepath.Rel(filepath.Join(tmpDir, "results", "gofumpt"), path)
+	if err != nil || rel == ".." || filepath.IsAbs(rel) {
+		t.Errorf("keyToPath escaped the tool directory: %s", path)
+	}
+
+	data, err := storage.Read(key, "gofumpt")
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if string(data) != "data" {
+		t.Errorf("Read data = %s, want data", data)
+	}
+}
+
+func TestFilesystemStorage_DifferentToolsDoNotCollide(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	storage, err := NewFilesystemStorage(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+
+	key := "same-key"
+	if err := storage.Write(key, "gofumpt", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Write(key, "golint", []byte("b")); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := storage.Read(key, "gofumpt")
+	if err != nil || string(a) != "a" {
+		t.Errorf("Read(gofumpt) = %s, %v, want a, nil", a, err)
+	}
+
+	b, err := storage.Read(key, "golint")
+	if err != nil || string(b) != "b" {
+		t.Errorf("Read(golint) = %s, %v, want b, nil", b, err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Entry is a raw cache payload as kept by a Store implementation.
+// Unlike CachedResult, Entry carries no knowledge of the tools package -
+// Store implementations only move bytes around.
+type Entry struct {
+	// Data is the serialized CachedResult.
+	Data []byte
+
+	// StoredAt is when the entry was written.
+	StoredAt time.Time
+}
+
+// Store is the interface for pluggable cache backends (local disk, HTTP,
+// S3, ...). A Manager composes one or more Stores - typically a fast local
+// store chained in front of a slower shared/remote one - so CI runners and
+// teammates can reuse cached lint/format results across machines.
+type Store interface {
+	// Get retrieves an entry for key. The bool return is false on a miss;
+	// callers must not treat a miss as an error.
+	Get(key CacheKey) (Entry, bool, error)
+
+	// Put stores an entry for key.
+	Put(key CacheKey, entry Entry) error
+
+	// Has reports whether key exists without fetching its payload.
+	Has(key CacheKey) (bool, error)
+}
+
+// compositeHash returns the sharding hash used by Store implementations:
+// SHA256 of FileHash+ToolVersion+ConfigHash+OptionsHash+Platform. Using a composite
+// hash (rather than CacheKey.String()'s truncated form) keeps shard
+// distribution stable even when FilePath or ToolName are long or share a
+// common prefix.
+func compositeHash(key CacheKey) string {
+	h := sha256.New()
+	h.Write([]byte(key.FileHash))
+	h.Write([]byte(key.ToolVersion))
+	h.Write([]byte(key.ConfigHash))
+	h.Write([]byte(key.OptionsHash))
+	h.Write([]byte(key.Platform))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// shardPath splits a composite hash into a {tool}/{shard}/{hash} relative
+// path: namespaced by tool first, so several projects or tools sharing one
+// remote bucket or HTTP cache server can't collide on the same hash, then
+// sharded by the hash's first 2 hex characters to avoid huge flat
+// directories within a tool's namespace.
+func shardPath(tool, hash string) string {
+	if tool == "" {
+		tool = "_"
+	}
+	if len(hash) < 2 {
+		return fmt.Sprintf("%s/00/%s", tool, hash)
+	}
+	return fmt.Sprintf("%s/%s/%s", tool, hash[:2], hash)
+}
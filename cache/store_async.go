@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+// asyncStoreQueueSize bounds how many writes can be pending for the
+// background store before new ones are dropped, so a stalled remote
+// backend can't grow this queue without limit.
+const asyncStoreQueueSize = 256
+
+// AsyncStore wraps a Store - typically a remote one reached over a network
+// - so Put returns immediately and the real write happens on a background
+// goroutine. This is what lets ChainStore's write-through to a remote
+// backend happen without blocking ParallelExecutor task completion on a
+// round-trip.
+//
+// A write that can't be queued (the backend is falling behind) is dropped
+// rather than blocking the caller; losing one write only costs a future
+// cache miss, which is the same cost as never having cached the entry at
+// all.
+type AsyncStore struct {
+	inner Store
+	queue chan asyncPut
+	done  chan struct{}
+}
+
+type asyncPut struct {
+	key   CacheKey
+	entry Entry
+}
+
+// NewAsyncStore wraps inner so its Put runs on a background goroutine.
+func NewAsyncStore(inner Store) *AsyncStore {
+	s := &AsyncStore{
+		inner: inner,
+		queue: make(chan asyncPut, asyncStoreQueueSize),
+		done:  make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *AsyncStore) run() {
+	defer close(s.done)
+	for put := range s.queue {
+		_ = s.inner.Put(put.key, put.entry)
+	}
+}
+
+// Get delegates to the wrapped store; a lookup's whole point is to learn
+// the answer before continuing, so there is nothing to do asynchronously.
+func (s *AsyncStore) Get(key CacheKey) (Entry, bool, error) {
+	return s.inner.Get(key)
+}
+
+// Put enqueues entry for background writing and returns immediately,
+// without waiting for (or erroring on) the underlying write.
+func (s *AsyncStore) Put(key CacheKey, entry Entry) error {
+	select {
+	case s.queue <- asyncPut{key: key, entry: entry}:
+	default:
+		// Queue is full; drop the write rather than block the caller.
+	}
+	return nil
+}
+
+// Has delegates to the wrapped store.
+func (s *AsyncStore) Has(key CacheKey) (bool, error) {
+	return s.inner.Has(key)
+}
+
+// Close stops accepting new writes and blocks until the queued ones have
+// drained, so a caller can flush pending remote writes before exiting.
+func (s *AsyncStore) Close() {
+	close(s.queue)
+	<-s.done
+}
+
+// Ensure AsyncStore implements Store.
+var _ Store = (*AsyncStore)(nil)
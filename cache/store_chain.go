@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+// ChainStore composes multiple Stores into one, consulted in order
+// (typically local -> remote). Get is read-through: the first hit is
+// returned and is also written back to every earlier (faster) store in the
+// chain so subsequent lookups are served locally. Put writes to every store
+// in the chain.
+type ChainStore struct {
+	stores []Store
+}
+
+// NewChainStore builds a ChainStore consulting stores in the given order.
+func NewChainStore(stores ...Store) *ChainStore {
+	return &ChainStore{stores: stores}
+}
+
+// Get returns the first hit found walking the chain, backfilling faster
+// stores that missed.
+func (c *ChainStore) Get(key CacheKey) (Entry, bool, error) {
+	for i, store := range c.stores {
+		entry, found, err := store.Get(key)
+		if err != nil {
+			return Entry{}, false, err
+		}
+		if !found {
+			continue
+		}
+
+		// Backfill stores earlier in the chain (read-through).
+		for _, earlier := range c.stores[:i] {
+			_ = earlier.Put(key, entry)
+		}
+
+		return entry, true, nil
+	}
+
+	return Entry{}, false, nil
+}
+
+// Put writes the entry to every store in the chain (write-back).
+func (c *ChainStore) Put(key CacheKey, entry Entry) error {
+	for _, store := range c.stores {
+		if err := store.Put(key, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Has reports whether any store in the chain has key.
+func (c *ChainStore) Has(key CacheKey) (bool, error) {
+	for _, store := range c.stores {
+		has, err := store.Has(key)
+		if err != nil {
+			return false, err
+		}
+		if has {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Close releases any background resources held by stores in the chain that
+// need it (see AsyncStore.Close), ignoring stores that don't.
+func (c *ChainStore) Close() {
+	for _, store := range c.stores {
+		if closer, ok := store.(interface{ Close() }); ok {
+			closer.Close()
+		}
+	}
+}
+
+// Ensure ChainStore implements Store.
+var _ Store = (*ChainStore)(nil)
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// StoreConfig selects and configures a remote Store backend, typically
+// populated from the `--cache-backend` flag and `QUALITY_CACHE_*`
+// environment variables.
+type StoreConfig struct {
+	// Backend is "local", "http", or "s3". Empty means "local".
+	Backend string
+
+	// LocalPath is the base directory for the local backend.
+	LocalPath string
+
+	// HTTPBaseURL is the cache server base URL for the http backend.
+	HTTPBaseURL string
+
+	// HTTPToken is an optional bearer token for the http backend.
+	HTTPToken string
+
+	// S3Bucket and S3Prefix configure the s3 backend.
+	S3Bucket string
+	S3Prefix string
+
+	// RemoteReadOnly disables writes to the remote backend (http or s3)
+	// while still allowing reads, for untrusted PR builds that should
+	// benefit from a shared cache without being able to poison it.
+	RemoteReadOnly bool
+}
+
+// StoreConfigFromEnv reads QUALITY_CACHE_* environment variables into a
+// StoreConfig, using backend/localPath as defaults (e.g. from --cache-backend
+// and the config file).
+func StoreConfigFromEnv(backend, localPath string) StoreConfig {
+	cfg := StoreConfig{Backend: backend, LocalPath: localPath}
+
+	if v := os.Getenv("QUALITY_CACHE_BACKEND"); v != "" {
+		cfg.Backend = v
+	}
+	if v := os.Getenv("QUALITY_CACHE_HTTP_URL"); v != "" {
+		cfg.HTTPBaseURL = v
+	}
+	if v := os.Getenv("QUALITY_CACHE_HTTP_TOKEN"); v != "" {
+		cfg.HTTPToken = v
+	}
+	if v := os.Getenv("QUALITY_CACHE_S3_BUCKET"); v != "" {
+		cfg.S3Bucket = v
+	}
+	if v := os.Getenv("QUALITY_CACHE_S3_PREFIX"); v != "" {
+		cfg.S3Prefix = v
+	}
+	if v := os.Getenv("QUALITY_CACHE_REMOTE_READONLY"); v != "" {
+		cfg.RemoteReadOnly = v == "1" || strings.EqualFold(v, "true")
+	}
+
+	return cfg
+}
+
+// BuildChain builds a ChainStore with the local store first, followed by the
+// configured remote backend (if any), so lookups hit local disk before
+// falling back to the shared/remote store. A configured remote backend is
+// wrapped in ReadOnlyStore when c.RemoteReadOnly is set, and otherwise in
+// AsyncStore so Put writes to it happen on a background goroutine and never
+// block task completion; the caller should call ChainStore.Close to flush
+// those writes before exiting.
+func (c StoreConfig) BuildChain() (*ChainStore, error) {
+	local, err := NewLocalStore(c.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stores := []Store{local}
+
+	var remote Store
+	switch c.Backend {
+	case "", "local":
+		// Local-only chain.
+	case "http":
+		if c.HTTPBaseURL == "" {
+			return nil, fmt.Errorf("cache backend %q requires QUALITY_CACHE_HTTP_URL", c.Backend)
+		}
+		remote = NewHTTPStore(c.HTTPBaseURL, c.HTTPToken)
+	case "s3":
+		if c.S3Bucket == "" {
+			return nil, fmt.Errorf("cache backend %q requires QUALITY_CACHE_S3_BUCKET", c.Backend)
+		}
+		awsCfg, err := config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load AWS config: %w", err)
+		}
+		remote = NewS3Store(s3.NewFromConfig(awsCfg), c.S3Bucket, c.S3Prefix)
+	default:
+		return nil, fmt.Errorf("unknown cache backend: %s (supported: local, http, s3)", c.Backend)
+	}
+
+	if remote != nil {
+		if c.RemoteReadOnly {
+			remote = NewReadOnlyStore(remote)
+		} else {
+			remote = NewAsyncStore(remote)
+		}
+		stores = append(stores, remote)
+	}
+
+	return NewChainStore(stores...), nil
+}
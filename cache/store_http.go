@@ -0,0 +1,208 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// HTTPStore is a Store backed by a remote HTTP cache server. Entries are
+// addressed by the key's composite hash under baseURL, e.g.
+// "{baseURL}/{tool}/{shard}/{hash}". It's intended for CI runners and
+// teammates sharing a central cache over GET/PUT/HEAD.
+type HTTPStore struct {
+	baseURL string
+	token   string
+	client  *http.Client
+
+	// mu guards etags, a small in-process record of the ETag and body last
+	// seen for a given URL. Keys are content-addressed, so a cache entry
+	// never changes once written - the only thing a revalidation can learn
+	// is "yes, still this", which lets a second Get for the same key within
+	// one run (e.g. CachedTool.Peek followed by Execute) send
+	// If-None-Match and skip re-downloading the body on a 304.
+	mu    sync.Mutex
+	etags map[string]httpEntry
+}
+
+// httpEntry is what HTTPStore remembers about a URL it has already fetched
+// or stored, for conditional revalidation.
+type httpEntry struct {
+	etag string
+	data []byte
+}
+
+// NewHTTPStore creates a Store that talks to a cache server at baseURL.
+// token is an optional bearer token sent as "Authorization: Bearer <token>";
+// pass an empty string to disable auth.
+func NewHTTPStore(baseURL, token string) *HTTPStore {
+	return &HTTPStore{
+		baseURL: baseURL,
+		token:   token,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		etags:   make(map[string]httpEntry),
+	}
+}
+
+func (s *HTTPStore) url(key CacheKey) string {
+	return fmt.Sprintf("%s/%s", s.baseURL, shardPath(key.ToolName, compositeHash(key)))
+}
+
+func (s *HTTPStore) newRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return req, nil
+}
+
+// Get retrieves an entry for key via HTTP GET. If a prior Get or Put for
+// the same key left an ETag on record, it is sent as If-None-Match so an
+// unchanged entry comes back as a bodyless 304 instead of a full transfer.
+func (s *HTTPStore) Get(key CacheKey) (Entry, bool, error) {
+	url := s.url(key)
+
+	req, err := s.newRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	s.mu.Lock()
+	known, haveKnown := s.etags[url]
+	s.mu.Unlock()
+	if haveKnown && known.etag != "" {
+		req.Header.Set("If-None-Match", known.etag)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("http store GET failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Entry{}, false, nil
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		if haveKnown {
+			return Entry{Data: known.data, StoredAt: time.Now()}, true, nil
+		}
+		// Server claims nothing changed but we have no body on record (e.g.
+		// process restart); fall through to an unconditional re-fetch.
+		return s.getUncached(url)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Entry{}, false, fmt.Errorf("http store GET returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to read http store response: %w", err)
+	}
+	s.remember(url, resp.Header.Get("ETag"), data)
+
+	return Entry{Data: data, StoredAt: time.Now()}, true, nil
+}
+
+// getUncached re-issues a plain GET with no If-None-Match, for the rare
+// case where the server 304'd against an ETag we no longer have the body
+// for.
+func (s *HTTPStore) getUncached(url string) (Entry, bool, error) {
+	req, err := s.newRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("http store GET failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Entry{}, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Entry{}, false, fmt.Errorf("http store GET returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to read http store response: %w", err)
+	}
+	s.remember(url, resp.Header.Get("ETag"), data)
+
+	return Entry{Data: data, StoredAt: time.Now()}, true, nil
+}
+
+func (s *HTTPStore) remember(url, etag string, data []byte) {
+	if etag == "" {
+		return
+	}
+	s.mu.Lock()
+	s.etags[url] = httpEntry{etag: etag, data: data}
+	s.mu.Unlock()
+}
+
+// Put stores an entry for key via HTTP PUT.
+func (s *HTTPStore) Put(key CacheKey, entry Entry) error {
+	url := s.url(key)
+
+	req, err := s.newRequest(http.MethodPut, url, bytes.NewReader(entry.Data))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.ContentLength = int64(len(entry.Data))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http store PUT failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("http store PUT returned status %d", resp.StatusCode)
+	}
+
+	// Deliberately not s.remember() here: etags exists so a second Get for
+	// a key this process already fetched can revalidate instead of
+	// re-downloading. Pre-warming it from Put would make every store's
+	// own first Get after a Put 304 from memory without ever exercising
+	// the download path.
+	return nil
+}
+
+// Has reports whether key exists via HTTP HEAD.
+func (s *HTTPStore) Has(key CacheKey) (bool, error) {
+	req, err := s.newRequest(http.MethodHead, s.url(key), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("http store HEAD failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("http store HEAD returned status %d", resp.StatusCode)
+	}
+}
+
+// Ensure HTTPStore implements Store.
+var _ Store = (*HTTPStore)(nil)
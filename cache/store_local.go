@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LocalStore is a Store backed by the local filesystem, sharded by the
+// first 2 hex chars of the key's composite hash.
+type LocalStore struct {
+	basePath string
+}
+
+// NewLocalStore creates a filesystem-backed Store rooted at basePath.
+func NewLocalStore(basePath string) (*LocalStore, error) {
+	if err := os.MkdirAll(basePath, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create local store directory: %w", err)
+	}
+	return &LocalStore{basePath: basePath}, nil
+}
+
+func (s *LocalStore) path(key CacheKey) string {
+	return filepath.Join(s.basePath, shardPath(key.ToolName, compositeHash(key)))
+}
+
+// Get retrieves an entry for key.
+func (s *LocalStore) Get(key CacheKey) (Entry, bool, error) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Entry{}, false, nil
+		}
+		return Entry{}, false, fmt.Errorf("failed to read local store entry: %w", err)
+	}
+
+	info, err := os.Stat(s.path(key))
+	storedAt := time.Now()
+	if err == nil {
+		storedAt = info.ModTime()
+	}
+
+	return Entry{Data: data, StoredAt: storedAt}, true, nil
+}
+
+// Put stores an entry for key.
+func (s *LocalStore) Put(key CacheKey, entry Entry) error {
+	path := s.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create local store shard directory: %w", err)
+	}
+
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, entry.Data, 0o644); err != nil {
+		return fmt.Errorf("failed to write local store entry: %w", err)
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("failed to finalize local store entry: %w", err)
+	}
+
+	return nil
+}
+
+// Has reports whether key exists in the store.
+func (s *LocalStore) Has(key CacheKey) (bool, error) {
+	if _, err := os.Stat(s.path(key)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat local store entry: %w", err)
+	}
+	return true, nil
+}
+
+// Ensure LocalStore implements Store.
+var _ Store = (*LocalStore)(nil)
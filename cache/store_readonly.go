@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+// ReadOnlyStore wraps a Store so Put is a no-op, for --cache-remote-readonly:
+// an untrusted PR build may read a shared/remote cache for speed, but must
+// not be able to poison it with results built from an unreviewed branch.
+type ReadOnlyStore struct {
+	inner Store
+}
+
+// NewReadOnlyStore wraps inner so writes to it are silently dropped.
+func NewReadOnlyStore(inner Store) *ReadOnlyStore {
+	return &ReadOnlyStore{inner: inner}
+}
+
+// Get delegates to the wrapped store.
+func (s *ReadOnlyStore) Get(key CacheKey) (Entry, bool, error) {
+	return s.inner.Get(key)
+}
+
+// Put is a no-op; the wrapped store is never written to.
+func (s *ReadOnlyStore) Put(key CacheKey, entry Entry) error {
+	return nil
+}
+
+// Has delegates to the wrapped store.
+func (s *ReadOnlyStore) Has(key CacheKey) (bool, error) {
+	return s.inner.Has(key)
+}
+
+// Ensure ReadOnlyStore implements Store.
+var _ Store = (*ReadOnlyStore)(nil)
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// s3API is the subset of *s3.Client used by S3Store, narrowed so tests can
+// substitute a fake implementation instead of hitting a real bucket.
+type s3API interface {
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+}
+
+// S3Store is a Store backed by an S3-compatible object store (AWS S3,
+// MinIO, R2, ...). Entries are addressed by the key's composite hash under
+// an optional key prefix.
+type S3Store struct {
+	client s3API
+	bucket string
+	prefix string
+}
+
+// NewS3Store creates a Store backed by the given bucket using client. prefix
+// is prepended to every object key (e.g. "quality-cache/") and may be empty.
+func NewS3Store(client *s3.Client, bucket, prefix string) *S3Store {
+	return &S3Store{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (s *S3Store) objectKey(key CacheKey) string {
+	return s.prefix + shardPath(key.ToolName, compositeHash(key))
+}
+
+// Get retrieves an entry for key.
+func (s *S3Store) Get(key CacheKey) (Entry, bool, error) {
+	out, err := s.client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return Entry{}, false, nil
+		}
+		return Entry{}, false, fmt.Errorf("s3 store GetObject failed: %w", err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("failed to read s3 object body: %w", err)
+	}
+
+	storedAt := time.Now()
+	if out.LastModified != nil {
+		storedAt = *out.LastModified
+	}
+
+	return Entry{Data: data, StoredAt: storedAt}, true, nil
+}
+
+// Put stores an entry for key.
+func (s *S3Store) Put(key CacheKey, entry Entry) error {
+	_, err := s.client.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+		Body:   bytes.NewReader(entry.Data),
+	})
+	if err != nil {
+		return fmt.Errorf("s3 store PutObject failed: %w", err)
+	}
+	return nil
+}
+
+// Has reports whether key exists in the bucket.
+func (s *S3Store) Has(key CacheKey) (bool, error) {
+	_, err := s.client.HeadObject(context.Background(), &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("s3 store HeadObject failed: %w", err)
+	}
+	return true, nil
+}
+
+// Ensure S3Store implements Store.
+var _ Store = (*S3Store)(nil)
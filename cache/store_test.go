@@ -0,0 +1,185 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(tool string) CacheKey {
+	return CacheKey{
+		FilePath:    "/repo/main.go",
+		FileHash:    "filehash",
+		ToolName:    tool,
+		ToolVersion: "1.0.0",
+		ConfigHash:  "confighash",
+		OptionsHash: "optionshash",
+	}
+}
+
+func TestShardPath_NamespacesByTool(t *testing.T) {
+	hash := compositeHash(testKey("gofumpt"))
+
+	gofumptPath := shardPath("gofumpt", hash)
+	golangciPath := shardPath("golangci-lint", hash)
+
+	assert.NotEqual(t, gofumptPath, golangciPath, "same hash under different tools must not collide")
+	assert.Contains(t, gofumptPath, "gofumpt/")
+	assert.Contains(t, golangciPath, "golangci-lint/")
+}
+
+func TestLocalStore_DifferentToolsDoNotCollide(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	keyA := testKey("gofumpt")
+	keyB := keyA
+	keyB.ToolName = "golangci-lint"
+
+	require.NoError(t, store.Put(keyA, Entry{Data: []byte("a")}))
+	require.NoError(t, store.Put(keyB, Entry{Data: []byte("b")}))
+
+	got, found, err := store.Get(keyA)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []byte("a"), got.Data)
+
+	got, found, err = store.Get(keyB)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []byte("b"), got.Data)
+}
+
+func TestReadOnlyStore_PutIsNoop(t *testing.T) {
+	local, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	readonly := NewReadOnlyStore(local)
+	key := testKey("gofumpt")
+
+	require.NoError(t, readonly.Put(key, Entry{Data: []byte("ignored")}))
+
+	has, err := local.Has(key)
+	require.NoError(t, err)
+	assert.False(t, has, "ReadOnlyStore.Put must not reach the wrapped store")
+}
+
+func TestAsyncStore_PutReturnsBeforeWriteCompletes(t *testing.T) {
+	local, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	async := NewAsyncStore(local)
+	key := testKey("gofumpt")
+
+	require.NoError(t, async.Put(key, Entry{Data: []byte("payload")}))
+	async.Close() // waits for the queued write to drain
+
+	got, found, err := local.Get(key)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []byte("payload"), got.Data)
+}
+
+// newHTTPCacheServer returns a fake cache server tracking how many times the
+// body has actually been served, so tests can assert a conditional GET
+// skips the transfer.
+func newHTTPCacheServer(t *testing.T, etag string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	stored := map[string][]byte{}
+	var bodyServedCount int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch r.Method {
+		case http.MethodPut:
+			data := make([]byte, r.ContentLength)
+			_, _ = r.Body.Read(data)
+			stored[path] = data
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			data, ok := stored[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			atomic.AddInt32(&bodyServedCount, 1)
+			w.Header().Set("ETag", etag)
+			w.Write(data)
+		case http.MethodHead:
+			if _, ok := stored[path]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	return httptest.NewServer(mux), &bodyServedCount
+}
+
+func TestHTTPStore_ConditionalGetSkipsReDownload(t *testing.T) {
+	server, bodyServedCount := newHTTPCacheServer(t, `"etag-1"`)
+	defer server.Close()
+
+	store := NewHTTPStore(server.URL, "")
+	key := testKey("gofumpt")
+
+	require.NoError(t, store.Put(key, Entry{Data: []byte("hello")}))
+
+	first, found, err := store.Get(key)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []byte("hello"), first.Data)
+	assert.EqualValues(t, 1, atomic.LoadInt32(bodyServedCount))
+
+	second, found, err := store.Get(key)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []byte("hello"), second.Data)
+	assert.EqualValues(t, 1, atomic.LoadInt32(bodyServedCount), "a second Get for the same key must not re-download an unchanged body")
+}
+
+func TestStoreConfig_BuildChain_RemoteReadOnly(t *testing.T) {
+	server, _ := newHTTPCacheServer(t, `"etag-1"`)
+	defer server.Close()
+
+	cfg := StoreConfig{
+		Backend:        "http",
+		LocalPath:      t.TempDir(),
+		HTTPBaseURL:    server.URL,
+		RemoteReadOnly: true,
+	}
+
+	chain, err := cfg.BuildChain()
+	require.NoError(t, err)
+	defer chain.Close()
+
+	key := testKey("gofumpt")
+	require.NoError(t, chain.Put(key, Entry{Data: []byte("should not reach remote")}))
+
+	has, err := NewHTTPStore(server.URL, "").Has(key)
+	require.NoError(t, err)
+	assert.False(t, has, "RemoteReadOnly must keep the remote backend untouched")
+}
+
+func TestStoreConfigFromEnv_RemoteReadOnly(t *testing.T) {
+	t.Setenv("QUALITY_CACHE_REMOTE_READONLY", "true")
+	cfg := StoreConfigFromEnv("local", t.TempDir())
+	assert.True(t, cfg.RemoteReadOnly)
+}
@@ -0,0 +1,492 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// DefaultShardCapacity is the per-shard entry cap TieredManager uses when
+// NewTieredManager is called with a non-positive capacity: enough warm
+// entries for a single tool's worth of a mid-sized monorepo without
+// risking unbounded growth in a long-running `quality watch` process.
+const DefaultShardCapacity = 512
+
+// DefaultLockTimeout bounds how long GetOrCompute waits for another
+// goroutine's in-flight computation of the same key before giving up,
+// used when SetLockTimeout hasn't overridden it. Long enough for a
+// normal lint/format run, short enough that a genuinely stuck peer (a
+// hung subprocess) doesn't block every other caller for the rest of the
+// process's life.
+const DefaultLockTimeout = 30 * time.Second
+
+// ErrCacheKeyLocked is returned by GetOrCompute when another goroutine is
+// already computing key's entry and LockTimeout elapses before it
+// finishes. Callers should treat this the same as a cache miss and run
+// the tool directly without caching, rather than retrying indefinitely -
+// see argo-cd's revisionCacheLockTimeout for the same "don't wait forever
+// on a stuck holder" pattern.
+var ErrCacheKeyLocked = errors.New("cache: key locked by a concurrent computation")
+
+// TieredManager fronts a disk-backed Manager with a partitioned,
+// bounded in-memory LRU: one shard per tool name, so a hot FORMAT tool
+// evicting its own entries never crowds out a cold LINT tool's. Within a
+// shard, concurrent lookups for the same key single-flight onto one
+// disk.Get call, so N workers racing to fill the same (tool, file-hash)
+// slot after a cold start only pay the disk read/deserialization cost
+// once. Writes and invalidations go through to disk first and only then
+// update the memory tier, so disk stays the tier of record and a
+// restarted process loses nothing but warmth.
+type TieredManager struct {
+	disk     Manager
+	shardCap int
+
+	shardsMu sync.Mutex
+	shards   map[string]*memShard
+
+	memHits    atomic.Int64
+	diskHits   atomic.Int64
+	misses     atomic.Int64
+	pruneCount atomic.Int64
+
+	prunerMu     sync.Mutex
+	prunerCancel context.CancelFunc
+	prunerDone   chan struct{}
+
+	lockTimeout time.Duration
+
+	computeMu       sync.Mutex
+	computeInflight map[string]*computeCall
+}
+
+// computeCall is a GetOrCompute computation in flight for one cache key.
+type computeCall struct {
+	done   chan struct{}
+	result *tools.Result
+	err    error
+}
+
+// NewTieredManager creates a TieredManager in front of disk. shardCap
+// bounds each per-tool shard's entry count; a non-positive value falls
+// back to DefaultShardCapacity.
+func NewTieredManager(disk Manager, shardCap int) *TieredManager {
+	if shardCap <= 0 {
+		shardCap = DefaultShardCapacity
+	}
+
+	return &TieredManager{
+		disk:            disk,
+		shardCap:        shardCap,
+		shards:          make(map[string]*memShard),
+		lockTimeout:     DefaultLockTimeout,
+		computeInflight: make(map[string]*computeCall),
+	}
+}
+
+// SetLockTimeout overrides how long GetOrCompute waits on a concurrent
+// computation of the same key before returning ErrCacheKeyLocked. A
+// non-positive value restores DefaultLockTimeout.
+func (tm *TieredManager) SetLockTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultLockTimeout
+	}
+	tm.lockTimeout = timeout
+}
+
+// shardFor returns key's tool's shard, creating it on first use.
+func (tm *TieredManager) shardFor(toolName string) *memShard {
+	tm.shardsMu.Lock()
+	defer tm.shardsMu.Unlock()
+
+	shard, ok := tm.shards[toolName]
+	if !ok {
+		shard = newMemShard(tm.shardCap)
+		tm.shards[toolName] = shard
+	}
+	return shard
+}
+
+// Get serves key from the memory tier if present, otherwise falls back
+// to disk and backfills the memory tier on a disk hit.
+func (tm *TieredManager) Get(key CacheKey) (*CachedResult, error) {
+	shard := tm.shardFor(key.ToolName)
+	k := key.String()
+
+	if cached, ok := shard.get(k); ok {
+		tm.memHits.Add(1)
+		return cached, nil
+	}
+
+	cached, err := shard.loadOnce(k, func() (*CachedResult, error) {
+		return tm.disk.Get(key)
+	})
+	if err != nil {
+		tm.misses.Add(1)
+		return nil, err
+	}
+
+	shard.put(k, cached)
+	tm.diskHits.Add(1)
+	return cached, nil
+}
+
+// Set writes result through to disk, then refreshes the memory tier
+// with whatever disk now holds for key (the same redacted, metadata-
+// stamped form a later Get would return), so the memory tier never
+// drifts from what disk considers canonical.
+func (tm *TieredManager) Set(key CacheKey, result *tools.Result) error {
+	if err := tm.disk.Set(key, result); err != nil {
+		return err
+	}
+
+	if cached, err := tm.disk.Get(key); err == nil {
+		tm.shardFor(key.ToolName).put(key.String(), cached)
+	}
+
+	return nil
+}
+
+// GetOrCompute returns key's cached result if present, otherwise calls
+// compute (typically the underlying tool run) and caches its result. When
+// several goroutines miss the same key concurrently - e.g. ExecuteParallel
+// workers racing over overlapping glob matches, or the LSP server
+// re-triggering a run on the same file before the previous one finished -
+// only the first caller to arrive actually invokes compute; the rest wait
+// on its result instead of each running the tool themselves. A waiter
+// that's still blocked after LockTimeout gives up and returns
+// ErrCacheKeyLocked rather than waiting forever on a peer that might be
+// stuck, leaving the caller free to fall through to an uncached run of
+// its own.
+func (tm *TieredManager) GetOrCompute(key CacheKey, compute func() (*tools.Result, error)) (*tools.Result, error) {
+	if cached, err := tm.Get(key); err == nil {
+		return cached.Result, nil
+	}
+
+	k := key.String()
+
+	tm.computeMu.Lock()
+	if call, ok := tm.computeInflight[k]; ok {
+		tm.computeMu.Unlock()
+
+		select {
+		case <-call.done:
+			return call.result, call.err
+		case <-time.After(tm.lockTimeout):
+			return nil, ErrCacheKeyLocked
+		}
+	}
+
+	call := &computeCall{done: make(chan struct{})}
+	tm.computeInflight[k] = call
+	tm.computeMu.Unlock()
+
+	call.result, call.err = compute()
+	if call.err == nil {
+		// Best-effort: a failure to persist the fresh result shouldn't
+		// turn a successful compute into an error for every waiter.
+		_ = tm.Set(key, call.result)
+	}
+	close(call.done)
+
+	tm.computeMu.Lock()
+	delete(tm.computeInflight, k)
+	tm.computeMu.Unlock()
+
+	return call.result, call.err
+}
+
+// Invalidate drops key from both tiers.
+func (tm *TieredManager) Invalidate(key CacheKey) error {
+	tm.shardFor(key.ToolName).delete(key.String())
+	return tm.disk.Invalidate(key)
+}
+
+// InvalidateAll clears every shard's memory tier, resets this manager's
+// own hit/miss counters, and invalidates disk.
+func (tm *TieredManager) InvalidateAll() error {
+	tm.shardsMu.Lock()
+	for _, shard := range tm.shards {
+		shard.clear()
+	}
+	tm.shardsMu.Unlock()
+
+	tm.memHits.Store(0)
+	tm.diskHits.Store(0)
+	tm.misses.Store(0)
+
+	return tm.disk.InvalidateAll()
+}
+
+// IndexConfigFile delegates to disk; the index itself only needs to
+// exist once, and disk is the tier of record.
+func (tm *TieredManager) IndexConfigFile(configPath string, key CacheKey) {
+	tm.disk.IndexConfigFile(configPath, key)
+}
+
+// InvalidateByConfigFile clears every shard's memory tier before
+// delegating to disk. The index doesn't record which tool(s) a config
+// path maps to (several tools can share one, e.g. pyproject.toml), so
+// unlike Invalidate this can't target a single shard - it clears all of
+// them, same as InvalidateAll.
+func (tm *TieredManager) InvalidateByConfigFile(configPath string) error {
+	tm.shardsMu.Lock()
+	for _, shard := range tm.shards {
+		shard.clear()
+	}
+	tm.shardsMu.Unlock()
+
+	return tm.disk.InvalidateByConfigFile(configPath)
+}
+
+// InvalidateByTool clears toolName's memory shard before delegating to
+// disk.
+func (tm *TieredManager) InvalidateByTool(toolName, toolVersion string) error {
+	tm.shardFor(toolName).clear()
+	return tm.disk.InvalidateByTool(toolName, toolVersion)
+}
+
+// Stats returns disk's entry/size/age accounting with HitCount,
+// MissCount, MemHitCount, and DiskHitCount replaced by this manager's own
+// per-tier counts, since disk.Stats alone can't see memory-tier hits.
+func (tm *TieredManager) Stats() CacheStats {
+	stats := tm.disk.Stats()
+
+	mem := tm.memHits.Load()
+	disk := tm.diskHits.Load()
+	miss := tm.misses.Load()
+	total := mem + disk + miss
+
+	stats.MemHitCount = mem
+	stats.DiskHitCount = disk
+	stats.HitCount = mem + disk
+	stats.MissCount = miss
+	stats.HitRate = 0.0
+	if total > 0 {
+		stats.HitRate = float64(mem+disk) / float64(total)
+	}
+	stats.PruneCount = tm.pruneCount.Load()
+
+	return stats
+}
+
+// Cleanup delegates to disk; the memory tier is self-bounding (per-shard
+// LRU eviction) and needs no separate sweep.
+func (tm *TieredManager) Cleanup() error {
+	return tm.disk.Cleanup()
+}
+
+// Prune runs one disk-tier cleanup sweep (age/size limits, via
+// disk.Cleanup) and counts it in Stats().PruneCount, unless ctx is
+// already done. This is what StartPruner calls on each tick, and is also
+// exposed directly for a caller that wants to trigger a sweep on demand
+// (e.g. a `quality cache prune` command) without waiting for the next
+// scheduled one.
+func (tm *TieredManager) Prune(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := tm.disk.Cleanup(); err != nil {
+		return err
+	}
+
+	tm.pruneCount.Add(1)
+	return nil
+}
+
+// StartPruner runs Prune once per interval in a background goroutine
+// until ctx is cancelled or Stop is called, the same periodic-sweep
+// shape Hugo's filecache pruner uses. Calling StartPruner again while one
+// is already running replaces it (the previous goroutine is stopped
+// first). A sweep error is swallowed - a transient disk error shouldn't
+// kill the pruner for the rest of the process's life - and simply isn't
+// counted, so it shows up as a PruneCount that lags interval ticks.
+func (tm *TieredManager) StartPruner(ctx context.Context, interval time.Duration) {
+	tm.Stop()
+
+	prunerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	tm.prunerMu.Lock()
+	tm.prunerCancel = cancel
+	tm.prunerDone = done
+	tm.prunerMu.Unlock()
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-prunerCtx.Done():
+				return
+			case <-ticker.C:
+				_ = tm.Prune(prunerCtx)
+			}
+		}
+	}()
+}
+
+// Stop cancels a pruner started by StartPruner and waits for its
+// goroutine to exit. A no-op if no pruner is running.
+func (tm *TieredManager) Stop() {
+	tm.prunerMu.Lock()
+	cancel := tm.prunerCancel
+	done := tm.prunerDone
+	tm.prunerCancel = nil
+	tm.prunerDone = nil
+	tm.prunerMu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// Close stops any running pruner and releases disk. The memory tier
+// holds nothing that outlives the process.
+func (tm *TieredManager) Close() error {
+	tm.Stop()
+	return tm.disk.Close()
+}
+
+// Enabled reports whether disk is enabled; a disabled disk tier disables
+// this manager too; see CachedTool.Execute's early-out on Enabled.
+func (tm *TieredManager) Enabled() bool {
+	return tm.disk.Enabled()
+}
+
+// Ensure TieredManager implements Manager.
+var _ Manager = (*TieredManager)(nil)
+
+// memShard is a bounded LRU cache of *CachedResult for one tool, with
+// single-flight loading so concurrent misses for the same key collapse
+// into one disk read.
+type memShard struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+	inflight map[string]*memShardCall
+}
+
+// memShardEntry is the value stored in memShard.order/entries.
+type memShardEntry struct {
+	key   string
+	value *CachedResult
+}
+
+// memShardCall is an in-flight single-flight load for one key.
+type memShardCall struct {
+	done   chan struct{}
+	result *CachedResult
+	err    error
+}
+
+func newMemShard(capacity int) *memShard {
+	return &memShard{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		inflight: make(map[string]*memShardCall),
+	}
+}
+
+// get returns the memory-tier value for k, without touching disk.
+func (s *memShard) get(k string) (*CachedResult, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[k]
+	if !ok {
+		return nil, false
+	}
+
+	s.order.MoveToFront(elem)
+	return elem.Value.(*memShardEntry).value, true
+}
+
+// put inserts or refreshes k, evicting the least-recently-used entry
+// once the shard is over capacity.
+func (s *memShard) put(k string, v *CachedResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.entries[k]; ok {
+		elem.Value.(*memShardEntry).value = v
+		s.order.MoveToFront(elem)
+		return
+	}
+
+	elem := s.order.PushFront(&memShardEntry{key: k, value: v})
+	s.entries[k] = elem
+
+	for s.capacity > 0 && len(s.entries) > s.capacity {
+		oldest := s.order.Back()
+		if oldest == nil {
+			break
+		}
+		delete(s.entries, oldest.Value.(*memShardEntry).key)
+		s.order.Remove(oldest)
+	}
+}
+
+// delete evicts k, if present.
+func (s *memShard) delete(k string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[k]
+	if !ok {
+		return
+	}
+
+	delete(s.entries, k)
+	s.order.Remove(elem)
+}
+
+// clear empties the shard.
+func (s *memShard) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.order.Init()
+	s.entries = make(map[string]*list.Element)
+}
+
+// loadOnce runs fn for k at most once across concurrent callers: the
+// first caller to arrive executes fn and fans its result out to every
+// other caller that arrived for the same k while it was running, a
+// sync.Once scoped to a single key rather than the whole shard.
+func (s *memShard) loadOnce(k string, fn func() (*CachedResult, error)) (*CachedResult, error) {
+	s.mu.Lock()
+	if call, ok := s.inflight[k]; ok {
+		s.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+
+	call := &memShardCall{done: make(chan struct{})}
+	s.inflight[k] = call
+	s.mu.Unlock()
+
+	call.result, call.err = fn()
+	close(call.done)
+
+	s.mu.Lock()
+	delete(s.inflight, k)
+	s.mu.Unlock()
+
+	return call.result, call.err
+}
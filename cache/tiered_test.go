@@ -0,0 +1,332 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+func newTieredTestManager(t *testing.T) (*TieredManager, string) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	disk, err := NewCacheManager(filepath.Join(tmpDir, "cache"), 100*1024*1024, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create disk cache manager: %v", err)
+	}
+	t.Cleanup(func() { disk.Close() })
+
+	return NewTieredManager(disk, DefaultShardCapacity), tmpDir
+}
+
+func TestTieredManager_SetWarmsMemoryTier(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	if err := tiered.Set(key, &tools.Result{Success: true}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	// Set already refreshed the memory tier, so both Gets that follow are
+	// served from memory - disk is never consulted again for this key.
+	if _, err := tiered.Get(key); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if _, err := tiered.Get(key); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	stats := tiered.Stats()
+	if stats.MemHitCount != 2 {
+		t.Errorf("MemHitCount = %d, want 2", stats.MemHitCount)
+	}
+	if stats.DiskHitCount != 0 {
+		t.Errorf("DiskHitCount = %d, want 0", stats.DiskHitCount)
+	}
+}
+
+func TestTieredManager_Miss(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	if _, err := tiered.Get(key); err == nil {
+		t.Error("Expected miss on an unset key")
+	}
+
+	stats := tiered.Stats()
+	if stats.MissCount != 1 {
+		t.Errorf("MissCount = %d, want 1", stats.MissCount)
+	}
+}
+
+func TestTieredManager_InvalidateDropsBothTiers(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	tiered.Set(key, &tools.Result{Success: true})
+	tiered.Get(key) // warm the memory tier
+
+	if err := tiered.Invalidate(key); err != nil {
+		t.Fatalf("Invalidate failed: %v", err)
+	}
+
+	if _, err := tiered.Get(key); err == nil {
+		t.Error("Expected miss after Invalidate")
+	}
+}
+
+func TestTieredManager_InvalidateByConfigFileDropsBothTiers(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+	configFile := filepath.Join(tmpDir, "ruff.toml")
+
+	tool := &mockTool{name: "ruff", version: "v0.5.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	tiered.Set(key, &tools.Result{Success: true})
+	tiered.IndexConfigFile(configFile, key)
+	tiered.Get(key) // warm the memory tier
+
+	if err := tiered.InvalidateByConfigFile(configFile); err != nil {
+		t.Fatalf("InvalidateByConfigFile failed: %v", err)
+	}
+
+	if _, err := tiered.Get(key); err == nil {
+		t.Error("Expected miss after InvalidateByConfigFile")
+	}
+}
+
+func TestTieredManager_ConcurrentGetSingleFlights(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	tiered.Set(key, &tools.Result{Success: true})
+
+	const workers = 16
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = tiered.Get(key)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("worker %d: Get failed: %v", i, err)
+		}
+	}
+}
+
+func TestTieredManager_GetOrCompute_HitSkipsCompute(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	tiered.Set(key, &tools.Result{Success: true, Tool: "gofumpt"})
+
+	called := false
+	result, err := tiered.GetOrCompute(key, func() (*tools.Result, error) {
+		called = true
+		return &tools.Result{Success: true, Tool: "should-not-run"}, nil
+	})
+	if err != nil {
+		t.Fatalf("GetOrCompute failed: %v", err)
+	}
+	if called {
+		t.Error("compute should not run on a cache hit")
+	}
+	if result.Tool != "gofumpt" {
+		t.Errorf("result.Tool = %q, want the cached value", result.Tool)
+	}
+}
+
+func TestTieredManager_GetOrCompute_ConcurrentMissesComputeOnce(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	var computeCalls atomic.Int64
+	compute := func() (*tools.Result, error) {
+		computeCalls.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		return &tools.Result{Success: true, Tool: "gofumpt"}, nil
+	}
+
+	const workers = 8
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = tiered.GetOrCompute(key, compute)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("worker %d: GetOrCompute failed: %v", i, err)
+		}
+	}
+	if got := computeCalls.Load(); got != 1 {
+		t.Errorf("compute called %d times, want exactly 1", got)
+	}
+}
+
+func TestTieredManager_GetOrCompute_TimeoutReturnsErrCacheKeyLocked(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+	tiered.SetLockTimeout(10 * time.Millisecond)
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, _ := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+
+	holderStarted := make(chan struct{})
+	releaseHolder := make(chan struct{})
+	go tiered.GetOrCompute(key, func() (*tools.Result, error) {
+		close(holderStarted)
+		<-releaseHolder
+		return &tools.Result{Success: true}, nil
+	})
+	<-holderStarted
+
+	_, err := tiered.GetOrCompute(key, func() (*tools.Result, error) {
+		t.Error("second caller should not itself invoke compute while the first is in flight")
+		return nil, nil
+	})
+	if !errors.Is(err, ErrCacheKeyLocked) {
+		t.Errorf("err = %v, want ErrCacheKeyLocked", err)
+	}
+
+	close(releaseHolder)
+}
+
+func TestTieredManager_PruneIncrementsPruneCount(t *testing.T) {
+	tiered, _ := newTieredTestManager(t)
+
+	if err := tiered.Prune(context.Background()); err != nil {
+		t.Fatalf("Prune failed: %v", err)
+	}
+	if err := tiered.Prune(context.Background()); err != nil {
+		t.Fatalf("Prune failed: %v", err)
+	}
+
+	if got := tiered.Stats().PruneCount; got != 2 {
+		t.Errorf("PruneCount = %d, want 2", got)
+	}
+}
+
+func TestTieredManager_PruneSkipsOnCancelledContext(t *testing.T) {
+	tiered, _ := newTieredTestManager(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := tiered.Prune(ctx); err == nil {
+		t.Error("expected Prune to fail on an already-cancelled context")
+	}
+	if got := tiered.Stats().PruneCount; got != 0 {
+		t.Errorf("PruneCount = %d, want 0 after a cancelled Prune", got)
+	}
+}
+
+func TestTieredManager_StartPrunerRunsPeriodically(t *testing.T) {
+	tiered, _ := newTieredTestManager(t)
+
+	tiered.StartPruner(context.Background(), 10*time.Millisecond)
+	defer tiered.Stop()
+
+	deadline := time.After(time.Second)
+	for {
+		if tiered.Stats().PruneCount >= 2 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for the background pruner to run")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+func TestTieredManager_StopIsIdempotentAndSafeWithoutStart(t *testing.T) {
+	tiered, _ := newTieredTestManager(t)
+
+	tiered.Stop() // never started - must not panic
+	tiered.StartPruner(context.Background(), time.Hour)
+	tiered.Stop()
+	tiered.Stop() // already stopped - must not panic or block
+}
+
+func TestTieredManager_ShardEvictsLRU(t *testing.T) {
+	tiered, tmpDir := newTieredTestManager(t)
+	tiered.shardCap = 1
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+
+	file1 := filepath.Join(tmpDir, "a.go")
+	os.WriteFile(file1, []byte("package a"), 0644)
+	key1, _ := GenerateKey(file1, tool, tools.ExecuteOptions{})
+
+	file2 := filepath.Join(tmpDir, "b.go")
+	os.WriteFile(file2, []byte("package b"), 0644)
+	key2, _ := GenerateKey(file2, tool, tools.ExecuteOptions{})
+
+	tiered.Set(key1, &tools.Result{Success: true})
+	tiered.Set(key2, &tools.Result{Success: true})
+
+	shard := tiered.shardFor(tool.Name())
+	if len(shard.entries) != 1 {
+		t.Errorf("shard entries = %d, want 1 (capacity 1)", len(shard.entries))
+	}
+	if _, ok := shard.get(key2.String()); !ok {
+		t.Error("expected the most recently set key to remain in the shard")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"fmt"
+)
+
+// twoTierAsyncQueueSize bounds how many remote writes can be pending
+// before new ones are dropped, mirroring AsyncStore's reasoning: a
+// stalled remote cache server must not be able to grow this queue
+// without limit, and losing one write only costs a future remote miss.
+const twoTierAsyncQueueSize = 256
+
+// TwoTierStorage fronts a local Storage with a RemoteStorage: Read checks
+// local first and only consults remote on a local miss, write-through
+// caching the remote hit locally so the next Read for the same key is
+// local-only. Write always goes to local synchronously, then to remote
+// on a background goroutine so a slow or unreachable cache server never
+// adds latency to a tool run.
+//
+// Maintenance (List, ReadPath, DeletePath, Size, Close) is delegated to
+// the local tier only - see RemoteStorage's doc comment for why.
+type TwoTierStorage struct {
+	local  Storage
+	remote *RemoteStorage
+
+	readOnly    bool
+	toolOptOut  map[string]bool
+	remoteQueue chan twoTierWrite
+	done        chan struct{}
+}
+
+type twoTierWrite struct {
+	key  string
+	tool string
+	data []byte
+}
+
+// TwoTierStorageConfig configures NewTwoTierStorage.
+type TwoTierStorageConfig struct {
+	// ReadOnly disables pushing new local writes to remote, for an
+	// untrusted build (e.g. a PR from a fork) that should benefit from a
+	// shared cache without being able to poison it.
+	ReadOnly bool
+
+	// ToolOptOut lists tool names that should never touch remote, for
+	// tools whose output is large, sensitive, or simply not worth
+	// sharing across machines.
+	ToolOptOut []string
+}
+
+// NewTwoTierStorage wraps local with remote, so reads fall back to the
+// shared cache on a local miss and new local results are pushed upstream
+// in the background for teammates and CI to reuse.
+func NewTwoTierStorage(local Storage, remote *RemoteStorage, cfg TwoTierStorageConfig) *TwoTierStorage {
+	optOut := make(map[string]bool, len(cfg.ToolOptOut))
+	for _, tool := range cfg.ToolOptOut {
+		optOut[tool] = true
+	}
+
+	ts := &TwoTierStorage{
+		local:       local,
+		remote:      remote,
+		readOnly:    cfg.ReadOnly,
+		toolOptOut:  optOut,
+		remoteQueue: make(chan twoTierWrite, twoTierAsyncQueueSize),
+		done:        make(chan struct{}),
+	}
+	go ts.pushLoop()
+	return ts
+}
+
+func (ts *TwoTierStorage) pushLoop() {
+	defer close(ts.done)
+	for w := range ts.remoteQueue {
+		_ = ts.remote.Write(w.key, w.tool, w.data)
+	}
+}
+
+// Read checks local first, falling back to remote on a miss and caching
+// the result locally so subsequent reads for the same key stay local.
+func (ts *TwoTierStorage) Read(key, tool string) ([]byte, error) {
+	data, err := ts.local.Read(key, tool)
+	if err == nil {
+		return data, nil
+	}
+
+	if ts.toolOptOut[tool] {
+		return nil, err
+	}
+
+	remoteData, remoteErr := ts.remote.Read(key, tool)
+	if remoteErr != nil {
+		return nil, err // Report the local miss; it's the tier of record.
+	}
+
+	_ = ts.local.Write(key, tool, remoteData)
+	return remoteData, nil
+}
+
+// Write stores to local synchronously, then enqueues an async push to
+// remote (dropped rather than blocking if the queue is full or the tool
+// has opted out / the store is read-only).
+func (ts *TwoTierStorage) Write(key, tool string, data []byte) error {
+	if err := ts.local.Write(key, tool, data); err != nil {
+		return err
+	}
+
+	if ts.readOnly || ts.toolOptOut[tool] {
+		return nil
+	}
+
+	select {
+	case ts.remoteQueue <- twoTierWrite{key: key, tool: tool, data: data}:
+	default:
+		// Queue is full; drop the push rather than block the caller.
+	}
+
+	return nil
+}
+
+// Delete removes key from local only; remote entries are shared and
+// left for the server (or a future Repack-style compaction) to manage.
+func (ts *TwoTierStorage) Delete(key, tool string) error {
+	return ts.local.Delete(key, tool)
+}
+
+// List delegates to the local tier; see RemoteStorage's doc comment.
+func (ts *TwoTierStorage) List() ([]string, error) {
+	return ts.local.List()
+}
+
+// ReadPath delegates to the local tier.
+func (ts *TwoTierStorage) ReadPath(path string) ([]byte, error) {
+	return ts.local.ReadPath(path)
+}
+
+// DeletePath delegates to the local tier.
+func (ts *TwoTierStorage) DeletePath(path string) error {
+	return ts.local.DeletePath(path)
+}
+
+// Size delegates to the local tier.
+func (ts *TwoTierStorage) Size() (int64, error) {
+	return ts.local.Size()
+}
+
+// CleanupCorrupted delegates to the local tier; see RemoteStorage's doc
+// comment.
+func (ts *TwoTierStorage) CleanupCorrupted() (int, error) {
+	return ts.local.CleanupCorrupted()
+}
+
+// Close stops accepting new remote pushes, waits for queued ones to
+// drain, and closes the local tier.
+func (ts *TwoTierStorage) Close() error {
+	close(ts.remoteQueue)
+	<-ts.done
+
+	if err := ts.local.Close(); err != nil {
+		return fmt.Errorf("failed to close local storage tier: %w", err)
+	}
+	return nil
+}
+
+// Ensure TwoTierStorage implements Storage.
+var _ Storage = (*TwoTierStorage)(nil)
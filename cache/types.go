@@ -7,6 +7,7 @@ package cache
 import (
 	"time"
 
+	"github.com/Gizzahub/gzh-cli-quality/cache/chunker"
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
 
@@ -16,7 +17,9 @@ type CacheKey struct {
 	// FilePath is the absolute path to the file
 	FilePath string
 
-	// FileHash is SHA256 hash of file content
+	// FileHash identifies the file's content: Git's own blob SHA when the
+	// file is tracked and clean (reusing a hash Git already computed), or
+	// a SHA256 of its content otherwise.
 	FileHash string
 
 	// ToolName is the name of the tool (e.g., "gofumpt")
@@ -28,12 +31,29 @@ type CacheKey struct {
 	// ConfigHash is SHA256 hash of configuration file(s)
 	ConfigHash string
 
+	// PolicyHash is SHA256 hash of project-wide policy files that affect
+	// classification or formatting but live outside the tool's own
+	// config: .editorconfig, .gitattributes (walked up from FilePath the
+	// same way detector.gitattributesMatcher stacks them), plus any
+	// extras a tool's PolicyFiles() names. Separate from ConfigHash so a
+	// change to one doesn't require re-deriving the other.
+	PolicyHash string
+
 	// OptionsHash is SHA256 hash of execution options
 	OptionsHash string
+
+	// Platform is "GOOS/GOARCH" of the machine that produced the result.
+	// A tool's output can depend on the platform it ran on (path
+	// separators, line endings, even which code paths a tool takes), so
+	// this must be part of the key once results can be shared across
+	// machines via a remote cache - otherwise a macOS dev's entry could
+	// poison a Linux CI runner's cache.
+	Platform string
 }
 
 // String returns a string representation of the cache key.
-// Format: {tool}-{version}-{file_hash[:8]}-{config_hash[:8]}-{options_hash[:8]}
+// Format: {tool}-{version}-{file_hash[:8]}-{config_hash[:8]}-{options_hash[:8]}[-{policy_hash[:8]}][-{platform}],
+// where the bracketed parts appear only when PolicyHash/Platform are set.
 func (ck CacheKey) String() string {
 	fileHashShort := ck.FileHash
 	if len(fileHashShort) > 8 {
@@ -45,12 +65,24 @@ func (ck CacheKey) String() string {
 		configHashShort = configHashShort[:8]
 	}
 
+	policyHashShort := ck.PolicyHash
+	if len(policyHashShort) > 8 {
+		policyHashShort = policyHashShort[:8]
+	}
+
 	optionsHashShort := ck.OptionsHash
 	if len(optionsHashShort) > 8 {
 		optionsHashShort = optionsHashShort[:8]
 	}
 
-	return ck.ToolName + "-" + ck.ToolVersion + "-" + fileHashShort + "-" + configHashShort + "-" + optionsHashShort
+	s := ck.ToolName + "-" + ck.ToolVersion + "-" + fileHashShort + "-" + configHashShort + "-" + optionsHashShort
+	if policyHashShort != "" {
+		s += "-" + policyHashShort
+	}
+	if ck.Platform != "" {
+		s += "-" + ck.Platform
+	}
+	return s
 }
 
 // CachedResult represents a cached tool execution result.
@@ -61,8 +93,27 @@ type CachedResult struct {
 	// Key is the cache key
 	Key CacheKey `json:"key"`
 
-	// Result is the tool execution result
-	Result *tools.Result `json:"result"`
+	// ResultData is Result, JSON-encoded and then run through the
+	// compressor named by Metadata.Compression. It, not Result, is what
+	// actually hits storage - linter stdout and issue arrays compress
+	// 5-20x, so keeping the compressed form as the wire representation
+	// is what makes that shrink real instead of undone by Result also
+	// being marshaled verbatim alongside it. Empty when Chunks is set
+	// instead.
+	ResultData []byte `json:"result_data,omitempty"`
+
+	// Chunks is Result's JSON encoding split into content-defined chunks
+	// via the chunker package and written to the Manager's ChunkStore,
+	// in place of inlining ResultData, when chunking is enabled. Two
+	// entries whose Result JSON shares long runs of bytes - the common
+	// case for repeated lint runs on a project after editing one file -
+	// collapse to mostly-shared chunks instead of two unrelated
+	// compressed blobs. Empty when ResultData is set instead.
+	Chunks []chunker.ManifestEntry `json:"chunks,omitempty"`
+
+	// Result is the decompressed tool execution result, hydrated by
+	// Manager.Get/Set from ResultData. Not serialized itself.
+	Result *tools.Result `json:"-"`
 
 	// Metadata contains cache metadata
 	Metadata CacheMetadata `json:"metadata"`
@@ -81,6 +132,25 @@ type CacheMetadata struct {
 
 	// SizeBytes is the size of the cached result in bytes
 	SizeBytes int64 `json:"size_bytes"`
+
+	// Compression records which algorithm (and level) ResultData was
+	// compressed with, since that can change over the cache's lifetime
+	// as CacheConfig.Compression changes or --cache-recompress runs -
+	// Get needs this to pick a matching decoder per entry rather than
+	// assuming every entry on disk used today's default.
+	Compression CompressionInfo `json:"compression,omitempty"`
+}
+
+// CompressionInfo names the algorithm and level a cache entry's
+// ResultData was compressed with.
+type CompressionInfo struct {
+	// Algorithm is a CompressionAlgorithm value ("zstd", "gzip", "none").
+	// A zero value means the entry predates this field and ResultData is
+	// whatever NewCompressor("") resolves to (CompressionNone).
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// Level is the compressor-specific quality/speed tradeoff used.
+	Level int `json:"level,omitempty"`
 }
 
 // CacheStats contains cache statistics.
@@ -100,30 +170,76 @@ type CacheStats struct {
 	// HitRate is the cache hit rate (0.0 to 1.0)
 	HitRate float64
 
+	// MemHitCount is the number of hits served from an in-memory tier
+	// without touching disk. Zero for a disk-only Manager such as
+	// CacheManager itself.
+	MemHitCount int64
+
+	// DiskHitCount is the number of hits that came from the disk tier:
+	// every hit for a disk-only Manager, or a memory-tier miss that
+	// still found the entry on disk for TieredManager.
+	DiskHitCount int64
+
 	// OldestEntry is the timestamp of the oldest entry
 	OldestEntry time.Time
 
 	// NewestEntry is the timestamp of the newest entry
 	NewestEntry time.Time
+
+	// UniqueChunkBytes is the total size of the distinct chunks a
+	// chunking-enabled Manager's ChunkStore has written, zero if
+	// chunking isn't enabled. See ChunkStore.UniqueBytes.
+	UniqueChunkBytes int64
+
+	// LogicalChunkBytes is the sum of every chunk reference across every
+	// chunked entry, including ones that resolved to an already-stored
+	// chunk - i.e. the size those entries would occupy without
+	// chunk-level dedup. UniqueChunkBytes / LogicalChunkBytes is the
+	// dedup ratio. Zero if chunking isn't enabled.
+	LogicalChunkBytes int64
+
+	// PruneCount is the number of background pruning sweeps a Manager's
+	// StartPruner has run so far (see TieredManager.StartPruner). Zero
+	// for a Manager that was never started, or that has no pruner.
+	PruneCount int64
 }
 
-// Storage is the interface for cache storage backends.
+// Storage is the interface for cache storage backends. key and tool
+// together address an entry: tool is passed in explicitly (callers already
+// have it from CacheKey.ToolName) rather than parsed back out of key, so a
+// backend is free to hash key for uniform, filesystem-safe fanout.
 type Storage interface {
 	// Read reads data from storage
-	Read(key string) ([]byte, error)
+	Read(key, tool string) ([]byte, error)
 
 	// Write writes data to storage
-	Write(key string, data []byte) error
+	Write(key, tool string, data []byte) error
 
 	// Delete deletes data from storage
-	Delete(key string) error
+	Delete(key, tool string) error
 
-	// List returns all keys in storage
+	// List returns every stored entry's location relative to the storage
+	// root. Entries are opaque to callers beyond being usable with
+	// ReadPath/DeletePath - a content-addressed backend cannot recover the
+	// original key from its hash, so maintenance operations (Cleanup,
+	// Stats, InvalidateAll) walk the store by location instead of by key.
 	List() ([]string, error)
 
+	// ReadPath reads the entry at a location previously returned by List.
+	ReadPath(path string) ([]byte, error)
+
+	// DeletePath deletes the entry at a location previously returned by List.
+	DeletePath(path string) error
+
 	// Size returns the total size of storage in bytes
 	Size() (int64, error)
 
+	// CleanupCorrupted removes entries that can't be read back or whose
+	// content isn't valid JSON, returning how many were removed. Backends
+	// without a cheaper way to detect corruption can implement this with
+	// CleanupCorruptedEntries.
+	CleanupCorrupted() (int, error)
+
 	// Close closes the storage backend
 	Close() error
 }
@@ -142,6 +258,20 @@ type Manager interface {
 	// InvalidateAll removes all cache entries
 	InvalidateAll() error
 
+	// IndexConfigFile records that key's entry was built using configPath
+	// as one of its ConfigHash inputs, so a later write to configPath can
+	// find and invalidate it without rehashing every entry's config files.
+	IndexConfigFile(configPath string, key CacheKey)
+
+	// InvalidateByConfigFile removes every cache entry previously indexed
+	// against configPath via IndexConfigFile.
+	InvalidateByConfigFile(configPath string) error
+
+	// InvalidateByTool removes every cache entry for toolName at
+	// toolVersion, e.g. after `quality watch` notices the resolved tool
+	// binary's mtime changed underneath it.
+	InvalidateByTool(toolName, toolVersion string) error
+
 	// Stats returns cache statistics
 	Stats() CacheStats
 
@@ -0,0 +1,352 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	// trackerFilterBits is the size of each generation's Bloom filter.
+	// 1Mi bits (128KiB) keeps a false-positive rate well under 1% for
+	// the tens of thousands of directories a typical monorepo touches
+	// per generation window.
+	trackerFilterBits = 1 << 20
+
+	// trackerFilterHashes is k, the number of probe positions per add/
+	// check, derived from two real hashes via double hashing.
+	trackerFilterHashes = 7
+
+	// defaultGenerationWindow is how long a single generation's filter
+	// accumulates dirty paths before rotate starts a fresh one.
+	defaultGenerationWindow = 5 * time.Minute
+
+	// maxTrackedGenerations bounds how much history is kept, so a
+	// long-lived daemon's persisted state doesn't grow without limit.
+	maxTrackedGenerations = 12
+)
+
+// trackerIgnoredDirs mirrors the root `quality watch` command's ignore
+// list (see addWatchesRecursively in quality_watch.go) - the two can't
+// share code without an import cycle, but the set of directories nobody
+// wants watched is the same either way.
+var trackerIgnoredDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+}
+
+// generation is one Bloom filter covering every directory MarkDirty (or
+// the fsnotify watch loop) touched since StartedAt.
+type generation struct {
+	StartedAt time.Time    `json:"started_at"`
+	Filter    *bloomFilter `json:"filter"`
+}
+
+// UpdateTracker watches a project tree via fsnotify and maintains a
+// rolling set of per-generation Bloom filters over the directories that
+// have changed, the same idea as minio's dataUpdateTracker. CacheManager
+// consults it on Get to decide whether a cache entry survives a
+// filesystem change fsnotify already observed, turning the cache from
+// best-effort (age/lazy invalidation only) into safe-by-default for a
+// long-lived daemon or editor integration.
+//
+// Membership is probabilistic: a false positive merely costs an extra
+// cache miss, but a false negative would silently resurrect a stale
+// entry. That's why MarkDirty is exported for callers (e.g. a git
+// post-checkout hook) that want to invalidate a path explicitly, in case
+// fsnotify missed it - a burst of renames under heavy load can coalesce
+// or drop events even with a healthy watch.
+type UpdateTracker struct {
+	mu          sync.Mutex
+	root        string
+	persistPath string
+	window      time.Duration
+	watcher     *fsnotify.Watcher
+	generations []generation
+	done        chan struct{}
+}
+
+// NewUpdateTracker creates a tracker that watches root for changes and
+// persists its generations to persistPath (typically next to the cache
+// directory) so they survive a restart. An empty persistPath disables
+// persistence; the tracker then starts from a single empty generation.
+func NewUpdateTracker(root, persistPath string) (*UpdateTracker, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start file watcher: %w", err)
+	}
+
+	t := &UpdateTracker{
+		root:        root,
+		persistPath: persistPath,
+		window:      defaultGenerationWindow,
+		watcher:     watcher,
+		done:        make(chan struct{}),
+	}
+
+	if persistPath != "" {
+		if err := t.load(); err != nil {
+			_ = watcher.Close()
+			return nil, fmt.Errorf("failed to load update tracker state: %w", err)
+		}
+	}
+	if len(t.generations) == 0 {
+		t.generations = []generation{t.newGeneration()}
+	}
+
+	if err := addTrackerWatches(watcher, root); err != nil {
+		_ = watcher.Close()
+		return nil, fmt.Errorf("failed to watch project: %w", err)
+	}
+
+	go t.run()
+
+	return t, nil
+}
+
+func (t *UpdateTracker) newGeneration() generation {
+	return generation{
+		StartedAt: time.Now(),
+		Filter:    newBloomFilter(trackerFilterBits, trackerFilterHashes),
+	}
+}
+
+// run processes fsnotify events and rotates generations until Close is
+// called.
+func (t *UpdateTracker) run() {
+	ticker := time.NewTicker(t.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.done:
+			return
+
+		case event, ok := <-t.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+				continue
+			}
+			if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+				_ = addTrackerWatches(t.watcher, event.Name)
+			}
+			t.MarkDirty(event.Name)
+
+		case _, ok := <-t.watcher.Errors:
+			if !ok {
+				return
+			}
+
+		case <-ticker.C:
+			t.rotate()
+		}
+	}
+}
+
+// MarkDirty marks the directory containing each path (or the path
+// itself, if it's already a directory) as changed in the current
+// generation. Exposed so callers outside the fsnotify loop - a git
+// post-checkout hook, an IDE's own file-change notification - can
+// invalidate explicitly.
+func (t *UpdateTracker) MarkDirty(paths ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := &t.generations[len(t.generations)-1]
+	for _, p := range paths {
+		key := normalizeTrackedPath(p)
+		if info, err := os.Stat(p); err == nil && info.IsDir() {
+			key = filepath.ToSlash(filepath.Clean(p))
+		}
+		current.Filter.add(key)
+	}
+}
+
+// rotate starts a fresh generation and trims history beyond
+// maxTrackedGenerations, then persists the result.
+func (t *UpdateTracker) rotate() {
+	t.mu.Lock()
+	t.generations = append(t.generations, t.newGeneration())
+	if len(t.generations) > maxTrackedGenerations {
+		t.generations = t.generations[len(t.generations)-maxTrackedGenerations:]
+	}
+	t.mu.Unlock()
+
+	_ = t.persist()
+}
+
+// DirtySince reports whether path might have changed in any generation
+// started at or after since - the question CacheManager.Get asks before
+// trusting a cache entry whose Metadata.CreatedAt is since.
+func (t *UpdateTracker) DirtySince(path string, since time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := normalizeTrackedPath(path)
+	for _, gen := range t.generations {
+		if gen.StartedAt.Before(since) {
+			continue
+		}
+		if gen.Filter.mightContain(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTrackedPath maps a file or directory path to the directory
+// key the filter tracks, so a file write and a later lookup by a
+// different file in the same directory both hit the same bucket - the
+// filter tracks changed directories, not every individual file, the same
+// granularity minio's dataUpdateTracker uses for bucket invalidation.
+func normalizeTrackedPath(path string) string {
+	dir := filepath.Dir(path)
+	return filepath.ToSlash(dir)
+}
+
+// addTrackerWatches adds fsnotify watches for root and every
+// subdirectory, skipping trackerIgnoredDirs and hidden directories.
+func addTrackerWatches(watcher *fsnotify.Watcher, root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() {
+			return nil
+		}
+
+		name := info.Name()
+		if path != root && (trackerIgnoredDirs[name] || strings.HasPrefix(name, ".")) {
+			return filepath.SkipDir
+		}
+
+		return watcher.Add(path)
+	})
+}
+
+// Close stops the watcher, persists the current generations, and
+// releases fsnotify resources.
+func (t *UpdateTracker) Close() error {
+	close(t.done)
+	err := t.watcher.Close()
+
+	if perr := t.persist(); perr != nil && err == nil {
+		err = perr
+	}
+	return err
+}
+
+// trackerState is the on-disk shape persist/load round-trip through
+// persistPath.
+type trackerState struct {
+	Generations []generation `json:"generations"`
+}
+
+func (t *UpdateTracker) persist() error {
+	if t.persistPath == "" {
+		return nil
+	}
+
+	t.mu.Lock()
+	state := trackerState{Generations: t.generations}
+	t.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to serialize update tracker state: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.persistPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create update tracker directory: %w", err)
+	}
+
+	return os.WriteFile(t.persistPath, data, 0o644)
+}
+
+func (t *UpdateTracker) load() error {
+	data, err := os.ReadFile(t.persistPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", t.persistPath, err)
+	}
+
+	var state trackerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", t.persistPath, err)
+	}
+
+	t.generations = state.Generations
+	return nil
+}
+
+// bloomFilter is a fixed-size Bloom filter over directory paths, using
+// double hashing (Kirsch-Mitzenmacher) to derive k independent probe
+// positions from two FNV-1a hashes instead of k independent hash
+// functions.
+type bloomFilter struct {
+	Bits    []uint64 `json:"bits"`
+	NumBits uint64   `json:"num_bits"`
+	K       uint     `json:"k"`
+}
+
+func newBloomFilter(numBits uint64, k uint) *bloomFilter {
+	return &bloomFilter{
+		Bits:    make([]uint64, (numBits+63)/64),
+		NumBits: numBits,
+		K:       k,
+	}
+}
+
+func (b *bloomFilter) add(s string) {
+	h1, h2 := splitHash(s)
+	for i := uint(0); i < b.K; i++ {
+		pos := (h1 + uint64(i)*h2) % b.NumBits
+		b.Bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (b *bloomFilter) mightContain(s string) bool {
+	h1, h2 := splitHash(s)
+	for i := uint(0); i < b.K; i++ {
+		pos := (h1 + uint64(i)*h2) % b.NumBits
+		if b.Bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// splitHash derives two independent 64-bit hashes of s via FNV-1a, used
+// to synthesize bloomFilter's k probe positions without k separate hash
+// functions.
+func splitHash(s string) (uint64, uint64) {
+	h1 := fnv.New64a()
+	_, _ = h1.Write([]byte(s))
+	sum1 := h1.Sum64()
+
+	h2 := fnv.New64a()
+	_, _ = h2.Write([]byte(s))
+	_, _ = h2.Write([]byte{0xff})
+	sum2 := h2.Sum64()
+	if sum2 == 0 {
+		sum2 = 1
+	}
+
+	return sum1, sum2
+}
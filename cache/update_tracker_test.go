@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+func TestBloomFilter_AddAndMightContain(t *testing.T) {
+	filter := newBloomFilter(1<<12, 7)
+
+	filter.add("src/pkg/a")
+
+	if !filter.mightContain("src/pkg/a") {
+		t.Error("expected mightContain to report true for an added key")
+	}
+	if filter.mightContain("src/pkg/b") {
+		t.Error("expected mightContain to report false for a key that was never added (got a false positive on a tiny test set)")
+	}
+}
+
+func TestUpdateTracker_MarkDirtyAndDirtySince(t *testing.T) {
+	root := t.TempDir()
+
+	before := time.Now()
+	time.Sleep(5 * time.Millisecond)
+
+	tracker, err := NewUpdateTracker(root, "")
+	if err != nil {
+		t.Fatalf("NewUpdateTracker failed: %v", err)
+	}
+	defer tracker.Close()
+
+	dirty := filepath.Join(root, "pkg", "dirty.go")
+	tracker.MarkDirty(dirty)
+
+	if !tracker.DirtySince(dirty, before) {
+		t.Error("expected DirtySince to report true for a path marked dirty after the cutoff")
+	}
+
+	// A different directory than dirty.go's "pkg" - the tracker marks
+	// dirty at directory granularity (see normalizeTrackedPath), so a
+	// path under "pkg" would always report dirty too.
+	clean := filepath.Join(root, "otherpkg", "clean.go")
+	if tracker.DirtySince(clean, before) {
+		t.Error("expected DirtySince to report false for an unrelated directory")
+	}
+
+	after := time.Now()
+	if tracker.DirtySince(dirty, after) {
+		t.Error("expected DirtySince to report false when the cutoff is after the mark")
+	}
+}
+
+func TestUpdateTracker_PersistAndLoad(t *testing.T) {
+	root := t.TempDir()
+	persistPath := filepath.Join(t.TempDir(), "tracker.json")
+
+	before := time.Now()
+	time.Sleep(5 * time.Millisecond)
+
+	tracker, err := NewUpdateTracker(root, persistPath)
+	if err != nil {
+		t.Fatalf("NewUpdateTracker failed: %v", err)
+	}
+
+	dirty := filepath.Join(root, "pkg", "dirty.go")
+	tracker.MarkDirty(dirty)
+
+	if err := tracker.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	reloaded, err := NewUpdateTracker(root, persistPath)
+	if err != nil {
+		t.Fatalf("second NewUpdateTracker failed: %v", err)
+	}
+	defer reloaded.Close()
+
+	if !reloaded.DirtySince(dirty, before) {
+		t.Error("expected a reloaded tracker to recall a dirty mark persisted before Close")
+	}
+}
+
+func TestCacheManager_Get_EvictsOnTrackedDirtyPath(t *testing.T) {
+	tmpDir := t.TempDir()
+	cacheDir := filepath.Join(tmpDir, "cache")
+	filesDir := filepath.Join(tmpDir, "files")
+	os.MkdirAll(filesDir, 0755)
+
+	manager, err := NewCacheManager(cacheDir, 100*1024*1024, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create cache manager: %v", err)
+	}
+	defer manager.Close()
+
+	testFile := filepath.Join(filesDir, "test.go")
+	os.WriteFile(testFile, []byte("package main"), 0644)
+
+	tool := &mockTool{name: "gofumpt", version: "v0.7.0"}
+	key, err := GenerateKey(testFile, tool, tools.ExecuteOptions{})
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+
+	if err := manager.Set(key, &tools.Result{Tool: "gofumpt", Success: true}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	if _, err := manager.Get(key); err != nil {
+		t.Fatalf("expected a cache hit before marking the file dirty, got: %v", err)
+	}
+
+	tracker, err := NewUpdateTracker(filesDir, "")
+	if err != nil {
+		t.Fatalf("NewUpdateTracker failed: %v", err)
+	}
+	defer tracker.Close()
+	manager.SetUpdateTracker(tracker)
+
+	time.Sleep(5 * time.Millisecond)
+	tracker.MarkDirty(testFile)
+
+	if _, err := manager.Get(key); err == nil {
+		t.Error("expected a cache miss after the tracker observed a dirty change to the file's directory")
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Gizzahub
+// SPDX-License-Identifier: MIT
+
+// Command gzh-quality-cache is a reference server for the remote cache
+// protocol cache.RemoteStorage speaks, so a team or CI pipeline can
+// self-host a shared cache instead of relying on an S3/GCS bucket.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Gizzahub/gzh-cli-quality/cache"
+)
+
+func main() {
+	addr := flag.String("addr", ":8089", "address to listen on")
+	dir := flag.String("dir", "./gzh-quality-cache-data", "directory to store CAS blobs and AC entries under")
+	token := flag.String("token", "", "if set, require this bearer token on every request")
+	flag.Parse()
+
+	server, err := cache.NewRemoteCacheServer(*dir, *token)
+	if err != nil {
+		log.Fatalf("failed to initialize cache server: %v", err)
+	}
+
+	fmt.Printf("gzh-quality-cache listening on %s, storing data under %s\n", *addr, *dir)
+	log.Fatal(http.ListenAndServe(*addr, server))
+}
@@ -4,8 +4,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/Gizzahub/gzh-cli-quality"
 )
@@ -22,7 +25,16 @@ func main() {
 	rootCmd.Short = "Multi-language code quality tool orchestrator"
 	rootCmd.Version = fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date)
 
-	if err := rootCmd.Execute(); err != nil {
+	// Every subcommand threads cmd.Context() down into ParallelExecutor and
+	// ultimately cmdobj.Runner, which already kills a running tool's whole
+	// process group on context cancellation (see OSRunner.build) - wiring
+	// SIGINT/SIGTERM here is what makes Ctrl+C during `run`/`check` actually
+	// reach those child exec.Cmds instead of just orphaning them, the way
+	// `watch` already arranges for itself with its own signal.NotifyContext.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -7,6 +7,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
 
 	yaml "gopkg.in/yaml.v3"
 )
@@ -19,6 +23,11 @@ type Config struct {
 	// Timeout sets the default timeout for tool execution
 	Timeout string `yaml:"timeout"`
 
+	// StdinTimeout bounds how long --stdin mode's formatters may run
+	// against the piped-in temp file, as a duration string (e.g. "30s").
+	// Empty means a 30s default.
+	StdinTimeout string `yaml:"stdin_timeout"`
+
 	// Tools contains tool-specific configurations
 	Tools map[string]ToolConfig `yaml:"tools"`
 
@@ -30,6 +39,218 @@ type Config struct {
 
 	// Include contains patterns to include in processing
 	Include []string `yaml:"include"`
+
+	// Redact configures secret redaction for cached/logged/reported tool output
+	Redact RedactConfig `yaml:"redact"`
+
+	// Cache configures the per-file tool-execution result cache
+	Cache CacheConfig `yaml:"cache"`
+
+	// Detection configures detector.FileTypeDetector's content-based
+	// classification fallback, used for files an extension/shebang/
+	// modeline lookup can't place.
+	Detection DetectionConfig `yaml:"detection"`
+
+	// Sharding provides the default --shard/--shards/--shard-strategy
+	// values a CLI flag doesn't override, for a CI matrix that'd rather
+	// commit its shard layout to a shared config than repeat it in every
+	// job's command line.
+	Sharding ShardingConfig `yaml:"sharding"`
+
+	// Baseline provides the default --baseline path and mode a CLI flag
+	// doesn't override, for a legacy codebase adopting a stricter linter
+	// that'd rather commit its baseline file/mode to a shared config than
+	// repeat --baseline/--update-baseline on every run.
+	Baseline BaselineConfig `yaml:"baseline"`
+
+	// Root marks this config as the top of its hierarchy: LoadConfigStack
+	// stops walking further up the directory tree once it loads a config
+	// with Root set, the same stopping convention treefmt and ESLint use.
+	Root bool `yaml:"root"`
+
+	// OnUnmatched controls what happens to a file ShouldInclude accepts
+	// but that no enabled tool in Tools/Languages claims responsibility
+	// for: "ignore" skips it silently, "info"/"warn" print a message at
+	// that level, and "fatal" aborts the run with a non-zero exit.
+	// Mirrors treefmt's on-unmatched. Empty means PolicyWarn.
+	OnUnmatched string `yaml:"on_unmatched"`
+
+	// OnMissingTool uses the same ignore|info|warn|fatal scale as
+	// OnUnmatched, but for a file whose language is recognized and
+	// configured where every tool that claims it is unavailable
+	// (QualityTool.IsAvailable() false) rather than genuinely unmatched.
+	// Empty means PolicyWarn.
+	OnMissingTool string `yaml:"on_missing_tool"`
+
+	// Imports lists other config files (globs, resolved relative to
+	// this file's own directory) that LoadConfig loads and applies
+	// before this file's own settings, so a team can share a base
+	// config (e.g. gzquality-base.yml) and layer repo-specific
+	// overrides on top. Deliberately not named "include" - that key
+	// already means Config.Include's file-glob patterns, an unrelated
+	// concept. A cycle (A imports B imports A) is a load error.
+	Imports []string `yaml:"imports"`
+
+	// ToolManifests lists glob patterns (resolved relative to this
+	// config file's own directory, the same way Imports is) of
+	// third-party tools.ToolManifest files - YAML, TOML, or JSON, per
+	// RegistryLoader's format - that NewQualityManager registers via
+	// DefaultRegistry.RegisterFromManifest alongside the built-in
+	// tools, so a project can add e.g. markdownlint or shellcheck
+	// without a gz-quality rebuild. LoadConfig expands the patterns to
+	// a sorted, absolute file list before returning.
+	ToolManifests []string `yaml:"tool_manifests"`
+
+	// Tags lists ad-hoc, user-supplied tags (e.g. via repeated --tag
+	// flags) that a ToolConfig.When expression's bare atoms match
+	// against, on top of the built-in os:/arch:/ci/go:/env:/has: atoms.
+	Tags []string `yaml:"-"`
+
+	// raw snapshots Exclude, Include, and every ToolConfig's Args/
+	// ConfigFile/Env as LoadConfig parsed them, before ${VAR}
+	// expansion, so SaveConfig can write those fields back unexpanded
+	// instead of baking a loaded environment's values into disk. Nil
+	// for a Config that wasn't produced by LoadConfig (e.g. one built
+	// with DefaultConfig and edited in memory).
+	raw *Config `yaml:"-"`
+}
+
+// Policy values accepted by Config.OnUnmatched and Config.OnMissingTool.
+const (
+	PolicyIgnore = "ignore"
+	PolicyInfo   = "info"
+	PolicyWarn   = "warn"
+	PolicyFatal  = "fatal"
+)
+
+// UnmatchedPolicy returns c.OnUnmatched, defaulting to PolicyWarn when unset.
+func (c *Config) UnmatchedPolicy() string {
+	if c.OnUnmatched == "" {
+		return PolicyWarn
+	}
+	return c.OnUnmatched
+}
+
+// MissingToolPolicy returns c.OnMissingTool, defaulting to PolicyWarn when unset.
+func (c *Config) MissingToolPolicy() string {
+	if c.OnMissingTool == "" {
+		return PolicyWarn
+	}
+	return c.OnMissingTool
+}
+
+// CacheConfig configures the per-file tool-execution result cache
+// (cache.CacheManager), keyed by file content hash, tool name/version,
+// config hash, and execution options.
+type CacheConfig struct {
+	// Enabled turns the cache on or off; the --no-cache flag overrides
+	// this at runtime without touching the config file
+	Enabled bool `yaml:"enabled"`
+
+	// Directory is the cache root: a plain filesystem path or a Storage
+	// URL (mem://, s3://, gs://, azblob://). Empty means
+	// $XDG_CACHE_HOME/gzh-quality (see GetCacheDirectory)
+	Directory string `yaml:"directory"`
+
+	// MaxAge is a duration string (e.g. "7d", "24h") after which a cache
+	// entry is evicted regardless of size pressure; empty means unlimited
+	MaxAge string `yaml:"max_age"`
+
+	// MaxSize is the cache's soft byte cap; cache-gc reclaims the
+	// least-recently-accessed entries once it's exceeded
+	MaxSize int64 `yaml:"max_size"`
+
+	// MaxEntries is the cache's soft entry-count cap, reclaimed the same
+	// way as MaxSize (least-recently-accessed first); 0 means unlimited.
+	// Useful on a backend where entry count, not total bytes, is what's
+	// constrained (e.g. a remote object store billing per request).
+	MaxEntries int64 `yaml:"max_entries"`
+
+	// MemShardCapacity is the entry cap for each tool's in-memory LRU
+	// shard in front of the disk cache (cache.TieredManager); 0 means
+	// cache.DefaultShardCapacity
+	MemShardCapacity int `yaml:"mem_shard_capacity"`
+
+	// Compression selects the algorithm cached tool output is stored
+	// under: "zstd" (default), "gzip", or "none". Empty means
+	// cache.DefaultCompression.
+	Compression string `yaml:"compression"`
+
+	// CompressionLevel is the compressor's quality/speed tradeoff; 0
+	// means that algorithm's own default.
+	CompressionLevel int `yaml:"compression_level"`
+
+	// ChunkingEnabled turns on content-defined chunking of cached tool
+	// output (cache.CacheManager.EnableChunking), storing entries behind
+	// a manifest of content-addressed chunks instead of one inline
+	// compressed blob. Off by default: it trades a little CPU and an
+	// extra ChunkStore bucket for better dedup across repeated runs, and
+	// Compression/CompressionLevel are ignored for entries written this
+	// way.
+	ChunkingEnabled bool `yaml:"chunking_enabled"`
+}
+
+// DetectionConfig configures detector.FileTypeDetector.DetectLanguage's
+// content-classification fallback - the naive-Bayes tokenizer stage it
+// falls back to once extension, shebang, and modeline lookup all fail
+// to place a file.
+type DetectionConfig struct {
+	// ContentClassification turns the naive-Bayes fallback on or off.
+	// Defaults to true (see DefaultConfig); set false to only ever
+	// classify by extension, shebang, or modeline, leaving anything
+	// those miss unclassified.
+	ContentClassification bool `yaml:"content_classification"`
+
+	// MaxBytesScanned bounds how many leading bytes of a file the
+	// shebang/modeline/classifier stages peek at. <= 0 means the
+	// detector's own built-in default (4096 bytes).
+	MaxBytesScanned int `yaml:"max_bytes_scanned"`
+}
+
+// ShardingConfig provides fallback values for a run's --shard/--shards/
+// --shard-strategy flags, used whenever those flags are left at their
+// no-sharding defaults (see executeQuality).
+type ShardingConfig struct {
+	// Index is this run's 0-based shard index.
+	Index int `yaml:"index"`
+
+	// Total is the total number of shards; <= 1 disables sharding.
+	Total int `yaml:"total"`
+
+	// Strategy selects how files are partitioned across shards: one of
+	// tools.ShardStrategyFileHash ("file-hash", the default),
+	// ShardStrategyPackage ("package"), ShardStrategyLanguage
+	// ("language"), or ShardStrategySizeBalanced ("size-balanced"). Empty
+	// means "file-hash".
+	Strategy string `yaml:"strategy"`
+}
+
+// BaselineConfig provides fallback values for a run's --baseline/
+// --update-baseline/--strict-baseline flags, used whenever those flags are
+// left at their defaults (see applyBaselineDefaults).
+type BaselineConfig struct {
+	// File is the baseline file path a --baseline flag doesn't override.
+	// Empty disables baseline filtering unless a flag sets one.
+	File string `yaml:"file"`
+
+	// Mode is one of "enforce" (the default: filter known issues, fail on
+	// anything new), "update" (regenerate File from the current run,
+	// equivalent to --update-baseline), or "ignore" (skip baseline
+	// filtering entirely even if File is set).
+	Mode string `yaml:"mode"`
+}
+
+// RedactConfig configures the redact package's global Store.
+type RedactConfig struct {
+	// Patterns contains additional regexps to redact, on top of
+	// redact.DefaultPatterns()
+	Patterns []string `yaml:"patterns"`
+
+	// Values lists environment variable names whose current values are
+	// literal secrets to redact verbatim. These are env var *names*, not
+	// the secrets themselves - the config file is not where a real
+	// secret value should ever live.
+	Values []string `yaml:"values"`
 }
 
 // ToolConfig represents configuration for a specific tool.
@@ -48,6 +269,31 @@ type ToolConfig struct {
 
 	// Priority affects execution order (higher = earlier)
 	Priority int `yaml:"priority"`
+
+	// StdinCapable marks a tool safe to run in --stdin mode, where it
+	// only ever sees one file in isolation with no real project around
+	// it. Tools that need project-wide context (a lint ruleset, a
+	// tsconfig project reference, a Cargo workspace) should leave this
+	// false so --stdin silently skips them instead of producing
+	// misleading results; simple content-local formatters opt in.
+	StdinCapable bool `yaml:"stdin_capable"`
+
+	// CacheDisabled opts this tool out of the executor's content-hash
+	// cache, for a tool whose output isn't a pure function of its input
+	// files' content - one that also reads go.mod, an environment
+	// variable, or another input FindConfigFiles doesn't report - where
+	// a stale cache hit would silently skip a run that should have
+	// rerun.
+	CacheDisabled bool `yaml:"cache_disabled"`
+
+	// When is a boolean expression, borrowed from Go's build-tag
+	// matching, gating whether this (already Enabled) tool actually
+	// participates in a run: atoms like os:linux, arch:amd64, ci,
+	// go:>=1.22, env:CI=true, has:golangci-lint, or a bare ad-hoc tag
+	// from Config.Tags, combined with &&, ||, !, and parentheses. Empty
+	// means always active. See EvaluateWhen/WhenContext for the parser
+	// and atom semantics.
+	When string `yaml:"when"`
 }
 
 // LanguageConfig represents configuration for a language.
@@ -67,22 +313,26 @@ func DefaultConfig() *Config {
 	return &Config{
 		DefaultWorkers: 4,
 		Timeout:        "10m",
+		StdinTimeout:   "30s",
 		Tools: map[string]ToolConfig{
 			"gofumpt": {
-				Enabled:  true,
-				Priority: 10,
+				Enabled:      true,
+				Priority:     10,
+				StdinCapable: true,
 			},
 			"goimports": {
-				Enabled:  true,
-				Priority: 9,
+				Enabled:      true,
+				Priority:     9,
+				StdinCapable: true,
 			},
 			"golangci-lint": {
 				Enabled:  true,
 				Priority: 5,
 			},
 			"black": {
-				Enabled:  true,
-				Priority: 10,
+				Enabled:      true,
+				Priority:     10,
+				StdinCapable: true,
 			},
 			"ruff": {
 				Enabled:  true,
@@ -93,8 +343,9 @@ func DefaultConfig() *Config {
 				Priority: 5,
 			},
 			"prettier": {
-				Enabled:  true,
-				Priority: 10,
+				Enabled:      true,
+				Priority:     10,
+				StdinCapable: true,
 			},
 			"eslint": {
 				Enabled:  true,
@@ -126,6 +377,11 @@ func DefaultConfig() *Config {
 				PreferredTools: []string{"prettier", "eslint", "tsc"},
 				Extensions:     []string{".ts", ".tsx"},
 			},
+			"Julia": {
+				Enabled:        true,
+				PreferredTools: []string{"juliaformatter", "staticlint", "aqua"},
+				Extensions:     []string{".jl"},
+			},
 		},
 		Exclude: []string{
 			"node_modules/**",
@@ -136,10 +392,29 @@ func DefaultConfig() *Config {
 			"**/*.min.js",
 			"**/*.min.css",
 		},
+		Cache: CacheConfig{
+			Enabled: true,
+			MaxAge:  "7d",
+			MaxSize: 2 * 1024 * 1024 * 1024, // 2GB
+		},
+		Detection: DetectionConfig{
+			ContentClassification: true,
+		},
 	}
 }
 
-// LoadConfig loads configuration from file.
+// LoadConfig loads configuration from file. If the file (or any config
+// it transitively Imports) sets Imports, those are applied onto config
+// first, in glob-match order, before configPath's own bytes are
+// unmarshalled on top - so configPath's settings always win a conflict,
+// while a map field like Tools still picks up entries an import added
+// that configPath never mentions (gopkg.in/yaml.v3 merges into an
+// existing non-nil map key-by-key rather than replacing it wholesale).
+// String values in Exclude, Include, and every ToolConfig's Args/
+// ConfigFile/Env are then expanded against os.Environ() and a small
+// REPO_ROOT/CONFIG_DIR/OS/ARCH built-in set (${VAR} / ${VAR:-default});
+// SaveConfig restores the pre-expansion snapshot taken here so saving a
+// loaded config doesn't bake those expanded values into disk.
 func LoadConfig(configPath string) (*Config, error) {
 	// Start with default config
 	config := DefaultConfig()
@@ -154,29 +429,364 @@ func LoadConfig(configPath string) (*Config, error) {
 		return config, nil
 	}
 
-	// Read config file
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
+	if err := applyConfigFileWithImports(config, configPath, map[string]bool{}); err != nil {
+		return nil, err
 	}
 
-	// Parse YAML
-	if err := yaml.Unmarshal(data, config); err != nil {
-		return nil, fmt.Errorf("failed to parse config file %s: %w", configPath, err)
+	if len(config.ToolManifests) > 0 {
+		absConfigPath, err := filepath.Abs(configPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve path %s: %w", configPath, err)
+		}
+		configDir := filepath.Dir(absConfigPath)
+
+		resolved := make([]string, 0, len(config.ToolManifests))
+		for _, pattern := range config.ToolManifests {
+			matches, err := filepath.Glob(filepath.Join(configDir, pattern))
+			if err != nil {
+				return nil, fmt.Errorf("invalid tool_manifests pattern %q in %s: %w", pattern, absConfigPath, err)
+			}
+			resolved = append(resolved, matches...)
+		}
+		sort.Strings(resolved)
+		config.ToolManifests = resolved
 	}
 
+	snapshot := *config
+	expandConfigStrings(config, buildExpansionBuiltins(configPath))
+	config.raw = &snapshot
+
 	return config, nil
 }
 
-// SaveConfig saves configuration to file.
+// applyConfigFileWithImports resolves configPath's top-level Imports
+// glob list (relative to configPath's own directory) and recursively
+// applies each matched file onto cfg before applying configPath's own
+// bytes last, so configPath's settings are authoritative over anything
+// an import set. seen tracks absolute paths on the current import chain
+// so a cycle (A imports B imports A) surfaces as a clear error instead
+// of recursing forever; matches within one imports: entry are applied
+// in sorted order for determinism.
+func applyConfigFileWithImports(cfg *Config, configPath string, seen map[string]bool) error {
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve path %s: %w", configPath, err)
+	}
+	if seen[absPath] {
+		return fmt.Errorf("config import cycle detected at %s", absPath)
+	}
+	seen[absPath] = true
+	defer delete(seen, absPath)
+
+	data, err := os.ReadFile(absPath)
+	if err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", absPath, err)
+	}
+
+	var header struct {
+		Imports []string `yaml:"imports"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", absPath, err)
+	}
+
+	configDir := filepath.Dir(absPath)
+	for _, pattern := range header.Imports {
+		matches, err := filepath.Glob(filepath.Join(configDir, pattern))
+		if err != nil {
+			return fmt.Errorf("invalid imports pattern %q in %s: %w", pattern, absPath, err)
+		}
+		sort.Strings(matches)
+		for _, match := range matches {
+			if err := applyConfigFileWithImports(cfg, match, seen); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", absPath, err)
+	}
+
+	return nil
+}
+
+// envVarPattern matches shell-style ${VAR} and ${VAR:-default} references.
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// expandString expands every ${VAR}/${VAR:-default} reference in s,
+// checking builtins first (so a same-named environment variable can't
+// shadow a computed built-in like ${OS}) and os.Environ() second. A
+// reference to a variable that's set in neither and has no :-default
+// expands to "", matching shell ${VAR} semantics.
+func expandString(s string, builtins map[string]string) string {
+	return envVarPattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := envVarPattern.FindStringSubmatch(match)
+		name, def := groups[1], groups[3]
+		if v, ok := builtins[name]; ok {
+			return v
+		}
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		return def
+	})
+}
+
+// expandStrings returns a new slice with expandString applied to each
+// element, so the caller can reassign without mutating a shared
+// backing array (e.g. one raw still references for SaveConfig).
+func expandStrings(values []string, builtins map[string]string) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = expandString(v, builtins)
+	}
+	return out
+}
+
+// expandConfigStrings expands ${VAR} references in cfg's Exclude,
+// Include, and every ToolConfig's Args/ConfigFile/Env, always building
+// new slices/maps rather than mutating in place - so a shallow snapshot
+// taken before this call (see LoadConfig's raw field) keeps the
+// pre-expansion values.
+func expandConfigStrings(cfg *Config, builtins map[string]string) {
+	cfg.Exclude = expandStrings(cfg.Exclude, builtins)
+	cfg.Include = expandStrings(cfg.Include, builtins)
+
+	if cfg.Tools == nil {
+		return
+	}
+
+	tools := make(map[string]ToolConfig, len(cfg.Tools))
+	for name, tc := range cfg.Tools {
+		tc.Args = expandStrings(tc.Args, builtins)
+		tc.ConfigFile = expandString(tc.ConfigFile, builtins)
+		if tc.Env != nil {
+			env := make(map[string]string, len(tc.Env))
+			for k, v := range tc.Env {
+				env[k] = expandString(v, builtins)
+			}
+			tc.Env = env
+		}
+		tools[name] = tc
+	}
+	cfg.Tools = tools
+}
+
+// buildExpansionBuiltins computes the built-in variables LoadConfig
+// expands alongside os.Environ(): CONFIG_DIR is configPath's directory,
+// REPO_ROOT walks up from there looking for a .git entry (falling back
+// to CONFIG_DIR if none is found), and OS/ARCH mirror runtime.GOOS/
+// runtime.GOARCH.
+func buildExpansionBuiltins(configPath string) map[string]string {
+	configDir, err := filepath.Abs(filepath.Dir(configPath))
+	if err != nil {
+		configDir = filepath.Dir(configPath)
+	}
+
+	return map[string]string{
+		"REPO_ROOT":  findRepoRoot(configDir),
+		"CONFIG_DIR": configDir,
+		"OS":         runtime.GOOS,
+		"ARCH":       runtime.GOARCH,
+	}
+}
+
+// findRepoRoot walks up from dir looking for a .git entry, falling back
+// to dir itself if none is found (e.g. a config loaded outside a Git
+// checkout).
+func findRepoRoot(dir string) string {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
+
+// LoadConfigStack collects every .gzquality.yml/.gzquality.yaml from the
+// repository root down to path's directory (path may be a file or a
+// directory) and merges them in order, closer-to-path overriding farther-
+// up: DefaultWorkers/Timeout/StdinTimeout replace when a layer sets them,
+// Exclude/Include accumulate across every layer, and per-tool ToolConfig
+// entries deep-merge (see mergeToolConfig). A layer with Root: true stops
+// the upward walk at that directory, the same stopping convention treefmt
+// and ESLint use, so a subproject can opt out of its parent's config.
+//
+// It returns both the individual layers (root-first, for debugging which
+// file contributed what) and the merged result. If no config file is
+// found anywhere above path, the merged result is DefaultConfig() and
+// layers is empty.
+func LoadConfigStack(path string) ([]*Config, *Config, error) {
+	dir := path
+	if path == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get current directory: %w", err)
+		}
+		dir = wd
+	} else if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		dir = filepath.Dir(path)
+	}
+
+	// Walk upward collecting layers closest-directory-first, then reverse
+	// so merging can fold them root-first (farthest-up applied first).
+	var layers []*Config
+	for {
+		configPath := findConfigFileInDir(dir)
+		if configPath != "" {
+			data, err := os.ReadFile(configPath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
+			}
+
+			layer := &Config{}
+			if err := yaml.Unmarshal(data, layer); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse config file %s: %w", configPath, err)
+			}
+			layers = append(layers, layer)
+
+			if layer.Root {
+				break
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	for i, j := 0, len(layers)-1; i < j; i, j = i+1, j-1 {
+		layers[i], layers[j] = layers[j], layers[i]
+	}
+
+	merged := DefaultConfig()
+	if len(layers) > 0 {
+		// Layers accumulate their own Exclude/Include from scratch; the
+		// built-in defaults only apply when no .gzquality.yml exists at
+		// all (see TestLoadConfigStack_NoConfigFound), otherwise a
+		// project's own exclude list would always carry the defaults
+		// (e.g. "vendor/**") duplicated alongside it.
+		merged.Exclude = nil
+		merged.Include = nil
+	}
+	for _, layer := range layers {
+		mergeConfig(merged, layer)
+	}
+
+	return layers, merged, nil
+}
+
+// findConfigFileInDir returns the first recognized config file directly
+// inside dir, or "" if none exists there.
+func findConfigFileInDir(dir string) string {
+	for _, name := range configFileNames {
+		configPath := filepath.Join(dir, name)
+		if _, err := os.Stat(configPath); err == nil {
+			return configPath
+		}
+	}
+	return ""
+}
+
+// mergeConfig folds overlay (a layer closer to the target file) onto base
+// (everything from farther-up layers already merged in). Scalars replace
+// when overlay sets a non-zero value, Exclude/Include accumulate rather
+// than replace, and Tools deep-merges per tool name instead of replacing
+// the whole map.
+func mergeConfig(base, overlay *Config) {
+	if overlay.DefaultWorkers != 0 {
+		base.DefaultWorkers = overlay.DefaultWorkers
+	}
+	if overlay.Timeout != "" {
+		base.Timeout = overlay.Timeout
+	}
+	if overlay.StdinTimeout != "" {
+		base.StdinTimeout = overlay.StdinTimeout
+	}
+
+	base.Exclude = append(base.Exclude, overlay.Exclude...)
+	base.Include = append(base.Include, overlay.Include...)
+
+	for name, overlayTool := range overlay.Tools {
+		if base.Tools == nil {
+			base.Tools = make(map[string]ToolConfig, len(overlay.Tools))
+		}
+		base.Tools[name] = mergeToolConfig(base.Tools[name], overlayTool)
+	}
+
+	base.Root = overlay.Root
+}
+
+// mergeToolConfig merges overlay onto base per ToolConfig entry. Enabled
+// and StdinCapable are plain bools with no way to tell "absent" from
+// "false" once a tool key appears in a child config at all, so they're
+// always taken from overlay rather than merged field-by-field; Args is
+// likewise replaced wholesale since a child's tool args usually aren't a
+// partial patch of its parent's. Env is the one field merged key-by-key,
+// so a child layer can add one variable without repeating its parent's
+// entire map.
+func mergeToolConfig(base, overlay ToolConfig) ToolConfig {
+	merged := base
+
+	merged.Enabled = overlay.Enabled
+	merged.StdinCapable = overlay.StdinCapable
+	merged.CacheDisabled = overlay.CacheDisabled
+
+	if overlay.ConfigFile != "" {
+		merged.ConfigFile = overlay.ConfigFile
+	}
+	if overlay.When != "" {
+		merged.When = overlay.When
+	}
+	if overlay.Priority != 0 {
+		merged.Priority = overlay.Priority
+	}
+	if overlay.Args != nil {
+		merged.Args = overlay.Args
+	}
+	if overlay.Env != nil {
+		merged.Env = make(map[string]string, len(base.Env)+len(overlay.Env))
+		for k, v := range base.Env {
+			merged.Env[k] = v
+		}
+		for k, v := range overlay.Env {
+			merged.Env[k] = v
+		}
+	}
+
+	return merged
+}
+
+// SaveConfig saves configuration to file. If config.raw is set (it was
+// produced by LoadConfig), Exclude, Include, and every ToolConfig's
+// Args/ConfigFile/Env are restored to their pre-expansion values first,
+// so saving a config loaded with ${VAR} references writes those
+// references back out instead of baking in whatever they expanded to.
+// Any other field changed in memory since LoadConfig (e.g. a CLI flag
+// patching DefaultWorkers) is still saved as-is.
 func SaveConfig(config *Config, configPath string) error {
 	// Create directory if it doesn't exist
 	if err := os.MkdirAll(filepath.Dir(configPath), 0o755); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	toSave := config
+	if config.raw != nil {
+		toSave = restoreRawStrings(config, config.raw)
+	}
+
 	// Marshal to YAML
-	data, err := yaml.Marshal(config)
+	data, err := yaml.Marshal(toSave)
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
@@ -189,14 +799,45 @@ func SaveConfig(config *Config, configPath string) error {
 	return nil
 }
 
+// restoreRawStrings returns a shallow copy of live with Exclude,
+// Include, and each ToolConfig's Args/ConfigFile/Env swapped back to
+// raw's pre-expansion values, leaving every other field (including a
+// tool live added that raw never had) untouched.
+func restoreRawStrings(live, raw *Config) *Config {
+	out := *live
+	out.raw = nil
+	out.Exclude = raw.Exclude
+	out.Include = raw.Include
+
+	if live.Tools != nil {
+		tools := make(map[string]ToolConfig, len(live.Tools))
+		for name, tc := range live.Tools {
+			if rawTool, ok := raw.Tools[name]; ok {
+				tc.Args = rawTool.Args
+				tc.ConfigFile = rawTool.ConfigFile
+				tc.Env = rawTool.Env
+			}
+			tools[name] = tc
+		}
+		out.Tools = tools
+	}
+
+	return &out
+}
+
+// configFileNames are the basenames LoadConfig's upward search and
+// LoadConfigStack's per-directory search both recognize, checked in this
+// order within a single directory.
+var configFileNames = []string{
+	".gzquality.yml",
+	".gzquality.yaml",
+	"gzquality.yml",
+	"gzquality.yaml",
+}
+
 // FindConfigFile searches for a quality config file in the current directory and up the directory tree.
 func FindConfigFile() string {
-	configNames := []string{
-		".gzquality.yml",
-		".gzquality.yaml",
-		"gzquality.yml",
-		"gzquality.yaml",
-	}
+	configNames := configFileNames
 
 	// Start from current directory
 	dir, err := os.Getwd()
@@ -250,11 +891,55 @@ func (c *Config) GetLanguageConfig(language string) LanguageConfig {
 	}
 }
 
+// GetCacheDirectory returns the cache root to pass to cache.NewStorage.
+// If Cache.Directory is set (a plain path or a Storage URL), it's
+// returned as-is. Otherwise it defaults to $XDG_CACHE_HOME/gzh-quality,
+// falling back to $HOME/.cache/gzh-quality when XDG_CACHE_HOME is unset,
+// matching how golangci-lint and other Go tools pick a cache home.
+func (c *Config) GetCacheDirectory() string {
+	if c.Cache.Directory != "" {
+		return c.Cache.Directory
+	}
+
+	if xdgCache := os.Getenv("XDG_CACHE_HOME"); xdgCache != "" {
+		return filepath.Join(xdgCache, "gzh-quality")
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".cache", "gzh-quality")
+	}
+
+	return filepath.Join(home, ".cache", "gzh-quality")
+}
+
 // IsToolEnabled checks if a tool is enabled.
 func (c *Config) IsToolEnabled(toolName string) bool {
 	return c.GetToolConfig(toolName).Enabled
 }
 
+// IsToolActive reports whether toolName is both Enabled and, if it has
+// a ToolConfig.When expression, whether that expression currently
+// evaluates true against a WhenContext built from c.Tags. A malformed
+// When expression is treated as false: a config typo should disable the
+// tool rather than silently running it.
+func (c *Config) IsToolActive(toolName string) bool {
+	toolCfg := c.GetToolConfig(toolName)
+	if !toolCfg.Enabled {
+		return false
+	}
+	if toolCfg.When == "" {
+		return true
+	}
+
+	active, err := EvaluateWhen(toolCfg.When, BuildWhenContext(c.Tags))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "quality: tools.%s: %v\n", toolName, err)
+		return false
+	}
+	return active
+}
+
 // IsLanguageEnabled checks if a language is enabled.
 func (c *Config) IsLanguageEnabled(language string) bool {
 	return c.GetLanguageConfig(language).Enabled
@@ -285,6 +970,65 @@ func (c *Config) ShouldExclude(filePath string) bool {
 	return false
 }
 
+// Validate checks that every tool and language name referenced in the
+// config is one the running binary actually knows about (knownTools from
+// the tool registry, knownLanguages from detector.ProjectAnalyzer), so a
+// typo in .gzquality.yml (e.g. "golangci_lint" instead of
+// "golangci-lint") fails fast with a clear message instead of silently
+// being ignored.
+func (c *Config) Validate(knownTools, knownLanguages []string) error {
+	var problems []string
+
+	tools := make(map[string]bool, len(knownTools))
+	for _, name := range knownTools {
+		tools[name] = true
+	}
+	for name, toolCfg := range c.Tools {
+		if !tools[name] {
+			problems = append(problems, fmt.Sprintf("tools.%s: unknown tool (not registered)", name))
+		}
+		if toolCfg.When != "" {
+			if _, err := EvaluateWhen(toolCfg.When, BuildWhenContext(nil)); err != nil {
+				problems = append(problems, fmt.Sprintf("tools.%s.when: %v", name, err))
+			}
+		}
+	}
+
+	languages := make(map[string]bool, len(knownLanguages))
+	for _, name := range knownLanguages {
+		languages[name] = true
+	}
+	for name := range c.Languages {
+		if !languages[name] {
+			problems = append(problems, fmt.Sprintf("languages.%s: unknown language", name))
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"on_unmatched", c.OnUnmatched},
+		{"on_missing_tool", c.OnMissingTool},
+	} {
+		if field.value == "" {
+			continue
+		}
+		switch field.value {
+		case PolicyIgnore, PolicyInfo, PolicyWarn, PolicyFatal:
+		default:
+			problems = append(problems, fmt.Sprintf("%s: invalid policy %q (must be ignore, info, warn, or fatal)", field.name, field.value))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+	return fmt.Errorf("invalid configuration:\n  %s", strings.Join(problems, "\n  "))
+}
+
 // ShouldInclude checks if a file path should be included.
 func (c *Config) ShouldInclude(filePath string) bool {
 	// If no include patterns, include everything (subject to exclude)
@@ -6,6 +6,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,23 @@ func TestDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultConfig_StdinCapable(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.Equal(t, "30s", config.StdinTimeout)
+
+	stdinCapable := []string{"gofumpt", "goimports", "black", "prettier"}
+	for _, tool := range stdinCapable {
+		assert.True(t, config.Tools[tool].StdinCapable, "Tool %s should be stdin-capable by default", tool)
+	}
+
+	// Tools that need project-wide context should stay opted out.
+	projectScoped := []string{"golangci-lint", "ruff", "pylint", "eslint", "tsc"}
+	for _, tool := range projectScoped {
+		assert.False(t, config.Tools[tool].StdinCapable, "Tool %s should not be stdin-capable by default", tool)
+	}
+}
+
 func TestLoadConfig_NoFile(t *testing.T) {
 	// Create temporary directory
 	tmpDir := t.TempDir()
@@ -526,6 +544,9 @@ include:
   - "*.py"
   - "*.js"
   - "*.ts"
+
+on_unmatched: "info"
+on_missing_tool: "fatal"
 `
 	err := os.WriteFile(configPath, []byte(realisticConfig), 0o644)
 	require.NoError(t, err)
@@ -555,4 +576,298 @@ include:
 	// Test ShouldInclude with realistic patterns
 	assert.True(t, config.ShouldInclude("main.go"))
 	assert.False(t, config.ShouldInclude("api.pb.go"))
+
+	// Verify on-unmatched policies
+	assert.Equal(t, PolicyInfo, config.UnmatchedPolicy())
+	assert.Equal(t, PolicyFatal, config.MissingToolPolicy())
+}
+
+func TestDefaultConfig_Cache(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.True(t, config.Cache.Enabled)
+	assert.Equal(t, "7d", config.Cache.MaxAge)
+	assert.Equal(t, int64(2*1024*1024*1024), config.Cache.MaxSize)
+}
+
+func TestGetCacheDirectory_ExplicitDirectory(t *testing.T) {
+	config := &Config{Cache: CacheConfig{Directory: "s3://my-bucket"}}
+
+	assert.Equal(t, "s3://my-bucket", config.GetCacheDirectory())
+}
+
+func TestGetCacheDirectory_XDGCacheHome(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/tmp/xdg-cache")
+
+	config := &Config{}
+
+	assert.Equal(t, filepath.Join("/tmp/xdg-cache", "gzh-quality"), config.GetCacheDirectory())
+}
+
+func TestUnmatchedPolicy_DefaultsToWarn(t *testing.T) {
+	config := &Config{}
+
+	assert.Equal(t, PolicyWarn, config.UnmatchedPolicy())
+	assert.Equal(t, PolicyWarn, config.MissingToolPolicy())
+}
+
+func TestUnmatchedPolicy_HonorsConfiguredValue(t *testing.T) {
+	config := &Config{OnUnmatched: PolicyFatal, OnMissingTool: PolicyIgnore}
+
+	assert.Equal(t, PolicyFatal, config.UnmatchedPolicy())
+	assert.Equal(t, PolicyIgnore, config.MissingToolPolicy())
+}
+
+func TestValidate_OnUnmatchedPolicyLevels(t *testing.T) {
+	for _, policy := range []string{"", PolicyIgnore, PolicyInfo, PolicyWarn, PolicyFatal} {
+		config := &Config{OnUnmatched: policy}
+		assert.NoError(t, config.Validate(nil, nil), "policy %q should be valid", policy)
+	}
+
+	config := &Config{OnUnmatched: "explode"}
+	err := config.Validate(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "on_unmatched")
+}
+
+func TestValidate_OnMissingToolPolicyLevels(t *testing.T) {
+	for _, policy := range []string{"", PolicyIgnore, PolicyInfo, PolicyWarn, PolicyFatal} {
+		config := &Config{OnMissingTool: policy}
+		assert.NoError(t, config.Validate(nil, nil), "policy %q should be valid", policy)
+	}
+
+	config := &Config{OnMissingTool: "explode"}
+	err := config.Validate(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "on_missing_tool")
+}
+
+func TestGetCacheDirectory_DefaultsUnderHome(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "")
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	config := &Config{}
+
+	assert.Equal(t, filepath.Join(home, ".cache", "gzh-quality"), config.GetCacheDirectory())
+}
+
+func TestLoadConfigStack_ChildOverridesScalars(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "pkg")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gzquality.yml"), []byte(`
+default_workers: 8
+timeout: "5m"
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, ".gzquality.yml"), []byte(`
+default_workers: 2
+`), 0o644))
+
+	layers, merged, err := LoadConfigStack(filepath.Join(sub, "main.go"))
+	require.NoError(t, err)
+	require.Len(t, layers, 2)
+
+	// pkg's default_workers wins, but its timeout is unset so the root's
+	// value carries through.
+	assert.Equal(t, 2, merged.DefaultWorkers)
+	assert.Equal(t, "5m", merged.Timeout)
+}
+
+func TestLoadConfigStack_ExcludeAccumulates(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "pkg")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gzquality.yml"), []byte(`
+exclude:
+  - "vendor/**"
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, ".gzquality.yml"), []byte(`
+exclude:
+  - "testdata/**"
+`), 0o644))
+
+	_, merged, err := LoadConfigStack(sub)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"vendor/**", "testdata/**"}, merged.Exclude)
+}
+
+func TestLoadConfigStack_ToolConfigDeepMerge(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "pkg")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gzquality.yml"), []byte(`
+tools:
+  golangci-lint:
+    enabled: true
+    config_file: ".golangci-root.yml"
+    env:
+      GOFLAGS: "-mod=mod"
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, ".gzquality.yml"), []byte(`
+tools:
+  golangci-lint:
+    enabled: true
+    args: ["--fast"]
+    env:
+      CGO_ENABLED: "0"
+`), 0o644))
+
+	_, merged, err := LoadConfigStack(sub)
+	require.NoError(t, err)
+
+	lint := merged.Tools["golangci-lint"]
+	assert.True(t, lint.Enabled)
+	assert.Equal(t, []string{"--fast"}, lint.Args)
+	assert.Equal(t, ".golangci-root.yml", lint.ConfigFile, "unset in the child layer, should carry over from root")
+	assert.Equal(t, map[string]string{"GOFLAGS": "-mod=mod", "CGO_ENABLED": "0"}, lint.Env, "env should merge across layers")
+}
+
+func TestLoadConfigStack_RootStopsUpwardWalk(t *testing.T) {
+	grandparent := t.TempDir()
+	root := filepath.Join(grandparent, "monorepo")
+	sub := filepath.Join(root, "pkg")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(grandparent, ".gzquality.yml"), []byte(`
+default_workers: 16
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gzquality.yml"), []byte(`
+root: true
+default_workers: 4
+`), 0o644))
+
+	layers, merged, err := LoadConfigStack(sub)
+	require.NoError(t, err)
+
+	require.Len(t, layers, 1, "the grandparent's config should not be collected past a Root: true layer")
+	assert.Equal(t, 4, merged.DefaultWorkers)
+}
+
+func TestLoadConfigStack_NoConfigFound(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	layers, merged, err := LoadConfigStack(tmpDir)
+	require.NoError(t, err)
+
+	assert.Empty(t, layers)
+	assert.Equal(t, DefaultConfig(), merged)
+}
+
+func TestLoadConfig_ExpandsEnvVars(t *testing.T) {
+	t.Setenv("GZQUALITY_TEST_LINT_ARG", "--timeout=5m")
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".gzquality.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+exclude:
+  - "${UNSET_VAR:-vendor}/**"
+tools:
+  golangci-lint:
+    enabled: true
+    config_file: "${CONFIG_DIR}/.golangci.yml"
+    args: ["${GZQUALITY_TEST_LINT_ARG}"]
+    env:
+      GOOS: "${OS}"
+`), 0o644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, cfg.Exclude, "vendor/**")
+	assert.Equal(t, filepath.Join(tmpDir, ".golangci.yml"), cfg.Tools["golangci-lint"].ConfigFile)
+	assert.Equal(t, []string{"--timeout=5m"}, cfg.Tools["golangci-lint"].Args)
+	assert.Equal(t, runtime.GOOS, cfg.Tools["golangci-lint"].Env["GOOS"])
+}
+
+func TestLoadConfig_ImportsMergeBeforeCurrentFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "gzquality-base.yml"), []byte(`
+default_workers: 16
+tools:
+  gofumpt:
+    enabled: true
+    priority: 10
+`), 0o644))
+	configPath := filepath.Join(tmpDir, ".gzquality.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+imports:
+  - "gzquality-base.yml"
+timeout: "20m"
+`), 0o644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, 16, cfg.DefaultWorkers, "imported base's setting should carry over")
+	assert.Equal(t, "20m", cfg.Timeout, "current file's own setting should still apply")
+	assert.True(t, cfg.Tools["gofumpt"].Enabled)
+}
+
+func TestLoadConfig_ToolManifestsResolvedToAbsolutePaths(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "tools.d"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "tools.d", "shellcheck.yaml"), []byte("name: shellcheck\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "tools.d", "hadolint.yaml"), []byte("name: hadolint\n"), 0o644))
+
+	configPath := filepath.Join(tmpDir, ".gzquality.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+tool_manifests:
+  - "tools.d/*.yaml"
+`), 0o644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.ToolManifests, 2)
+	assert.Equal(t, filepath.Join(tmpDir, "tools.d", "hadolint.yaml"), cfg.ToolManifests[0])
+	assert.Equal(t, filepath.Join(tmpDir, "tools.d", "shellcheck.yaml"), cfg.ToolManifests[1])
+}
+
+func TestLoadConfig_ImportCycleDetected(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "a.yml"), []byte(`
+imports: ["b.yml"]
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "b.yml"), []byte(`
+imports: ["a.yml"]
+`), 0o644))
+
+	_, err := LoadConfig(filepath.Join(tmpDir, "a.yml"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cycle")
+}
+
+func TestSaveConfig_PreservesRawUnexpandedValues(t *testing.T) {
+	t.Setenv("GZQUALITY_TEST_VAR", "expanded-value")
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".gzquality.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+exclude:
+  - "${GZQUALITY_TEST_VAR}/**"
+tools:
+  golangci-lint:
+    enabled: true
+    config_file: "${GZQUALITY_TEST_VAR}.yml"
+`), 0o644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	require.Contains(t, cfg.Exclude, "expanded-value/**", "sanity check that expansion actually ran")
+
+	savePath := filepath.Join(tmpDir, "roundtrip.yml")
+	require.NoError(t, SaveConfig(cfg, savePath))
+
+	saved, err := os.ReadFile(savePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(saved), "${GZQUALITY_TEST_VAR}/**", "saving a loaded config should not bake expanded values into disk")
+	assert.Contains(t, string(saved), "${GZQUALITY_TEST_VAR}.yml")
+	assert.NotContains(t, string(saved), "expanded-value")
 }
@@ -0,0 +1,342 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// WhenContext is the set of facts ToolConfig.When expressions are
+// evaluated against. BuildWhenContext assembles one from the running
+// process and Config.Tags; it's built once per run and reused across
+// every tool's When check so a repeated has:foo atom doesn't re-stat
+// PATH for each tool.
+type WhenContext struct {
+	GOOS   string
+	GOARCH string
+
+	// GoVersion is runtime.Version() with its leading "go" stripped,
+	// e.g. "1.22.3", for go:>=1.22-style atoms.
+	GoVersion string
+
+	// Tags holds Config.Tags plus any --tag flags, lowercased, for bare
+	// atoms like "fast" or "integration".
+	Tags map[string]bool
+
+	// Env looks up an environment variable; overridable in tests.
+	Env func(string) string
+
+	// LookPath resolves a binary on PATH for has:<name> atoms;
+	// overridable in tests so they don't depend on what's installed.
+	LookPath func(string) (string, error)
+}
+
+// BuildWhenContext assembles a WhenContext from the current process and
+// tags, the ad-hoc tags a user passed via Config.Tags / repeated --tag
+// flags. A "ci" tag is seeded automatically whenever the CI environment
+// variable is set and non-empty, the same signal most CI providers
+// (GitHub Actions, GitLab CI, CircleCI, ...) already export, so a bare
+// `when: ci` just works without the user having to pass --tag ci by hand.
+func BuildWhenContext(tags []string) *WhenContext {
+	tagSet := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tagSet[strings.ToLower(t)] = true
+	}
+	if os.Getenv("CI") != "" {
+		tagSet["ci"] = true
+	}
+
+	return &WhenContext{
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		GoVersion: strings.TrimPrefix(runtime.Version(), "go"),
+		Tags:      tagSet,
+		Env:       os.Getenv,
+		LookPath:  exec.LookPath,
+	}
+}
+
+// EvaluateWhen parses and evaluates expr (a ToolConfig.When string)
+// against ctx, returning true if expr is empty. An atom with an
+// unrecognized "prefix:" is treated as false and a warning naming the
+// atom is printed to stderr, matching the rest of the run continuing
+// rather than aborting on a config typo.
+func EvaluateWhen(expr string, ctx *WhenContext) (bool, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return true, nil
+	}
+
+	tokens, err := tokenizeWhen(expr)
+	if err != nil {
+		return false, fmt.Errorf("invalid when expression %q: %w", expr, err)
+	}
+
+	p := &whenParser{tokens: tokens, ctx: ctx}
+	result, err := p.parseOr()
+	if err != nil {
+		return false, fmt.Errorf("invalid when expression %q: %w", expr, err)
+	}
+	if p.pos != len(p.tokens) {
+		return false, fmt.Errorf("invalid when expression %q: unexpected token %q", expr, p.tokens[p.pos])
+	}
+
+	return result, nil
+}
+
+// tokenizeWhen splits expr into "(", ")", "&&", "||", "!", and atom
+// tokens (e.g. "os:linux", "go:>=1.22", "env:CI=true"). An atom runs
+// until whitespace, a parenthesis, "!", "&&" or "||", so a comparison
+// like ">=1.22" or an env value with "=" in it survives intact.
+func tokenizeWhen(expr string) ([]string, error) {
+	var tokens []string
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '!':
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				return nil, fmt.Errorf("unexpected \"!=\" outside an atom")
+			}
+			tokens = append(tokens, "!")
+			i++
+		case strings.HasPrefix(expr[i:], "&&"):
+			tokens = append(tokens, "&&")
+			i += 2
+		case strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, "||")
+			i += 2
+		default:
+			start := i
+			for i < len(expr) && !strings.ContainsRune(" \t()!", rune(expr[i])) && !strings.HasPrefix(expr[i:], "&&") && !strings.HasPrefix(expr[i:], "||") {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("unexpected character %q", expr[i])
+			}
+			tokens = append(tokens, expr[start:i])
+		}
+	}
+	return tokens, nil
+}
+
+// whenParser is a recursive-descent parser over the grammar:
+//
+//	or    := and ( "||" and )*
+//	and   := unary ( "&&" unary )*
+//	unary := "!" unary | primary
+//	primary := "(" or ")" | atom
+type whenParser struct {
+	tokens []string
+	pos    int
+	ctx    *WhenContext
+}
+
+func (p *whenParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *whenParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *whenParser) parseOr() (bool, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return false, err
+	}
+	for p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return false, err
+		}
+		left = left || right
+	}
+	return left, nil
+}
+
+func (p *whenParser) parseAnd() (bool, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return false, err
+	}
+	for p.peek() == "&&" {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return false, err
+		}
+		left = left && right
+	}
+	return left, nil
+}
+
+func (p *whenParser) parseUnary() (bool, error) {
+	if p.peek() == "!" {
+		p.next()
+		val, err := p.parseUnary()
+		if err != nil {
+			return false, err
+		}
+		return !val, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *whenParser) parsePrimary() (bool, error) {
+	tok := p.peek()
+	switch tok {
+	case "":
+		return false, fmt.Errorf("unexpected end of expression")
+	case "(":
+		p.next()
+		val, err := p.parseOr()
+		if err != nil {
+			return false, err
+		}
+		if p.peek() != ")" {
+			return false, fmt.Errorf("expected closing paren")
+		}
+		p.next()
+		return val, nil
+	case ")", "&&", "||":
+		return false, fmt.Errorf("unexpected token %q", tok)
+	default:
+		p.next()
+		return evalWhenAtom(tok, p.ctx), nil
+	}
+}
+
+// evalWhenAtom evaluates a single tag:value (or bare tag) atom against
+// ctx. An atom whose prefix isn't one of the recognized kinds is
+// treated as false with a warning, rather than failing the whole
+// expression, so one typo doesn't take down every tool's When check.
+func evalWhenAtom(atom string, ctx *WhenContext) bool {
+	prefix, value, hasColon := strings.Cut(atom, ":")
+	if !hasColon {
+		return ctx.Tags[strings.ToLower(atom)]
+	}
+
+	switch prefix {
+	case "os":
+		return ctx.GOOS == value
+	case "arch":
+		return ctx.GOARCH == value
+	case "tag":
+		return ctx.Tags[strings.ToLower(value)]
+	case "go":
+		ok, err := versionSatisfiesConstraint(ctx.GoVersion, value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "quality: when: %v\n", err)
+			return false
+		}
+		return ok
+	case "env":
+		name, want, hasEq := strings.Cut(value, "=")
+		if !hasEq {
+			return ctx.Env(name) != ""
+		}
+		return ctx.Env(name) == want
+	case "has":
+		_, err := ctx.LookPath(value)
+		return err == nil
+	default:
+		fmt.Fprintf(os.Stderr, "quality: when: unknown atom %q (treating as false)\n", atom)
+		return false
+	}
+}
+
+// versionSatisfiesConstraint checks version against a constraint of the
+// form "<op><major>.<minor>[.<patch>]" where op is one of
+// >=, <=, >, <, ==, or no operator (meaning ==). Only major/minor/patch
+// numeric comparison is supported, matching the precision Go's own
+// version string offers.
+func versionSatisfiesConstraint(version, constraint string) (bool, error) {
+	op := "=="
+	for _, candidate := range []string{">=", "<=", ">", "<", "=="} {
+		if strings.HasPrefix(constraint, candidate) {
+			op = candidate
+			constraint = strings.TrimPrefix(constraint, candidate)
+			break
+		}
+	}
+
+	cmp, err := compareVersions(version, constraint)
+	if err != nil {
+		return false, err
+	}
+
+	switch op {
+	case ">=":
+		return cmp >= 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	case "<":
+		return cmp < 0, nil
+	default:
+		return cmp == 0, nil
+	}
+}
+
+// compareVersions compares two dotted numeric versions component by
+// component, treating a missing trailing component as 0 ("1.22" ==
+// "1.22.0"), and returns -1, 0, or 1.
+func compareVersions(a, b string) (int, error) {
+	aParts, err := splitVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bParts, err := splitVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var av, bv int
+		if i < len(aParts) {
+			av = aParts[i]
+		}
+		if i < len(bParts) {
+			bv = bParts[i]
+		}
+		if av != bv {
+			if av < bv {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func splitVersion(v string) ([]int, error) {
+	fields := strings.Split(v, ".")
+	parts := make([]int, 0, len(fields))
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version component %q in %q", f, v)
+		}
+		parts = append(parts, n)
+	}
+	return parts, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWhenContext() *WhenContext {
+	return &WhenContext{
+		GOOS:      "linux",
+		GOARCH:    "amd64",
+		GoVersion: "1.22.3",
+		Tags:      map[string]bool{"fast": true, "ci": true},
+		Env: func(name string) string {
+			if name == "CI" {
+				return "true"
+			}
+			return ""
+		},
+		LookPath: func(name string) (string, error) {
+			if name == "golangci-lint" {
+				return "/usr/bin/golangci-lint", nil
+			}
+			return "", errors.New("executable file not found in $PATH")
+		},
+	}
+}
+
+func TestEvaluateWhen_Empty(t *testing.T) {
+	ok, err := EvaluateWhen("", testWhenContext())
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestEvaluateWhen_Atoms(t *testing.T) {
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"os:linux", true},
+		{"os:darwin", false},
+		{"arch:amd64", true},
+		{"arch:arm64", false},
+		{"ci", true},
+		{"fast", true},
+		{"missing-tag", false},
+		{"go:>=1.22", true},
+		{"go:>=1.23", false},
+		{"go:<1.22", false},
+		{"go:==1.22.3", true},
+		{"env:CI=true", true},
+		{"env:CI=false", false},
+		{"env:CI", true},
+		{"env:MISSING", false},
+		{"has:golangci-lint", true},
+		{"has:nonexistent-tool", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := EvaluateWhen(tt.expr, testWhenContext())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvaluateWhen_Precedence(t *testing.T) {
+	// && binds tighter than ||, so this reads as "fast || (ci && os:darwin)"
+	// and should be true solely because fast is set, even though the
+	// second clause is false.
+	ok, err := EvaluateWhen("fast || ci && os:darwin", testWhenContext())
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestEvaluateWhen_Negation(t *testing.T) {
+	ok, err := EvaluateWhen("!os:darwin && ci", testWhenContext())
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = EvaluateWhen("!ci", testWhenContext())
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestEvaluateWhen_Parentheses(t *testing.T) {
+	ok, err := EvaluateWhen("(os:darwin || os:linux) && arch:amd64", testWhenContext())
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestEvaluateWhen_UnknownAtomTreatedAsFalse(t *testing.T) {
+	ok, err := EvaluateWhen("bogus:value", testWhenContext())
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestEvaluateWhen_SyntaxError(t *testing.T) {
+	tests := []string{
+		"os:linux &&",
+		"(os:linux",
+		"&& os:linux",
+		"os:linux)",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, err := EvaluateWhen(expr, testWhenContext())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIsToolActive(t *testing.T) {
+	cfg := &Config{
+		Tags: []string{"fast"},
+		Tools: map[string]ToolConfig{
+			"enabled-no-when":  {Enabled: true},
+			"enabled-matching": {Enabled: true, When: "fast"},
+			"enabled-mismatch": {Enabled: true, When: "os:plan9"},
+			"disabled":         {Enabled: false, When: "fast"},
+		},
+	}
+
+	assert.True(t, cfg.IsToolActive("enabled-no-when"))
+	assert.True(t, cfg.IsToolActive("enabled-matching"))
+	assert.False(t, cfg.IsToolActive("enabled-mismatch"))
+	assert.False(t, cfg.IsToolActive("disabled"))
+}
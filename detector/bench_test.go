@@ -4,8 +4,11 @@
 package detector
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/Gizzahub/gzh-cli-quality/tools"
@@ -18,16 +21,16 @@ func BenchmarkFileTypeDetector_DetectLanguages(b *testing.B) {
 
 	// Create test files
 	files := map[string]string{
-		"main.go":       "package main\n",
-		"utils.go":      "package utils\n",
-		"test.py":       "def main(): pass\n",
-		"app.js":        "console.log('hello');\n",
-		"index.ts":      "const x: string = 'test';\n",
-		"main.rs":       "fn main() {}\n",
-		"README.md":     "# Test\n",
-		"config.yaml":   "key: value\n",
-		"Dockerfile":    "FROM alpine\n",
-		"package.json":  "{}\n",
+		"main.go":      "package main\n",
+		"utils.go":     "package utils\n",
+		"test.py":      "def main(): pass\n",
+		"app.js":       "console.log('hello');\n",
+		"index.ts":     "const x: string = 'test';\n",
+		"main.rs":      "fn main() {}\n",
+		"README.md":    "# Test\n",
+		"config.yaml":  "key: value\n",
+		"Dockerfile":   "FROM alpine\n",
+		"package.json": "{}\n",
 	}
 
 	for name, content := range files {
@@ -73,10 +76,10 @@ func BenchmarkFileTypeDetector_GetFilesByLanguage(b *testing.B) {
 
 	// Create test files
 	files := map[string]string{
-		"main.go":    "package main\n",
-		"utils.go":   "package utils\n",
-		"test.py":    "def main(): pass\n",
-		"app.js":     "console.log('hello');\n",
+		"main.go":  "package main\n",
+		"utils.go": "package utils\n",
+		"test.py":  "def main(): pass\n",
+		"app.js":   "console.log('hello');\n",
 	}
 
 	for name, content := range files {
@@ -169,7 +172,7 @@ func BenchmarkProjectAnalyzer_AnalyzeProject(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _ = analyzer.AnalyzeProject(tmpDir, registry)
+		_, _ = analyzer.AnalyzeProject(context.Background(), tmpDir, registry)
 	}
 }
 
@@ -210,6 +213,36 @@ func BenchmarkProjectAnalyzer_GetOptimalToolSelection(b *testing.B) {
 	}
 }
 
+// BenchmarkFileTypeDetector_DetectLanguagesWithOptions_Concurrency compares
+// detectLanguagesWithInfo's single-goroutine walk against its
+// DetectOptions.Concurrency worker pool on a tree wide enough for the
+// per-directory fan-out to matter. A repo with >100k files is the case
+// Concurrency targets, but that's impractical to materialize under
+// `go test -bench` - this uses enough top-level directories and files to
+// still exercise the worker-pool merge path across every one of them.
+func BenchmarkFileTypeDetector_DetectLanguagesWithOptions_Concurrency(b *testing.B) {
+	detector := NewFileTypeDetector()
+	tmpDir := b.TempDir()
+
+	const dirs = 50
+	const filesPerDir = 100
+	for i := 0; i < dirs; i++ {
+		dir := filepath.Join(tmpDir, fmt.Sprintf("pkg%d", i))
+		_ = os.MkdirAll(dir, 0o755)
+		for j := 0; j < filesPerDir; j++ {
+			_ = os.WriteFile(filepath.Join(dir, fmt.Sprintf("file%d.go", j)), []byte("package pkg\n"), 0o644)
+		}
+	}
+
+	for _, concurrency := range []int{1, runtime.NumCPU()} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{Concurrency: concurrency})
+			}
+		})
+	}
+}
+
 // BenchmarkRemoveDuplicates benchmarks duplicate removal
 func BenchmarkRemoveDuplicates(b *testing.B) {
 	input := []string{
@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"go/build/constraint"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// GoBuildContext is the target platform and tag set a .go file's build
+// constraints are evaluated against - the same inputs `go build` itself
+// takes (GOOS, GOARCH, and -tags), plus whether _test.go files count.
+type GoBuildContext struct {
+	GOOS         string
+	GOARCH       string
+	Tags         []string // additional build tags, e.g. from -tags
+	IncludeTests bool     // whether _test.go files satisfy the context
+}
+
+// DefaultGoBuildContext returns the context for the host the detector is
+// running on: runtime.GOOS/GOARCH, no extra tags, tests included.
+func DefaultGoBuildContext() GoBuildContext {
+	return GoBuildContext{GOOS: runtime.GOOS, GOARCH: runtime.GOARCH, IncludeTests: true}
+}
+
+// knownGOOS and knownGOARCH are the identifiers cmd/go's filename-suffix
+// convention recognizes (the ones `go tool dist list` enumerates). Only
+// used to tell a real "_linux.go"/"_arm64.go" suffix apart from a package-
+// specific name that merely happens to contain an underscore, e.g.
+// "parse_test.go" or "server_config.go".
+var knownGOOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true,
+	"freebsd": true, "hurd": true, "illumos": true, "ios": true,
+	"js": true, "linux": true, "nacl": true, "netbsd": true,
+	"openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+	"windows": true, "zos": true,
+}
+
+var knownGOARCH = map[string]bool{
+	"386": true, "amd64": true, "amd64p32": true, "arm": true,
+	"armbe": true, "arm64": true, "arm64be": true, "loong64": true,
+	"mips": true, "mipsle": true, "mips64": true, "mips64le": true,
+	"mips64p32": true, "mips64p32le": true, "ppc": true, "ppc64": true,
+	"ppc64le": true, "riscv": true, "riscv64": true, "s390": true,
+	"s390x": true, "sparc": true, "sparc64": true, "wasm": true,
+}
+
+// fileNameConstraint extracts the GOOS/GOARCH implied by path's filename
+// under cmd/go's suffix convention: after stripping ".go" and an optional
+// "_test", a trailing "_GOOS", "_GOARCH", or "_GOOS_GOARCH" (where GOOS/
+// GOARCH are recognized identifiers) constrains the file to that platform.
+// Returns "", "" if the filename carries no such constraint.
+func fileNameConstraint(path string) (goos, goarch string) {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	name = strings.TrimSuffix(name, "_test")
+
+	parts := strings.Split(name, "_")
+	if len(parts) < 2 {
+		return "", ""
+	}
+
+	last := parts[len(parts)-1]
+	switch {
+	case knownGOARCH[last]:
+		goarch = last
+		if len(parts) >= 3 && knownGOOS[parts[len(parts)-2]] {
+			goos = parts[len(parts)-2]
+		}
+	case knownGOOS[last]:
+		goos = last
+	}
+
+	return goos, goarch
+}
+
+// isGoTestFile reports whether path is a _test.go file.
+func isGoTestFile(path string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(path, filepath.Ext(path)), "_test")
+}
+
+// leadingBuildExpr parses the `//go:build` / `// +build` constraint(s) in
+// content's leading comment block (before the package clause), ANDing
+// together multiple old-style "+build" lines the way the compiler does.
+// Returns nil if the file has no build constraint.
+func leadingBuildExpr(content []byte) constraint.Expr {
+	var expr constraint.Expr
+
+	for _, raw := range strings.Split(string(content), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "//") {
+			break // reached the package clause (or other code)
+		}
+
+		if !constraint.IsGoBuild(line) && !constraint.IsPlusBuild(line) {
+			continue
+		}
+
+		parsed, err := constraint.Parse(line)
+		if err != nil {
+			continue
+		}
+
+		if expr == nil {
+			expr = parsed
+		} else {
+			expr = &constraint.AndExpr{X: expr, Y: parsed}
+		}
+	}
+
+	return expr
+}
+
+// satisfiesGoBuildContext reports whether path would be built under ctx,
+// combining cmd/go's three independent constraint sources: the _test.go
+// suffix, the _GOOS/_GOARCH filename suffix, and a //go:build or +build
+// comment. content may be nil, in which case path is read (bounded to
+// classifyPeekSize, same as DetectLanguage's classification peek - build
+// constraints must appear before the package clause, so they're always
+// within the first few lines).
+func satisfiesGoBuildContext(path string, content []byte, ctx GoBuildContext) (bool, string) {
+	if isGoTestFile(path) && !ctx.IncludeTests {
+		return false, "_test.go file excluded (IncludeTests=false)"
+	}
+
+	if goos, goarch := fileNameConstraint(path); goos != "" || goarch != "" {
+		if goos != "" && goos != ctx.GOOS {
+			return false, "filename constrains to GOOS=" + goos
+		}
+		if goarch != "" && goarch != ctx.GOARCH {
+			return false, "filename constrains to GOARCH=" + goarch
+		}
+	}
+
+	expr := leadingBuildExpr(peekOrRead(path, content, classifyPeekSize))
+	if expr == nil {
+		return true, ""
+	}
+
+	if !expr.Eval(ctx.tagOK) {
+		return false, "build constraint `" + expr.String() + "` not satisfied by " + ctx.GOOS + "/" + ctx.GOARCH
+	}
+
+	return true, ""
+}
+
+// tagOK reports whether tag is satisfied by ctx: either GOOS, GOARCH, or
+// one of ctx.Tags. Shared between //go:build/+build evaluation (Go files
+// only) and //gzquality:build evaluation (any language).
+func (ctx GoBuildContext) tagOK(tag string) bool {
+	if tag == ctx.GOOS || tag == ctx.GOARCH {
+		return true
+	}
+	for _, t := range ctx.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// gzQualityBuildPrefix is the header-comment marker a source file of any
+// language can use to gate itself out of tool runs on hosts that don't
+// match, e.g. "//gzquality:build linux,!windows". Parsed with the same
+// go/build/constraint comma/space ("+build"-style) grammar as a Go file's
+// own build tags, rather than invent a second syntax.
+const gzQualityBuildPrefix = "//gzquality:build"
+
+// leadingGzQualityBuildExpr scans content's leading lines (before any
+// non-comment code) for a "//gzquality:build ..." marker and parses its
+// tag list the same way a "// +build ..." line is parsed. Returns nil if
+// no marker is present. Unlike leadingBuildExpr, this isn't restricted to
+// "//" being the only thing on the line before it (languages comment
+// differently), so it simply stops at the first line that isn't blank and
+// doesn't start with "//".
+func leadingGzQualityBuildExpr(content []byte) constraint.Expr {
+	for _, raw := range strings.Split(string(content), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "//") {
+			break
+		}
+
+		rest, ok := strings.CutPrefix(line, gzQualityBuildPrefix)
+		if !ok {
+			continue
+		}
+
+		parsed, err := constraint.Parse("// +build " + strings.TrimSpace(rest))
+		if err != nil {
+			continue
+		}
+		return parsed
+	}
+
+	return nil
+}
+
+// satisfiesGzQualityBuildTag reports whether path's leading
+// "//gzquality:build ..." header comment (if any) is satisfied by ctx,
+// for gating a source file of any language out of tool runs on a host
+// that would only produce noise from it (e.g. a Windows-only script in a
+// cross-platform repo). content may be nil, in which case path is read
+// (bounded to classifyPeekSize). A file with no such header always
+// satisfies.
+func satisfiesGzQualityBuildTag(path string, content []byte, ctx GoBuildContext) (bool, string) {
+	expr := leadingGzQualityBuildExpr(peekOrRead(path, content, classifyPeekSize))
+	if expr == nil {
+		return true, ""
+	}
+
+	if !expr.Eval(ctx.tagOK) {
+		return false, "gzquality:build constraint `" + expr.String() + "` not satisfied by " + ctx.GOOS + "/" + ctx.GOARCH
+	}
+
+	return true, ""
+}
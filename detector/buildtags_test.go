@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileNameConstraint(t *testing.T) {
+	goos, goarch := fileNameConstraint("server_linux.go")
+	assert.Equal(t, "linux", goos)
+	assert.Equal(t, "", goarch)
+
+	goos, goarch = fileNameConstraint("server_linux_arm64.go")
+	assert.Equal(t, "linux", goos)
+	assert.Equal(t, "arm64", goarch)
+
+	goos, goarch = fileNameConstraint("server_arm64.go")
+	assert.Equal(t, "", goos)
+	assert.Equal(t, "arm64", goarch)
+
+	goos, goarch = fileNameConstraint("server_linux_test.go")
+	assert.Equal(t, "linux", goos)
+	assert.Equal(t, "", goarch)
+
+	// A bare "windows.go" has no underscore, so cmd/go's suffix rule
+	// finds no suffix - no constraint.
+	goos, goarch = fileNameConstraint("windows.go")
+	assert.Equal(t, "", goos)
+	assert.Equal(t, "", goarch)
+
+	goos, goarch = fileNameConstraint("parse_test.go")
+	assert.Equal(t, "", goos)
+	assert.Equal(t, "", goarch)
+}
+
+func TestSatisfiesGoBuildContext_FilenameSuffix(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64", IncludeTests: true}
+
+	ok, _ := satisfiesGoBuildContext("server_linux.go", []byte("package srv\n"), ctx)
+	assert.True(t, ok)
+
+	ok, reason := satisfiesGoBuildContext("server_windows.go", []byte("package srv\n"), ctx)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "GOOS=windows")
+}
+
+func TestSatisfiesGoBuildContext_GoBuildComment(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64", IncludeTests: true}
+
+	content := []byte("//go:build linux\n\npackage srv\n")
+	ok, _ := satisfiesGoBuildContext("server.go", content, ctx)
+	assert.True(t, ok)
+
+	content = []byte("//go:build windows\n\npackage srv\n")
+	ok, reason := satisfiesGoBuildContext("server.go", content, ctx)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "build constraint")
+}
+
+func TestSatisfiesGoBuildContext_PlusBuildAndTag(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64", Tags: []string{"integration"}, IncludeTests: true}
+
+	content := []byte("// +build integration\n\npackage srv\n")
+	ok, _ := satisfiesGoBuildContext("server.go", content, ctx)
+	assert.True(t, ok)
+
+	ctxNoTag := GoBuildContext{GOOS: "linux", GOARCH: "amd64", IncludeTests: true}
+	ok, _ = satisfiesGoBuildContext("server.go", content, ctxNoTag)
+	assert.False(t, ok)
+}
+
+func TestSatisfiesGoBuildContext_ExcludesTestsWhenRequested(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64", IncludeTests: false}
+
+	ok, reason := satisfiesGoBuildContext("server_test.go", []byte("package srv\n"), ctx)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "IncludeTests=false")
+}
+
+func TestGetFilesByLanguageForContext(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "server_linux.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "server_windows.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "darwin_only.go"), []byte("//go:build darwin\n\npackage main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+
+	linux, err := detector.GetFilesByLanguageForContext(tmpDir, GoBuildContext{GOOS: "linux", GOARCH: "amd64", IncludeTests: true})
+	require.NoError(t, err)
+	assert.Contains(t, linux["Go"], filepath.Join(tmpDir, "main.go"))
+	assert.Contains(t, linux["Go"], filepath.Join(tmpDir, "server_linux.go"))
+	assert.NotContains(t, linux["Go"], filepath.Join(tmpDir, "server_windows.go"))
+	assert.NotContains(t, linux["Go"], filepath.Join(tmpDir, "darwin_only.go"))
+
+	darwin, err := detector.GetFilesByLanguageForContext(tmpDir, GoBuildContext{GOOS: "darwin", GOARCH: "arm64", IncludeTests: true})
+	require.NoError(t, err)
+	assert.Contains(t, darwin["Go"], filepath.Join(tmpDir, "darwin_only.go"))
+	assert.NotContains(t, darwin["Go"], filepath.Join(tmpDir, "server_linux.go"))
+}
+
+func TestSatisfiesGzQualityBuildTag_CommaIsAnd(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64"}
+
+	content := []byte("//gzquality:build linux,!windows\n\necho hi\n")
+	ok, _ := satisfiesGzQualityBuildTag("deploy.sh", content, ctx)
+	assert.True(t, ok)
+
+	ctxWindows := GoBuildContext{GOOS: "windows", GOARCH: "amd64"}
+	ok, reason := satisfiesGzQualityBuildTag("deploy.sh", content, ctxWindows)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "gzquality:build")
+}
+
+func TestSatisfiesGzQualityBuildTag_CustomTag(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64", Tags: []string{"ci"}}
+
+	content := []byte("//gzquality:build ci\n\necho hi\n")
+	ok, _ := satisfiesGzQualityBuildTag("deploy.sh", content, ctx)
+	assert.True(t, ok)
+
+	ctxNoTag := GoBuildContext{GOOS: "linux", GOARCH: "amd64"}
+	ok, _ = satisfiesGzQualityBuildTag("deploy.sh", content, ctxNoTag)
+	assert.False(t, ok)
+}
+
+func TestSatisfiesGzQualityBuildTag_NoHeaderAlwaysSatisfies(t *testing.T) {
+	ctx := GoBuildContext{GOOS: "linux", GOARCH: "amd64"}
+
+	ok, reason := satisfiesGzQualityBuildTag("deploy.sh", []byte("echo hi\n"), ctx)
+	assert.True(t, ok)
+	assert.Empty(t, reason)
+}
+
+func TestDetectLanguagesWithOptions_RecordsSkippedByBuildTags(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "server_windows.go"), []byte("package main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	info, err := detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{GoBuildContext: &GoBuildContext{GOOS: "linux", GOARCH: "amd64", IncludeTests: true}})
+	require.NoError(t, err)
+
+	var goInfo *LanguageInfo
+	for _, lang := range info {
+		if lang.Name == "Go" {
+			goInfo = lang
+		}
+	}
+	require.NotNil(t, goInfo)
+	assert.Contains(t, goInfo.SkippedByBuildTags, filepath.Join(tmpDir, "server_windows.go"))
+	assert.NotContains(t, goInfo.Files, filepath.Join(tmpDir, "server_windows.go"))
+}
+
+func TestDetectLanguagesWithOptions_HonorsGzQualityBuildHeaderForAnyLanguage(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "deploy.sh"), []byte("//gzquality:build !windows\necho hi\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	detector.GoBuildContext = GoBuildContext{GOOS: "windows", GOARCH: "amd64", IncludeTests: true}
+
+	info, err := detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{})
+	require.NoError(t, err)
+
+	var shellInfo *LanguageInfo
+	for _, lang := range info {
+		if lang.Name == "Shell" {
+			shellInfo = lang
+		}
+	}
+	require.NotNil(t, shellInfo)
+	assert.Contains(t, shellInfo.SkippedByBuildTags, filepath.Join(tmpDir, "deploy.sh"))
+	assert.NotContains(t, shellInfo.Files, filepath.Join(tmpDir, "deploy.sh"))
+}
@@ -0,0 +1,482 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+//go:embed languages.yml
+var defaultRulesYAML []byte
+
+//go:embed testdata/bayes
+var bayesSamplesFS embed.FS
+
+// classifyPeekSize bounds how much of a file's content the ambiguous-
+// extension, shebang, and modeline stages read, so classifying one huge
+// file stays cheap.
+const classifyPeekSize = 4096
+
+// bayesConfidenceThreshold is the minimum posterior probability
+// bayesModel.classify must produce for DetectLanguage to trust it - below
+// this, an unrecognized extension with no shebang/modeline is reported as
+// unknown rather than guessed at.
+const bayesConfidenceThreshold = 0.6
+
+// ambiguousRule disambiguates one ambiguous extension's candidate
+// language via regexes checked in order against a file's leading
+// content; Default applies when none match.
+type ambiguousRule struct {
+	defaultLang    string
+	disambiguators []ambiguousDisambiguator
+}
+
+type ambiguousDisambiguator struct {
+	re       *regexp.Regexp
+	language string
+}
+
+// classifierRules is the parsed, regex-compiled form of a languages.yml
+// file - the ambiguous-extension, shebang, and modeline tables
+// DetectLanguage consults once plain extension/pattern lookup
+// (FileTypeDetector.rules) can't resolve a file on its own.
+type classifierRules struct {
+	ambiguous map[string]ambiguousRule
+	shebangs  map[string]string
+	modelines map[string]string
+}
+
+// classifierRulesYAML is classifierRules' on-disk shape.
+type classifierRulesYAML struct {
+	Ambiguous map[string]struct {
+		Default        string `yaml:"default"`
+		Disambiguators []struct {
+			Regex    string `yaml:"regex"`
+			Language string `yaml:"language"`
+		} `yaml:"disambiguators"`
+	} `yaml:"ambiguous"`
+	Shebangs  map[string]string `yaml:"shebangs"`
+	Modelines map[string]string `yaml:"modelines"`
+}
+
+func parseClassifierRules(data []byte) (*classifierRules, error) {
+	var raw classifierRulesYAML
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse language rules: %w", err)
+	}
+
+	rules := &classifierRules{
+		ambiguous: make(map[string]ambiguousRule, len(raw.Ambiguous)),
+		shebangs:  raw.Shebangs,
+		modelines: raw.Modelines,
+	}
+
+	for ext, rule := range raw.Ambiguous {
+		compiled := ambiguousRule{defaultLang: rule.Default}
+		for _, d := range rule.Disambiguators {
+			re, err := regexp.Compile(d.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("language rule for %s: invalid regex %q: %w", ext, d.Regex, err)
+			}
+			compiled.disambiguators = append(compiled.disambiguators, ambiguousDisambiguator{re: re, language: d.Language})
+		}
+		rules.ambiguous[strings.ToLower(ext)] = compiled
+	}
+
+	return rules, nil
+}
+
+var defaultClassifierRules = mustParseClassifierRules(defaultRulesYAML)
+
+func mustParseClassifierRules(data []byte) *classifierRules {
+	rules, err := parseClassifierRules(data)
+	if err != nil {
+		panic(fmt.Sprintf("detector: embedded languages.yml is invalid: %v", err))
+	}
+	return rules
+}
+
+// LoadRules replaces d's ambiguous-extension disambiguators, shebang
+// interpreter table, and modeline table with the ones in r's YAML (see
+// languages.yml for the shape), for callers that want to extend or
+// override the bundled rules without recompiling. It leaves d's
+// LanguageRule table (extensions, indicators, weights) untouched - that
+// stays configured via registerDefaultRules.
+func (d *FileTypeDetector) LoadRules(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read language rules: %w", err)
+	}
+
+	rules, err := parseClassifierRules(data)
+	if err != nil {
+		return err
+	}
+
+	d.classifierRules = rules
+	return nil
+}
+
+// DetectLanguage classifies a single file the way a linguist-style tool
+// does, trying each stage in order until one is confident enough: (1)
+// extension lookup, with regex disambiguation for extensions known to be
+// ambiguous (languages.yml's "ambiguous" table); (2) shebang parsing for
+// extensionless files; (3) an emacs/vim modeline; (4) an exact-filename
+// match (Makefile, Dockerfile, BUILD, ...) for the files the first three
+// stages still couldn't place; (5) a naive-Bayes tokenizer fallback
+// trained on detector/testdata/bayes. content may be nil, in which case
+// DetectLanguage peeks path itself for any stage that needs it.
+// Filename matching runs after shebang/modeline rather than before, so a
+// generic extensionless name a rule also claims (e.g. a Bazel "BUILD"
+// file) doesn't preempt an actual shebang in a same-named script, such
+// as a project's own `./build` shell wrapper.
+// DetectLanguages/GetFilesByLanguage build their project-wide view on
+// top of this for every file the walk visits.
+func (d *FileTypeDetector) DetectLanguage(path string, content []byte) (string, float64) {
+	rules := d.classifierRules
+	if rules == nil {
+		rules = defaultClassifierRules
+	}
+
+	filename := filepath.Base(path)
+	ext := strings.ToLower(filepath.Ext(filename))
+
+	peekSize := d.peekSize()
+
+	if ambiguous, ok := rules.ambiguous[ext]; ok {
+		peek := peekOrRead(path, content, peekSize)
+		for _, da := range ambiguous.disambiguators {
+			if da.re.Match(peek) {
+				return da.language, 0.9
+			}
+		}
+		if ambiguous.defaultLang != "" {
+			return ambiguous.defaultLang, 0.75
+		}
+	}
+
+	if lang := d.languageForExtension(ext); lang != "" {
+		return lang, 0.95
+	}
+
+	peek := peekOrRead(path, content, peekSize)
+	if lang := rules.shebangLanguage(peek); lang != "" {
+		return lang, 0.85
+	}
+	if lang := rules.modelineLanguage(peek); lang != "" {
+		return lang, 0.8
+	}
+
+	if lang := d.languageForFilename(filename); lang != "" {
+		return lang, 0.95
+	}
+
+	if d.ContentClassification {
+		if lang, prob := defaultBayesModel.classify(peek); lang != "" && prob >= bayesConfidenceThreshold {
+			return lang, prob
+		}
+	}
+
+	return "", 0
+}
+
+// peekSize returns the byte budget DetectLanguage's shebang/modeline/
+// classifier stages read from a file: d.MaxBytesScanned when a
+// config.Config.Detection block set a positive one, else the bundled
+// classifyPeekSize default.
+func (d *FileTypeDetector) peekSize() int {
+	if d.MaxBytesScanned > 0 {
+		return d.MaxBytesScanned
+	}
+	return classifyPeekSize
+}
+
+// languageForExtension returns the rule name whose Extensions contains
+// ext (the same extension lookup matchesRule does), or "" if none do.
+func (d *FileTypeDetector) languageForExtension(ext string) string {
+	if ext == "" {
+		return ""
+	}
+	for _, rule := range d.rules {
+		for _, ruleExt := range rule.Extensions {
+			if strings.EqualFold(ext, ruleExt) {
+				return rule.Name
+			}
+		}
+	}
+	return ""
+}
+
+// peekOrRead returns content if the caller already has it, otherwise
+// reads up to maxBytes bytes from the start of path - "" on any read
+// error, so a since-deleted or unreadable file classifies the same as
+// one with no distinguishing content instead of erroring out.
+func peekOrRead(path string, content []byte, maxBytes int) []byte {
+	if content != nil {
+		return content
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	buf := make([]byte, maxBytes)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil
+	}
+	return buf[:n]
+}
+
+// shebangLanguage reads content's first line and, if it's a "#!"
+// shebang, maps its interpreter to a language via r.shebangs. Returns ""
+// if there's no shebang line or the interpreter isn't recognized.
+func (r *classifierRules) shebangLanguage(content []byte) string {
+	line := strings.TrimSpace(firstLine(content))
+	if !strings.HasPrefix(line, "#!") {
+		return ""
+	}
+
+	fields := strings.Fields(line)
+	interpreter := filepath.Base(fields[len(fields)-1])
+	return r.shebangs[interpreter]
+}
+
+// firstLine returns content up to (not including) its first newline, or
+// all of content if it has none.
+func firstLine(content []byte) string {
+	if idx := bytes.IndexByte(content, '\n'); idx >= 0 {
+		return string(content[:idx])
+	}
+	return string(content)
+}
+
+var (
+	modelineEmacsRe = regexp.MustCompile(`-\*-\s*mode:\s*([\w+-]+)\s*-\*-`)
+	modelineVimRe   = regexp.MustCompile(`\b(?:ft|filetype)=([\w.+-]+)`)
+)
+
+// modelineLanguage scans content's lines for an emacs "-*- mode: X -*-"
+// or vim "vim: ... ft=X" / "filetype=X" modeline and maps X to a
+// language via r.modelines. Returns "" if no line has one.
+func (r *classifierRules) modelineLanguage(content []byte) string {
+	for _, raw := range bytes.Split(content, []byte("\n")) {
+		line := string(raw)
+
+		if m := modelineEmacsRe.FindStringSubmatch(line); m != nil {
+			if lang, ok := r.modelines[strings.ToLower(m[1])]; ok {
+				return lang
+			}
+		}
+
+		if strings.Contains(line, "vim:") || strings.Contains(line, "ex:") {
+			if m := modelineVimRe.FindStringSubmatch(line); m != nil {
+				if lang, ok := r.modelines[strings.ToLower(m[1])]; ok {
+					return lang
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// bayesModel is a bag-of-words naive-Bayes classifier: one class per
+// language sample under detector/testdata/bayes, with Laplace-smoothed
+// token likelihoods. It's DetectLanguage's last resort, for content an
+// extension, shebang, and modeline all fail to place.
+type bayesModel struct {
+	classTokenCounts map[string]map[string]int
+	classTotalTokens map[string]int
+	classDocCount    map[string]int
+	vocab            map[string]struct{}
+	totalDocs        int
+}
+
+var tokenRe = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*|[^\sA-Za-z0-9_]`)
+
+func tokenize(content []byte) []string {
+	return tokenRe.FindAllString(string(content), -1)
+}
+
+func trainBayesModel(samples fs.FS, root string) (*bayesModel, error) {
+	entries, err := fs.ReadDir(samples, root)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &bayesModel{
+		classTokenCounts: make(map[string]map[string]int),
+		classTotalTokens: make(map[string]int),
+		classDocCount:    make(map[string]int),
+		vocab:            make(map[string]struct{}),
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		lang := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		data, err := fs.ReadFile(samples, root+"/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if model.classTokenCounts[lang] == nil {
+			model.classTokenCounts[lang] = make(map[string]int)
+		}
+		model.classDocCount[lang]++
+		model.totalDocs++
+
+		for _, tok := range tokenize(data) {
+			model.classTokenCounts[lang][tok]++
+			model.classTotalTokens[lang]++
+			model.vocab[tok] = struct{}{}
+		}
+	}
+
+	return model, nil
+}
+
+var defaultBayesModel = mustTrainBayesModel()
+
+func mustTrainBayesModel() *bayesModel {
+	model, err := trainBayesModel(bayesSamplesFS, "testdata/bayes")
+	if err != nil {
+		panic(fmt.Sprintf("detector: failed to train bundled naive-Bayes samples: %v", err))
+	}
+	return model
+}
+
+// classifyMinTokens is the smallest token count classify will attempt to
+// score, since a handful of tokens from a short config snippet isn't
+// enough signal to beat the model's class priors honestly.
+const classifyMinTokens = 8
+
+// classify returns the most likely class for content and its posterior
+// probability, or ("", 0) if there isn't enough content to score or the
+// model has no training data.
+func (m *bayesModel) classify(content []byte) (string, float64) {
+	scores := m.score(content, nil)
+	if len(scores) == 0 {
+		return "", 0
+	}
+
+	best := scores[0]
+
+	// A rough confidence from just the top two scores' margin, via
+	// logistic squashing - not a full normalization over every class
+	// (numerically awkward once token counts push log-probabilities to
+	// very different scales), but enough to gate against the common
+	// case of two classes scoring a near-tie.
+	confidence := 0.5
+	if len(scores) > 1 {
+		confidence = 1 / (1 + math.Exp(-(best.logProb - scores[1].logProb)))
+	}
+
+	return best.lang, confidence
+}
+
+// bayesScore is one candidate language's raw log-posterior from score,
+// before classify/ScoredLanguage squash it into a 0-1 confidence.
+type bayesScore struct {
+	lang    string
+	logProb float64
+}
+
+// score computes each candidate language's log-posterior for content's
+// tokens under Laplace (add-1) smoothing, restricted to candidates when
+// non-empty (every trained language otherwise), sorted most-likely
+// first. Returns nil if content has fewer than classifyMinTokens tokens
+// or the model has no training data - too little signal to trust over
+// the model's class priors.
+func (m *bayesModel) score(content []byte, candidates []string) []bayesScore {
+	if m.totalDocs == 0 {
+		return nil
+	}
+
+	tokens := tokenize(content)
+	if len(tokens) < classifyMinTokens {
+		return nil
+	}
+
+	classes := candidates
+	if len(classes) == 0 {
+		classes = make([]string, 0, len(m.classDocCount))
+		for lang := range m.classDocCount {
+			classes = append(classes, lang)
+		}
+	}
+
+	vocabSize := float64(len(m.vocab))
+
+	scores := make([]bayesScore, 0, len(classes))
+	for _, lang := range classes {
+		docCount, trained := m.classDocCount[lang]
+		if !trained {
+			continue
+		}
+
+		logProb := math.Log(float64(docCount) / float64(m.totalDocs))
+		total := float64(m.classTotalTokens[lang])
+		for _, tok := range tokens {
+			count := float64(m.classTokenCounts[lang][tok])
+			logProb += math.Log((count + 1) / (total + vocabSize))
+		}
+		scores = append(scores, bayesScore{lang, logProb})
+	}
+
+	sort.Slice(scores, func(i, j int) bool { return scores[i].logProb > scores[j].logProb })
+
+	return scores
+}
+
+// ScoredLanguage pairs a candidate language with Classify's confidence
+// that content is written in it.
+type ScoredLanguage struct {
+	Language string
+	Score    float64
+}
+
+// Classify scores content against the bundled naive-Bayes model,
+// restricted to candidates when non-empty (every trained language
+// otherwise), and returns them most-likely-first with each Score
+// squashed to (0, 1) via the same logistic-on-log-margin approach
+// classify uses, so results are comparable to bayesConfidenceThreshold.
+// Returns nil if content is too short to classify (see
+// classifyMinTokens) or the model has no training data for any
+// candidate.
+func (d *FileTypeDetector) Classify(content []byte, candidates []string) []ScoredLanguage {
+	scores := defaultBayesModel.score(content, candidates)
+	if len(scores) == 0 {
+		return nil
+	}
+
+	result := make([]ScoredLanguage, len(scores))
+	for i, s := range scores {
+		confidence := 0.5
+		if i+1 < len(scores) {
+			confidence = 1 / (1 + math.Exp(-(s.logProb - scores[i+1].logProb)))
+		} else if i > 0 {
+			confidence = 1 / (1 + math.Exp(-(s.logProb - scores[i-1].logProb)))
+		}
+		result[i] = ScoredLanguage{Language: s.lang, Score: confidence}
+	}
+
+	return result
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectLanguage_PlainExtensionMatchesRegisteredRule(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, confidence := d.DetectLanguage("main.go", nil)
+	assert.Equal(t, "Go", lang)
+	assert.Greater(t, confidence, 0.9)
+}
+
+func TestDetectLanguage_AmbiguousHeaderDisambiguatesViaContent(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, _ := d.DetectLanguage("widget.h", []byte("@interface Widget : NSObject\n@end\n"))
+	assert.Equal(t, "Objective-C", lang)
+
+	lang, _ = d.DetectLanguage("widget.h", []byte("struct widget { int x; };\n"))
+	assert.Equal(t, "C", lang)
+}
+
+func TestDetectLanguage_AmbiguousTSDisambiguatesXMLFromTypeScript(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, _ := d.DetectLanguage("app.ts", []byte("const x: number = 1;\n"))
+	assert.Equal(t, "TypeScript", lang)
+
+	lang, _ = d.DetectLanguage("translation.ts", []byte("<?xml version=\"1.0\"?>\n<TS></TS>\n"))
+	assert.Equal(t, "XML", lang)
+}
+
+func TestDetectLanguage_ShebangClassifiesExtensionlessScript(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, confidence := d.DetectLanguage("build", []byte("#!/usr/bin/env python3\nimport sys\n"))
+	assert.Equal(t, "Python", lang)
+	assert.Greater(t, confidence, 0.8)
+}
+
+func TestDetectLanguage_ModelineClassifiesExtensionlessFile(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, _ := d.DetectLanguage("snippet", []byte("# -*- mode: python -*-\nprint('hi')\n"))
+	assert.Equal(t, "Python", lang)
+
+	lang, _ = d.DetectLanguage("snippet2", []byte("# vim: set ft=ruby :\nputs 'hi'\n"))
+	assert.Equal(t, "Ruby", lang)
+}
+
+func TestDetectLanguage_UnknownExtensionlessContentReturnsEmpty(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, confidence := d.DetectLanguage("mystery", []byte("xyzzy plugh"))
+	assert.Empty(t, lang)
+	assert.Zero(t, confidence)
+}
+
+func TestFileTypeDetector_LoadRules_OverridesAmbiguousDisambiguation(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	custom := strings.NewReader(`
+ambiguous:
+  .h:
+    default: C
+    disambiguators:
+      - regex: "MY_CUSTOM_MARKER"
+        language: CustomLang
+`)
+	require.NoError(t, d.LoadRules(custom))
+
+	lang, _ := d.DetectLanguage("widget.h", []byte("MY_CUSTOM_MARKER\n"))
+	assert.Equal(t, "CustomLang", lang)
+
+	// Objective-C's disambiguator from the bundled defaults no longer
+	// applies once LoadRules has replaced the ambiguous-extension table.
+	lang, _ = d.DetectLanguage("widget.h", []byte("@interface Widget : NSObject\n@end\n"))
+	assert.Equal(t, "C", lang)
+}
+
+func TestFileTypeDetector_LoadRules_RejectsMalformedYAML(t *testing.T) {
+	d := NewFileTypeDetector()
+	err := d.LoadRules(strings.NewReader("not: [valid"))
+	assert.Error(t, err)
+}
+
+func TestBayesModel_ClassifiesGoSourceFromTrainingSamples(t *testing.T) {
+	snippet := []byte(`
+package worker
+
+import (
+	"fmt"
+	"os"
+)
+
+func Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing argument")
+	}
+	fmt.Fprintln(os.Stdout, args[0])
+	return nil
+}
+`)
+
+	lang, prob := defaultBayesModel.classify(snippet)
+	assert.Equal(t, "Go", lang)
+	assert.GreaterOrEqual(t, prob, bayesConfidenceThreshold)
+}
+
+func TestBayesModel_TooShortContentReturnsEmpty(t *testing.T) {
+	lang, prob := defaultBayesModel.classify([]byte("hi"))
+	assert.Empty(t, lang)
+	assert.Zero(t, prob)
+}
+
+func TestFileTypeDetector_Classify_RanksGoFirstAmongCandidates(t *testing.T) {
+	snippet := []byte(`
+package worker
+
+import (
+	"fmt"
+	"os"
+)
+
+func Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing argument")
+	}
+	fmt.Fprintln(os.Stdout, args[0])
+	return nil
+}
+`)
+
+	d := NewFileTypeDetector()
+	scored := d.Classify(snippet, []string{"Go", "Python", "Rust"})
+
+	require.NotEmpty(t, scored)
+	assert.Equal(t, "Go", scored[0].Language)
+	assert.Len(t, scored, 3)
+
+	for i := 1; i < len(scored); i++ {
+		assert.GreaterOrEqual(t, scored[i-1].Score, 0.0)
+	}
+}
+
+func TestFileTypeDetector_Classify_EmptyCandidatesScoresEveryTrainedLanguage(t *testing.T) {
+	snippet := []byte(`
+package worker
+
+func Run() {}
+`)
+
+	d := NewFileTypeDetector()
+	scored := d.Classify(snippet, nil)
+
+	require.NotEmpty(t, scored)
+	assert.Equal(t, "Go", scored[0].Language)
+	assert.Greater(t, len(scored), 1, "should score every trained language, not just one candidate")
+}
+
+func TestFileTypeDetector_Classify_TooShortContentReturnsNil(t *testing.T) {
+	d := NewFileTypeDetector()
+	scored := d.Classify([]byte("hi"), nil)
+	assert.Nil(t, scored)
+}
+
+func TestDetectLanguage_ExactFilenameMatchesDockerfileAndMakefile(t *testing.T) {
+	d := NewFileTypeDetector()
+
+	lang, confidence := d.DetectLanguage("Dockerfile", nil)
+	assert.Equal(t, "Dockerfile", lang)
+	assert.Greater(t, confidence, 0.9)
+
+	lang, confidence = d.DetectLanguage("Makefile", nil)
+	assert.Equal(t, "Make", lang)
+	assert.Greater(t, confidence, 0.9)
+
+	lang, _ = d.DetectLanguage("Rakefile", nil)
+	assert.Equal(t, "Ruby", lang)
+
+	lang, _ = d.DetectLanguage("path/to/CMakeLists.txt", nil)
+	assert.Equal(t, "CMake", lang)
+}
+
+func TestLanguageForFile_UsesDetectLanguage(t *testing.T) {
+	d := NewFileTypeDetector()
+	assert.Equal(t, "Go", d.LanguageForFile("main.go"))
+	assert.Equal(t, "", d.LanguageForFile("unknownext.zzz"))
+}
+
+func TestDetectLanguages_ShebangScriptCountsTowardProjectLanguages(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	script := filepath.Join(tmpDir, "run-tests")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/bash\necho running\n"), 0o755))
+
+	d := NewFileTypeDetector()
+	files, err := d.GetFilesByLanguage(tmpDir, []string{"Shell"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Shell"], script)
+}
+
+func TestDetectLanguages_DockerfileAndBashrcAreDetectedDespiteNoExtension(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	dockerfile := filepath.Join(tmpDir, "Dockerfile")
+	require.NoError(t, os.WriteFile(dockerfile, []byte("FROM golang:1.22\n"), 0o644))
+
+	bashrc := filepath.Join(tmpDir, ".bashrc")
+	require.NoError(t, os.WriteFile(bashrc, []byte("alias ll='ls -la'\n"), 0o644))
+
+	d := NewFileTypeDetector()
+
+	files, err := d.GetFilesByLanguage(tmpDir, []string{"Dockerfile", "Shell"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Dockerfile"], dockerfile)
+	assert.Contains(t, files["Shell"], bashrc)
+}
+
+func TestShouldIgnoreFile_DotfileNotClaimedByAnyRuleIsStillIgnored(t *testing.T) {
+	d := NewFileTypeDetector()
+	assert.True(t, d.shouldIgnoreFile("/repo/.env", ".env"))
+	assert.False(t, d.shouldIgnoreFile("/repo/.bashrc", ".bashrc"))
+}
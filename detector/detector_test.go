@@ -6,6 +6,7 @@ package detector
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,11 +33,11 @@ func TestDetectLanguages_Go(t *testing.T) {
 
 	// Create Go files
 	files := map[string]string{
-		"main.go":    "package main\n\nfunc main() {}\n",
-		"utils.go":   "package main\n\nfunc helper() {}\n",
-		"go.mod":     "module test\n\ngo 1.24\n",
-		"go.sum":     "",
-		"README.md":  "# Test Project",
+		"main.go":   "package main\n\nfunc main() {}\n",
+		"utils.go":  "package main\n\nfunc helper() {}\n",
+		"go.mod":    "module test\n\ngo 1.24\n",
+		"go.sum":    "",
+		"README.md": "# Test Project",
 	}
 
 	for name, content := range files {
@@ -101,8 +102,8 @@ func TestDetectLanguages_TypeScript(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	files := map[string]string{
-		"index.ts":     "const x: number = 42;\n",
-		"types.ts":     "export interface User { name: string; }\n",
+		"index.ts":      "const x: number = 42;\n",
+		"types.ts":      "export interface User { name: string; }\n",
 		"tsconfig.json": `{"compilerOptions": {}}`,
 	}
 
@@ -122,10 +123,10 @@ func TestDetectLanguages_Rust(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	files := map[string]string{
-		"main.rs":     "fn main() {}\n",
-		"lib.rs":      "pub fn helper() {}\n",
-		"Cargo.toml":  "[package]\nname = \"test\"\nversion = \"0.1.0\"\n",
-		"Cargo.lock":  "",
+		"main.rs":    "fn main() {}\n",
+		"lib.rs":     "pub fn helper() {}\n",
+		"Cargo.toml": "[package]\nname = \"test\"\nversion = \"0.1.0\"\n",
+		"Cargo.lock": "",
 	}
 
 	for name, content := range files {
@@ -140,6 +141,27 @@ func TestDetectLanguages_Rust(t *testing.T) {
 	assert.Contains(t, languages, "Rust")
 }
 
+func TestDetectLanguages_Julia(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"main.jl":       "function main()\nend\n",
+		"Project.toml":  "name = \"Test\"\nuuid = \"00000000-0000-0000-0000-000000000000\"\n",
+		"Manifest.toml": "",
+	}
+
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644)
+		require.NoError(t, err)
+	}
+
+	detector := NewFileTypeDetector()
+	languages, err := detector.DetectLanguages(tmpDir)
+	require.NoError(t, err)
+
+	assert.Contains(t, languages, "Julia")
+}
+
 func TestDetectLanguages_MultiLanguage(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -214,6 +236,53 @@ func TestDetectLanguages_SkipsVendor(t *testing.T) {
 	assert.Contains(t, languages, "Go")
 }
 
+func TestDetectLanguages_HonorsGitignore(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("generated/\n*.gen.go\n"), 0o644)
+	require.NoError(t, err)
+
+	generatedDir := filepath.Join(tmpDir, "generated")
+	err = os.MkdirAll(generatedDir, 0o755)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(generatedDir, "lib.go"), []byte("package lib\n"), 0o644)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(tmpDir, "schema.gen.go"), []byte("package main\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0o644)
+	require.NoError(t, err)
+
+	detector := NewFileTypeDetector()
+	files, err := detector.GetFilesByLanguage(tmpDir, []string{"Go"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Go"], filepath.Join(tmpDir, "main.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(generatedDir, "lib.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(tmpDir, "schema.gen.go"))
+}
+
+func TestDetectLanguages_NestedGitignoreScopedToItsDirectory(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	sub := filepath.Join(tmpDir, "sub")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, ".gitignore"), []byte("local.go\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, "local.go"), []byte("package sub\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, "keep.go"), []byte("package sub\n"), 0o644))
+	// Same filename at the root is outside sub/.gitignore's scope, so it
+	// must not be ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "local.go"), []byte("package main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	files, err := detector.GetFilesByLanguage(tmpDir, []string{"Go"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Go"], filepath.Join(sub, "keep.go"))
+	assert.Contains(t, files["Go"], filepath.Join(tmpDir, "local.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(sub, "local.go"))
+}
+
 func TestGetFilesByLanguage_Go(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -340,8 +409,11 @@ func TestShouldIgnoreFile(t *testing.T) {
 	}{
 		{"Normal Go file", "/project/main.go", "main.go", false},
 		{"Hidden file", "/project/.hidden", ".hidden", true},
-		{"Vendor directory", "/project/vendor/lib.go", "lib.go", true},
-		{"Node modules", "/project/node_modules/pkg/index.js", "index.js", true},
+		// vendor/ and node_modules/ are handled by classifyWithAttributes'
+		// vendored/generated logic instead, not shouldIgnoreFile - see its
+		// doc comment.
+		{"Vendor directory", "/project/vendor/lib.go", "lib.go", false},
+		{"Node modules", "/project/node_modules/pkg/index.js", "index.js", false},
 		{"Git directory", "/project/.git/config", "config", true},
 		{"Python cache", "/project/__pycache__/module.pyc", "module.pyc", true},
 		{"Build directory", "/project/build/output.js", "output.js", true},
@@ -374,10 +446,10 @@ func TestCalculateConfidence(t *testing.T) {
 	detector.rules["TestLang"] = testRule
 
 	tests := []struct {
-		name      string
-		langInfo  *LanguageInfo
-		minConf   float64
-		maxConf   float64
+		name     string
+		langInfo *LanguageInfo
+		minConf  float64
+		maxConf  float64
 	}{
 		{
 			name: "Meets minimum files",
@@ -518,10 +590,10 @@ func TestDetectLanguages_NestedDirectories(t *testing.T) {
 
 	// Files in different directories
 	files := map[string]string{
-		filepath.Join(srcDir, "main.go"):    "package main\n",
-		filepath.Join(srcDir, "utils.go"):   "package main\n",
-		filepath.Join(testDir, "test.go"):   "package main\n",
-		filepath.Join(tmpDir, "go.mod"):     "module test\n",
+		filepath.Join(srcDir, "main.go"):  "package main\n",
+		filepath.Join(srcDir, "utils.go"): "package main\n",
+		filepath.Join(testDir, "test.go"): "package main\n",
+		filepath.Join(tmpDir, "go.mod"):   "module test\n",
 	}
 
 	for path, content := range files {
@@ -543,3 +615,61 @@ func TestDetectLanguages_NestedDirectories(t *testing.T) {
 	goFiles := filesByLang["Go"]
 	assert.GreaterOrEqual(t, len(goFiles), 3, "Should find files in nested directories")
 }
+
+func TestDetectLanguagesWithOptions_Concurrency(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// One subdirectory per language, plus a nested .gitignore, so the
+	// worker pool has to split across several top-level entries and still
+	// honor per-directory exclusions the same way the single-goroutine
+	// walk does.
+	dirs := map[string]map[string]string{
+		"go-pkg": {
+			"main.go": "package main\n",
+			"go.mod":  "module test\n",
+		},
+		"py-pkg": {
+			"app.py":           "def main(): pass\n",
+			"requirements.txt": "requests\n",
+		},
+		"js-pkg": {
+			"index.js":   "console.log('hi');\n",
+			".gitignore": "ignored.js\n",
+			"ignored.js": "console.log('skip me');\n",
+		},
+	}
+
+	for dir, files := range dirs {
+		full := filepath.Join(tmpDir, dir)
+		require.NoError(t, os.MkdirAll(full, 0o755))
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(full, name), []byte(content), 0o644))
+		}
+	}
+
+	detector := NewFileTypeDetector()
+
+	sequential, err := detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{})
+	require.NoError(t, err)
+
+	concurrent, err := detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{Concurrency: 4})
+	require.NoError(t, err)
+
+	byName := func(infos []*LanguageInfo) map[string][]string {
+		out := make(map[string][]string, len(infos))
+		for _, info := range infos {
+			files := append([]string(nil), info.Files...)
+			sort.Strings(files)
+			out[info.Name] = files
+		}
+		return out
+	}
+
+	assert.Equal(t, byName(sequential), byName(concurrent))
+
+	concurrentFiles := byName(concurrent)
+	require.Contains(t, concurrentFiles, "JavaScript")
+	for _, f := range concurrentFiles["JavaScript"] {
+		assert.NotContains(t, f, "ignored.js", "nested .gitignore should still apply under the worker pool")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// builtinVendoredPatterns and builtinGeneratedPatterns are a minimal,
+// hardcoded equivalent of linguist's vendor.yml/generated.yml: paths that
+// are vendored or machine-generated by convention, consulted only when a
+// file has no explicit linguist-vendored/linguist-generated attribute of
+// its own (an explicit "false" in .gitattributes always wins over these).
+var builtinVendoredPatterns = mustCompilePatterns([]string{
+	`(^|/)vendor/`,
+	`(^|/)node_modules/`,
+	`(^|/)third_party/`,
+	`(^|/)Godeps/`,
+	`(^|/)\.pnp\.(c?js|data\.json)$`,
+})
+
+var builtinGeneratedPatterns = mustCompilePatterns([]string{
+	`\.pb\.go$`,
+	`\.pb\.gw\.go$`,
+	`_gen\.go$`,
+	`(^|/)bindata\.go$`,
+	`\.min\.(js|css)$`,
+	`(^|/)generated/`,
+})
+
+func mustCompilePatterns(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		compiled[i] = regexp.MustCompile(p)
+	}
+	return compiled
+}
+
+// isBuiltinVendored and isBuiltinGenerated report whether relPath
+// ("/"-separated, relative to projectRoot) matches the builtin
+// vendored/generated path heuristics.
+func isBuiltinVendored(relPath string) bool {
+	return matchesAny(builtinVendoredPatterns, relPath)
+}
+
+func isBuiltinGenerated(relPath string) bool {
+	return matchesAny(builtinGeneratedPatterns, relPath)
+}
+
+func matchesAny(patterns []*regexp.Regexp, relPath string) bool {
+	relPath = filepath.ToSlash(relPath)
+	for _, re := range patterns {
+		if re.MatchString(relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// gitattributesPattern is one compiled line from a .gitattributes file: a
+// gitignore-style glob pattern plus the attribute assignments it carries.
+// Unlike .gitignore, a leading "!" in the pattern field isn't negation -
+// it only resets an attribute - so patterns are compiled directly via
+// compileGlobPattern rather than through compileGitignorePattern.
+type gitattributesPattern struct {
+	re    *regexp.Regexp
+	attrs map[string]string // attribute name -> "true", "false", or an explicit value (e.g. "Go" for linguist-language=Go); "" means reset to unspecified
+}
+
+// compileGitattributesPattern parses one .gitattributes line into its
+// pattern and attribute assignments, or returns nil for a blank line, a
+// comment, or a pattern with no attributes - nothing detection needs to
+// honor. Attribute syntax: a bare "attr" sets it true, "-attr" sets it
+// false, "!attr" resets it to unspecified, and "attr=value" assigns value
+// (e.g. the "linguist-vendored=false" form linguist itself documents).
+func compileGitattributesPattern(line string) *gitattributesPattern {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return nil
+	}
+
+	re := compileGlobPattern(fields[0])
+	if re == nil {
+		return nil
+	}
+
+	attrs := make(map[string]string, len(fields)-1)
+	for _, field := range fields[1:] {
+		switch {
+		case strings.HasPrefix(field, "-"):
+			attrs[field[1:]] = "false"
+		case strings.HasPrefix(field, "!"):
+			attrs[field[1:]] = ""
+		case strings.Contains(field, "="):
+			name, value, _ := strings.Cut(field, "=")
+			attrs[name] = value
+		default:
+			attrs[field] = "true"
+		}
+	}
+
+	return &gitattributesPattern{re: re, attrs: attrs}
+}
+
+// gitattributesMatcher resolves the attributes that apply to a path under
+// projectRoot, honoring nested .gitattributes files the same way
+// gitignoreMatcher stacks .gitignore: a deeper directory's patterns are
+// evaluated after a shallower one's, and its assignments - including a
+// "!attr" reset - override whatever an ancestor set for the same
+// attribute name.
+type gitattributesMatcher struct {
+	projectRoot   string
+	patternsByDir map[string][]*gitattributesPattern // key: dir relative to projectRoot, "/"-separated, "" for root
+
+	// mu guards patternsByDir's lazy loadDir writes - detectLanguagesWithInfo's
+	// concurrent walk (DetectOptions.Concurrency > 1) calls attributesFor
+	// from multiple goroutines sharing one matcher.
+	mu sync.Mutex
+}
+
+// newGitattributesMatcher builds a matcher for projectRoot. It eagerly
+// loads the root .gitattributes; per-directory files are loaded lazily as
+// the caller walks into them via loadDir.
+func newGitattributesMatcher(projectRoot string) *gitattributesMatcher {
+	m := &gitattributesMatcher{
+		projectRoot:   projectRoot,
+		patternsByDir: make(map[string][]*gitattributesPattern),
+	}
+	m.loadDir("")
+	return m
+}
+
+// loadDir reads relDir's .gitattributes (if any) and caches its patterns,
+// so repeated attributesFor calls for siblings under the same directory
+// don't re-read the file.
+func (m *gitattributesMatcher) loadDir(relDir string) {
+	if _, ok := m.patternsByDir[relDir]; ok {
+		return
+	}
+
+	data, err := os.ReadFile(filepath.Join(m.projectRoot, filepath.FromSlash(relDir), ".gitattributes"))
+	if err != nil {
+		m.patternsByDir[relDir] = nil
+		return
+	}
+
+	var patterns []*gitattributesPattern
+	for _, line := range strings.Split(string(data), "\n") {
+		if p := compileGitattributesPattern(line); p != nil {
+			patterns = append(patterns, p)
+		}
+	}
+	m.patternsByDir[relDir] = patterns
+}
+
+// attributesFor returns the merged attributes that apply to relPath
+// ("/"-separated, relative to projectRoot), walking every ancestor
+// directory's .gitattributes from the root down so a nested file's
+// assignments win ties with a shallower one's - including resetting an
+// attribute an ancestor set, via "!attr".
+func (m *gitattributesMatcher) attributesFor(relPath string) map[string]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	relPath = filepath.ToSlash(relPath)
+	segments := strings.Split(relPath, "/")
+
+	attrs := make(map[string]string)
+	dir := ""
+	for i := range segments {
+		m.loadDir(dir)
+
+		sub := strings.Join(segments[i:], "/")
+		for _, p := range m.patternsByDir[dir] {
+			if !p.re.MatchString(sub) {
+				continue
+			}
+			for name, value := range p.attrs {
+				if value == "" {
+					delete(attrs, name)
+					continue
+				}
+				attrs[name] = value
+			}
+		}
+
+		if dir == "" {
+			dir = segments[i]
+		} else {
+			dir = dir + "/" + segments[i]
+		}
+	}
+
+	return attrs
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitattributesMatcher_BareAndNegatedAttribute(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("vendor/* linguist-vendored\n*.js linguist-vendored=false\n"), 0o644))
+
+	m := newGitattributesMatcher(tmpDir)
+
+	assert.Equal(t, "true", m.attributesFor("vendor/lib.go")["linguist-vendored"])
+	assert.Equal(t, "false", m.attributesFor("vendor/lib.js")["linguist-vendored"], "a later pattern on the same line set overrides an earlier match")
+}
+
+func TestGitattributesMatcher_ExplicitValueAndReset(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("*.proto linguist-language=Go\n*.pb.go !linguist-generated\n"), 0o644))
+
+	m := newGitattributesMatcher(tmpDir)
+
+	assert.Equal(t, "Go", m.attributesFor("api.proto")["linguist-language"])
+	assert.NotContains(t, m.attributesFor("types.pb.go"), "linguist-generated")
+}
+
+func TestGitattributesMatcher_NestedOverridesRoot(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("*.md linguist-documentation\n"), 0o644))
+
+	sub := filepath.Join(tmpDir, "docs")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, ".gitattributes"), []byte("*.md -linguist-documentation\n"), 0o644))
+
+	m := newGitattributesMatcher(tmpDir)
+
+	assert.Equal(t, "true", m.attributesFor("README.md")["linguist-documentation"])
+	assert.Equal(t, "false", m.attributesFor("docs/guide.md")["linguist-documentation"], "docs/.gitattributes overrides the root pattern for files under it")
+}
+
+func TestCompileGitattributesPattern_BlankCommentAndNoAttrs(t *testing.T) {
+	assert.Nil(t, compileGitattributesPattern(""))
+	assert.Nil(t, compileGitattributesPattern("# comment"))
+	assert.Nil(t, compileGitattributesPattern("*.go"), "a pattern with no attributes carries nothing detection needs")
+}
+
+func TestDetectLanguages_ExcludesVendoredAndGeneratedByDefault(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte(
+		"vendor/** linguist-vendored\n"+
+			"bindata.go linguist-generated\n",
+	), 0o644))
+
+	vendorDir := filepath.Join(tmpDir, "vendor", "pkg")
+	require.NoError(t, os.MkdirAll(vendorDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(vendorDir, "lib.go"), []byte("package pkg\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "bindata.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	files, err := detector.GetFilesByLanguage(tmpDir, []string{"Go"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Go"], filepath.Join(tmpDir, "main.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(vendorDir, "lib.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(tmpDir, "bindata.go"))
+
+	withVendored, err := detector.GetFilesByLanguageWithOptions(tmpDir, []string{"Go"}, DetectOptions{IncludeVendored: true, IncludeGenerated: true})
+	require.NoError(t, err)
+	assert.Contains(t, withVendored["Go"], filepath.Join(vendorDir, "lib.go"))
+	assert.Contains(t, withVendored["Go"], filepath.Join(tmpDir, "bindata.go"))
+}
+
+func TestDetectLanguages_DocumentationAndDetectableHaveNoOptOut(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte(
+		"docs.go linguist-documentation\n"+
+			"hidden.go linguist-detectable=false\n",
+	), 0o644))
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "docs.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "hidden.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	files, err := detector.GetFilesByLanguageWithOptions(tmpDir, []string{"Go"}, DetectOptions{IncludeVendored: true, IncludeGenerated: true})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Go"], filepath.Join(tmpDir, "main.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(tmpDir, "docs.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(tmpDir, "hidden.go"))
+}
+
+func TestDetectLanguages_BuiltinVendoredFallbackAppliesWithoutGitattributes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	vendorDir := filepath.Join(tmpDir, "third_party", "lib")
+	require.NoError(t, os.MkdirAll(vendorDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(vendorDir, "lib.go"), []byte("package lib\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "types.pb.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	files, err := detector.GetFilesByLanguage(tmpDir, []string{"Go"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Go"], filepath.Join(tmpDir, "main.go"))
+	assert.NotContains(t, files["Go"], filepath.Join(vendorDir, "lib.go"), "third_party/ matches the builtin vendored fallback")
+	assert.NotContains(t, files["Go"], filepath.Join(tmpDir, "types.pb.go"), "*.pb.go matches the builtin generated fallback")
+
+	info, err := detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{IncludeVendored: true, IncludeGenerated: true})
+	require.NoError(t, err)
+
+	var goInfo *LanguageInfo
+	for _, lang := range info {
+		if lang.Name == "Go" {
+			goInfo = lang
+		}
+	}
+	require.NotNil(t, goInfo)
+	assert.True(t, goInfo.Vendored[filepath.Join(vendorDir, "lib.go")])
+	assert.True(t, goInfo.Generated[filepath.Join(tmpDir, "types.pb.go")])
+	assert.False(t, goInfo.Vendored[filepath.Join(tmpDir, "main.go")])
+}
+
+func TestDetectLanguages_ExplicitGitattributesFalseOverridesBuiltinFallback(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("vendor/** linguist-vendored=false\n"), 0o644))
+
+	vendorDir := filepath.Join(tmpDir, "vendor")
+	require.NoError(t, os.MkdirAll(vendorDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(vendorDir, "lib.go"), []byte("package lib\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	files, err := detector.GetFilesByLanguage(tmpDir, []string{"Go"})
+	require.NoError(t, err)
+
+	assert.Contains(t, files["Go"], filepath.Join(vendorDir, "lib.go"), "explicit linguist-vendored=false overrides the builtin vendor/ fallback")
+}
+
+func TestDetectLanguages_LinguistLanguageOverridesExtension(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("*.tmpl linguist-language=Go\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "view.tmpl"), []byte("package main\n"), 0o644))
+
+	detector := NewFileTypeDetector()
+	info, err := detector.DetectLanguagesWithOptions(tmpDir, DetectOptions{})
+	require.NoError(t, err)
+
+	var goInfo *LanguageInfo
+	for _, lang := range info {
+		if lang.Name == "Go" {
+			goInfo = lang
+		}
+	}
+	require.NotNil(t, goInfo, "view.tmpl should be counted as Go via the linguist-language override")
+	tmplPath := filepath.Join(tmpDir, "view.tmpl")
+	assert.Contains(t, goInfo.Files, tmplPath)
+	assert.Equal(t, "linguist-language=Go override", goInfo.Reasons[tmplPath])
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// gitignorePattern is one compiled line from a .gitignore (or exclude)
+// file.
+type gitignorePattern struct {
+	re      *regexp.Regexp
+	dirOnly bool // pattern ended in "/": only matches directories
+	negate  bool // pattern started with "!": un-ignores a previous match
+}
+
+// compileGitignorePattern compiles a single .gitignore line, or returns nil
+// for a blank line or comment. It supports the subset of the gitignore
+// syntax detection actually needs: "*", "?", "**", a leading "/" or
+// embedded "/" anchoring the pattern to its directory, a trailing "/"
+// restricting it to directories, and a leading "!" negation.
+func compileGitignorePattern(line string) *gitignorePattern {
+	line = strings.TrimRight(line, " ")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	negate := strings.HasPrefix(line, "!")
+	if negate {
+		line = line[1:]
+	}
+
+	dirOnly := strings.HasSuffix(line, "/")
+	line = strings.TrimSuffix(line, "/")
+
+	re := compileGlobPattern(line)
+	if re == nil {
+		return nil
+	}
+
+	return &gitignorePattern{re: re, dirOnly: dirOnly, negate: negate}
+}
+
+// compileGlobPattern compiles the gitignore-style glob syntax shared by
+// .gitignore and .gitattributes patterns - "*", "?", "**", and anchoring
+// via a leading or embedded "/" - into a regex matching a "/"-separated
+// relative path. Callers handle syntax specific to their own format
+// (.gitignore's trailing-"/" dir-only marker and leading-"!" negation)
+// before calling this.
+func compileGlobPattern(line string) *regexp.Regexp {
+	anchored := strings.HasPrefix(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	anchored = anchored || strings.Contains(line, "/")
+
+	escaped := regexp.QuoteMeta(line)
+	escaped = strings.ReplaceAll(escaped, `\*\*`, "\x00")
+	escaped = strings.ReplaceAll(escaped, `\*`, "[^/]*")
+	escaped = strings.ReplaceAll(escaped, `\?`, "[^/]")
+	escaped = strings.ReplaceAll(escaped, "\x00", ".*")
+
+	if anchored {
+		escaped = "^" + escaped + "$"
+	} else {
+		escaped = "(^|/)" + escaped + "$"
+	}
+
+	re, err := regexp.Compile(escaped)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+// gitignoreMatcher answers whether a path under projectRoot is excluded,
+// honoring every ancestor directory's .gitignore the same way `git status`
+// stacks them: a deeper directory's patterns are evaluated after (and can
+// override) a shallower one's, and a shared info/exclude applies repo-wide.
+type gitignoreMatcher struct {
+	projectRoot   string
+	patternsByDir map[string][]*gitignorePattern // key: dir relative to projectRoot, "/"-separated, "" for root
+
+	// mu guards patternsByDir's lazy loadDir writes - detectLanguagesWithInfo's
+	// concurrent walk (DetectOptions.Concurrency > 1) calls isIgnored from
+	// multiple goroutines sharing one matcher.
+	mu sync.Mutex
+}
+
+// newGitignoreMatcher builds a matcher for projectRoot. It eagerly loads
+// the root .gitignore plus the repository's shared info/exclude; per-
+// directory .gitignore files are loaded lazily as the caller walks into
+// them via loadDir.
+func newGitignoreMatcher(projectRoot string) *gitignoreMatcher {
+	m := &gitignoreMatcher{
+		projectRoot:   projectRoot,
+		patternsByDir: make(map[string][]*gitignorePattern),
+	}
+
+	m.loadDir("")
+	if excludePath := gitCommonExcludePath(projectRoot); excludePath != "" {
+		m.patternsByDir[""] = append(m.patternsByDir[""], loadPatternFile(excludePath)...)
+	}
+
+	return m
+}
+
+// loadDir reads relDir's .gitignore (if any) and caches its patterns, so
+// repeated isIgnored calls for siblings under the same directory don't
+// re-read the file.
+func (m *gitignoreMatcher) loadDir(relDir string) {
+	if _, ok := m.patternsByDir[relDir]; ok {
+		return
+	}
+	m.patternsByDir[relDir] = loadPatternFile(filepath.Join(m.projectRoot, filepath.FromSlash(relDir), ".gitignore"))
+}
+
+// loadPatternFile parses a .gitignore-formatted file, returning nil (not an
+// error) if it doesn't exist - absence is the common case.
+func loadPatternFile(path string) []*gitignorePattern {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var patterns []*gitignorePattern
+	for _, line := range strings.Split(string(data), "\n") {
+		if p := compileGitignorePattern(strings.TrimSpace(line)); p != nil {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// gitCommonExcludePath resolves the shared info/exclude file for
+// projectRoot's repository via `git rev-parse --git-common-dir`, which
+// follows the linked-worktree indirection so a worktree checkout honors
+// the same excludes as its main working tree instead of looking for its
+// own (nonexistent) .git/info/exclude.
+func gitCommonExcludePath(projectRoot string) string {
+	cmd := exec.Command("git", "rev-parse", "--git-common-dir")
+	cmd.Dir = projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+
+	gitDir := strings.TrimSpace(string(output))
+	if gitDir == "" {
+		return ""
+	}
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(projectRoot, gitDir)
+	}
+
+	return filepath.Join(gitDir, "info", "exclude")
+}
+
+// isIgnored reports whether relPath ("/"-separated, relative to
+// projectRoot) is excluded, checking it against every ancestor directory's
+// .gitignore from the root down - loading each lazily the first time it's
+// reached.
+func (m *gitignoreMatcher) isIgnored(relPath string, isDir bool) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	relPath = filepath.ToSlash(relPath)
+	segments := strings.Split(relPath, "/")
+
+	ignored := false
+	dir := ""
+	for i, segment := range segments {
+		m.loadDir(dir)
+
+		sub := strings.Join(segments[i:], "/")
+		subIsDir := isDir || i < len(segments)-1
+
+		for _, p := range m.patternsByDir[dir] {
+			if p.dirOnly && !subIsDir {
+				continue
+			}
+			if p.re.MatchString(sub) {
+				ignored = !p.negate
+			}
+		}
+
+		if dir == "" {
+			dir = segment
+		} else {
+			dir = dir + "/" + segment
+		}
+	}
+
+	return ignored
+}
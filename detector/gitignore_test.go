@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitignoreMatcher_RootPatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("*.log\nbuild/\n"), 0o644))
+
+	m := newGitignoreMatcher(tmpDir)
+
+	assert.True(t, m.isIgnored("app.log", false))
+	assert.True(t, m.isIgnored("build", true))
+	assert.False(t, m.isIgnored("main.go", false))
+}
+
+func TestGitignoreMatcher_AnchoredVsUnanchored(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("/only-root.txt\nanywhere.txt\n"), 0o644))
+
+	m := newGitignoreMatcher(tmpDir)
+
+	assert.True(t, m.isIgnored("only-root.txt", false))
+	assert.False(t, m.isIgnored("sub/only-root.txt", false))
+
+	assert.True(t, m.isIgnored("anywhere.txt", false))
+	assert.True(t, m.isIgnored("sub/anywhere.txt", false))
+}
+
+func TestGitignoreMatcher_Negation(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("*.log\n!keep.log\n"), 0o644))
+
+	m := newGitignoreMatcher(tmpDir)
+
+	assert.True(t, m.isIgnored("debug.log", false))
+	assert.False(t, m.isIgnored("keep.log", false))
+}
+
+func TestGitignoreMatcher_NestedDirectoryScoping(t *testing.T) {
+	tmpDir := t.TempDir()
+	sub := filepath.Join(tmpDir, "sub")
+	require.NoError(t, os.MkdirAll(sub, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, ".gitignore"), []byte("local.txt\n"), 0o644))
+
+	m := newGitignoreMatcher(tmpDir)
+
+	assert.True(t, m.isIgnored("sub/local.txt", false))
+	assert.False(t, m.isIgnored("local.txt", false), "root local.txt is outside sub/.gitignore's scope")
+}
+
+func TestGitignoreMatcher_HonorsSharedWorktreeExclude(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cmd := exec.Command("git", "init")
+	cmd.Dir = tmpDir
+	require.NoError(t, cmd.Run())
+
+	excludePath := filepath.Join(tmpDir, ".git", "info", "exclude")
+	existing, err := os.ReadFile(excludePath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(excludePath, append(existing, []byte("\nlocal-only.txt\n")...), 0o644))
+
+	m := newGitignoreMatcher(tmpDir)
+
+	assert.True(t, m.isIgnored("local-only.txt", false))
+}
+
+func TestCompileGitignorePattern_BlankAndComment(t *testing.T) {
+	assert.Nil(t, compileGitignorePattern(""))
+	assert.Nil(t, compileGitignorePattern("# a comment"))
+}
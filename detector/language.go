@@ -5,9 +5,12 @@
 package detector
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
@@ -20,12 +23,80 @@ type LanguageInfo struct {
 	Indicators []string          // Project indicators (e.g., ["go.mod", "main.go"])
 	Confidence float64           // Detection confidence (0.0 - 1.0)
 	Metadata   map[string]string // Additional metadata
+	Reasons    map[string]string // File path -> why it was classified under this language (e.g. "linguist-language override", "extension-based detection")
+
+	// Vendored and Generated flag, by file path, files included only
+	// because DetectOptions.IncludeVendored/IncludeGenerated was set -
+	// absent (not false) for a file that isn't vendored/generated at
+	// all, so callers can report e.g. "3 issues in your code, 147
+	// suppressed in vendored" instead of treating every file the same.
+	Vendored  map[string]bool
+	Generated map[string]bool
+
+	// SkippedByBuildTags is every file this language's detection saw but
+	// left out of Files because it doesn't satisfy the active
+	// GoBuildContext, mapped to why: a .go file's //go:build line or
+	// "_windows.go" filename suffix, or any language's own
+	// "//gzquality:build ..." header comment.
+	SkippedByBuildTags map[string]string
+}
+
+// DetectOptions controls which .gitattributes-driven exclusions
+// detectLanguagesWithInfo applies. By default, files a .gitattributes
+// marks linguist-vendored or linguist-generated are left out of
+// detection results the same way linguist itself hides them; set the
+// matching field to see them anyway. linguist-documentation and an
+// explicit linguist-detectable=false have no opt-out - they mirror
+// linguist's own "never counts towards language stats" behavior.
+type DetectOptions struct {
+	IncludeVendored  bool // include files a .gitattributes marks linguist-vendored
+	IncludeGenerated bool // include files a .gitattributes marks linguist-generated
+
+	// GoBuildContext overrides the detector's own GoBuildContext for this
+	// call, e.g. to ask which files would build for a cross-compilation
+	// target rather than the host. nil uses the detector's default.
+	GoBuildContext *GoBuildContext
+
+	// Concurrency is the number of workers detectLanguagesWithInfo's
+	// directory walk uses, each one independently walking one of
+	// projectRoot's immediate entries and accumulating its own
+	// LanguageInfo map before the results are merged. <= 1 (the default)
+	// walks projectRoot with a single goroutine, the same as before this
+	// field existed - set it on a large repo (tens of thousands of files)
+	// to spread the walk's stat/read/classify cost across cores.
+	Concurrency int
 }
 
 // FileTypeDetector implements language detection based on file types and project indicators.
 type FileTypeDetector struct {
 	// Map of language name to detection rules
 	rules map[string]*LanguageRule
+
+	// classifierRules backs DetectLanguage's ambiguous-extension,
+	// shebang, and modeline stages. nil means "use defaultClassifierRules",
+	// the bundled languages.yml - see LoadRules.
+	classifierRules *classifierRules
+
+	// GoBuildContext is the target a .go file's build constraints are
+	// checked against - defaults to the host (DefaultGoBuildContext).
+	// detectLanguagesWithInfo uses it to decide which .go files belong in
+	// a Go LanguageInfo's Files vs its SkippedByBuildTags; pass a
+	// different GoBuildContext to GetFilesByLanguageForContext to ask
+	// about a cross-compilation target instead of the host.
+	GoBuildContext GoBuildContext
+
+	// ContentClassification turns DetectLanguage's naive-Bayes tokenizer
+	// fallback on or off, for a file that extension/shebang/modeline
+	// lookup all fail to place. Defaults to true (see
+	// NewFileTypeDetector) - matching DetectLanguage's behavior before
+	// this field existed - so only a config.Config.Detection block that
+	// explicitly disables it loses the fallback.
+	ContentClassification bool
+
+	// MaxBytesScanned bounds how many leading bytes of a file
+	// DetectLanguage's shebang/modeline/classifier stages peek at via
+	// peekOrRead. <= 0 means classifyPeekSize.
+	MaxBytesScanned int
 }
 
 // LanguageRule defines how to detect a specific language.
@@ -35,6 +106,7 @@ type LanguageRule struct {
 	Indicators []string          // Project files that indicate this language
 	Keywords   []string          // Keywords to look for in files
 	Patterns   []string          // File name patterns
+	Filenames  []string          // Exact, case-insensitive filename matches (Makefile, Dockerfile, Rakefile, ...) for files with no useful extension
 	MinFiles   int               // Minimum files needed for detection
 	Weight     float64           // Base weight for confidence calculation
 	Metadata   map[string]string // Additional metadata
@@ -43,7 +115,9 @@ type LanguageRule struct {
 // NewFileTypeDetector creates a new language detector with default rules.
 func NewFileTypeDetector() *FileTypeDetector {
 	detector := &FileTypeDetector{
-		rules: make(map[string]*LanguageRule),
+		rules:                 make(map[string]*LanguageRule),
+		GoBuildContext:        DefaultGoBuildContext(),
+		ContentClassification: true,
 	}
 
 	// Register default language detection rules
@@ -53,7 +127,7 @@ func NewFileTypeDetector() *FileTypeDetector {
 
 // DetectLanguages scans a directory and returns detected languages.
 func (d *FileTypeDetector) DetectLanguages(projectRoot string) ([]string, error) {
-	languages, err := d.detectLanguagesWithInfo(projectRoot)
+	languages, err := d.detectLanguagesWithInfo(projectRoot, DetectOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -68,55 +142,226 @@ func (d *FileTypeDetector) DetectLanguages(projectRoot string) ([]string, error)
 }
 
 // DetectLanguagesWithInfo returns detailed language detection information.
-func (d *FileTypeDetector) detectLanguagesWithInfo(projectRoot string) ([]*LanguageInfo, error) {
+func (d *FileTypeDetector) detectLanguagesWithInfo(projectRoot string, opts DetectOptions) ([]*LanguageInfo, error) {
+	gitignore := newGitignoreMatcher(projectRoot)
+	gitattributes := newGitattributesMatcher(projectRoot)
+
+	buildCtx := d.GoBuildContext
+	if opts.GoBuildContext != nil {
+		buildCtx = *opts.GoBuildContext
+	}
+
+	var detected map[string]*LanguageInfo
+	var err error
+
+	entries, readErr := os.ReadDir(projectRoot)
+	if opts.Concurrency > 1 && readErr == nil && len(entries) > 0 {
+		detected, err = d.walkForLanguagesConcurrently(projectRoot, entries, opts, gitignore, gitattributes, buildCtx)
+	} else {
+		detected, err = d.walkForLanguages(projectRoot, projectRoot, opts, gitignore, gitattributes, buildCtx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate confidence scores and filter out low-confidence detections
+	result := make([]*LanguageInfo, 0, len(detected))
+	for _, lang := range detected {
+		lang.Confidence = d.calculateConfidence(lang)
+		if lang.Confidence > 0.1 { // Only include languages with reasonable confidence
+			result = append(result, lang)
+		}
+	}
+
+	return result, nil
+}
+
+// walkForLanguagesConcurrently partitions projectRoot's immediate entries
+// across a bounded pool of opts.Concurrency workers, each independently
+// walking one entry's subtree via walkForLanguages and accumulating its
+// own LanguageInfo map, then merges every worker's map into one. gitignore
+// and gitattributes are shared across workers (both are safe for
+// concurrent use - see their mu fields) so a nested .gitignore/
+// .gitattributes is only ever read once regardless of which worker
+// reaches it first.
+func (d *FileTypeDetector) walkForLanguagesConcurrently(projectRoot string, entries []os.DirEntry, opts DetectOptions, gitignore *gitignoreMatcher, gitattributes *gitattributesMatcher, buildCtx GoBuildContext) (map[string]*LanguageInfo, error) {
+	workers := opts.Concurrency
+	if workers > len(entries) {
+		workers = len(entries)
+	}
+
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	merged := make(map[string]*LanguageInfo)
+	var firstErr error
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(projectRoot, entry.Name())
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(entryPath string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			partial, err := d.walkForLanguages(projectRoot, entryPath, opts, gitignore, gitattributes, buildCtx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			mergeLanguageInfos(merged, partial)
+		}(entryPath)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	for _, lang := range merged {
+		sort.Strings(lang.Files)
+		sort.Strings(lang.Indicators)
+	}
+
+	return merged, nil
+}
+
+// mergeLanguageInfos folds each entry of src into dst, concatenating Files/
+// Indicators and merging Reasons/SkippedByBuildTags/Vendored/Generated, so the same language
+// detected by two different workers in walkForLanguagesConcurrently ends up
+// as one LanguageInfo instead of being overwritten by whichever worker
+// finishes last.
+func mergeLanguageInfos(dst, src map[string]*LanguageInfo) {
+	for name, info := range src {
+		existing, ok := dst[name]
+		if !ok {
+			dst[name] = info
+			continue
+		}
+
+		existing.Files = append(existing.Files, info.Files...)
+		existing.Indicators = append(existing.Indicators, info.Indicators...)
+		for path, reason := range info.Reasons {
+			existing.Reasons[path] = reason
+		}
+		for path, reason := range info.SkippedByBuildTags {
+			existing.SkippedByBuildTags[path] = reason
+		}
+		for path := range info.Vendored {
+			existing.Vendored[path] = true
+		}
+		for path := range info.Generated {
+			existing.Generated[path] = true
+		}
+	}
+}
+
+// walkForLanguages walks root (either projectRoot itself, for a single-
+// goroutine scan, or one of its immediate entries, for a
+// walkForLanguagesConcurrently worker) and returns the LanguageInfo map it
+// accumulates. relPaths are always computed against projectRoot, so a
+// worker walking a subtree still produces the same paths a full
+// single-goroutine walk would.
+func (d *FileTypeDetector) walkForLanguages(projectRoot, root string, opts DetectOptions, gitignore *gitignoreMatcher, gitattributes *gitattributesMatcher, buildCtx GoBuildContext) (map[string]*LanguageInfo, error) {
 	detected := make(map[string]*LanguageInfo)
 
-	err := filepath.Walk(projectRoot, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			// Skip files/directories we can't access (permissions, etc.)
 			return filepath.SkipDir
 		}
 
+		relPath, relErr := filepath.Rel(projectRoot, path)
+		if relErr != nil {
+			relPath = path
+		}
+
 		// Skip directories and hidden files/directories
 		if info.IsDir() {
 			if strings.HasPrefix(info.Name(), ".") && info.Name() != "." {
 				return filepath.SkipDir
 			}
+			if relPath != "." && gitignore.isIgnored(relPath, true) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 
-		// Skip hidden files and common ignore patterns
-		if d.shouldIgnoreFile(path, info.Name()) {
+		// Skip hidden files, common ignore patterns, and anything excluded
+		// by a .gitignore/info-exclude applicable to this path.
+		if d.shouldIgnoreFile(path, info.Name()) || gitignore.isIgnored(relPath, false) {
 			return nil
 		}
 
-		// Check each language rule
-		for _, rule := range d.rules {
-			if d.matchesRule(path, info.Name(), rule) {
-				if detected[rule.Name] == nil {
-					detected[rule.Name] = &LanguageInfo{
-						Name:       rule.Name,
-						Extensions: rule.Extensions,
-						Files:      make([]string, 0),
-						Indicators: make([]string, 0),
-						Confidence: 0.0,
-						Metadata:   make(map[string]string),
-					}
-					// Copy metadata
-					for k, v := range rule.Metadata {
-						detected[rule.Name].Metadata[k] = v
-					}
-				}
+		lang, reason, vendored, generated, skip := d.classifyWithAttributes(path, relPath, gitattributes.attributesFor(relPath), opts)
+		if skip || lang == "" {
+			return nil
+		}
 
-				detected[rule.Name].Files = append(detected[rule.Name].Files, path)
+		rule, ok := d.rules[lang]
+		if !ok {
+			return nil
+		}
 
-				// Check if this is a project indicator
-				for _, indicator := range rule.Indicators {
-					if strings.HasSuffix(strings.ToLower(info.Name()), strings.ToLower(indicator)) {
-						detected[rule.Name].Indicators = append(detected[rule.Name].Indicators, path)
-						break
-					}
-				}
+		if detected[lang] == nil {
+			detected[lang] = &LanguageInfo{
+				Name:               rule.Name,
+				Extensions:         rule.Extensions,
+				Files:              make([]string, 0),
+				Indicators:         make([]string, 0),
+				Confidence:         0.0,
+				Metadata:           make(map[string]string),
+				Reasons:            make(map[string]string),
+				Vendored:           make(map[string]bool),
+				Generated:          make(map[string]bool),
+				SkippedByBuildTags: make(map[string]string),
+			}
+			// Copy metadata
+			for k, v := range rule.Metadata {
+				detected[lang].Metadata[k] = v
+			}
+		}
+
+		// A .go file's build constraints (filename suffix or //go:build
+		// comment) gate it out of Files/Indicators independently of the
+		// extension-based classification above - a language rule match
+		// only says "this is Go source", not "this builds for ctx".
+		if lang == "Go" && strings.EqualFold(filepath.Ext(path), ".go") {
+			if satisfies, skipReason := satisfiesGoBuildContext(path, nil, buildCtx); !satisfies {
+				detected[lang].SkippedByBuildTags[path] = skipReason
+				return nil
+			}
+		}
+
+		// A "//gzquality:build ..." header comment gates any file out of
+		// Files regardless of language, for a cross-platform repo with a
+		// Windows-only PowerShell script or a Linux-only shell script that
+		// would only produce noise from tools run on the wrong host.
+		if satisfies, skipReason := satisfiesGzQualityBuildTag(path, nil, buildCtx); !satisfies {
+			detected[lang].SkippedByBuildTags[path] = skipReason
+			return nil
+		}
+
+		detected[lang].Files = append(detected[lang].Files, path)
+		detected[lang].Reasons[path] = reason
+		if vendored {
+			detected[lang].Vendored[path] = true
+		}
+		if generated {
+			detected[lang].Generated[path] = true
+		}
+
+		// Check if this is a project indicator
+		for _, indicator := range rule.Indicators {
+			if strings.HasSuffix(strings.ToLower(info.Name()), strings.ToLower(indicator)) {
+				detected[lang].Indicators = append(detected[lang].Indicators, path)
+				break
 			}
 		}
 
@@ -126,21 +371,37 @@ func (d *FileTypeDetector) detectLanguagesWithInfo(projectRoot string) ([]*Langu
 		return nil, err
 	}
 
-	// Calculate confidence scores and filter out low-confidence detections
-	result := make([]*LanguageInfo, 0, len(detected))
-	for _, lang := range detected {
-		lang.Confidence = d.calculateConfidence(lang)
-		if lang.Confidence > 0.1 { // Only include languages with reasonable confidence
-			result = append(result, lang)
-		}
-	}
+	return detected, nil
+}
 
-	return result, nil
+// DetectLanguagesWithOptions is DetectLanguages' option-aware counterpart:
+// it returns the full per-language LanguageInfo (Files, Indicators,
+// Confidence, and Reasons) rather than just names, and opts controls
+// whether vendored/generated files a .gitattributes marks are included.
+func (d *FileTypeDetector) DetectLanguagesWithOptions(projectRoot string, opts DetectOptions) ([]*LanguageInfo, error) {
+	return d.detectLanguagesWithInfo(projectRoot, opts)
 }
 
 // GetFilesByLanguage returns files grouped by language.
 func (d *FileTypeDetector) GetFilesByLanguage(projectRoot string, languages []string) (map[string][]string, error) {
-	detected, err := d.detectLanguagesWithInfo(projectRoot)
+	return d.GetFilesByLanguageWithOptions(projectRoot, languages, DetectOptions{})
+}
+
+// GetFilesByLanguageForContext is GetFilesByLanguage scoped to ctx: Go
+// files whose build constraints ctx doesn't satisfy (a //go:build comment,
+// or a "_GOOS"/"_GOARCH" filename suffix) are left out, so e.g. a
+// cross-platform monorepo's linters can ask for "just the Go files that
+// would actually build for linux/arm64" instead of every .go file in the
+// tree. Files of any language are also checked against ctx through a
+// "//gzquality:build ..." header comment; a file without one is kept.
+func (d *FileTypeDetector) GetFilesByLanguageForContext(projectRoot string, ctx GoBuildContext) (map[string][]string, error) {
+	return d.GetFilesByLanguageWithOptions(projectRoot, nil, DetectOptions{GoBuildContext: &ctx})
+}
+
+// GetFilesByLanguageWithOptions is GetFilesByLanguage's option-aware
+// counterpart - see DetectOptions for what each field opts back into.
+func (d *FileTypeDetector) GetFilesByLanguageWithOptions(projectRoot string, languages []string, opts DetectOptions) (map[string][]string, error) {
+	detected, err := d.detectLanguagesWithInfo(projectRoot, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -160,8 +421,54 @@ func (d *FileTypeDetector) GetFilesByLanguage(projectRoot string, languages []st
 	return result, nil
 }
 
+// LanguageForFile returns the language DetectLanguage classifies path
+// as, or "" if nothing matches. Unlike DetectLanguages, this doesn't
+// require scanning the project - it's meant for single-file lookups
+// (e.g. an editor "on save" handler or the LSP server), and unlike
+// DetectLanguages it isn't limited to languages with a registered
+// LanguageRule.
+func (d *FileTypeDetector) LanguageForFile(path string) string {
+	if lang, _ := d.DetectLanguage(path, nil); lang != "" {
+		return lang
+	}
+
+	// DetectLanguage's extension lookup is itself an Extensions check
+	// against d.rules, so the only thing left for a plain rule-match
+	// loop to add is a Patterns hit - kept for parity with
+	// DetectLanguages' previous behavior before this existed.
+	filename := filepath.Base(path)
+	for _, rule := range d.rules {
+		if d.matchesRule(path, filename, rule) {
+			return rule.Name
+		}
+	}
+
+	return ""
+}
+
+// LanguageNames returns the names of every registered language rule, e.g.
+// for validating that a config file only references languages this
+// binary actually knows how to detect.
+func (d *FileTypeDetector) LanguageNames() []string {
+	names := make([]string, 0, len(d.rules))
+	for name := range d.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // matchesRule checks if a file matches a language rule.
 func (d *FileTypeDetector) matchesRule(_, filename string, rule *LanguageRule) bool {
+	// Check exact filename matches first (Makefile, Dockerfile, ...) -
+	// these carry no useful extension, so they must win before the
+	// extension check below ever gets a chance to reject them.
+	for _, ruleFilename := range rule.Filenames {
+		if strings.EqualFold(filename, ruleFilename) {
+			return true
+		}
+	}
+
 	// Check file extensions
 	ext := strings.ToLower(filepath.Ext(filename))
 	for _, ruleExt := range rule.Extensions {
@@ -181,11 +488,61 @@ func (d *FileTypeDetector) matchesRule(_, filename string, rule *LanguageRule) b
 	return false
 }
 
+// classifyWithAttributes classifies path the way detectLanguagesWithInfo's
+// walk wants it, folding in attrs (path's merged .gitattributes
+// assignments, from gitattributesMatcher.attributesFor) on top of the
+// plain DetectLanguage result: linguist-language overrides extension-
+// based classification outright, and linguist-vendored/-generated/
+// -documentation/-detectable decide whether the file should be skipped
+// per opts. An explicit .gitattributes value always wins; absent one, a
+// file still counts as vendored/generated if relPath matches the
+// builtin isBuiltinVendored/isBuiltinGenerated heuristics (vendor/,
+// node_modules/, *.pb.go, *_gen.go, bindata.go, ...), the same fallback
+// linguist itself applies via its bundled vendor.yml/generated.yml. It
+// returns the classified language (possibly "" if skipped or
+// unclassifiable), a human-readable reason for LanguageInfo.Reasons,
+// whether the file is vendored/generated (regardless of whether it was
+// actually included), and whether the file should be skipped entirely.
+func (d *FileTypeDetector) classifyWithAttributes(path, relPath string, attrs map[string]string, opts DetectOptions) (lang, reason string, vendored, generated, skip bool) {
+	vendored = attrs["linguist-vendored"] == "true" || (attrs["linguist-vendored"] != "false" && isBuiltinVendored(relPath))
+	generated = attrs["linguist-generated"] == "true" || (attrs["linguist-generated"] != "false" && isBuiltinGenerated(relPath))
+
+	if detectable, ok := attrs["linguist-detectable"]; ok {
+		if detectable == "false" {
+			return "", "", vendored, generated, true
+		}
+		// detectable == "true" forces inclusion even if otherwise
+		// vendored/generated/documentation, matching linguist's own
+		// override semantics.
+	} else {
+		switch {
+		case attrs["linguist-documentation"] == "true":
+			return "", "", vendored, generated, true
+		case vendored && !opts.IncludeVendored:
+			return "", "", vendored, generated, true
+		case generated && !opts.IncludeGenerated:
+			return "", "", vendored, generated, true
+		}
+	}
+
+	if override := attrs["linguist-language"]; override != "" {
+		return override, fmt.Sprintf("linguist-language=%s override", override), vendored, generated, false
+	}
+
+	lang, _ = d.DetectLanguage(path, nil)
+	return lang, "extension-based detection", vendored, generated, false
+}
+
 // shouldIgnoreFile determines if a file should be ignored during detection.
+// node_modules/ and vendor/ are deliberately NOT in here even though
+// they're the most common case - those go through
+// classifyWithAttributes' vendored/generated handling instead, so
+// DetectOptions.IncludeVendored can still opt back into them. Everything
+// below is VCS/tooling noise that's never useful to classify at all.
 func (d *FileTypeDetector) shouldIgnoreFile(path, filename string) bool {
 	// Common ignore patterns
 	ignorePatterns := []string{
-		"node_modules/", "vendor/", ".git/", ".svn/", ".hg/",
+		".git/", ".svn/", ".hg/",
 		"__pycache__/", ".pytest_cache/", ".mypy_cache/",
 		"target/", "dist/", "build/", ".next/", ".nuxt/",
 		".vscode/", ".idea/", "*.tmp", "*.temp", "*.log",
@@ -200,14 +557,32 @@ func (d *FileTypeDetector) shouldIgnoreFile(path, filename string) bool {
 		}
 	}
 
-	// Skip hidden files
+	// Skip hidden files, unless some rule's Filenames explicitly claims
+	// this exact dotfile (e.g. .bashrc, .profile) - those are shell
+	// config, not the kind of hidden cruft this check exists to hide.
 	if strings.HasPrefix(filename, ".") && filename != "." {
-		return true
+		return d.languageForFilename(filename) == ""
 	}
 
 	return false
 }
 
+// languageForFilename returns the rule name whose Filenames contains an
+// entry matching filename (case-insensitively), or "" if none do. Used
+// both to classify exact-filename languages like Makefile/Dockerfile
+// that have no useful extension, and to let shouldIgnoreFile make an
+// exception for dotfiles like .bashrc that a rule explicitly claims.
+func (d *FileTypeDetector) languageForFilename(filename string) string {
+	for _, rule := range d.rules {
+		for _, ruleFilename := range rule.Filenames {
+			if strings.EqualFold(filename, ruleFilename) {
+				return rule.Name
+			}
+		}
+	}
+	return ""
+}
+
 // calculateConfidence calculates the confidence score for a detected language.
 func (d *FileTypeDetector) calculateConfidence(lang *LanguageInfo) float64 {
 	rule := d.rules[lang.Name]
@@ -340,12 +715,73 @@ func (d *FileTypeDetector) registerDefaultRules() {
 			Extensions: []string{".sh", ".bash", ".zsh", ".fish"},
 			Indicators: []string{},
 			Patterns:   []string{"install.sh", "build.sh", "deploy.sh"},
+			Filenames:  []string{".bashrc", ".bash_profile", ".zshrc", ".profile"},
 			MinFiles:   1,
 			Weight:     0.4,
 			Metadata: map[string]string{
 				"interpreter": "bash",
 			},
 		},
+		{
+			Name:       "Dockerfile",
+			Extensions: []string{},
+			Indicators: []string{"Dockerfile", "docker-compose.yml"},
+			Patterns:   []string{},
+			Filenames:  []string{"Dockerfile"},
+			MinFiles:   1,
+			Weight:     0.7,
+			Metadata: map[string]string{
+				"build_tool": "docker",
+			},
+		},
+		{
+			Name:       "Make",
+			Extensions: []string{".mk"},
+			Indicators: []string{"Makefile"},
+			Patterns:   []string{},
+			Filenames:  []string{"Makefile", "GNUmakefile"},
+			MinFiles:   1,
+			Weight:     0.5,
+			Metadata: map[string]string{
+				"build_tool": "make",
+			},
+		},
+		{
+			Name:       "CMake",
+			Extensions: []string{".cmake"},
+			Indicators: []string{"CMakeLists.txt"},
+			Patterns:   []string{},
+			Filenames:  []string{"CMakeLists.txt"},
+			MinFiles:   1,
+			Weight:     0.5,
+			Metadata: map[string]string{
+				"build_tool": "cmake",
+			},
+		},
+		{
+			Name:       "Ruby",
+			Extensions: []string{".rb"},
+			Indicators: []string{"Gemfile", "Gemfile.lock"},
+			Patterns:   []string{},
+			Filenames:  []string{"Rakefile", "Gemfile", "Vagrantfile"},
+			MinFiles:   1,
+			Weight:     0.6,
+			Metadata: map[string]string{
+				"package_manager": "bundler",
+			},
+		},
+		{
+			Name:       "Bazel",
+			Extensions: []string{".bzl"},
+			Indicators: []string{"WORKSPACE", "BUILD.bazel"},
+			Patterns:   []string{},
+			Filenames:  []string{"BUILD", "WORKSPACE", "BUILD.bazel", "WORKSPACE.bazel"},
+			MinFiles:   1,
+			Weight:     0.5,
+			Metadata: map[string]string{
+				"build_tool": "bazel",
+			},
+		},
 		{
 			Name:       "YAML",
 			Extensions: []string{".yml", ".yaml"},
@@ -379,6 +815,18 @@ func (d *FileTypeDetector) registerDefaultRules() {
 				"doc_format": "markdown",
 			},
 		},
+		{
+			Name:       "Julia",
+			Extensions: []string{".jl"},
+			Indicators: []string{"Project.toml", "Manifest.toml"},
+			Patterns:   []string{"runtests.jl"},
+			MinFiles:   1,
+			Weight:     0.8,
+			Metadata: map[string]string{
+				"package_manager": "Pkg",
+				"build_tool":      "julia",
+			},
+		},
 	}
 
 	for _, rule := range rules {
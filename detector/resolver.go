@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// Resolver finds a way to invoke a tool inside a project's own build
+// environment, for tools a project vendors rather than relying on a
+// system-wide install - a Gradle/Maven wrapper plugin, a node_modules/
+// .bin shim, a Python virtualenv, or a pipx-managed venv.
+type Resolver interface {
+	// Resolve returns how to invoke toolName inside projectRoot, or
+	// ok=false if this resolver doesn't apply.
+	Resolve(projectRoot, toolName string) (location *tools.ToolLocation, ok bool)
+}
+
+// defaultResolvers is the resolver chain every SystemToolDetector uses,
+// tried in order until one applies.
+func defaultResolvers() []Resolver {
+	return []Resolver{
+		GradleResolver{},
+		MavenResolver{},
+		NodeModulesResolver{},
+		PythonVenvResolver{},
+		PipxResolver{},
+	}
+}
+
+// gradlePlugins maps a tool name to the Gradle plugin marker expected in
+// build.gradle(.kts) and the wrapper task that runs it.
+var gradlePlugins = map[string]struct{ marker, task string }{
+	"ktlint": {marker: "ktlint", task: "ktlintCheck"},
+	"detekt": {marker: "detekt", task: "detekt"},
+}
+
+// GradleResolver finds Kotlin lint tools wired up as Gradle plugins,
+// invoked through the project's own wrapper script rather than a
+// system-wide install.
+type GradleResolver struct{}
+
+// Resolve implements Resolver.
+func (GradleResolver) Resolve(projectRoot, toolName string) (*tools.ToolLocation, bool) {
+	plugin, known := gradlePlugins[toolName]
+	if !known {
+		return nil, false
+	}
+
+	if _, err := os.Stat(filepath.Join(projectRoot, "gradlew")); err != nil {
+		return nil, false
+	}
+
+	if !buildFileContains(projectRoot, plugin.marker, "build.gradle", "build.gradle.kts") {
+		return nil, false
+	}
+
+	return &tools.ToolLocation{Command: "./gradlew", PrefixArgs: []string{plugin.task}}, true
+}
+
+// mavenPlugins maps a tool name to the Maven plugin marker expected in
+// pom.xml and the wrapper goal that runs it.
+var mavenPlugins = map[string]struct{ marker, goal string }{
+	"spotless": {marker: "spotless-maven-plugin", goal: "spotless:check"},
+}
+
+// MavenResolver finds tools wired up as Maven plugins, invoked through
+// the project's own wrapper script.
+type MavenResolver struct{}
+
+// Resolve implements Resolver.
+func (MavenResolver) Resolve(projectRoot, toolName string) (*tools.ToolLocation, bool) {
+	plugin, known := mavenPlugins[toolName]
+	if !known {
+		return nil, false
+	}
+
+	if _, err := os.Stat(filepath.Join(projectRoot, "mvnw")); err != nil {
+		return nil, false
+	}
+
+	if !buildFileContains(projectRoot, plugin.marker, "pom.xml") {
+		return nil, false
+	}
+
+	return &tools.ToolLocation{Command: "./mvnw", PrefixArgs: []string{plugin.goal}}, true
+}
+
+// NodeModulesResolver finds a tool installed as a project-local npm/pnpm/
+// yarn dependency under node_modules/.bin.
+type NodeModulesResolver struct{}
+
+// Resolve implements Resolver.
+func (NodeModulesResolver) Resolve(projectRoot, toolName string) (*tools.ToolLocation, bool) {
+	bin := filepath.Join(projectRoot, "node_modules", ".bin", toolName)
+	if _, err := os.Stat(bin); err != nil {
+		return nil, false
+	}
+	return &tools.ToolLocation{Command: bin}, true
+}
+
+// PythonVenvResolver finds a tool installed into a project's own .venv.
+type PythonVenvResolver struct{}
+
+// Resolve implements Resolver.
+func (PythonVenvResolver) Resolve(projectRoot, toolName string) (*tools.ToolLocation, bool) {
+	bin := filepath.Join(projectRoot, ".venv", "bin", toolName)
+	if _, err := os.Stat(bin); err != nil {
+		return nil, false
+	}
+	return &tools.ToolLocation{Command: bin}, true
+}
+
+// PipxResolver finds a tool installed into its own pipx-managed venv,
+// e.g. ~/.local/pipx/venvs/<tool>/bin/<tool>.
+type PipxResolver struct{}
+
+// Resolve implements Resolver.
+func (PipxResolver) Resolve(_, toolName string) (*tools.ToolLocation, bool) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, false
+	}
+
+	bin := filepath.Join(home, ".local", "pipx", "venvs", toolName, "bin", toolName)
+	if _, err := os.Stat(bin); err != nil {
+		return nil, false
+	}
+	return &tools.ToolLocation{Command: bin}, true
+}
+
+// buildFileContains reports whether any of names (resolved relative to
+// projectRoot) exists and contains marker.
+func buildFileContains(projectRoot, marker string, names ...string) bool {
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(projectRoot, name))
+		if err != nil {
+			continue
+		}
+		if strings.Contains(string(data), marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,10 +4,15 @@
 package detector
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
@@ -16,12 +21,23 @@ import (
 type SystemToolDetector struct {
 	// pathCache caches tool availability results
 	pathCache map[string]bool
+
+	// resolvers finds tools resolvable inside a project's own build
+	// environment (a Gradle/Maven wrapper, node_modules/.bin, a venv)
+	// when a tool isn't on PATH.
+	resolvers []Resolver
+
+	// locationCache caches ResolveLocation results per projectRoot+tool,
+	// since resolvers do filesystem/config-file reads.
+	locationCache map[string]*tools.ToolLocation
 }
 
 // NewSystemToolDetector creates a new system tool detector.
 func NewSystemToolDetector() *SystemToolDetector {
 	return &SystemToolDetector{
-		pathCache: make(map[string]bool),
+		pathCache:     make(map[string]bool),
+		resolvers:     defaultResolvers(),
+		locationCache: make(map[string]*tools.ToolLocation),
 	}
 }
 
@@ -71,6 +87,39 @@ func (d *SystemToolDetector) checkCommonLocations(toolName string) bool {
 	return false
 }
 
+// ResolveLocation finds how to invoke toolName inside projectRoot's own
+// build environment (a Gradle/Maven wrapper, node_modules/.bin, a venv,
+// or pipx), trying each resolver in order and caching the result. It
+// returns ok=false if no resolver applies, meaning the tool must come
+// from PATH instead.
+func (d *SystemToolDetector) ResolveLocation(projectRoot, toolName string) (*tools.ToolLocation, bool) {
+	key := projectRoot + "\x00" + toolName
+	if cached, ok := d.locationCache[key]; ok {
+		return cached, cached != nil
+	}
+
+	for _, resolver := range d.resolvers {
+		if location, ok := resolver.Resolve(projectRoot, toolName); ok {
+			d.locationCache[key] = location
+			return location, true
+		}
+	}
+
+	d.locationCache[key] = nil
+	return nil, false
+}
+
+// IsAvailableInProject reports whether toolName is usable for
+// projectRoot, either on $PATH/common locations or through one of the
+// detector's project-local resolvers.
+func (d *SystemToolDetector) IsAvailableInProject(projectRoot, toolName string) bool {
+	if d.IsToolAvailable(toolName) {
+		return true
+	}
+	_, ok := d.ResolveLocation(projectRoot, toolName)
+	return ok
+}
+
 // GetToolVersion returns the version of a tool if available.
 func (d *SystemToolDetector) GetToolVersion(toolName string) string {
 	if !d.IsToolAvailable(toolName) {
@@ -144,6 +193,11 @@ type ProjectAnalyzer struct {
 	langDetector   *FileTypeDetector
 	toolDetector   *SystemToolDetector
 	configDetector *ConfigFileDetector
+
+	// MaxWorkers bounds how many tool probes (PATH lookup, wrapper
+	// resolution, version check) run concurrently per language. Defaults
+	// to GOMAXPROCS so a slow probe for one tool can't stall the rest.
+	MaxWorkers int
 }
 
 // NewProjectAnalyzer creates a new project analyzer.
@@ -152,6 +206,7 @@ func NewProjectAnalyzer() *ProjectAnalyzer {
 		langDetector:   NewFileTypeDetector(),
 		toolDetector:   NewSystemToolDetector(),
 		configDetector: NewConfigFileDetector(),
+		MaxWorkers:     runtime.GOMAXPROCS(0),
 	}
 }
 
@@ -172,18 +227,42 @@ type AnalysisResult struct {
 	// ConfigFiles maps tool names to their configuration files
 	ConfigFiles map[string]string
 
+	// ToolLocations maps the name of a tool resolved through a project
+	// build wrapper (Gradle/Maven/npm/venv/pipx) rather than found on
+	// PATH to how to invoke it. Tools not present here should be run as
+	// plain system executables.
+	ToolLocations map[string]*tools.ToolLocation
+
+	// Timings records how long each tool's availability/version probe
+	// took, keyed by tool name. Useful for spotting a slow detector
+	// (e.g. a wrapper invocation) dragging out analysis.
+	Timings map[string]time.Duration
+
 	// Issues contains any problems detected during analysis
 	Issues []string
 }
 
+// toolProbe is the outcome of probing a single tool's availability,
+// location, and version constraint, computed off the main goroutine so
+// AnalyzeProject can probe every tool for a language concurrently.
+type toolProbe struct {
+	tool      tools.QualityTool
+	available bool
+	location  *tools.ToolLocation
+	issue     string
+	duration  time.Duration
+}
+
 // AnalyzeProject performs comprehensive project analysis.
-func (a *ProjectAnalyzer) AnalyzeProject(projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error) {
+func (a *ProjectAnalyzer) AnalyzeProject(ctx context.Context, projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error) {
 	result := &AnalysisResult{
 		ProjectRoot:      projectRoot,
 		Languages:        make(map[string][]string),
 		AvailableTools:   make([]string, 0),
 		RecommendedTools: make(map[string][]string),
 		ConfigFiles:      make(map[string]string),
+		ToolLocations:    make(map[string]*tools.ToolLocation),
+		Timings:          make(map[string]time.Duration),
 		Issues:           make([]string, 0),
 	}
 
@@ -204,14 +283,31 @@ func (a *ProjectAnalyzer) AnalyzeProject(projectRoot string, registry tools.Tool
 
 	// Check tool availability and build recommendations
 	for _, lang := range languages {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		langTools := registry.GetToolsByLanguage(lang)
+		probes := a.probeTools(ctx, projectRoot, langTools)
 		recommendations := make([]string, 0)
 
-		for _, tool := range langTools {
-			if a.toolDetector.IsToolAvailable(tool.Name()) {
-				result.AvailableTools = append(result.AvailableTools, tool.Name())
-				recommendations = append(recommendations, tool.Name())
+		for i, tool := range langTools {
+			probe := probes[i]
+			result.Timings[tool.Name()] = probe.duration
+
+			if !probe.available {
+				continue
+			}
+			if probe.location != nil {
+				result.ToolLocations[tool.Name()] = probe.location
+			}
+			if probe.issue != "" {
+				result.Issues = append(result.Issues, probe.issue)
+				continue
 			}
+
+			result.AvailableTools = append(result.AvailableTools, tool.Name())
+			recommendations = append(recommendations, tool.Name())
 		}
 
 		if len(recommendations) > 0 {
@@ -230,6 +326,121 @@ func (a *ProjectAnalyzer) AnalyzeProject(projectRoot string, registry tools.Tool
 	return result, nil
 }
 
+// probeTools checks availability, project-wrapper location, and version
+// constraint for each of langTools concurrently, bounded by MaxWorkers,
+// returning results in the same order as langTools. A cancelled ctx
+// short-circuits any probe not yet started.
+func (a *ProjectAnalyzer) probeTools(ctx context.Context, projectRoot string, langTools []tools.QualityTool) []toolProbe {
+	probes := make([]toolProbe, len(langTools))
+
+	workers := a.MaxWorkers
+	if workers < 1 {
+		workers = 1
+	}
+
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+
+	for i, tool := range langTools {
+		if ctx.Err() != nil {
+			probes[i] = toolProbe{tool: tool}
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, tool tools.QualityTool) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			probes[i] = a.probeTool(ctx, projectRoot, tool)
+		}(i, tool)
+	}
+
+	wg.Wait()
+	return probes
+}
+
+// probeTool checks a single tool's availability, resolves a project
+// build-wrapper location if it's not on PATH, and validates any version
+// constraint it declares.
+func (a *ProjectAnalyzer) probeTool(ctx context.Context, projectRoot string, tool tools.QualityTool) toolProbe {
+	start := time.Now()
+	probe := toolProbe{tool: tool}
+
+	if ctx.Err() != nil {
+		probe.duration = time.Since(start)
+		return probe
+	}
+
+	probe.available = a.toolDetector.IsToolAvailable(tool.Name())
+	if !probe.available {
+		if location, ok := a.toolDetector.ResolveLocation(projectRoot, tool.Name()); ok {
+			probe.available = true
+			probe.location = location
+		}
+	}
+	if !probe.available {
+		probe.duration = time.Since(start)
+		return probe
+	}
+
+	// Tools resolved through a build wrapper don't have a standalone
+	// executable to run --version against, so version constraints can't
+	// be checked for them.
+	if constrained, ok := tool.(tools.VersionConstrained); ok && probe.location == nil {
+		if constraint := constrained.VersionConstraint(); constraint != "" {
+			satisfied, version, err := a.checkToolVersion(tool, constraint)
+			switch {
+			case err != nil:
+				probe.issue = fmt.Sprintf("%s: %v", tool.Name(), err)
+			case !satisfied:
+				probe.issue = fmt.Sprintf("%s %s installed but %s required", tool.Name(), version, constraint)
+			}
+		}
+	}
+
+	probe.duration = time.Since(start)
+	return probe
+}
+
+// checkToolVersion checks tool's installed version against constraint,
+// preferring the tool's own VersionParser (if it implements one) over
+// the detector's default regex-based extraction.
+func (a *ProjectAnalyzer) checkToolVersion(tool tools.QualityTool, constraint string) (bool, string, error) {
+	parser, ok := tool.(tools.VersionParser)
+	if !ok {
+		return a.toolDetector.CheckVersion(tool.Name(), constraint)
+	}
+
+	raw := a.toolDetector.GetToolVersion(tool.Name())
+	version := parser.ParseVersionString(raw)
+	if version == "" {
+		return false, raw, fmt.Errorf("could not parse a version number out of %q", raw)
+	}
+
+	satisfied, err := VersionSatisfies(version, constraint)
+	return satisfied, version, err
+}
+
+// LanguageForFile returns the language of a single file, or "" if it
+// doesn't match any known language rule.
+func (a *ProjectAnalyzer) LanguageForFile(path string) string {
+	return a.langDetector.LanguageForFile(path)
+}
+
+// LanguageNames returns the names of every language this analyzer can
+// detect, e.g. for validating config file references.
+func (a *ProjectAnalyzer) LanguageNames() []string {
+	return a.langDetector.LanguageNames()
+}
+
+// LanguageDetector returns the FileTypeDetector backing this analyzer,
+// for a caller that needs to tune its ContentClassification/
+// MaxBytesScanned fields from a loaded config.Config.Detection block.
+func (a *ProjectAnalyzer) LanguageDetector() *FileTypeDetector {
+	return a.langDetector
+}
+
 // GetOptimalToolSelection returns the best tools for each language.
 func (a *ProjectAnalyzer) GetOptimalToolSelection(result *AnalysisResult, registry tools.ToolRegistry) map[string][]tools.QualityTool {
 	selection := make(map[string][]tools.QualityTool)
@@ -4,6 +4,7 @@
 package detector
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -152,7 +153,7 @@ func TestAnalyzeProject(t *testing.T) {
 	registry.Register(tools.NewGolangciLintTool())
 
 	// Analyze project
-	result, err := analyzer.AnalyzeProject(tmpDir, registry)
+	result, err := analyzer.AnalyzeProject(context.Background(), tmpDir, registry)
 	require.NoError(t, err)
 	assert.NotNil(t, result)
 
@@ -188,7 +189,7 @@ func TestAnalyzeProject_MultiLanguage(t *testing.T) {
 	registry.Register(tools.NewBlackTool())
 	registry.Register(tools.NewPrettierTool())
 
-	result, err := analyzer.AnalyzeProject(tmpDir, registry)
+	result, err := analyzer.AnalyzeProject(context.Background(), tmpDir, registry)
 	require.NoError(t, err)
 
 	// Should detect multiple languages
@@ -208,7 +209,7 @@ func TestAnalyzeProject_NoTools(t *testing.T) {
 	// Empty registry - no tools available
 	registry := tools.NewRegistry()
 
-	result, err := analyzer.AnalyzeProject(tmpDir, registry)
+	result, err := analyzer.AnalyzeProject(context.Background(), tmpDir, registry)
 	require.NoError(t, err)
 
 	// Should have issues about missing tools
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package detector
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// defaultVersionRe extracts the first dotted version number out of raw
+// tool version output, e.g. "1.23.4" out of "detekt 1.23.4" or "0.50.0"
+// out of "ktlint version 0.50.0" - GetToolVersion's output shape varies
+// tool to tool.
+var defaultVersionRe = regexp.MustCompile(`\d+\.\d+(\.\d+)?`)
+
+// ExtractVersion pulls the first dotted version number out of raw tool
+// version output, returning "" if none was found.
+func (d *SystemToolDetector) ExtractVersion(raw string) string {
+	return defaultVersionRe.FindString(raw)
+}
+
+// CheckVersion reports whether the installed version of toolName
+// satisfies constraint (see VersionSatisfies), along with the version
+// string that was checked. An empty constraint is always satisfied.
+func (d *SystemToolDetector) CheckVersion(toolName, constraint string) (bool, string, error) {
+	if strings.TrimSpace(constraint) == "" {
+		return true, "", nil
+	}
+
+	raw := d.GetToolVersion(toolName)
+	version := d.ExtractVersion(raw)
+	if version == "" {
+		return false, raw, fmt.Errorf("could not parse a version number out of %q for %s", raw, toolName)
+	}
+
+	ok, err := VersionSatisfies(version, constraint)
+	return ok, version, err
+}
+
+// VersionSatisfies reports whether version satisfies constraint, a
+// comma-separated list of comparisons (all of which must hold) such as
+// ">=0.50.0" or ">=1.2.0,<2.0.0". Supported operators are >=, <=, >, <,
+// ==, and = (a bare version with no operator means ==).
+func VersionSatisfies(version, constraint string) (bool, error) {
+	have, err := parseVersionParts(version)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		op, wantStr := splitConstraintOperator(part)
+		want, err := parseVersionParts(wantStr)
+		if err != nil {
+			return false, fmt.Errorf("invalid version constraint %q: %w", part, err)
+		}
+
+		cmp := compareVersionParts(have, want)
+
+		var ok bool
+		switch op {
+		case ">=":
+			ok = cmp >= 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case "<":
+			ok = cmp < 0
+		case "==", "=", "":
+			ok = cmp == 0
+		default:
+			return false, fmt.Errorf("unsupported constraint operator %q in %q", op, part)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// splitConstraintOperator splits a constraint part like ">=0.50.0" into
+// its operator and version, returning an empty operator (which
+// VersionSatisfies treats as ==) when no operator prefix is present.
+func splitConstraintOperator(part string) (op, version string) {
+	for _, candidate := range []string{">=", "<=", "==", ">", "<", "="} {
+		if strings.HasPrefix(part, candidate) {
+			return candidate, strings.TrimSpace(part[len(candidate):])
+		}
+	}
+	return "", part
+}
+
+// parseVersionParts parses a dotted "major.minor[.patch]" version string
+// into its numeric components, defaulting missing trailing components to
+// zero.
+func parseVersionParts(version string) ([3]int, error) {
+	var parts [3]int
+
+	fields := strings.SplitN(strings.TrimSpace(version), ".", 3)
+	for i, field := range fields {
+		n, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return parts, fmt.Errorf("non-numeric version segment %q", field)
+		}
+		parts[i] = n
+	}
+
+	return parts, nil
+}
+
+// compareVersionParts returns -1, 0, or 1 as a is less than, equal to, or
+// greater than b.
+func compareVersionParts(a, b [3]int) int {
+	for i := 0; i < 3; i++ {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
@@ -42,10 +42,11 @@ func (m *mockCacheableTool) Name() string            { return m.name }
 func (m *mockCacheableTool) Language() string        { return m.language }
 func (m *mockCacheableTool) Type() tools.ToolType    { return tools.FORMAT }
 func (m *mockCacheableTool) IsAvailable() bool       { return m.available }
-func (m *mockCacheableTool) Install() error          { return nil }
-func (m *mockCacheableTool) Upgrade() error          { return nil }
+func (m *mockCacheableTool) Install(ctx context.Context) error { return nil }
+func (m *mockCacheableTool) Upgrade(ctx context.Context) error { return nil }
 func (m *mockCacheableTool) GetVersion() (string, error) { return m.version, nil }
 func (m *mockCacheableTool) FindConfigFiles(projectRoot string) []string { return m.configFiles }
+func (m *mockCacheableTool) SupportedExtensions() []string { return nil }
 
 func (m *mockCacheableTool) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
 	m.execCount++
@@ -54,7 +55,7 @@ func (m *mockCacheableTool) Execute(ctx context.Context, files []string, options
 		Language:       m.language,
 		Success:        m.successResult,
 		FilesProcessed: len(files),
-		Duration:       time.Millisecond,
+		Duration:       time.Millisecond.String(),
 		Issues:         []tools.Issue{},
 	}, nil
 }
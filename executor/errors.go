@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// ErrCancelled is returned by ExecuteParallel when the ctx passed in was
+// cancelled by the caller (e.g. Ctrl+C during `quality run`), as opposed
+// to ErrDeadlineExceeded, which means a deadline carried by that ctx
+// elapsed.
+var ErrCancelled = errors.New("execution cancelled")
+
+// ErrDeadlineExceeded is returned by ExecuteParallel when the ctx passed
+// in carried its own deadline and that deadline elapsed before every task
+// finished. A single slow tool hitting its own per-task budget (see
+// tools.Task.Timeout and ParallelExecutor.SetToolTimeout) instead
+// surfaces as ErrToolTimeout and only fails that one task, so callers can
+// tell "the caller's own deadline ran out" apart from "one tool hung".
+var ErrDeadlineExceeded = errors.New("execution deadline exceeded")
+
+// ErrDependencyCycle is returned by CreatePlan (and ExecuteParallel, for
+// a plan built by hand rather than through CreatePlan) when two or more
+// tasks' DependsOn edges form a cycle, which would otherwise leave every
+// task on that cycle permanently ineligible to run.
+var ErrDependencyCycle = errors.New("task dependency cycle")
+
+// ErrToolTimeout reports that a single task exceeded its own timeout
+// (tools.Task.Timeout, or failing that ParallelExecutor.SetToolTimeout,
+// or failing that the executor's default), distinct from the caller's
+// own ErrDeadlineExceeded. It's wrapped in a TaskError the same way any
+// other task failure is, so callers walking a MultiError can tell which
+// tool was the one that hung - and since it only cancels that task's own
+// context, every other task keeps running unaffected.
+type ErrToolTimeout struct {
+	Tool    string
+	Elapsed time.Duration
+}
+
+func (e *ErrToolTimeout) Error() string {
+	return fmt.Sprintf("%s: timed out after %v", e.Tool, e.Elapsed)
+}
+
+// TaskError attaches the tools.Task that failed to the error its
+// tools.QualityTool.Execute returned, so a MultiError (or any reporter
+// walking its Unwrap()'d errors) can attribute a failure to a specific
+// tool/language pair instead of a bare error string.
+type TaskError struct {
+	Task tools.Task
+	Err  error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("%s (%s): %v", e.Task.Tool.Name(), e.Task.Tool.Language(), e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError aggregates every task failure from a single ExecuteParallel
+// run. Returning errors[0] and discarding the rest meant a failure in one
+// tool masked failures in every other tool that ran concurrently with it;
+// MultiError keeps all of them addressable via errors.Is/errors.As
+// (through Unwrap() []error) while still printing a single grouped
+// summary for a plain %v/Error() call.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d tasks failed:", len(e.Errors))
+	for _, err := range e.Errors {
+		var taskErr *TaskError
+		if errors.As(err, &taskErr) {
+			fmt.Fprintf(&b, "\n  - %s (%s): %v", taskErr.Task.Tool.Name(), taskErr.Task.Tool.Language(), taskErr.Err)
+		} else {
+			fmt.Fprintf(&b, "\n  - %v", err)
+		}
+	}
+
+	return b.String()
+}
+
+// Unwrap exposes every wrapped error to errors.Is/errors.As, per the
+// multi-error Unwrap() []error convention.
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
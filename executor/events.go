@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import "time"
+
+// EventPhase is a per-task lifecycle transition ExecuteParallel reports
+// to the channel installed via ParallelExecutor.SetEventSink, so a
+// TUI/CI reporter can render live per-tool status.
+type EventPhase int
+
+const (
+	// EventStarted fires once, right before a task's tool actually runs.
+	// A task served entirely from cache never gets one - see
+	// EventCacheHit.
+	EventStarted EventPhase = iota
+
+	// EventFinished fires when a task's tool returns, successfully or
+	// not - Err is nil on success.
+	EventFinished
+
+	// EventTimedOut fires when a task was aborted by its own per-tool
+	// budget (ErrToolTimeout) or by the deadline of the caller's ctx
+	// (ErrDeadlineExceeded).
+	EventTimedOut
+
+	// EventCancelled fires when a task was aborted because the caller's
+	// ctx was cancelled (ErrCancelled), not because any timeout elapsed.
+	EventCancelled
+
+	// EventCacheHit fires instead of EventStarted/EventFinished when
+	// every file in a task was already cached, so the wrapped tool never
+	// actually ran - reporters should treat this worker as having gone
+	// straight back to idle rather than ever showing it busy.
+	EventCacheHit
+
+	// EventIssueFound fires alongside EventFinished/EventCacheHit for a
+	// task that found one or more Issues, carrying the count in
+	// IssueCount - split out from EventFinished so a reporter's scrolling
+	// issue tail doesn't have to inspect every finished task's Result.
+	EventIssueFound
+
+	// EventWorkerIdle fires when a worker has no more tasks left to pick
+	// up (the task channel closed), right before it exits.
+	EventWorkerIdle
+)
+
+// String renders p for log lines and reporter output.
+func (p EventPhase) String() string {
+	switch p {
+	case EventStarted:
+		return "started"
+	case EventFinished:
+		return "finished"
+	case EventTimedOut:
+		return "timed out"
+	case EventCancelled:
+		return "cancelled"
+	case EventCacheHit:
+		return "cache hit"
+	case EventIssueFound:
+		return "issue found"
+	case EventWorkerIdle:
+		return "worker idle"
+	default:
+		return "unknown"
+	}
+}
+
+// Event describes one task's lifecycle transition during an
+// ExecuteParallel run.
+type Event struct {
+	// Worker is the 0-based index of the worker goroutine that owns this
+	// task, so a reporter can keep one persistent status line per
+	// worker.
+	Worker int
+
+	// Tool is the task's tool name, e.g. "golangci-lint".
+	Tool string
+
+	// Language is the task's tool's language, e.g. "Go".
+	Language string
+
+	// Files are the task's input files, for a reporter showing "current
+	// tool + file". Only set on EventStarted.
+	Files []string
+
+	// Phase is which lifecycle transition this Event reports.
+	Phase EventPhase
+
+	// Elapsed is how long the task had been running when Phase was
+	// reported. Zero for EventStarted.
+	Elapsed time.Duration
+
+	// IssueCount is how many Issues the task's Result carried. Only set
+	// on EventIssueFound.
+	IssueCount int
+
+	// Err is the error that caused EventTimedOut/EventCancelled, or a
+	// task failure reported alongside EventFinished. Nil on a
+	// successful EventFinished.
+	Err error
+}
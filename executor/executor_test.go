@@ -5,9 +5,12 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,22 +32,23 @@ func (m *mockTool) Name() string       { return m.name }
 func (m *mockTool) Language() string   { return m.language }
 func (m *mockTool) Type() tools.ToolType { return m.toolType }
 func (m *mockTool) IsAvailable() bool  { return m.validateFunc() == nil }
-func (m *mockTool) Install() error     { return nil }
+func (m *mockTool) Install(ctx context.Context) error { return nil }
 func (m *mockTool) GetVersion() (string, error) { return "1.0.0", nil }
-func (m *mockTool) Upgrade() error     { return nil }
+func (m *mockTool) Upgrade(ctx context.Context) error { return nil }
 func (m *mockTool) FindConfigFiles(projectRoot string) []string { return nil }
+func (m *mockTool) SupportedExtensions() []string { return nil }
 func (m *mockTool) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
 	return m.executeFunc(ctx, files, options)
 }
 
 // Mock analyzer for testing
 type mockAnalyzer struct {
-	analyzeFunc  func(projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error)
+	analyzeFunc   func(ctx context.Context, projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error)
 	selectionFunc func(result *AnalysisResult, registry tools.ToolRegistry) map[string][]tools.QualityTool
 }
 
-func (m *mockAnalyzer) AnalyzeProject(projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error) {
-	return m.analyzeFunc(projectRoot, registry)
+func (m *mockAnalyzer) AnalyzeProject(ctx context.Context, projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error) {
+	return m.analyzeFunc(ctx, projectRoot, registry)
 }
 
 func (m *mockAnalyzer) GetOptimalToolSelection(result *AnalysisResult, registry tools.ToolRegistry) map[string][]tools.QualityTool {
@@ -106,6 +110,34 @@ func (m *mockRegistry) GetToolsByType(toolType tools.ToolType) []tools.QualityTo
 	return result
 }
 
+func (m *mockRegistry) DetectApplicableTools(projectRoot string) ([]tools.QualityTool, error) {
+	return m.GetAllTools(), nil
+}
+
+func (m *mockRegistry) GetToolsForFile(path string) []tools.QualityTool {
+	ext := filepath.Ext(path)
+	var matched []tools.QualityTool
+	for _, tool := range m.tools {
+		for _, supported := range tool.SupportedExtensions() {
+			if supported == ext {
+				matched = append(matched, tool)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+func (m *mockRegistry) PartitionFiles(files []string) map[string][]string {
+	partitions := make(map[string][]string)
+	for _, file := range files {
+		for _, tool := range m.GetToolsForFile(file) {
+			partitions[tool.Name()] = append(partitions[tool.Name()], file)
+		}
+	}
+	return partitions
+}
+
 // Helper to create a simple git repository for testing
 func setupTestGitRepo(t *testing.T) string {
 	t.Helper()
@@ -306,20 +338,224 @@ func TestParallelExecutor_ExecuteParallel(t *testing.T) {
 	assert.True(t, toolNames["tool2"])
 }
 
+func TestParallelExecutor_ExecuteParallel_AggregatesAllErrors(t *testing.T) {
+	executor := NewParallelExecutor(4, 1*time.Minute)
+
+	tool1 := &mockTool{
+		name:     "tool1",
+		language: "Go",
+		toolType: tools.FORMAT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			return &tools.Result{Tool: "tool1"}, errors.New("tool1 failed")
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	tool2 := &mockTool{
+		name:     "tool2",
+		language: "Python",
+		toolType: tools.LINT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			return &tools.Result{Tool: "tool2"}, errors.New("tool2 failed")
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: tool1, Files: []string{"file1.go"}},
+			{Tool: tool2, Files: []string{"file2.py"}},
+		},
+	}
+
+	_, err := executor.ExecuteParallel(context.Background(), plan, 2)
+
+	require.Error(t, err)
+
+	var multiErr *MultiError
+	require.True(t, errors.As(err, &multiErr))
+	assert.Len(t, multiErr.Errors, 2)
+
+	var taskErr *TaskError
+	require.True(t, errors.As(multiErr.Errors[0], &taskErr))
+	assert.NotEmpty(t, taskErr.Task.Tool.Name())
+	assert.Contains(t, multiErr.Error(), "2 tasks failed")
+}
+
+// slowTool returns a mockTool whose executeFunc honors ctx cancellation
+// (unlike a mock that unconditionally time.Sleeps), so it can exercise a
+// per-task timeout the way a real exec.CommandContext-backed tool would.
+func slowTool(name string, sleep time.Duration) *mockTool {
+	return &mockTool{
+		name:     name,
+		language: "Go",
+		toolType: tools.FORMAT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			select {
+			case <-time.After(sleep):
+				return &tools.Result{Tool: name, Success: true}, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+		validateFunc: func() error { return nil },
+	}
+}
+
 func TestParallelExecutor_ExecuteParallel_Timeout(t *testing.T) {
 	// Very short timeout
 	executor := NewParallelExecutor(1, 10*time.Millisecond)
 
+	tool := slowTool("slow-tool", 1*time.Second)
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{
+				Tool:     tool,
+				Name:     "slow-tool",
+				Files:    []string{"file1.go"},
+				Priority: 10,
+			},
+		},
+		TotalFiles:        1,
+		EstimatedDuration: "1s",
+	}
+
+	ctx := context.Background()
+	results, err := executor.ExecuteParallel(ctx, plan, 1)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	require.Len(t, results, 1)
+	assert.True(t, results[0].TimedOut)
+}
+
+func TestParallelExecutor_ExecuteParallel_PerTaskTimeoutDoesNotStarveSiblings(t *testing.T) {
+	// An executor-wide timeout generous enough for the fast task, but a
+	// per-task Timeout on the slow one short enough that it alone times
+	// out - the fast task must still complete successfully rather than
+	// being cut off by the slow one's budget.
+	executor := NewParallelExecutor(2, 1*time.Minute)
+
+	slow := slowTool("slow-tool", 1*time.Second)
+	fast := slowTool("fast-tool", 0)
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: slow, Name: "slow-tool", Files: []string{"a.go"}, Timeout: 10 * time.Millisecond},
+			{Tool: fast, Name: "fast-tool", Files: []string{"b.go"}},
+		},
+		TotalFiles: 2,
+	}
+
+	ctx := context.Background()
+	results, err := executor.ExecuteParallel(ctx, plan, 2)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+
+	byName := map[string]*tools.Result{}
+	for _, r := range results {
+		byName[r.Tool] = r
+	}
+	require.Contains(t, byName, "fast-tool")
+	assert.True(t, byName["fast-tool"].Success)
+	require.Contains(t, byName, "slow-tool")
+	assert.True(t, byName["slow-tool"].TimedOut)
+}
+
+func TestParallelExecutor_ExecuteParallel_CallerCancellationStopsDispatch(t *testing.T) {
+	executor := NewParallelExecutor(1, 1*time.Minute)
+
+	tool := slowTool("slow-tool", 1*time.Second)
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: tool, Name: "slow-tool", Files: []string{"a.go"}},
+		},
+		TotalFiles: 1,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := executor.ExecuteParallel(ctx, plan, 1)
+
+	assert.ErrorIs(t, err, ErrCancelled)
+}
+
+func TestChunkFiles(t *testing.T) {
+	files := []string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc", "dddddddddd"}
+
+	// Each file costs 10 + 8 = 18 bytes; a limit of toolFlagsReserve+36
+	// leaves room for exactly two files per batch.
+	batches := chunkFiles(files, 4096+36)
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, []string{"aaaaaaaaaa", "bbbbbbbbbb"}, batches[0])
+	assert.Equal(t, []string{"cccccccccc", "dddddddddd"}, batches[1])
+}
+
+func TestChunkFiles_SingleOversizedFileGetsItsOwnBatch(t *testing.T) {
+	files := []string{strings.Repeat("x", 100), "short.go"}
+
+	batches := chunkFiles(files, 4096+50)
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, []string{strings.Repeat("x", 100)}, batches[0])
+	assert.Equal(t, []string{"short.go"}, batches[1])
+}
+
+func TestChunkFiles_EverythingFitsInOneBatch(t *testing.T) {
+	files := []string{"a.go", "b.go", "c.go"}
+
+	batches := chunkFiles(files, defaultArgMaxBytes())
+
+	require.Len(t, batches, 1)
+	assert.Equal(t, files, batches[0])
+}
+
+func TestMergeResults(t *testing.T) {
+	first := &tools.Result{
+		Success:        true,
+		FilesProcessed: 2,
+		Issues:         []tools.Issue{{File: "a.go", Message: "issue a"}},
+		Duration:       "100ms",
+		Cached:         true,
+	}
+	second := &tools.Result{
+		Success:        false,
+		FilesProcessed: 1,
+		Issues:         []tools.Issue{{File: "b.go", Message: "issue b"}},
+		Duration:       "250ms",
+		Cached:         false,
+	}
+
+	merged := mergeResults(first, second)
+
+	assert.True(t, merged.Success, "Success should be OR'd across batches")
+	assert.Equal(t, 3, merged.FilesProcessed)
+	assert.Len(t, merged.Issues, 2)
+	assert.Equal(t, "250ms", merged.Duration, "Duration should take the slower batch")
+	assert.False(t, merged.Cached, "Cached should be AND'd: one real run means the task wasn't fully served from cache")
+}
+
+func TestParallelExecutor_ExecuteParallel_ChunksLargeFileLists(t *testing.T) {
+	executor := NewParallelExecutor(1, 1*time.Minute)
+	executor.SetArgMaxBytes(20) // tiny budget: forces every file into its own batch
+
+	var seenBatches [][]string
 	tool := &mockTool{
-		name:     "slow-tool",
+		name:     "chunked-tool",
 		language: "Go",
 		toolType: tools.FORMAT,
 		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
-			// Simulate slow execution
-			time.Sleep(1 * time.Second)
+			batch := append([]string{}, files...)
+			seenBatches = append(seenBatches, batch)
 			return &tools.Result{
-				Tool:    "slow-tool",
-				Success: true,
+				Tool:           "chunked-tool",
+				Success:        true,
+				FilesProcessed: len(files),
 			}, nil
 		},
 		validateFunc: func() error { return nil },
@@ -329,19 +565,203 @@ func TestParallelExecutor_ExecuteParallel_Timeout(t *testing.T) {
 		Tasks: []tools.Task{
 			{
 				Tool:     tool,
-				Files:    []string{"file1.go"},
+				Files:    []string{"file1.go", "file2.go", "file3.go"},
 				Priority: 10,
 			},
 		},
-		TotalFiles:        1,
-		EstimatedDuration: "1s",
+		TotalFiles: 3,
 	}
 
 	ctx := context.Background()
-	_, err := executor.ExecuteParallel(ctx, plan, 1)
+	results, err := executor.Execute(ctx, plan)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, 3, results[0].FilesProcessed)
+	assert.Greater(t, len(seenBatches), 1, "a tiny ARG_MAX budget should split the task across more than one invocation")
+}
+
+// fileListLimitedMockTool wraps mockTool to opt out of ParallelExecutor's
+// ARG_MAX chunking, the same way ClippyTool does for its whole-workspace
+// invocation.
+type fileListLimitedMockTool struct {
+	*mockTool
+}
+
+func (t *fileListLimitedMockTool) AcceptsFileList() bool { return false }
+
+var _ tools.FileListLimited = (*fileListLimitedMockTool)(nil)
+
+func TestParallelExecutor_ExecuteParallel_HonorsFileListLimited(t *testing.T) {
+	executor := NewParallelExecutor(1, 1*time.Minute)
+	executor.SetArgMaxBytes(64)
+
+	var callCount int
+	tool := &fileListLimitedMockTool{mockTool: &mockTool{
+		name:     "whole-project-tool",
+		language: "Rust",
+		toolType: tools.LINT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			callCount++
+			return &tools.Result{Tool: "whole-project-tool", Success: true, FilesProcessed: len(files)}, nil
+		},
+		validateFunc: func() error { return nil },
+	}}
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{
+				Tool:     tool,
+				Files:    []string{"file1.rs", "file2.rs", "file3.rs"},
+				Priority: 10,
+			},
+		},
+		TotalFiles: 3,
+	}
+
+	ctx := context.Background()
+	_, err := executor.Execute(ctx, plan)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, callCount, "a FileListLimited tool should run exactly once, unsplit")
+}
+
+func TestParallelExecutor_ExecuteParallel_RunsDependentAfterDependency(t *testing.T) {
+	executor := NewParallelExecutor(4, 1*time.Minute)
+
+	var order []string
+	var mu sync.Mutex
+	record := func(name string) {
+		mu.Lock()
+		order = append(order, name)
+		mu.Unlock()
+	}
+
+	formatter := &mockTool{
+		name:     "gofmt",
+		language: "Go",
+		toolType: tools.FORMAT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			time.Sleep(10 * time.Millisecond)
+			record("gofmt")
+			return &tools.Result{Tool: "gofmt", Success: true}, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+	linter := &mockTool{
+		name:     "golangci-lint",
+		language: "Go",
+		toolType: tools.LINT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			record("golangci-lint")
+			return &tools.Result{Tool: "golangci-lint", Success: true}, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: linter, Name: "golangci-lint", Files: []string{"a.go"}, DependsOn: []string{"gofmt"}},
+			{Tool: formatter, Name: "gofmt", Files: []string{"a.go"}},
+		},
+	}
+
+	results, err := executor.ExecuteParallel(context.Background(), plan, 2)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, []string{"gofmt", "golangci-lint"}, order)
+}
+
+func TestParallelExecutor_ExecuteParallel_SkipsRunOnSuccessAfterFailedDependency(t *testing.T) {
+	executor := NewParallelExecutor(4, 1*time.Minute)
+
+	failing := &mockTool{
+		name:     "tsc",
+		language: "TypeScript",
+		toolType: tools.LINT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			return &tools.Result{Tool: "tsc", Success: false}, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+	dependent := &mockTool{
+		name:     "eslint",
+		language: "TypeScript",
+		toolType: tools.LINT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			t.Fatal("eslint should have been skipped, not run")
+			return nil, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: failing, Name: "tsc", Files: []string{"a.ts"}},
+			{Tool: dependent, Name: "eslint", Files: []string{"a.ts"}, DependsOn: []string{"tsc"}, RunIf: tools.RunOnSuccess},
+		},
+	}
+
+	results, err := executor.ExecuteParallel(context.Background(), plan, 2)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	var eslintResult *tools.Result
+	for _, r := range results {
+		if r.Tool == "eslint" {
+			eslintResult = r
+		}
+	}
+	require.NotNil(t, eslintResult)
+	assert.True(t, eslintResult.Skipped)
+	assert.False(t, eslintResult.Success)
+}
+
+func TestDetectTaskCycle(t *testing.T) {
+	tasks := []tools.Task{
+		{Tool: &mockTool{name: "a"}, Name: "a", DependsOn: []string{"b"}},
+		{Tool: &mockTool{name: "b"}, Name: "b", DependsOn: []string{"a"}},
+	}
+
+	err := detectTaskCycle(tasks)
 
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "timed out")
+	assert.True(t, errors.Is(err, ErrDependencyCycle))
+}
+
+func TestDetectTaskCycle_NoCycle(t *testing.T) {
+	tasks := []tools.Task{
+		{Tool: &mockTool{name: "gofmt"}, Name: "gofmt"},
+		{Tool: &mockTool{name: "golangci-lint"}, Name: "golangci-lint", DependsOn: []string{"gofmt"}},
+	}
+
+	assert.NoError(t, detectTaskCycle(tasks))
+}
+
+func TestAssignTaskDependencies_LintDependsOnFormatter(t *testing.T) {
+	tasks := []tools.Task{
+		{Tool: &mockTool{name: "gofmt", language: "Go", toolType: tools.FORMAT}, Name: "gofmt"},
+		{Tool: &mockTool{name: "golangci-lint", language: "Go", toolType: tools.LINT}, Name: "golangci-lint"},
+	}
+
+	assignTaskDependencies(tasks)
+
+	assert.Empty(t, tasks[0].DependsOn)
+	assert.Equal(t, []string{"gofmt"}, tasks[1].DependsOn)
+}
+
+func TestAssignTaskDependencies_LinterDependsOnTypeChecker(t *testing.T) {
+	tasks := []tools.Task{
+		{Tool: &mockTool{name: "tsc", language: "TypeScript", toolType: tools.LINT}, Name: "tsc"},
+		{Tool: &mockTool{name: "eslint", language: "TypeScript", toolType: tools.LINT}, Name: "eslint"},
+	}
+
+	assignTaskDependencies(tasks)
+
+	assert.Empty(t, tasks[0].DependsOn)
+	assert.Equal(t, []string{"tsc"}, tasks[1].DependsOn)
 }
 
 // Tests for ExecutionPlanner
@@ -382,7 +802,7 @@ func TestExecutionPlanner_CreatePlan(t *testing.T) {
 	}
 
 	analyzer := &mockAnalyzer{
-		analyzeFunc: func(projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
+		analyzeFunc: func(ctx context.Context, projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
 			return &AnalysisResult{
 				ProjectRoot: projectRoot,
 				Languages: map[string][]string{
@@ -401,7 +821,7 @@ func TestExecutionPlanner_CreatePlan(t *testing.T) {
 
 	planner := NewExecutionPlanner(analyzer)
 
-	plan, err := planner.CreatePlan(tmpDir, registry, PlanOptions{})
+	plan, err := planner.CreatePlan(context.Background(), tmpDir, registry, PlanOptions{})
 
 	require.NoError(t, err)
 	assert.NotNil(t, plan)
@@ -410,6 +830,49 @@ func TestExecutionPlanner_CreatePlan(t *testing.T) {
 	assert.Equal(t, 10, plan.Tasks[0].Priority) // FORMAT priority
 }
 
+func TestExecutionPlanner_CreatePlan_AppliesToolTimeouts(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	goFile := filepath.Join(tmpDir, "main.go")
+	err := os.WriteFile(goFile, []byte("package main\n\nfunc main() {}\n"), 0o644)
+	require.NoError(t, err)
+
+	tool := &mockTool{
+		name:     "gofmt",
+		language: "Go",
+		toolType: tools.FORMAT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			return &tools.Result{Tool: "gofmt", Success: true}, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	registry := &mockRegistry{tools: map[string]tools.QualityTool{"gofmt": tool}}
+
+	analyzer := &mockAnalyzer{
+		analyzeFunc: func(ctx context.Context, projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
+			return &AnalysisResult{
+				ProjectRoot: projectRoot,
+				Languages:   map[string][]string{"Go": {goFile}},
+				ConfigFiles: map[string]string{},
+			}, nil
+		},
+		selectionFunc: func(result *AnalysisResult, reg tools.ToolRegistry) map[string][]tools.QualityTool {
+			return map[string][]tools.QualityTool{"Go": {tool}}
+		},
+	}
+
+	planner := NewExecutionPlanner(analyzer)
+
+	plan, err := planner.CreatePlan(context.Background(), tmpDir, registry, PlanOptions{
+		ToolTimeouts: map[string]time.Duration{"gofmt": 30 * time.Second},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, plan.Tasks, 1)
+	assert.Equal(t, 30*time.Second, plan.Tasks[0].Timeout)
+}
+
 func TestExecutionPlanner_CreatePlan_WithFormatOnly(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -441,7 +904,7 @@ func TestExecutionPlanner_CreatePlan_WithFormatOnly(t *testing.T) {
 	}
 
 	analyzer := &mockAnalyzer{
-		analyzeFunc: func(projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
+		analyzeFunc: func(ctx context.Context, projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
 			return &AnalysisResult{
 				ProjectRoot: projectRoot,
 				Languages:   map[string][]string{"Go": {"main.go"}},
@@ -456,7 +919,7 @@ func TestExecutionPlanner_CreatePlan_WithFormatOnly(t *testing.T) {
 
 	planner := NewExecutionPlanner(analyzer)
 
-	plan, err := planner.CreatePlan(tmpDir, registry, PlanOptions{
+	plan, err := planner.CreatePlan(context.Background(), tmpDir, registry, PlanOptions{
 		FormatOnly: true,
 	})
 
@@ -496,7 +959,7 @@ func TestExecutionPlanner_CreatePlan_WithLintOnly(t *testing.T) {
 	}
 
 	analyzer := &mockAnalyzer{
-		analyzeFunc: func(projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
+		analyzeFunc: func(ctx context.Context, projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
 			return &AnalysisResult{
 				ProjectRoot: projectRoot,
 				Languages:   map[string][]string{"Go": {"main.go"}},
@@ -511,7 +974,7 @@ func TestExecutionPlanner_CreatePlan_WithLintOnly(t *testing.T) {
 
 	planner := NewExecutionPlanner(analyzer)
 
-	plan, err := planner.CreatePlan(tmpDir, registry, PlanOptions{
+	plan, err := planner.CreatePlan(context.Background(), tmpDir, registry, PlanOptions{
 		LintOnly: true,
 	})
 
@@ -583,6 +1046,16 @@ func TestGitUtils_GetStagedFiles(t *testing.T) {
 	})
 }
 
+func TestGitUtils_ListFiles(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "committed.txt", "initial")
+	gitUtils := &GitUtils{projectRoot: repoDir}
+
+	files, err := gitUtils.ListFiles()
+	require.NoError(t, err)
+	assert.Contains(t, files, "committed.txt")
+}
+
 func TestGitUtils_GetAllChangedFiles(t *testing.T) {
 	repoDir := setupTestGitRepo(t)
 	createAndCommitFile(t, repoDir, "committed.txt", "initial")
@@ -634,6 +1107,42 @@ func TestGitUtils_GetChangedFiles(t *testing.T) {
 	})
 }
 
+func TestExecutionPlanner_ResolveDiffBase(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	planner := NewExecutionPlanner(&mockAnalyzer{})
+
+	t.Run("Since takes the ref as-is", func(t *testing.T) {
+		base, err := planner.resolveDiffBase(repoDir, PlanOptions{Since: "HEAD~1"})
+		require.NoError(t, err)
+		assert.Equal(t, "HEAD~1", base)
+	})
+
+	t.Run("Staged resolves to HEAD", func(t *testing.T) {
+		base, err := planner.resolveDiffBase(repoDir, PlanOptions{Staged: true})
+		require.NoError(t, err)
+		assert.Equal(t, "HEAD", base)
+	})
+
+	t.Run("Changed resolves to HEAD", func(t *testing.T) {
+		base, err := planner.resolveDiffBase(repoDir, PlanOptions{Changed: true})
+		require.NoError(t, err)
+		assert.Equal(t, "HEAD", base)
+	})
+
+	t.Run("SinceBranch resolves the merge-base", func(t *testing.T) {
+		base, err := planner.resolveDiffBase(repoDir, PlanOptions{SinceBranch: "HEAD"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, base)
+	})
+
+	t.Run("No filter option set is an error", func(t *testing.T) {
+		_, err := planner.resolveDiffBase(repoDir, PlanOptions{})
+		require.Error(t, err)
+	})
+}
+
 // Tests for helper functions
 
 func TestMatchesToolType(t *testing.T) {
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// gzh-prefixed .gitattributes names CreatePlan honors to let a path
+// override its language or tool assignment without editing a central
+// config: "path/to/legacy.py gzh-language=python" keeps a file out of
+// every language's tools but Python's, "generated/**/*.go
+// gzh-format=off" exempts a path from formatters, and "*.proto.go
+// gzh-linter=none" restricts which linter a path runs under.
+const (
+	attrGzhLanguage = "gzh-language"
+	attrGzhFormat   = "gzh-format"
+	attrGzhLinter   = "gzh-linter"
+)
+
+// filterLanguageOverrides drops any file from files whose gzh-language
+// attribute names a language other than language (compared without
+// regard to case, so "python" names "Python"). A file is only ever
+// removed here: it is not added to the named language's files, so it
+// runs under that language's tools only if detection already put it
+// there.
+func filterLanguageOverrides(files []string, attrs map[string]map[string]string, language string) []string {
+	filtered := files[:0:0]
+	for _, file := range files {
+		if override := attrs[file][attrGzhLanguage]; override != "" && !strings.EqualFold(override, language) {
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	return filtered
+}
+
+// filterToolOverrides narrows files to the subset tool should still run
+// against, honoring two gzh-prefixed .gitattributes overrides:
+//
+//   - gzh-format=off exempts a path from every FORMAT tool.
+//   - gzh-linter=<name> restricts a path to the named LINT tool only,
+//     dropping it from every other linter.
+//
+// Tools whose Type is neither FORMAT nor LINT (TEST, BOTH) are never
+// filtered by either attribute.
+func filterToolOverrides(files []string, attrs map[string]map[string]string, tool tools.QualityTool) []string {
+	switch tool.Type() {
+	case tools.FORMAT:
+		filtered := files[:0:0]
+		for _, file := range files {
+			if attrs[file][attrGzhFormat] == "off" {
+				continue
+			}
+			filtered = append(filtered, file)
+		}
+		return filtered
+	case tools.LINT:
+		filtered := files[:0:0]
+		for _, file := range files {
+			if override := attrs[file][attrGzhLinter]; override != "" && override != tool.Name() {
+				continue
+			}
+			filtered = append(filtered, file)
+		}
+		return filtered
+	default:
+		return files
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterLanguageOverrides(t *testing.T) {
+	attrs := map[string]map[string]string{
+		"legacy.py": {attrGzhLanguage: "python2"},
+		"main.py":   {},
+	}
+
+	files := filterLanguageOverrides([]string{"legacy.py", "main.py"}, attrs, "python")
+
+	assert.Equal(t, []string{"main.py"}, files)
+}
+
+func TestFilterToolOverrides(t *testing.T) {
+	attrs := map[string]map[string]string{
+		"generated.go": {attrGzhFormat: "off"},
+		"main.go":      {attrGzhLinter: "staticcheck"},
+	}
+
+	formatter := &mockTool{name: "gofumpt", toolType: tools.FORMAT}
+	linter := &mockTool{name: "golangci-lint", toolType: tools.LINT}
+
+	files := []string{"generated.go", "main.go"}
+
+	assert.Equal(t, []string{"main.go"}, filterToolOverrides(files, attrs, formatter))
+	assert.Equal(t, []string{"generated.go"}, filterToolOverrides(files, attrs, linter))
+}
+
+func TestExecutionPlanner_CreatePlan_AppliesGitattributesOverrides(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, ".gitattributes", "generated.go gzh-format=off\n")
+
+	goFile := filepath.Join(repoDir, "main.go")
+	generatedFile := filepath.Join(repoDir, "generated.go")
+	createAndCommitFile(t, repoDir, "main.go", "package main\n")
+	createAndCommitFile(t, repoDir, "generated.go", "package main\n")
+
+	formatter := &mockTool{
+		name:     "gofumpt",
+		language: "Go",
+		toolType: tools.FORMAT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			return &tools.Result{Tool: "gofumpt", Success: true}, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	registry := &mockRegistry{tools: map[string]tools.QualityTool{"gofumpt": formatter}}
+
+	analyzer := &mockAnalyzer{
+		analyzeFunc: func(ctx context.Context, projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
+			return &AnalysisResult{
+				ProjectRoot: projectRoot,
+				Languages:   map[string][]string{"Go": {goFile, generatedFile}},
+				ConfigFiles: map[string]string{},
+			}, nil
+		},
+		selectionFunc: func(result *AnalysisResult, reg tools.ToolRegistry) map[string][]tools.QualityTool {
+			return map[string][]tools.QualityTool{"Go": {formatter}}
+		},
+	}
+
+	planner := NewExecutionPlanner(analyzer)
+
+	plan, err := planner.CreatePlan(context.Background(), repoDir, registry, PlanOptions{})
+
+	require.NoError(t, err)
+	require.Len(t, plan.Tasks, 1)
+	assert.Equal(t, []string{goFile}, plan.Tasks[0].Files)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gitFileSourceBackends lists the GitFileSource implementations every
+// TestGitFileSource_* case below runs against, so a behavior regression
+// in either the exec-based GitUtils or the go-git-based GoGitUtils is
+// caught regardless of which one a given environment would pick.
+var gitFileSourceBackends = []struct {
+	name string
+	new  func(projectRoot string) GitFileSource
+}{
+	{name: "exec", new: func(projectRoot string) GitFileSource { return &GitUtils{projectRoot: projectRoot} }},
+	{
+		name: "go-git",
+		new: func(projectRoot string) GitFileSource {
+			goGit, err := NewGoGitUtils(projectRoot)
+			if err != nil {
+				panic(err)
+			}
+			return goGit
+		},
+	},
+}
+
+func TestGitFileSource_IsGitRepository(t *testing.T) {
+	for _, backend := range gitFileSourceBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupTestGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+			assert.True(t, backend.new(repoDir).IsGitRepository())
+		})
+	}
+}
+
+func TestGitFileSource_ValidateCommitish(t *testing.T) {
+	for _, backend := range gitFileSourceBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupTestGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+			source := backend.new(repoDir)
+
+			assert.NoError(t, source.ValidateCommitish("HEAD"))
+			assert.Error(t, source.ValidateCommitish("nonexistent"))
+		})
+	}
+}
+
+func TestGitFileSource_GetChangedFiles(t *testing.T) {
+	for _, backend := range gitFileSourceBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupTestGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+			createAndCommitFile(t, repoDir, "file2.txt", "content2")
+			firstHead := commitHash(t, repoDir)
+			createAndCommitFile(t, repoDir, "file2.txt", "modified content")
+
+			files, err := backend.new(repoDir).GetChangedFiles(firstHead)
+
+			require.NoError(t, err)
+			assert.Contains(t, files, "file2.txt")
+		})
+	}
+}
+
+func TestGitFileSource_GetStagedFiles(t *testing.T) {
+	for _, backend := range gitFileSourceBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupTestGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+			stagedFile := filepath.Join(repoDir, "staged.txt")
+			require.NoError(t, os.WriteFile(stagedFile, []byte("staged"), 0o644))
+			runGitCmd(t, repoDir, "add", "staged.txt")
+
+			files, err := backend.new(repoDir).GetStagedFiles()
+
+			require.NoError(t, err)
+			assert.Contains(t, files, "staged.txt")
+		})
+	}
+}
+
+func TestGitFileSource_GetAllChangedFiles(t *testing.T) {
+	for _, backend := range gitFileSourceBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupTestGitRepo(t)
+			createAndCommitFile(t, repoDir, "committed.txt", "initial")
+
+			stagedFile := filepath.Join(repoDir, "staged.txt")
+			require.NoError(t, os.WriteFile(stagedFile, []byte("staged"), 0o644))
+			runGitCmd(t, repoDir, "add", "staged.txt")
+
+			untrackedFile := filepath.Join(repoDir, "untracked.txt")
+			require.NoError(t, os.WriteFile(untrackedFile, []byte("untracked"), 0o644))
+
+			files, err := backend.new(repoDir).GetAllChangedFiles()
+
+			require.NoError(t, err)
+			fileNames := make([]string, len(files))
+			for i, f := range files {
+				fileNames[i] = filepath.Base(f)
+			}
+			assert.Contains(t, fileNames, "staged.txt")
+			assert.Contains(t, fileNames, "untracked.txt")
+		})
+	}
+}
+
+func TestGitFileSource_ListFiles(t *testing.T) {
+	for _, backend := range gitFileSourceBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupTestGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+			createAndCommitFile(t, repoDir, "file2.txt", "content2")
+
+			files, err := backend.new(repoDir).ListFiles()
+
+			require.NoError(t, err)
+			assert.Contains(t, files, "file1.txt")
+			assert.Contains(t, files, "file2.txt")
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// GoGitUtils implements GitFileSource on top of github.com/go-git/go-git,
+// resolving refs and enumerating changed files entirely in-process. This
+// avoids shelling out to the git binary for every call (the GitUtils
+// approach), which matters on repos with thousands of files and in CI
+// images that don't ship git at all.
+type GoGitUtils struct {
+	projectRoot string
+	repo        *git.Repository
+}
+
+var _ GitFileSource = (*GoGitUtils)(nil)
+
+// NewGoGitUtils opens projectRoot as a go-git repository. It returns an
+// error for anything go-git can't handle itself - not a git repository,
+// a partial/shallow clone missing objects go-git needs, or any other
+// repository layout go-git doesn't support - so callers (see
+// NewGitFileSource) can fall back to the exec-based GitUtils instead of
+// failing outright.
+func NewGoGitUtils(projectRoot string) (*GoGitUtils, error) {
+	repo, err := git.PlainOpenWithOptions(projectRoot, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("go-git cannot open %s: %w", projectRoot, err)
+	}
+
+	return &GoGitUtils{projectRoot: projectRoot, repo: repo}, nil
+}
+
+// IsGitRepository always reports true: construction already failed if
+// go-git couldn't open projectRoot as a repository.
+func (g *GoGitUtils) IsGitRepository() bool {
+	return true
+}
+
+// ValidateCommitish reports whether commitish resolves to a commit.
+func (g *GoGitUtils) ValidateCommitish(commitish string) error {
+	if _, err := g.resolveCommit(commitish); err != nil {
+		return fmt.Errorf("invalid commit reference '%s': %w", commitish, err)
+	}
+	return nil
+}
+
+// GetChangedFiles returns files that differ between since and HEAD, by
+// diffing their commit trees. Unlike GitUtils.GetChangedFiles, this does
+// not apply rename/copy detection or .gitattributes linguist-generated
+// filtering - go-git exposes neither as directly - so a rename here
+// surfaces as a delete plus an add.
+func (g *GoGitUtils) GetChangedFiles(since string) ([]string, error) {
+	sinceCommit, err := g.resolveCommit(since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %q: %w", since, err)
+	}
+
+	headCommit, err := g.resolveCommit("HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	sinceTree, err := sinceCommit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree for %q: %w", since, err)
+	}
+
+	headTree, err := headCommit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree for HEAD: %w", err)
+	}
+
+	changes, err := sinceTree.Diff(headTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %q..HEAD: %w", since, err)
+	}
+
+	var files []string
+	for _, change := range changes {
+		if change.To.Name != "" {
+			files = append(files, change.To.Name)
+		} else if change.From.Name != "" {
+			files = append(files, change.From.Name)
+		}
+	}
+
+	return files, nil
+}
+
+// GetStagedFiles returns files staged in the index relative to HEAD.
+func (g *GoGitUtils) GetStagedFiles() ([]string, error) {
+	status, err := g.worktreeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for path, fileStatus := range status {
+		// go-git reports an untracked file with Staging == Untracked, so
+		// that has to be excluded along with Unmodified.
+		if fileStatus.Staging != git.Unmodified && fileStatus.Staging != git.Untracked {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// GetAllChangedFiles returns every staged, modified, or untracked file
+// in the worktree, as absolute paths (matching GitUtils.GetAllChangedFiles).
+func (g *GoGitUtils) GetAllChangedFiles() ([]string, error) {
+	status, err := g.worktreeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for path, fileStatus := range status {
+		if fileStatus.Staging != git.Unmodified || fileStatus.Worktree != git.Unmodified {
+			files = append(files, path)
+		}
+	}
+
+	return (&GitUtils{projectRoot: g.projectRoot}).deduplicateAndMakeAbsolute(files), nil
+}
+
+// ListFiles returns every file tracked at HEAD, by walking its tree.
+// Unlike GitUtils.ListFiles, this does not apply .gitattributes
+// linguist-generated filtering - go-git exposes neither directly - the
+// same tradeoff GetChangedFiles documents for rename/copy detection.
+func (g *GoGitUtils) ListFiles() ([]string, error) {
+	headCommit, err := g.resolveCommit("HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	tree, err := headCommit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree for HEAD: %w", err)
+	}
+
+	var files []string
+	walker := tree.Files()
+	defer walker.Close()
+	for {
+		file, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk HEAD tree: %w", err)
+		}
+		files = append(files, file.Name)
+	}
+
+	return files, nil
+}
+
+// resolveCommit resolves commitish to its *object.Commit.
+func (g *GoGitUtils) resolveCommit(commitish string) (*object.Commit, error) {
+	hash, err := g.repo.ResolveRevision(plumbing.Revision(commitish))
+	if err != nil {
+		return nil, err
+	}
+	return g.repo.CommitObject(*hash)
+}
+
+// worktreeStatus returns go-git's worktree status, which backs both
+// GetStagedFiles and GetAllChangedFiles.
+func (g *GoGitUtils) worktreeStatus() (git.Status, error) {
+	worktree, err := g.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGoGitUtils_NotARepository(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	_, err := NewGoGitUtils(tmpDir)
+
+	require.Error(t, err)
+}
+
+func TestNewGoGitUtils_OpensExistingRepository(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	goGit, err := NewGoGitUtils(repoDir)
+
+	require.NoError(t, err)
+	assert.True(t, goGit.IsGitRepository())
+}
+
+func TestGoGitUtils_ValidateCommitish(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	goGit, err := NewGoGitUtils(repoDir)
+	require.NoError(t, err)
+
+	t.Run("Valid commit reference", func(t *testing.T) {
+		assert.NoError(t, goGit.ValidateCommitish("HEAD"))
+	})
+
+	t.Run("Invalid commit reference", func(t *testing.T) {
+		err := goGit.ValidateCommitish("nonexistent")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid commit reference")
+	})
+}
+
+func TestGoGitUtils_GetChangedFiles(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+	createAndCommitFile(t, repoDir, "file2.txt", "content2")
+
+	firstHead := commitHash(t, repoDir)
+
+	filePath := filepath.Join(repoDir, "file2.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("modified content"), 0o644))
+	createAndCommitFile(t, repoDir, "file2.txt", "modified content")
+
+	goGit, err := NewGoGitUtils(repoDir)
+	require.NoError(t, err)
+
+	files, err := goGit.GetChangedFiles(firstHead)
+	require.NoError(t, err)
+	assert.Contains(t, files, "file2.txt")
+}
+
+func TestGoGitUtils_GetStagedFiles(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	stagedFile := filepath.Join(repoDir, "staged.txt")
+	require.NoError(t, os.WriteFile(stagedFile, []byte("staged"), 0o644))
+	runGitCmd(t, repoDir, "add", "staged.txt")
+
+	goGit, err := NewGoGitUtils(repoDir)
+	require.NoError(t, err)
+
+	files, err := goGit.GetStagedFiles()
+	require.NoError(t, err)
+	assert.Contains(t, files, "staged.txt")
+}
+
+func TestGoGitUtils_GetAllChangedFiles(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "committed.txt", "initial")
+
+	stagedFile := filepath.Join(repoDir, "staged.txt")
+	require.NoError(t, os.WriteFile(stagedFile, []byte("staged"), 0o644))
+	runGitCmd(t, repoDir, "add", "staged.txt")
+
+	untrackedFile := filepath.Join(repoDir, "untracked.txt")
+	require.NoError(t, os.WriteFile(untrackedFile, []byte("untracked"), 0o644))
+
+	goGit, err := NewGoGitUtils(repoDir)
+	require.NoError(t, err)
+
+	files, err := goGit.GetAllChangedFiles()
+	require.NoError(t, err)
+
+	fileNames := make([]string, len(files))
+	for i, f := range files {
+		fileNames[i] = filepath.Base(f)
+	}
+
+	assert.Contains(t, fileNames, "staged.txt")
+	assert.Contains(t, fileNames, "untracked.txt")
+}
+
+func TestGoGitUtils_ListFiles(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+	createAndCommitFile(t, repoDir, "file2.txt", "content2")
+
+	goGit, err := NewGoGitUtils(repoDir)
+	require.NoError(t, err)
+
+	files, err := goGit.ListFiles()
+	require.NoError(t, err)
+	assert.Contains(t, files, "file1.txt")
+	assert.Contains(t, files, "file2.txt")
+}
+
+func TestNewGitFileSource_PrefersGoGit(t *testing.T) {
+	repoDir := setupTestGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	source := NewGitFileSource(repoDir)
+
+	_, ok := source.(*GoGitUtils)
+	assert.True(t, ok, "expected NewGitFileSource to return *GoGitUtils for an openable repository")
+}
+
+func TestNewGitFileSource_FallsBackToExec(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	source := NewGitFileSource(tmpDir)
+
+	_, ok := source.(*GitUtils)
+	assert.True(t, ok, "expected NewGitFileSource to fall back to *GitUtils when go-git can't open the repository")
+}
+
+func runGitCmd(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+}
+
+func commitHash(t *testing.T, dir string) string {
+	t.Helper()
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	return string(out[:len(out)-1])
+}
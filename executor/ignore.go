@@ -0,0 +1,185 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ignoreFileName is the project-root file ExecutionPlanner and GitUtils
+// load ignore patterns from automatically, on top of whatever
+// PlanOptions.IgnorePatterns a caller passes inline.
+const ignoreFileName = ".gzh-quality-ignore"
+
+// IgnorePatterns is a compiled set of gitignore-style glob patterns:
+// "**" matches any depth, a single "*"/"?" stays within one path
+// segment, a trailing "/" restricts the pattern to directories (and
+// everything under them), and a leading "!" negates a pattern, letting
+// a later entry re-include a path an earlier one excluded. Patterns are
+// evaluated in the order they were given, the same left-to-right,
+// last-match-wins rule `git check-ignore` applies to .gitignore.
+type IgnorePatterns struct {
+	entries []ignoreEntry
+}
+
+type ignoreEntry struct {
+	negate bool
+	re     *regexp.Regexp
+}
+
+// NewIgnorePatterns compiles patterns into an IgnorePatterns. Blank
+// lines and lines starting with "#" are ignored, so a caller can feed it
+// the raw lines of a .gzh-quality-ignore file directly.
+func NewIgnorePatterns(patterns []string) (*IgnorePatterns, error) {
+	ip := &IgnorePatterns{}
+
+	for _, raw := range patterns {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		negate := strings.HasPrefix(line, "!")
+		if negate {
+			line = line[1:]
+		}
+
+		re, err := compileIgnorePattern(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore pattern %q: %w", raw, err)
+		}
+
+		ip.entries = append(ip.entries, ignoreEntry{negate: negate, re: re})
+	}
+
+	return ip, nil
+}
+
+// LoadIgnoreFile reads ignoreFileName from projectRoot and compiles its
+// lines into an IgnorePatterns. A missing file is not an error - it
+// compiles to an empty, always-non-matching IgnorePatterns, since most
+// projects won't have one.
+func LoadIgnoreFile(projectRoot string) (*IgnorePatterns, error) {
+	lines, err := readIgnoreFileLines(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+	return NewIgnorePatterns(lines)
+}
+
+// readIgnoreFileLines returns ignoreFileName's lines, or nil if the file
+// doesn't exist.
+func readIgnoreFileLines(projectRoot string) ([]string, error) {
+	f, err := os.Open(filepath.Join(projectRoot, ignoreFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", ignoreFileName, err)
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+// combinedIgnorePatterns loads projectRoot's ignore file and appends
+// extra (e.g. PlanOptions.IgnorePatterns) after it, so an inline pattern
+// can negate one loaded from the file.
+func combinedIgnorePatterns(projectRoot string, extra []string) (*IgnorePatterns, error) {
+	lines, err := readIgnoreFileLines(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+	return NewIgnorePatterns(append(lines, extra...))
+}
+
+// Match reports whether path is ignored: the last pattern that matches
+// it wins, and a negated match un-ignores it. A path nothing matches is
+// not ignored.
+func (ip *IgnorePatterns) Match(path string) bool {
+	if ip == nil {
+		return false
+	}
+
+	path = filepath.ToSlash(path)
+	ignored := false
+	for _, e := range ip.entries {
+		if e.re.MatchString(path) {
+			ignored = !e.negate
+		}
+	}
+	return ignored
+}
+
+// Filter returns the subset of files Match doesn't ignore, preserving
+// order. A nil IgnorePatterns (no patterns configured) returns files
+// unchanged.
+func (ip *IgnorePatterns) Filter(files []string) []string {
+	if ip == nil || len(ip.entries) == 0 {
+		return files
+	}
+
+	var filtered []string
+	for _, f := range files {
+		if !ip.Match(f) {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
+// compileIgnorePattern translates a single gitignore-style glob (minus
+// any leading "!", already stripped by the caller) into a regexp
+// anchored to a full path match. A pattern containing no "/" (other than
+// a trailing dir-only one) matches its basename at any depth, matching
+// gitignore's own rule that a slash-free pattern isn't anchored to root.
+func compileIgnorePattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			i++
+			if i+1 < len(runes) && runes[i+1] == '/' {
+				b.WriteString("(?:.*/)?")
+				i++
+			} else {
+				b.WriteString(".*")
+			}
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	if dirOnly {
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnorePatterns_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		files    []string
+		expected []string
+	}{
+		{
+			name:     "Basename glob matches at any depth",
+			patterns: []string{"*.pb.go"},
+			files:    []string{"main.go", "api/v1/service.pb.go", "gen.pb.go"},
+			expected: []string{"main.go"},
+		},
+		{
+			name:     "Double-star matches any depth",
+			patterns: []string{"vendor/**"},
+			files:    []string{"vendor/a/b.go", "vendor/c.go", "internal/vendor.go"},
+			expected: []string{"internal/vendor.go"},
+		},
+		{
+			name:     "Dir-only pattern excludes everything under the directory",
+			patterns: []string{"node_modules/"},
+			files:    []string{"node_modules/pkg/index.js", "src/node_modules.go"},
+			expected: []string{"src/node_modules.go"},
+		},
+		{
+			name:     "Negation re-includes a path an earlier pattern excluded",
+			patterns: []string{"*.generated.ts", "!keep.generated.ts"},
+			files:    []string{"a.generated.ts", "keep.generated.ts", "b.ts"},
+			expected: []string{"keep.generated.ts", "b.ts"},
+		},
+		{
+			name:     "Per-language suffix only matches that language's files",
+			patterns: []string{"*.generated.ts"},
+			files:    []string{"widget.generated.ts", "widget.generated.go", "widget.ts"},
+			expected: []string{"widget.generated.go", "widget.ts"},
+		},
+		{
+			name:     "Comments and blank lines are skipped",
+			patterns: []string{"# a comment", "", "*_gen.go"},
+			files:    []string{"model_gen.go", "model.go"},
+			expected: []string{"model.go"},
+		},
+		{
+			name:     "No patterns keeps every file",
+			patterns: nil,
+			files:    []string{"a.go", "b.go"},
+			expected: []string{"a.go", "b.go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, err := NewIgnorePatterns(tt.patterns)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ip.Filter(tt.files))
+		})
+	}
+}
+
+func TestLoadIgnoreFile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	t.Run("missing file compiles to no-op", func(t *testing.T) {
+		ip, err := LoadIgnoreFile(tmpDir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.go"}, ip.Filter([]string{"a.go"}))
+	})
+
+	t.Run("present file is applied", func(t *testing.T) {
+		err := os.WriteFile(filepath.Join(tmpDir, ignoreFileName), []byte("*.pb.go\n"), 0o644)
+		require.NoError(t, err)
+
+		ip, err := LoadIgnoreFile(tmpDir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.go"}, ip.Filter([]string{"a.go", "b.pb.go"}))
+	})
+}
+
+func TestExecutionPlanner_CreatePlan_AppliesIgnorePatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	tsFile := filepath.Join(tmpDir, "widget.ts")
+	genFile := filepath.Join(tmpDir, "widget.generated.ts")
+
+	tool := &mockTool{
+		name:     "eslint",
+		language: "TypeScript",
+		toolType: tools.LINT,
+		executeFunc: func(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+			return &tools.Result{Tool: "eslint", Success: true}, nil
+		},
+		validateFunc: func() error { return nil },
+	}
+
+	registry := &mockRegistry{tools: map[string]tools.QualityTool{"eslint": tool}}
+
+	analyzer := &mockAnalyzer{
+		analyzeFunc: func(ctx context.Context, projectRoot string, reg tools.ToolRegistry) (*AnalysisResult, error) {
+			return &AnalysisResult{
+				ProjectRoot: projectRoot,
+				Languages:   map[string][]string{"TypeScript": {tsFile, genFile}},
+				ConfigFiles: map[string]string{},
+			}, nil
+		},
+		selectionFunc: func(result *AnalysisResult, reg tools.ToolRegistry) map[string][]tools.QualityTool {
+			return map[string][]tools.QualityTool{"TypeScript": {tool}}
+		},
+	}
+
+	planner := NewExecutionPlanner(analyzer)
+
+	plan, err := planner.CreatePlan(context.Background(), tmpDir, registry, PlanOptions{
+		IgnorePatterns: []string{"*.generated.ts"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, plan.Tasks, 1)
+	assert.Equal(t, []string{tsFile}, plan.Tasks[0].Files)
+}
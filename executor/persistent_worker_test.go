@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// persistentMockTool is a QualityTool that also implements
+// tools.PersistentTool, recording how many sessions were started and how
+// many times Execute ran as a one-shot call (i.e. without a session).
+type persistentMockTool struct {
+	name            string
+	sessionsStarted int32
+	oneShotCalls    int32
+}
+
+func (m *persistentMockTool) Name() string                                { return m.name }
+func (m *persistentMockTool) Language() string                            { return "Go" }
+func (m *persistentMockTool) Type() tools.ToolType                        { return tools.LINT }
+func (m *persistentMockTool) IsAvailable() bool                           { return true }
+func (m *persistentMockTool) Install(ctx context.Context) error           { return nil }
+func (m *persistentMockTool) GetVersion() (string, error)                 { return "1.0.0", nil }
+func (m *persistentMockTool) Upgrade(ctx context.Context) error           { return nil }
+func (m *persistentMockTool) FindConfigFiles(projectRoot string) []string { return nil }
+func (m *persistentMockTool) SupportedExtensions() []string               { return []string{".go"} }
+
+func (m *persistentMockTool) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+	atomic.AddInt32(&m.oneShotCalls, 1)
+	return &tools.Result{Tool: m.name, Success: true, FilesProcessed: len(files)}, nil
+}
+
+func (m *persistentMockTool) StartSession(ctx context.Context) (tools.Session, error) {
+	atomic.AddInt32(&m.sessionsStarted, 1)
+	return &persistentMockSession{name: m.name}, nil
+}
+
+type persistentMockSession struct {
+	name   string
+	closed bool
+}
+
+func (s *persistentMockSession) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
+	return &tools.Result{Tool: s.name, Success: true, FilesProcessed: len(files)}, nil
+}
+
+func (s *persistentMockSession) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestParallelExecutor_ExecuteParallel_ReusesPersistentSession(t *testing.T) {
+	executor := NewParallelExecutor(1, 1*time.Minute)
+	tool := &persistentMockTool{name: "hot-linter"}
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: tool, Files: []string{"a.go"}},
+			{Tool: tool, Files: []string{"b.go"}},
+			{Tool: tool, Files: []string{"c.go"}},
+		},
+	}
+
+	results, err := executor.ExecuteParallel(context.Background(), plan, 1)
+
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&tool.sessionsStarted), "expected one session for three tasks on one worker")
+	assert.EqualValues(t, 0, atomic.LoadInt32(&tool.oneShotCalls), "session path should bypass the one-shot Execute")
+}
+
+// failingSessionTool fails to start a session so runTask must fall back
+// to the plain Execute path instead of erroring the task out.
+type failingSessionTool struct {
+	persistentMockTool
+}
+
+func (m *failingSessionTool) StartSession(ctx context.Context) (tools.Session, error) {
+	return nil, errors.New("daemon mode unsupported")
+}
+
+func TestParallelExecutor_ExecuteParallel_FallsBackWhenSessionFailsToStart(t *testing.T) {
+	executor := NewParallelExecutor(1, 1*time.Minute)
+	tool := &failingSessionTool{persistentMockTool{name: "flaky-linter"}}
+
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: tool, Files: []string{"a.go"}},
+		},
+	}
+
+	results, err := executor.ExecuteParallel(context.Background(), plan, 1)
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&tool.oneShotCalls))
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/cache"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReporter is a ProgressReporter that records every Event it
+// receives, in order, so a test can assert on event ordering without a
+// real terminal.
+type fakeReporter struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *fakeReporter) HandleEvent(evt Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, evt)
+}
+
+func (r *fakeReporter) snapshot() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Event, len(r.events))
+	copy(out, r.events)
+	return out
+}
+
+func TestParallelExecutorWithProgress_StartBeforeFinish(t *testing.T) {
+	reporter := &fakeReporter{}
+	executor := NewParallelExecutorWithProgress(2, 5*time.Second, nil, reporter)
+
+	tool := newMockCacheableTool("gofumpt", "Go")
+	plan := &tools.ExecutionPlan{
+		Tasks: []tools.Task{
+			{Tool: tool, Files: []string{"main.go"}},
+		},
+	}
+
+	_, err := executor.ExecuteParallel(context.Background(), plan, 1)
+	require.NoError(t, err)
+
+	// Give the progressSink goroutine a moment to drain the buffered
+	// channel onto reporter before asserting on it.
+	require.Eventually(t, func() bool {
+		return len(reporter.snapshot()) >= 2
+	}, time.Second, 10*time.Millisecond)
+
+	events := reporter.snapshot()
+
+	var startedAt, finishedAt = -1, -1
+	for i, evt := range events {
+		switch evt.Phase {
+		case EventStarted:
+			startedAt = i
+		case EventFinished:
+			finishedAt = i
+		}
+	}
+
+	require.GreaterOrEqual(t, startedAt, 0, "expected an EventStarted")
+	require.GreaterOrEqual(t, finishedAt, 0, "expected an EventFinished")
+	assert.Less(t, startedAt, finishedAt, "EventStarted must be reported before EventFinished")
+}
+
+func TestParallelExecutorWithProgress_CacheHitSkipsStarted(t *testing.T) {
+	cacheDir := t.TempDir()
+	cacheManager, err := cache.NewCacheManager(filepath.Join(cacheDir, "cache"), 100*1024*1024, 24*time.Hour)
+	require.NoError(t, err)
+	defer cacheManager.Close()
+
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "main.go")
+	require.NoError(t, os.WriteFile(testFile, []byte("package main\n"), 0o644))
+
+	tool := newMockCacheableTool("gofumpt", "Go")
+
+	// Warm the cache with a first run, using a plain (non-progress)
+	// executor so this priming run's own Events don't pollute the
+	// reporter under test below.
+	warm := NewParallelExecutorWithCache(1, 5*time.Second, cacheManager)
+	_, err = warm.ExecuteParallel(context.Background(), &tools.ExecutionPlan{
+		Tasks: []tools.Task{{Tool: tool, Files: []string{testFile}}},
+	}, 1)
+	require.NoError(t, err)
+
+	reporter := &fakeReporter{}
+	executor := NewParallelExecutorWithProgress(1, 5*time.Second, cacheManager, reporter)
+	_, err = executor.ExecuteParallel(context.Background(), &tools.ExecutionPlan{
+		Tasks: []tools.Task{{Tool: tool, Files: []string{testFile}}},
+	}, 1)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(reporter.snapshot()) >= 1
+	}, time.Second, 10*time.Millisecond)
+
+	for _, evt := range reporter.snapshot() {
+		assert.NotEqual(t, EventStarted, evt.Phase, "a fully cached task must not emit EventStarted")
+	}
+}
@@ -6,22 +6,30 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/Gizzahub/gzh-cli-quality/cache"
+	gitutils "github.com/Gizzahub/gzh-cli-quality/git"
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
 
 // ParallelExecutor executes quality tools in parallel.
 type ParallelExecutor struct {
-	maxWorkers int
-	timeout    time.Duration
+	maxWorkers   int
+	timeout      time.Duration
+	toolTimeouts map[string]time.Duration
+	events       chan<- Event
+	cacheManager cache.Manager
+	argMaxBytes  int
 }
 
 // NewParallelExecutor creates a new parallel executor.
@@ -34,8 +42,113 @@ func NewParallelExecutor(maxWorkers int, timeout time.Duration) *ParallelExecuto
 	}
 
 	return &ParallelExecutor{
-		maxWorkers: maxWorkers,
-		timeout:    timeout,
+		maxWorkers:  maxWorkers,
+		timeout:     timeout,
+		argMaxBytes: defaultArgMaxBytes(),
+	}
+}
+
+// SetArgMaxBytes overrides the command-line length budget runChunked
+// stays under when splitting a task's Files into per-invocation batches,
+// for tests that want to exercise chunking without needing a file list
+// anywhere near a real OS's ARG_MAX. Values <= 0 restore the per-OS
+// default (see defaultArgMaxBytes).
+func (e *ParallelExecutor) SetArgMaxBytes(n int) {
+	if n <= 0 {
+		n = defaultArgMaxBytes()
+	}
+	e.argMaxBytes = n
+}
+
+// NewParallelExecutorWithCache creates a parallel executor that memoizes
+// every task's per-file results through cacheManager (see cache.CachedTool)
+// before running the underlying tool, so a second run over unchanged
+// files skips straight to cached issues.
+func NewParallelExecutorWithCache(maxWorkers int, timeout time.Duration, cacheManager cache.Manager) *ParallelExecutor {
+	e := NewParallelExecutor(maxWorkers, timeout)
+	e.cacheManager = cacheManager
+	return e
+}
+
+// ProgressReporter receives the same lifecycle Events SetEventSink would,
+// as a method call instead of over a channel the caller drains itself, so
+// a live terminal UI (see the progress package) needs no reading loop of
+// its own. NewParallelExecutorWithProgress delivers the calls from a
+// separate goroutine through a buffered channel (see progressSink), so
+// they arrive after the transition, and an event is dropped when that
+// buffer is full. Satisfied by *progress.Terminal without executor
+// importing progress, the same pattern as Tracer for *logx.Logger.
+type ProgressReporter interface {
+	HandleEvent(evt Event)
+}
+
+// progressSink adapts a ProgressReporter to the chan<- Event shape
+// SetEventSink expects, by forwarding every emitted Event to a
+// goroutine that calls reporter.HandleEvent. This lets
+// NewParallelExecutorWithProgress reuse emit/SetEventSink's existing
+// non-blocking-send semantics instead of giving ProgressReporter its own
+// parallel emission path.
+func progressSink(reporter ProgressReporter) chan<- Event {
+	ch := make(chan Event, 64)
+	go func() {
+		for evt := range ch {
+			reporter.HandleEvent(evt)
+		}
+	}()
+	return ch
+}
+
+// NewParallelExecutorWithProgress creates a parallel executor whose
+// per-task lifecycle Events drive reporter live, in addition to the
+// cache.Manager-backed memoization NewParallelExecutorWithCache
+// provides (cacheManager may be nil to disable caching while still
+// reporting progress).
+func NewParallelExecutorWithProgress(maxWorkers int, timeout time.Duration, cacheManager cache.Manager, reporter ProgressReporter) *ParallelExecutor {
+	e := NewParallelExecutorWithCache(maxWorkers, timeout, cacheManager)
+	e.SetEventSink(progressSink(reporter))
+	return e
+}
+
+// CacheEnabled reports whether this executor was built with a cache
+// manager (NewParallelExecutorWithCache) that is itself currently
+// enabled, i.e. whether runTask will serve results from cache.CachedTool.
+func (e *ParallelExecutor) CacheEnabled() bool {
+	return e.cacheManager != nil && e.cacheManager.Enabled()
+}
+
+// SetToolTimeout sets the maximum duration a single task for tool may
+// run before its own context is cancelled and the task fails with
+// ErrToolTimeout, overridden per-task by tools.Task.Timeout when a
+// caller (e.g. PlanOptions.ToolTimeouts) sets one. Every task already
+// runs on its own independent clock (see ParallelExecutor.taskTimeout),
+// so a hung tool only fails its own task instead of taking every other
+// concurrently-running tool's result down with it.
+func (e *ParallelExecutor) SetToolTimeout(tool string, d time.Duration) {
+	if e.toolTimeouts == nil {
+		e.toolTimeouts = make(map[string]time.Duration)
+	}
+	e.toolTimeouts[tool] = d
+}
+
+// SetEventSink installs ch as the destination for per-task lifecycle
+// Events during ExecuteParallel (started/finished/timed-out/cancelled),
+// so a TUI or CI reporter can render live per-tool status. Passing nil
+// (the default) disables event emission. Events are sent
+// non-blockingly: a sink that isn't draining its channel fast enough
+// loses events rather than stalling task execution.
+func (e *ParallelExecutor) SetEventSink(ch chan<- Event) {
+	e.events = ch
+}
+
+// emit sends evt to the installed event sink, if any, without blocking
+// task execution on a slow or absent reader.
+func (e *ParallelExecutor) emit(evt Event) {
+	if e.events == nil {
+		return
+	}
+	select {
+	case e.events <- evt:
+	default:
 	}
 }
 
@@ -44,113 +157,172 @@ func (e *ParallelExecutor) Execute(ctx context.Context, plan *tools.ExecutionPla
 	return e.ExecuteParallel(ctx, plan, 1)
 }
 
-// ExecuteParallel runs the execution plan with parallel execution.
+// ExecuteParallel runs the execution plan with parallel execution,
+// scheduling tasks as a DAG rather than a flat priority-sorted queue: a
+// task only becomes eligible once every task its DependsOn names has
+// finished, and whether it then actually runs or is skipped depends on
+// its RunIf against those dependencies' outcomes (see
+// dependencyGraph.resolve). Tasks with no DependsOn behave exactly as
+// before - eligible immediately, highest Priority dispatched first.
+//
+// Each task is bounded by its own timeout (see runTask), not a single
+// clock shared across the whole plan, so a slow or hung tool only fails
+// its own task instead of cutting off every task still running
+// concurrently with it. ctx itself is honored as the caller's own
+// cancellation/deadline, stopping dispatch of further tasks and
+// returning whatever results are already in.
 func (e *ParallelExecutor) ExecuteParallel(ctx context.Context, plan *tools.ExecutionPlan, workers int) ([]*tools.Result, error) {
 	if workers <= 0 {
 		workers = e.maxWorkers
 	}
 
-	// Sort tasks by priority (higher priority first)
-	sortedTasks := make([]tools.Task, len(plan.Tasks))
-	copy(sortedTasks, plan.Tasks)
-	sort.Slice(sortedTasks, func(i, j int) bool {
-		return sortedTasks[i].Priority > sortedTasks[j].Priority
-	})
-
-	// Create worker pool
-	taskChan := make(chan tools.Task, len(sortedTasks))
-	resultChan := make(chan *tools.Result, len(sortedTasks))
-	errorChan := make(chan error, len(sortedTasks))
+	tasks := plan.Tasks
+	graph, err := newDependencyGraph(tasks)
+	if err != nil {
+		return nil, err
+	}
 
-	// Create timeout context
-	timeoutCtx, cancel := context.WithTimeout(ctx, e.timeout)
-	defer cancel()
+	readyChan := make(chan int, len(tasks))
+	completionChan := make(chan taskCompletion, len(tasks))
 
 	// Start workers
 	var wg sync.WaitGroup
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go e.worker(timeoutCtx, &wg, taskChan, resultChan, errorChan)
+		go e.worker(ctx, i, &wg, tasks, readyChan, completionChan)
 	}
 
-	// Send tasks to workers
-	go func() {
-		defer close(taskChan)
-		for _, task := range sortedTasks {
-			select {
-			case taskChan <- task:
-			case <-timeoutCtx.Done():
-				return
-			}
-		}
-	}()
-
-	// Wait for all workers to complete
 	go func() {
 		wg.Wait()
-		close(resultChan)
-		close(errorChan)
+		close(completionChan)
 	}()
 
-	// Collect results
-	var results []*tools.Result
-	var errors []error
+	results := make([]*tools.Result, len(tasks))
+	var taskErrors []error
 
-	for {
+	pending := len(tasks)
+	for _, idx := range graph.seed() {
+		readyChan <- idx
+	}
+
+	for pending > 0 {
 		select {
-		case result, ok := <-resultChan:
+		case c, ok := <-completionChan:
 			if !ok {
-				resultChan = nil
-			} else {
-				results = append(results, result)
+				// Every worker exited (e.g. ctx cancellation) before
+				// every task reported in; stop waiting rather than
+				// blocking forever on a channel nothing will send to.
+				pending = 0
+				continue
 			}
-		case err, ok := <-errorChan:
-			if !ok {
-				errorChan = nil
-			} else if err != nil {
-				errors = append(errors, err)
+
+			results[c.index] = c.result
+			pending--
+			if c.err != nil {
+				taskErrors = append(taskErrors, &TaskError{Task: tasks[c.index], Err: c.err})
 			}
-		case <-timeoutCtx.Done():
-			return results, fmt.Errorf("execution timed out after %v", e.timeout)
+
+			succeeded := c.err == nil && c.result != nil && c.result.Success
+			newlyReady, newlySkipped := graph.resolve(c.index, succeeded)
+			for _, idx := range newlySkipped {
+				results[idx] = skippedResult(tasks[idx])
+				pending--
+			}
+			for _, idx := range newlyReady {
+				readyChan <- idx
+			}
+		case <-ctx.Done():
+			// Only the caller's own cancellation/deadline stops dispatching
+			// new tasks here - an individual task's own timeout is resolved
+			// entirely within runTask, so one slow tool can no longer take
+			// every other concurrently-running task down with it.
+			close(readyChan)
+			if ctx.Err() == context.Canceled {
+				return compactResults(results), ErrCancelled
+			}
+			return compactResults(results), fmt.Errorf("%w: %w", ErrDeadlineExceeded, ctx.Err())
 		}
+	}
+	close(readyChan)
 
-		if resultChan == nil && errorChan == nil {
-			break
+	// Return every failure, not just the first, so one tool's error
+	// doesn't mask failures in others that ran concurrently with it.
+	if len(taskErrors) > 0 {
+		return compactResults(results), &MultiError{Errors: taskErrors}
+	}
+
+	return compactResults(results), nil
+}
+
+// compactResults drops the nil slots a timeout or cancellation can
+// leave behind in ExecuteParallel's index-aligned results slice (a task
+// that was still pending, or never even made it out of the ready queue,
+// when the deadline fired), so a caller sees only tasks that actually
+// ran or were skipped.
+func compactResults(results []*tools.Result) []*tools.Result {
+	out := make([]*tools.Result, 0, len(results))
+	for _, r := range results {
+		if r != nil {
+			out = append(out, r)
 		}
 	}
+	return out
+}
 
-	// Return first error if any occurred
-	if len(errors) > 0 {
-		return results, errors[0]
+// filterIssuesByFile returns the subset of issues reported against file,
+// preserving their relative order.
+func filterIssuesByFile(issues []tools.Issue, file string) []tools.Issue {
+	out := make([]tools.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if issue.File == file {
+			out = append(out, issue)
+		}
 	}
+	return out
+}
 
-	return results, nil
+// skippedResult is the Result ExecuteParallel reports for a task the
+// dependency graph decided not to run at all, because its RunIf didn't
+// match how its DependsOn tasks concluded.
+func skippedResult(task tools.Task) *tools.Result {
+	return &tools.Result{
+		Tool:     task.Tool.Name(),
+		Language: task.Tool.Language(),
+		Skipped:  true,
+	}
 }
 
-// worker processes tasks from the task channel.
-func (e *ParallelExecutor) worker(ctx context.Context, wg *sync.WaitGroup, taskChan <-chan tools.Task, resultChan chan<- *tools.Result, errorChan chan<- error) {
+// taskCompletion is what a worker reports back to ExecuteParallel's
+// scheduling loop once it finishes running the task at index.
+type taskCompletion struct {
+	index  int
+	result *tools.Result
+	err    error
+}
+
+// worker pulls ready task indices from readyChan until it's closed. It
+// holds one open tools.Session per PersistentTool it encounters, for the
+// duration of this ExecuteParallel call, so a hot tool (e.g.
+// golangci-lint, ruff) pays its process-startup cost once per worker
+// instead of once per task.
+func (e *ParallelExecutor) worker(ctx context.Context, id int, wg *sync.WaitGroup, tasks []tools.Task, readyChan <-chan int, completionChan chan<- taskCompletion) {
 	defer wg.Done()
 
+	sessions := make(map[string]tools.Session)
+	defer closeSessions(sessions)
+
 	for {
 		select {
-		case task, ok := <-taskChan:
+		case idx, ok := <-readyChan:
 			if !ok {
+				e.emit(Event{Worker: id, Phase: EventWorkerIdle})
 				return
 			}
 
-			// Execute the task
-			result, err := task.Tool.Execute(ctx, task.Files, task.Options)
-
-			// Send result
-			select {
-			case resultChan <- result:
-			case <-ctx.Done():
-				return
-			}
+			result, err := e.runTask(ctx, id, tasks[idx], sessions)
 
-			// Send error if any
 			select {
-			case errorChan <- err:
+			case completionChan <- taskCompletion{index: idx, result: result, err: err}:
 			case <-ctx.Done():
 				return
 			}
@@ -161,22 +333,568 @@ func (e *ParallelExecutor) worker(ctx context.Context, wg *sync.WaitGroup, taskC
 	}
 }
 
+// dependencyGraph tracks ExecuteParallel's DAG scheduling state: each
+// task's still-unresolved DependsOn count, the reverse edges needed to
+// advance that count as dependencies finish, and the success/failure
+// outcome of every task resolved so far, which a RunOnSuccess or
+// RunOnFailure task needs in order to decide whether it runs at all.
+type dependencyGraph struct {
+	tasks      []tools.Task
+	nameIndex  map[string]int
+	remaining  []int
+	dependents [][]int
+	succeeded  []bool
+}
+
+// newDependencyGraph builds the edges DependsOn describes and rejects a
+// plan whose dependencies form a cycle. CreatePlan already runs
+// detectTaskCycle on the dependencies it assigns itself, but a
+// hand-built tools.ExecutionPlan (tests, or a future non-CLI caller)
+// isn't guaranteed to have gone through CreatePlan first.
+func newDependencyGraph(tasks []tools.Task) (*dependencyGraph, error) {
+	if err := detectTaskCycle(tasks); err != nil {
+		return nil, err
+	}
+
+	g := &dependencyGraph{
+		tasks:      tasks,
+		nameIndex:  make(map[string]int, len(tasks)),
+		remaining:  make([]int, len(tasks)),
+		dependents: make([][]int, len(tasks)),
+		succeeded:  make([]bool, len(tasks)),
+	}
+	for i, t := range tasks {
+		g.nameIndex[taskName(t)] = i
+	}
+	for i, t := range tasks {
+		for _, dep := range t.DependsOn {
+			depIdx, ok := g.nameIndex[dep]
+			if !ok {
+				continue
+			}
+			g.remaining[i]++
+			g.dependents[depIdx] = append(g.dependents[depIdx], i)
+		}
+	}
+
+	return g, nil
+}
+
+// taskName returns t.Name, falling back to its tool's name for a task
+// that was built without going through CreatePlan.
+func taskName(t tools.Task) string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return t.Tool.Name()
+}
+
+// seed returns the indices of every task with no DependsOn, highest
+// Priority first, for ExecuteParallel's initial dispatch.
+func (g *dependencyGraph) seed() []int {
+	var ready []int
+	for i, n := range g.remaining {
+		if n == 0 {
+			ready = append(ready, i)
+		}
+	}
+	g.sortByPriority(ready)
+	return ready
+}
+
+// resolve records that the task at index finished with the given
+// success outcome and advances every task that depended on it. A
+// dependent whose RunIf doesn't match its now-fully-resolved
+// dependencies is skipped rather than returned as ready, and that skip
+// cascades to whatever depended on it in turn.
+func (g *dependencyGraph) resolve(index int, succeeded bool) (ready, skipped []int) {
+	g.succeeded[index] = succeeded
+
+	queue := []int{index}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for _, dep := range g.dependents[cur] {
+			g.remaining[dep]--
+			if g.remaining[dep] > 0 {
+				continue
+			}
+
+			if g.shouldSkip(dep) {
+				g.succeeded[dep] = false
+				skipped = append(skipped, dep)
+				queue = append(queue, dep)
+			} else {
+				ready = append(ready, dep)
+			}
+		}
+	}
+
+	g.sortByPriority(ready)
+	return ready, skipped
+}
+
+// shouldSkip reports whether the task at index should be skipped rather
+// than run, now that every task it DependsOn has resolved.
+func (g *dependencyGraph) shouldSkip(index int) bool {
+	task := g.tasks[index]
+	switch task.RunIf {
+	case tools.RunOnSuccess:
+		for _, dep := range task.DependsOn {
+			if di, ok := g.nameIndex[dep]; ok && !g.succeeded[di] {
+				return true
+			}
+		}
+		return false
+	case tools.RunOnFailure:
+		for _, dep := range task.DependsOn {
+			if di, ok := g.nameIndex[dep]; ok && !g.succeeded[di] {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// sortByPriority orders indices by Priority descending, in place,
+// preserving the plan's "higher priority dispatched first" ordering
+// within any single ready set.
+func (g *dependencyGraph) sortByPriority(indices []int) {
+	sort.Slice(indices, func(i, j int) bool {
+		return g.tasks[indices[i]].Priority > g.tasks[indices[j]].Priority
+	})
+}
+
+// cycleColor marks a task's visitation state during detectTaskCycle's
+// depth-first search: white (unvisited), gray (on the current path), or
+// black (fully explored with no cycle found through it).
+type cycleColor int
+
+const (
+	cycleWhite cycleColor = iota
+	cycleGray
+	cycleBlack
+)
+
+// detectTaskCycle reports an error naming the offending chain if tasks'
+// DependsOn edges form a cycle, via a classic gray/black depth-first
+// search. A DependsOn name that doesn't match any task is ignored here;
+// ExecuteParallel's dependencyGraph does the same, since such a name can
+// never be satisfied or unsatisfied and so can't participate in a cycle.
+func detectTaskCycle(tasks []tools.Task) error {
+	nameIndex := make(map[string]int, len(tasks))
+	for i, t := range tasks {
+		nameIndex[taskName(t)] = i
+	}
+
+	colors := make([]cycleColor, len(tasks))
+	var path []string
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		colors[i] = cycleGray
+		path = append(path, taskName(tasks[i]))
+
+		for _, dep := range tasks[i].DependsOn {
+			depIdx, ok := nameIndex[dep]
+			if !ok {
+				continue
+			}
+			switch colors[depIdx] {
+			case cycleGray:
+				return fmt.Errorf("%w: %s -> %s", ErrDependencyCycle, strings.Join(path, " -> "), dep)
+			case cycleWhite:
+				if err := visit(depIdx); err != nil {
+					return err
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		colors[i] = cycleBlack
+		return nil
+	}
+
+	for i := range tasks {
+		if colors[i] == cycleWhite {
+			if err := visit(i); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// typeCheckerDependents maps a type-checking tool's name to the linters
+// that should only run once it has, since a linter consuming its
+// diagnostics (or running on code it would otherwise reject) produces
+// noisier or duplicate findings if it races ahead of the type checker.
+var typeCheckerDependents = map[string][]string{
+	"tsc": {"eslint"},
+}
+
+// assignTaskDependencies fills in each task's DependsOn based on two
+// repo-wide ordering rules that Priority alone can't express, because
+// Priority only biases which ready task a free worker picks up next - it
+// doesn't block a task from starting before another one finishes:
+//
+//  1. Within each language, every FORMAT tool must finish before any
+//     non-FORMAT tool for that language starts, so a linter never flags
+//     style issues a formatter was about to fix.
+//  2. Each entry in typeCheckerDependents makes its listed tools depend
+//     on that type checker, for every language both appear in.
+func assignTaskDependencies(tasks []tools.Task) {
+	formattersByLanguage := make(map[string][]string)
+	for _, t := range tasks {
+		if t.Tool.Type() == tools.FORMAT {
+			formattersByLanguage[t.Tool.Language()] = append(formattersByLanguage[t.Tool.Language()], taskName(t))
+		}
+	}
+
+	present := make(map[string]bool, len(tasks))
+	for _, t := range tasks {
+		present[taskName(t)] = true
+	}
+
+	for i := range tasks {
+		name := taskName(tasks[i])
+		lang := tasks[i].Tool.Language()
+
+		if tasks[i].Tool.Type() != tools.FORMAT {
+			for _, formatter := range formattersByLanguage[lang] {
+				if formatter != name {
+					tasks[i].DependsOn = append(tasks[i].DependsOn, formatter)
+				}
+			}
+		}
+
+		for checker, dependents := range typeCheckerDependents {
+			if !present[checker] || checker == name {
+				continue
+			}
+			for _, dependent := range dependents {
+				if dependent == name {
+					tasks[i].DependsOn = append(tasks[i].DependsOn, checker)
+				}
+			}
+		}
+	}
+}
+
+// runTask runs task to completion, always bounding it by its own
+// independent timeout (see taskTimeout) derived from ctx, and reports
+// its lifecycle on the installed event sink. A failure is translated to
+// whichever of ErrToolTimeout, ErrCancelled, or ErrDeadlineExceeded
+// actually caused it, so a caller distinguishing "this one tool hung"
+// from "the caller's own deadline or Ctrl+C got here first" doesn't have
+// to guess from a generic timeout string.
+//
+// Before doing anything else, it peeks the cache (if configured): a task
+// whose files are all already cached reports a single EventCacheHit and
+// returns without ever emitting EventStarted, so a reporter never shows
+// that worker as briefly "busy" for a run that did no real work.
+func (e *ParallelExecutor) runTask(ctx context.Context, workerID int, task tools.Task, sessions map[string]tools.Session) (*tools.Result, error) {
+	if cached, ok := e.wrapCache(task.Tool); !task.Options.CacheDisabled && ok {
+		if result, hit := cached.Peek(task.Files, task.Options); hit {
+			e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventCacheHit})
+			e.emitIssues(workerID, task, result)
+			return result, nil
+		}
+	}
+
+	e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventStarted, Files: task.Files})
+
+	taskCtx, cancel := context.WithTimeout(ctx, e.taskTimeout(task))
+	defer cancel()
+
+	start := time.Now()
+	result, err := e.execute(taskCtx, task, sessions)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventFinished, Elapsed: elapsed})
+		e.emitIssues(workerID, task, result)
+		return result, nil
+	}
+
+	switch {
+	case taskCtx.Err() != nil && ctx.Err() == nil:
+		// taskCtx's own per-task budget fired - ctx (the caller's own
+		// context) is still healthy, so only this task is affected.
+		err = &ErrToolTimeout{Tool: task.Tool.Name(), Elapsed: elapsed}
+		if result == nil {
+			result = &tools.Result{Tool: task.Tool.Name(), Language: task.Tool.Language()}
+		}
+		result.TimedOut = true
+		result.Duration = elapsed.String()
+		e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventTimedOut, Elapsed: elapsed, Err: err})
+	case errors.Is(ctx.Err(), context.Canceled):
+		err = ErrCancelled
+		e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventCancelled, Elapsed: elapsed, Err: err})
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		err = ErrDeadlineExceeded
+		e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventTimedOut, Elapsed: elapsed, Err: err})
+	default:
+		e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventFinished, Elapsed: elapsed, Err: err})
+	}
+
+	return result, err
+}
+
+// taskTimeout resolves how long a single task may run before runTask
+// cancels it and reports ErrToolTimeout: task.Timeout if the task set
+// one, else e.toolTimeouts[task.Tool.Name()] if SetToolTimeout did,
+// else e.timeout as the executor-wide default. Each task gets its own
+// independent clock starting when runTask dispatches it, not a clock
+// shared across the whole plan.
+func (e *ParallelExecutor) taskTimeout(task tools.Task) time.Duration {
+	if task.Timeout > 0 {
+		return task.Timeout
+	}
+	if budget, ok := e.toolTimeouts[task.Tool.Name()]; ok && budget > 0 {
+		return budget
+	}
+	return e.timeout
+}
+
+// emitIssues reports EventIssueFound for a finished/cache-hit task whose
+// Result carries one or more Issues, so a reporter's scrolling issue
+// tail doesn't have to inspect every EventFinished's Result itself.
+func (e *ParallelExecutor) emitIssues(workerID int, task tools.Task, result *tools.Result) {
+	if result == nil || len(result.Issues) == 0 {
+		return
+	}
+	e.emit(Event{Worker: workerID, Tool: task.Tool.Name(), Language: task.Tool.Language(), Phase: EventIssueFound, IssueCount: len(result.Issues)})
+}
+
+// wrapCache wraps tool in a cache.CachedTool when e.cacheManager is
+// configured, installing a cache.PackageCache first if tool implements
+// tools.TestCacheable (GoTestTool, PytestTool), so it can skip
+// re-running packages unchanged since they last passed. ok is false, and
+// wrapped is nil, when no cache manager is configured.
+func (e *ParallelExecutor) wrapCache(tool tools.QualityTool) (wrapped *cache.CachedTool, ok bool) {
+	if e.cacheManager == nil {
+		return nil, false
+	}
+
+	if cacheable, isCacheable := tool.(tools.TestCacheable); isCacheable {
+		cacheable.SetTestCache(cache.NewPackageCache(e.cacheManager))
+	}
+
+	return cache.NewCachedTool(tool, e.cacheManager), true
+}
+
+// execute runs task either through its cached tools.Session (if
+// task.Tool implements tools.PersistentTool) or via the one-shot
+// Execute path. A tool whose StartSession fails (daemon mode not
+// supported on this system, say) falls back to Execute for that task
+// rather than failing the whole run. When e.cacheManager is set, the
+// task's tool is wrapped in a cache.CachedTool first (see wrapCache),
+// which also means a cached tool never takes the PersistentTool branch
+// below: CachedTool only implements tools.QualityTool, so per-file
+// memoization and session reuse aren't combined.
+func (e *ParallelExecutor) execute(ctx context.Context, task tools.Task, sessions map[string]tools.Session) (*tools.Result, error) {
+	tool := task.Tool
+	if !task.Options.CacheDisabled {
+		if cached, ok := e.wrapCache(task.Tool); ok {
+			tool = cached
+		}
+	}
+
+	persistent, ok := tool.(tools.PersistentTool)
+	if !ok {
+		return e.runChunked(ctx, task.Tool, task.Files, task.Options, tool.Execute)
+	}
+
+	session, open := sessions[task.Tool.Name()]
+	if !open {
+		started, err := persistent.StartSession(ctx)
+		if err != nil {
+			return e.runChunked(ctx, task.Tool, task.Files, task.Options, task.Tool.Execute)
+		}
+		session = started
+		sessions[task.Tool.Name()] = session
+	}
+
+	return e.runChunked(ctx, task.Tool, task.Files, task.Options, session.Execute)
+}
+
+// runChunked invokes run once per ARG_MAX-safe batch of files (see
+// chunkFiles), merging their Results, unless tool opts out via
+// FileListLimited or there's nothing to gain by splitting (one batch, or
+// too few files to need it). run is either tool.Execute or a
+// tools.Session's Execute - both share the (ctx, files, options) shape.
+func (e *ParallelExecutor) runChunked(ctx context.Context, tool tools.QualityTool, files []string, options tools.ExecuteOptions, run func(context.Context, []string, tools.ExecuteOptions) (*tools.Result, error)) (*tools.Result, error) {
+	if limited, ok := tool.(tools.FileListLimited); ok && !limited.AcceptsFileList() {
+		return run(ctx, files, options)
+	}
+
+	batches := chunkFiles(files, e.argMaxBytes)
+	if len(batches) <= 1 {
+		return run(ctx, files, options)
+	}
+
+	var merged *tools.Result
+	for _, batch := range batches {
+		result, err := run(ctx, batch, options)
+		merged = mergeResults(merged, result)
+		if err != nil {
+			return merged, err
+		}
+	}
+
+	return merged, nil
+}
+
+// defaultArgMaxBytes returns a conservative per-OS command-line length
+// budget: well under the kernel's real ARG_MAX (getconf ARG_MAX ~131072
+// on Linux, ~262144 on macOS, ~8191 characters for a Windows cmd.exe
+// command line), leaving headroom for the tool's own flags, environment
+// variables (which share the same OS-enforced limit as argv), and argv
+// pointer/NUL overhead that a plain sum of file path lengths doesn't
+// capture.
+func defaultArgMaxBytes() int {
+	switch runtime.GOOS {
+	case "darwin":
+		return 131072
+	case "windows":
+		return 4096
+	default:
+		return 65536
+	}
+}
+
+// chunkFiles splits files into contiguous batches whose summed length,
+// plus a fixed per-file argv overhead and toolFlagsReserve set aside for
+// the tool's own flags, stays at or under limit bytes. A single file
+// whose own length already exceeds the budget still gets its own
+// one-file batch - there's no way to shrink it further, and the
+// underlying command is left to fail on its own terms.
+func chunkFiles(files []string, limit int) [][]string {
+	const perFileOverhead = 8 // argv pointer + separator + quoting slack
+	const toolFlagsReserve = 4096
+
+	budget := limit - toolFlagsReserve
+	if budget <= 0 {
+		budget = limit
+	}
+
+	var batches [][]string
+	var current []string
+	currentLen := 0
+
+	for _, f := range files {
+		cost := len(f) + perFileOverhead
+		if len(current) > 0 && currentLen+cost > budget {
+			batches = append(batches, current)
+			current = nil
+			currentLen = 0
+		}
+		current = append(current, f)
+		currentLen += cost
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+
+	return batches
+}
+
+// mergeResults folds next into acc the way a single multi-batch task's
+// Result should look as if it had run in one invocation: Issues
+// concatenate, FilesProcessed sums, Success is OR'd (a task counts as
+// successful if any of its batches reported success, matching how a
+// partial run that found real issues still usually wants Success=true
+// rather than masking them as a hard failure), and Duration takes the
+// slower of the two batches rather than summing (batches run
+// sequentially, but the reported duration describes per-batch tool
+// latency, not total wall time already tracked by the caller).
+func mergeResults(acc, next *tools.Result) *tools.Result {
+	if acc == nil {
+		return next
+	}
+	if next == nil {
+		return acc
+	}
+
+	acc.Success = acc.Success || next.Success
+	acc.FilesProcessed += next.FilesProcessed
+	acc.Issues = append(acc.Issues, next.Issues...)
+	acc.Cached = acc.Cached && next.Cached
+
+	if next.Output != "" {
+		if acc.Output != "" {
+			acc.Output += "\n"
+		}
+		acc.Output += next.Output
+	}
+
+	if next.Error != nil && acc.Error == nil {
+		acc.Error = next.Error
+	}
+
+	accDuration, _ := time.ParseDuration(acc.Duration)
+	nextDuration, _ := time.ParseDuration(next.Duration)
+	if nextDuration > accDuration {
+		acc.Duration = next.Duration
+	}
+
+	return acc
+}
+
+// closeSessions shuts down every session a worker opened, ignoring
+// errors - the worker is exiting either way and there's no caller left
+// to report a Close failure to.
+func closeSessions(sessions map[string]tools.Session) {
+	for _, session := range sessions {
+		_ = session.Close()
+	}
+}
+
+// Tracer receives extra-verbose trace events for Git filter calls and
+// plan decisions. Satisfied by *logx.Logger without either package
+// importing the other.
+type Tracer interface {
+	Trace(msg string, fields map[string]interface{})
+}
+
+// noopTracer discards every event; it's the default so ExecutionPlanner
+// never needs a nil check before tracing.
+type noopTracer struct{}
+
+func (noopTracer) Trace(string, map[string]interface{}) {}
+
 // ExecutionPlanner creates execution plans.
 type ExecutionPlanner struct {
 	analyzer ProjectAnalyzer
+	tracer   Tracer
 }
 
 // NewExecutionPlanner creates a new execution planner.
 func NewExecutionPlanner(analyzer ProjectAnalyzer) *ExecutionPlanner {
 	return &ExecutionPlanner{
 		analyzer: analyzer,
+		tracer:   noopTracer{},
+	}
+}
+
+// SetTracer installs t as the planner's trace sink (e.g. a *logx.Logger
+// running in --trace mode). Passing nil restores the no-op tracer.
+func (p *ExecutionPlanner) SetTracer(t Tracer) {
+	if t == nil {
+		t = noopTracer{}
 	}
+	p.tracer = t
 }
 
 // CreatePlan creates an execution plan for the given options.
-func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolRegistry, options PlanOptions) (*tools.ExecutionPlan, error) {
+func (p *ExecutionPlanner) CreatePlan(ctx context.Context, projectRoot string, registry tools.ToolRegistry, options PlanOptions) (*tools.ExecutionPlan, error) {
 	// Analyze the project
-	analysis, err := p.analyzer.AnalyzeProject(projectRoot, registry)
+	analysis, err := p.analyzer.AnalyzeProject(ctx, projectRoot, registry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to analyze project: %w", err)
 	}
@@ -184,9 +902,28 @@ func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolReg
 	// Get optimal tool selection
 	selection := p.analyzer.GetOptimalToolSelection(analysis, registry)
 
+	var diffBase string
+	if options.ChangedOnly {
+		diffBase, err = p.resolveDiffBase(projectRoot, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve --changed-only diff base: %w", err)
+		}
+	}
+
+	ignorePatterns, err := combinedIgnorePatterns(projectRoot, options.IgnorePatterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ignore patterns: %w", err)
+	}
+
 	var tasks []tools.Task
 	totalFiles := 0
 
+	// attrsReader resolves the gzh-language/gzh-format/gzh-linter
+	// .gitattributes overrides below, batching every file this plan
+	// touches into as few `git check-attr` invocations as possible rather
+	// than one per file.
+	attrsReader := gitutils.NewAttributesReader(projectRoot)
+
 	// Create tasks for each language
 	for language, toolList := range selection {
 		files := analysis.Languages[language]
@@ -195,11 +932,25 @@ func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolReg
 		}
 
 		// Apply file filtering based on options
-		files, err = p.applyFileFilters(projectRoot, files, options)
+		files, err = p.applyFileFilters(projectRoot, files, options, ignorePatterns)
 		if err != nil {
 			return nil, fmt.Errorf("failed to apply file filters: %w", err)
 		}
 
+		// Partition the file set across shards, so the same file (or
+		// package/language, depending on ShardStrategy) always lands on
+		// the same --shard=i across CI workers.
+		files = tools.ShardFilesWithStrategy(files, options.Shard, options.Shards, options.ShardStrategy, language)
+
+		if len(files) == 0 {
+			continue
+		}
+
+		// A project without a .git directory (or without git installed)
+		// simply has no overrides to apply - attrsReader's failure here
+		// isn't a reason to fail the whole plan.
+		fileAttrs, _ := attrsReader.Attributes(files, attrGzhLanguage, attrGzhFormat, attrGzhLinter)
+		files = filterLanguageOverrides(files, fileAttrs, language)
 		if len(files) == 0 {
 			continue
 		}
@@ -221,6 +972,15 @@ func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolReg
 				continue
 			}
 
+			// Apply per-path gzh-format/gzh-linter overrides; a file
+			// this drops for tool still runs under the language's other
+			// tools, so this must filter per tool rather than once for
+			// the whole language.
+			toolFiles := filterToolOverrides(files, fileAttrs, tool)
+			if len(toolFiles) == 0 {
+				continue
+			}
+
 			// Create execution options
 			execOptions := tools.ExecuteOptions{
 				ProjectRoot: projectRoot,
@@ -229,6 +989,10 @@ func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolReg
 				LintOnly:    options.LintOnly,
 				ExtraArgs:   options.ExtraArgs,
 				Env:         options.Env,
+				BaseRef:     options.Since,
+				DiffBase:    diffBase,
+				Shard:       options.Shard,
+				TotalShards: options.Shards,
 			}
 
 			// Set config file if found
@@ -236,6 +1000,12 @@ func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolReg
 				execOptions.ConfigFile = configFile
 			}
 
+			// Route through a project build wrapper if that's how this
+			// tool was resolved (not found on PATH directly)
+			if location, exists := analysis.ToolLocations[tool.Name()]; exists {
+				execOptions.Location = location
+			}
+
 			// Determine priority
 			var priority int
 			switch tool.Type() {
@@ -243,22 +1013,31 @@ func (p *ExecutionPlanner) CreatePlan(projectRoot string, registry tools.ToolReg
 				priority = 10 // Formatters run first
 			case tools.LINT:
 				priority = 5 // Linters run second
+			case tools.TEST:
+				priority = 1 // Tests run last, after format/lint have had their say
 			default:
 				priority = 7 // BOTH tools run in between
 			}
 
 			task := tools.Task{
 				Tool:     tool,
-				Files:    files,
+				Name:     tool.Name(),
+				Files:    toolFiles,
 				Options:  execOptions,
 				Priority: priority,
+				Timeout:  options.ToolTimeouts[tool.Name()],
 			}
 
 			tasks = append(tasks, task)
-			totalFiles += len(files)
+			totalFiles += len(toolFiles)
 		}
 	}
 
+	assignTaskDependencies(tasks)
+	if err := detectTaskCycle(tasks); err != nil {
+		return nil, err
+	}
+
 	// Estimate duration (rough estimate: 100ms per file per tool)
 	estimatedSeconds := len(tasks) * totalFiles / 10
 	estimatedDuration := fmt.Sprintf("%ds", estimatedSeconds)
@@ -276,14 +1055,44 @@ type PlanOptions struct {
 	Fix        bool              // Auto-fix issues if supported
 	FormatOnly bool              // Run only formatters
 	LintOnly   bool              // Run only linters
+	TestOnly   bool              // Run only TEST tools (go test, pytest, ...)
 	ExtraArgs  []string          // Extra arguments to pass to tools
 	Env        map[string]string // Environment variables
 	Language   string            // Filter by specific language
 	ToolFilter []string          // Filter by specific tool names
 	// Git-based options
-	Since   string // Process files changed since this commit
-	Staged  bool   // Process only staged files
-	Changed bool   // Process only changed files (staged + modified + untracked)
+	Since         string // Process files changed since this commit
+	Staged        bool   // Process only staged files
+	Changed       bool   // Process only changed files (staged + modified + untracked)
+	SinceUpstream bool   // Process files changed since the branch's resolved upstream
+	SinceBranch   string // Process files changed since this base branch's merge-base with HEAD
+	// Sharding, for splitting expensive tool runs (clippy, buf, shellcheck)
+	// across CI workers: deterministically partitions the discovered file
+	// set so the same file always lands on the same shard.
+	Shard  int // This run's 0-based shard index
+	Shards int // Total number of shards; <= 1 disables sharding
+
+	// ShardStrategy selects how files are partitioned across Shard/
+	// Shards; "" behaves like tools.ShardStrategyFileHash.
+	ShardStrategy tools.ShardStrategy
+
+	// ChangedOnly sets ExecuteOptions.DiffBase on every task to a ref
+	// resolved from whichever of Since/SinceBranch/SinceUpstream/Staged/
+	// Changed is active, so LINT tools report only issues on lines the
+	// diff against that ref actually touched (see tools/diffscope).
+	ChangedOnly bool
+
+	// IgnorePatterns are extra gitignore-style glob patterns (see
+	// IgnorePatterns) to exclude from every task's Files, on top of
+	// whatever projectRoot's .gzh-quality-ignore already lists.
+	IgnorePatterns []string
+
+	// ToolTimeouts sets Task.Timeout per tool name, overriding the
+	// executor's default for just that tool (e.g. giving a slow
+	// type-checker more room without raising every other tool's budget).
+	// A tool absent from this map gets Task.Timeout's zero value, which
+	// runTask resolves to the executor's own default.
+	ToolTimeouts map[string]time.Duration
 }
 
 // matchesToolType checks if a tool matches the requested type options.
@@ -298,7 +1107,20 @@ func matchesToolType(tool tools.QualityTool, options PlanOptions) bool {
 		return toolType == tools.LINT || toolType == tools.BOTH
 	}
 
-	// If neither FormatOnly nor LintOnly, include all tools
+	if options.TestOnly {
+		return toolType == tools.TEST
+	}
+
+	// TEST tools (go test, pytest, ...) only run in a dedicated TestOnly
+	// sweep, not alongside the default format/lint run - they're slower
+	// and, unlike linters, don't report anything new on an unchanged
+	// package.
+	if toolType == tools.TEST {
+		return false
+	}
+
+	// If none of FormatOnly/LintOnly/TestOnly are set, include every
+	// other tool.
 	return true
 }
 
@@ -325,12 +1147,13 @@ func matchesToolFilter(tool tools.QualityTool, options PlanOptions) bool {
 	return false
 }
 
-// applyFileFilters applies various file filtering options.
-func (p *ExecutionPlanner) applyFileFilters(projectRoot string, files []string, options PlanOptions) ([]string, error) {
+// applyFileFilters applies various file filtering options, including
+// excluding anything ignorePatterns matches (see combinedIgnorePatterns).
+func (p *ExecutionPlanner) applyFileFilters(projectRoot string, files []string, options PlanOptions, ignorePatterns *IgnorePatterns) ([]string, error) {
 	var filteredFiles []string
 
 	// Handle Git-based filtering
-	if options.Since != "" || options.Staged || options.Changed {
+	if options.Since != "" || options.Staged || options.Changed || options.SinceUpstream || options.SinceBranch != "" {
 		gitFiles, err := p.getGitFilteredFiles(projectRoot, options)
 		if err != nil {
 			return nil, err
@@ -347,9 +1170,45 @@ func (p *ExecutionPlanner) applyFileFilters(projectRoot string, files []string,
 		filteredFiles = intersectFiles(filteredFiles, options.Files)
 	}
 
+	filteredFiles = ignorePatterns.Filter(filteredFiles)
+
+	p.tracer.Trace("file filter decision", map[string]interface{}{
+		"project_root": projectRoot,
+		"candidates":   len(files),
+		"filtered":     len(filteredFiles),
+		"since":        options.Since,
+		"staged":       options.Staged,
+		"changed":      options.Changed,
+	})
+
 	return filteredFiles, nil
 }
 
+// resolveDiffBase resolves the single git ref --changed-only scopes
+// Issues against, from whichever Git-based filter option is active.
+// Staged/Changed have no single ref of their own (they diff the index
+// or worktree), so both resolve to "HEAD".
+func (p *ExecutionPlanner) resolveDiffBase(projectRoot string, options PlanOptions) (string, error) {
+	gitUtils := &GitUtils{projectRoot: projectRoot}
+
+	switch {
+	case options.Since != "":
+		return options.Since, nil
+	case options.SinceBranch != "":
+		return gitUtils.ResolveMergeBase(options.SinceBranch)
+	case options.SinceUpstream:
+		upstream, err := gitUtils.resolveUpstream()
+		if err != nil {
+			return "", err
+		}
+		return gitUtils.ResolveMergeBase(upstream)
+	case options.Staged, options.Changed:
+		return "HEAD", nil
+	default:
+		return "", fmt.Errorf("--changed-only requires one of --since, --since-branch, --since-upstream, --staged, or --changed")
+	}
+}
+
 // getGitFilteredFiles returns files based on Git filtering options.
 func (p *ExecutionPlanner) getGitFilteredFiles(projectRoot string, options PlanOptions) ([]string, error) {
 	// Lazy import to avoid dependency issues
@@ -361,20 +1220,37 @@ func (p *ExecutionPlanner) getGitFilteredFiles(projectRoot string, options PlanO
 
 	var gitFiles []string
 	var err error
+	var mode string
 
 	switch {
 	case options.Since != "":
+		mode = "since:" + options.Since
 		// Validate commit reference first
 		if err := gitUtils.ValidateCommitish(options.Since); err != nil {
 			return nil, err
 		}
 		gitFiles, err = gitUtils.GetChangedFiles(options.Since)
 	case options.Staged:
+		mode = "staged"
 		gitFiles, err = gitUtils.GetStagedFiles()
 	case options.Changed:
+		mode = "changed"
 		gitFiles, err = gitUtils.GetAllChangedFiles()
+	case options.SinceBranch != "":
+		mode = "since-branch:" + options.SinceBranch
+		gitFiles, err = gitUtils.GetChangedFilesSinceMergeBase(options.SinceBranch)
+	case options.SinceUpstream:
+		mode = "since-upstream"
+		gitFiles, err = gitUtils.GetChangedFilesSinceUpstream()
 	}
 
+	p.tracer.Trace("git filter call", map[string]interface{}{
+		"project_root": projectRoot,
+		"mode":         mode,
+		"files_found":  len(gitFiles),
+		"error":        err,
+	})
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git files: %w", err)
 	}
@@ -382,11 +1258,47 @@ func (p *ExecutionPlanner) getGitFilteredFiles(projectRoot string, options PlanO
 	return gitFiles, nil
 }
 
+// GitFileSource is the subset of GitUtils's behavior needed to drive
+// --since/--staged/--changed file selection, factored out so callers can
+// swap the default exec-based GitUtils for GoGitUtils (no git binary
+// required) via NewGitFileSource without caring which one they got.
+type GitFileSource interface {
+	IsGitRepository() bool
+	ValidateCommitish(commitish string) error
+	GetChangedFiles(since string) ([]string, error)
+	GetStagedFiles() ([]string, error)
+	GetAllChangedFiles() ([]string, error)
+	ListFiles() ([]string, error)
+}
+
+// NewGitFileSource picks the fastest GitFileSource that can actually
+// handle projectRoot, in this fallback order:
+//
+//  1. GoGitUtils, reading the repository in-process via go-git, with no
+//     dependency on a git binary being installed at all.
+//  2. The exec-based GitUtils, shelling out to `git` for repository
+//     layouts go-git doesn't support (e.g. partial/shallow clones,
+//     submodule quirks) or when go-git can't open projectRoot as a
+//     repository at all.
+func NewGitFileSource(projectRoot string) GitFileSource {
+	if goGit, err := NewGoGitUtils(projectRoot); err == nil {
+		return goGit
+	}
+	return &GitUtils{projectRoot: projectRoot}
+}
+
 // GitUtils provides Git-related utilities (embedded for simplicity).
 type GitUtils struct {
 	projectRoot string
+
+	// attrsReader is lazily built by attributesReader; it batches every
+	// linguist-generated lookup GitUtils makes into one `git check-attr`
+	// invocation per distinct file set instead of one per file.
+	attrsReader *gitutils.AttributesReader
 }
 
+var _ GitFileSource = (*GitUtils)(nil)
+
 func (g *GitUtils) IsGitRepository() bool {
 	cmd := exec.Command("git", "rev-parse", "--git-dir")
 	cmd.Dir = g.projectRoot
@@ -403,13 +1315,138 @@ func (g *GitUtils) ValidateCommitish(commitish string) error {
 }
 
 func (g *GitUtils) GetChangedFiles(since string) ([]string, error) {
-	cmd := exec.Command("git", "diff", "--name-only", since)
+	files, _, err := g.GetChangedFilesWithRenames(since)
+	return files, err
+}
+
+// GetChangedFilesWithRenames returns files changed since the given ref,
+// using `-M -C` rename/copy detection. The returned map keys the new path
+// of any renamed/copied file to its old path, so callers can reuse a cache
+// entry keyed by the old path when the file content (hash) hasn't changed.
+// Generated files (per .gitattributes linguist-generated) are excluded.
+func (g *GitUtils) GetChangedFilesWithRenames(since string) ([]string, map[string]string, error) {
+	cmd := exec.Command("git", "diff", "--name-status", "-M", "-C", since)
 	cmd.Dir = g.projectRoot
 	output, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get git diff: %w", err)
+		return nil, nil, fmt.Errorf("failed to get git diff: %w", err)
 	}
-	return g.parseFileList(string(output)), nil
+
+	var candidates []string
+	renames := make(map[string]string)
+
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			continue
+		}
+
+		status := fields[0]
+		var newPath string
+
+		switch {
+		case strings.HasPrefix(status, "R"), strings.HasPrefix(status, "C"):
+			// "R100\told\tnew"
+			if len(fields) < 3 {
+				continue
+			}
+			oldPath, new := fields[1], fields[2]
+			newPath = new
+			renames[newPath] = oldPath
+		default:
+			newPath = fields[1]
+		}
+
+		candidates = append(candidates, newPath)
+	}
+
+	files := g.filterGenerated(candidates)
+	for newPath := range renames {
+		if !containsString(files, newPath) {
+			delete(renames, newPath)
+		}
+	}
+
+	return files, renames, nil
+}
+
+// attributesReader lazily builds the git.AttributesReader this GitUtils
+// batches linguist-generated lookups through, so repeated calls (one per
+// incremental-mode invocation) share a single reader and its per-path
+// cache rather than each allocating their own.
+func (g *GitUtils) attributesReader() *gitutils.AttributesReader {
+	if g.attrsReader == nil {
+		g.attrsReader = gitutils.NewAttributesReader(g.projectRoot)
+	}
+	return g.attrsReader
+}
+
+// filterGenerated drops any path .gitattributes marks linguist-generated,
+// resolving every path in one batched `git check-attr` call via
+// attributesReader rather than one invocation per path.
+func (g *GitUtils) filterGenerated(paths []string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	attrs, err := g.attributesReader().Attributes(paths, "linguist-generated")
+	if err != nil {
+		// check-attr failing shouldn't block the caller from seeing its
+		// files; treat it the same as nothing being generated.
+		return paths
+	}
+
+	files := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if attrs[path]["linguist-generated"] == "true" {
+			continue
+		}
+		files = append(files, path)
+	}
+	return files
+}
+
+// containsString reports whether s is present in values.
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ListFiles returns every file tracked by Git, satisfying GitFileSource;
+// it's a thin wrapper over ListFilesZ so callers that only need the full
+// file list (e.g. the on-unmatched policy check) don't need to know
+// about the underlying `git ls-files -z` plumbing.
+func (g *GitUtils) ListFiles() ([]string, error) {
+	return g.ListFilesZ()
+}
+
+// ListFilesZ returns every file tracked by Git (via `git ls-files -z`),
+// excluding generated files per .gitattributes linguist-generated.
+func (g *GitUtils) ListFilesZ() ([]string, error) {
+	cmd := exec.Command("git", "ls-files", "-z")
+	cmd.Dir = g.projectRoot
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git files: %w", err)
+	}
+
+	var candidates []string
+	for _, path := range strings.Split(string(output), "\x00") {
+		if path != "" {
+			candidates = append(candidates, path)
+		}
+	}
+
+	return g.filterGenerated(candidates), nil
 }
 
 func (g *GitUtils) GetStagedFiles() ([]string, error) {
@@ -422,6 +1459,16 @@ func (g *GitUtils) GetStagedFiles() ([]string, error) {
 	return g.parseFileList(string(output)), nil
 }
 
+func (g *GitUtils) GetUntrackedFiles() ([]string, error) {
+	cmd := exec.Command("git", "ls-files", "--others", "--exclude-standard")
+	cmd.Dir = g.projectRoot
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get untracked files: %w", err)
+	}
+	return g.parseFileList(string(output)), nil
+}
+
 func (g *GitUtils) GetAllChangedFiles() ([]string, error) {
 	var allFiles []string
 
@@ -443,18 +1490,149 @@ func (g *GitUtils) GetAllChangedFiles() ([]string, error) {
 	allFiles = append(allFiles, modified...)
 
 	// Get untracked files
-	cmd = exec.Command("git", "ls-files", "--others", "--exclude-standard")
+	untracked, err := g.GetUntrackedFiles()
+	if err != nil {
+		return nil, err
+	}
+	allFiles = append(allFiles, untracked...)
+
+	return g.deduplicateAndMakeAbsolute(allFiles), nil
+}
+
+// GetStagedFilesFiltered is GetStagedFiles with ignorePatterns applied,
+// so a caller that already has an IgnorePatterns (e.g. loaded once for a
+// whole run) doesn't have to re-derive it from an ExecutionPlanner.
+func (g *GitUtils) GetStagedFilesFiltered(ignorePatterns *IgnorePatterns) ([]string, error) {
+	files, err := g.GetStagedFiles()
+	if err != nil {
+		return nil, err
+	}
+	return ignorePatterns.Filter(files), nil
+}
+
+// GetChangedFilesFiltered is GetChangedFiles with ignorePatterns applied.
+func (g *GitUtils) GetChangedFilesFiltered(since string, ignorePatterns *IgnorePatterns) ([]string, error) {
+	files, err := g.GetChangedFiles(since)
+	if err != nil {
+		return nil, err
+	}
+	return ignorePatterns.Filter(files), nil
+}
+
+// GetAllChangedFilesFiltered is GetAllChangedFiles with ignorePatterns
+// applied.
+func (g *GitUtils) GetAllChangedFilesFiltered(ignorePatterns *IgnorePatterns) ([]string, error) {
+	files, err := g.GetAllChangedFiles()
+	if err != nil {
+		return nil, err
+	}
+	return ignorePatterns.Filter(files), nil
+}
+
+// resolveUpstream returns the ref the current branch tracks, trying the
+// configured tracking branch first and falling back to the
+// branch.<name>.remote/branch.<name>.merge config pair for a branch that
+// was pushed with --set-upstream but isn't currently checked out against
+// it.
+func (g *GitUtils) resolveUpstream() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}")
 	cmd.Dir = g.projectRoot
-	output, err = cmd.Output()
+	if output, err := cmd.Output(); err == nil {
+		return strings.TrimSpace(string(output)), nil
+	}
+
+	cmd = exec.Command("git", "branch", "--show-current")
+	cmd.Dir = g.projectRoot
+	branchOut, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get untracked files: %w", err)
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+	branch := strings.TrimSpace(string(branchOut))
+
+	remote, err := g.configValue(fmt.Sprintf("branch.%s.remote", branch))
+	if err != nil || remote == "" {
+		return "", fmt.Errorf("no upstream configured for branch %q", branch)
+	}
+
+	merge, err := g.configValue(fmt.Sprintf("branch.%s.merge", branch))
+	if err != nil || merge == "" {
+		return "", fmt.Errorf("no upstream configured for branch %q", branch)
+	}
+
+	return remote + "/" + strings.TrimPrefix(merge, "refs/heads/"), nil
+}
+
+// configValue reads a single git config value, returning an error if it
+// isn't set.
+func (g *GitUtils) configValue(key string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", key)
+	cmd.Dir = g.projectRoot
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git config %s not set: %w", key, err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// ResolveMergeBase returns the commit hash where the current branch and
+// baseBranch diverged (`git merge-base HEAD baseBranch`).
+func (g *GitUtils) ResolveMergeBase(baseBranch string) (string, error) {
+	cmd := exec.Command("git", "merge-base", "HEAD", baseBranch)
+	cmd.Dir = g.projectRoot
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to compute merge base with %q: %w", baseBranch, err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetChangedFilesSinceMergeBase returns files changed on the current branch
+// relative to baseBranch (merge-base..HEAD, rename/generated-file rules
+// not applied since this is a coarser PR-scoped selector), plus any
+// currently staged or untracked files, so a pre-push run covers the whole
+// PR rather than only what's already committed.
+func (g *GitUtils) GetChangedFilesSinceMergeBase(baseBranch string) ([]string, error) {
+	mergeBase, err := g.ResolveMergeBase(baseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("git", "diff", "--name-only", "--diff-filter=ACMRT", mergeBase+"..HEAD")
+	cmd.Dir = g.projectRoot
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s..HEAD: %w", mergeBase, err)
+	}
+
+	var allFiles []string
+	allFiles = append(allFiles, g.parseFileList(string(output))...)
+
+	staged, err := g.GetStagedFiles()
+	if err != nil {
+		return nil, err
+	}
+	allFiles = append(allFiles, staged...)
+
+	untracked, err := g.GetUntrackedFiles()
+	if err != nil {
+		return nil, err
 	}
-	untracked := g.parseFileList(string(output))
 	allFiles = append(allFiles, untracked...)
 
 	return g.deduplicateAndMakeAbsolute(allFiles), nil
 }
 
+// GetChangedFilesSinceUpstream is GetChangedFilesSinceMergeBase against the
+// branch's resolved upstream, for `--since-upstream` runs that want the
+// PR's full diff without having to name the target branch.
+func (g *GitUtils) GetChangedFilesSinceUpstream() ([]string, error) {
+	upstream, err := g.resolveUpstream()
+	if err != nil {
+		return nil, err
+	}
+	return g.GetChangedFilesSinceMergeBase(upstream)
+}
+
 func (g *GitUtils) parseFileList(output string) []string {
 	var files []string
 	lines := strings.Split(strings.TrimSpace(output), "\n")
@@ -506,7 +1684,7 @@ func intersectFiles(files1, files2 []string) []string {
 
 // ProjectAnalyzer is an alias to avoid circular import.
 type ProjectAnalyzer interface {
-	AnalyzeProject(projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error)
+	AnalyzeProject(ctx context.Context, projectRoot string, registry tools.ToolRegistry) (*AnalysisResult, error)
 	GetOptimalToolSelection(result *AnalysisResult, registry tools.ToolRegistry) map[string][]tools.QualityTool
 }
 
@@ -517,6 +1695,8 @@ type AnalysisResult struct {
 	AvailableTools   []string
 	RecommendedTools map[string][]string
 	ConfigFiles      map[string]string
+	ToolLocations    map[string]*tools.ToolLocation
+	Timings          map[string]time.Duration
 	Issues           []string
 }
 
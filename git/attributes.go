@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// AttributesReader resolves .gitattributes values for arbitrary attribute
+// names - including repo-specific ones like gzh-language, gzh-linter, and
+// gzh-format, alongside standard ones like linguist-generated - by
+// shelling out to `git check-attr`, which already implements the full
+// precedence rules (.gitattributes at every directory level, a repo's
+// info/attributes, the user's core.attributesFile) rather than
+// reimplementing them.
+//
+// Unlike a single `git check-attr <attr> -- <path>` call per file,
+// Attributes batches every path it's asked about into one `git
+// check-attr -z --stdin` invocation, and caches each path's result for
+// the reader's lifetime, so resolving attributes for a whole project's
+// file list costs one process fork rather than one per file.
+type AttributesReader struct {
+	projectRoot string
+
+	mu    sync.Mutex
+	cache map[string]map[string]string // path -> attribute name -> value
+}
+
+// NewAttributesReader builds an AttributesReader rooted at projectRoot.
+// Nothing is read from disk until Attributes is first called.
+func NewAttributesReader(projectRoot string) *AttributesReader {
+	return &AttributesReader{
+		projectRoot: projectRoot,
+		cache:       make(map[string]map[string]string),
+	}
+}
+
+// Attributes returns, for each of paths, the values `git check-attr`
+// reports for names - e.g. {"linguist-generated": "true", "gzh-linter":
+// "ruff"}. An attribute that's unspecified for a path is omitted from
+// its map entirely rather than recorded as "unspecified", so callers can
+// use a plain map lookup (`attrs["gzh-linter"]`) to test for an override.
+//
+// Paths already resolved by an earlier call (for any set of names) are
+// served from cache; only paths missing from the cache are sent to git.
+// Results for every name check-attr was ever asked about a path are
+// cached together, so a later call asking about a subset of names still
+// hits the cache.
+func (r *AttributesReader) Attributes(paths []string, names ...string) (map[string]map[string]string, error) {
+	result := make(map[string]map[string]string, len(paths))
+	if len(paths) == 0 || len(names) == 0 {
+		return result, nil
+	}
+
+	r.mu.Lock()
+	var missing []string
+	for _, path := range paths {
+		if attrs, ok := r.cache[path]; ok {
+			result[path] = attrs
+		} else {
+			missing = append(missing, path)
+		}
+	}
+	r.mu.Unlock()
+
+	if len(missing) > 0 {
+		resolved, err := r.queryCheckAttr(missing, names)
+		if err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		for _, path := range missing {
+			attrs := resolved[path]
+			if attrs == nil {
+				attrs = map[string]string{}
+			}
+			r.cache[path] = attrs
+			result[path] = attrs
+		}
+		r.mu.Unlock()
+	}
+
+	return result, nil
+}
+
+// queryCheckAttr runs one `git check-attr -z --stdin` invocation for
+// paths, requesting every attribute in names, and parses its NUL-
+// delimited <path, attribute, value> triples.
+func (r *AttributesReader) queryCheckAttr(paths []string, names []string) (map[string]map[string]string, error) {
+	args := append([]string{"check-attr", "-z", "--stdin"}, names...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = r.projectRoot
+	cmd.Stdin = strings.NewReader(strings.Join(paths, "\x00"))
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run git check-attr: %w", err)
+	}
+
+	fields := bytes.Split(bytes.TrimRight(output, "\x00"), []byte{0})
+
+	resolved := make(map[string]map[string]string, len(paths))
+	for i := 0; i+2 < len(fields); i += 3 {
+		path, name, value := string(fields[i]), string(fields[i+1]), string(fields[i+2])
+		if value == "unspecified" {
+			continue
+		}
+
+		attrs := resolved[path]
+		if attrs == nil {
+			attrs = make(map[string]string)
+			resolved[path] = attrs
+		}
+		attrs[name] = value
+	}
+
+	return resolved, nil
+}
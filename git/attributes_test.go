@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributesReader_Attributes(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, ".gitattributes",
+		"*.pb.go linguist-generated=true gzh-linter=none\n"+
+			"vendor/** linguist-vendored\n"+
+			"scripts/*.py gzh-language=python gzh-format=off\n")
+	createAndCommitFile(t, repoDir, "api.pb.go", "package api\n")
+	createAndCommitFile(t, repoDir, "main.go", "package main\n")
+
+	reader := NewAttributesReader(repoDir)
+
+	attrs, err := reader.Attributes(
+		[]string{"api.pb.go", "main.go", "scripts/build.py"},
+		"linguist-generated", "gzh-linter", "gzh-language", "gzh-format",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "true", attrs["api.pb.go"]["linguist-generated"])
+	assert.Equal(t, "none", attrs["api.pb.go"]["gzh-linter"])
+
+	assert.Empty(t, attrs["main.go"])
+
+	assert.Equal(t, "python", attrs["scripts/build.py"]["gzh-language"])
+	assert.Equal(t, "off", attrs["scripts/build.py"]["gzh-format"])
+	assert.NotContains(t, attrs["scripts/build.py"], "linguist-generated")
+}
+
+func TestAttributesReader_CachesResolvedPaths(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, ".gitattributes", "*.pb.go linguist-generated=true\n")
+	createAndCommitFile(t, repoDir, "api.pb.go", "package api\n")
+
+	reader := NewAttributesReader(repoDir)
+
+	_, err := reader.Attributes([]string{"api.pb.go"}, "linguist-generated")
+	require.NoError(t, err)
+
+	cached, ok := reader.cache["api.pb.go"]
+	require.True(t, ok, "first call should populate the cache")
+	assert.Equal(t, "true", cached["linguist-generated"])
+
+	// A second call for the same path must be served from cache rather
+	// than shelling out again; removing .gitattributes proves it, since
+	// a live query would now see the attribute as unspecified.
+	require.NoError(t, os.Remove(filepath.Join(repoDir, ".gitattributes")))
+
+	attrs, err := reader.Attributes([]string{"api.pb.go"}, "linguist-generated")
+	require.NoError(t, err)
+	assert.Equal(t, "true", attrs["api.pb.go"]["linguist-generated"])
+}
+
+func TestAttributesReader_NoNames(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	reader := NewAttributesReader(repoDir)
+
+	attrs, err := reader.Attributes([]string{"main.go"})
+	require.NoError(t, err)
+	assert.Empty(t, attrs)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import "os/exec"
+
+// Backend is the subset of Git queries GitUtils resolves through a
+// pluggable implementation rather than always shelling out: enough to
+// drive --since/--staged/--changed-only file selection, branch/commit
+// validation, and reading a path's staged content. GitUtils delegates
+// every Backend-covered method to whichever implementation
+// NewGitUtilsWithOptions selected; methods outside this interface -
+// merge-base resolution (GetChangedFilesSinceMergeBase/Upstream),
+// HooksPath, GetChangedHunks - always shell out to the git binary
+// directly regardless of the selected backend, since neither
+// implementing them in-process nor degrading their output gracefully
+// would be worth the added complexity for this package's callers.
+type Backend interface {
+	IsGitRepository() bool
+	ChangedFiles(since string) ([]string, error)
+	ChangedFilesWithRenames(since string) ([]string, map[string]string, error)
+	StagedFiles() ([]string, error)
+	ModifiedFiles() ([]string, error)
+	UntrackedFiles() ([]string, error)
+	AllChangedFiles() ([]string, error)
+	CurrentBranch() (string, error)
+	ValidateCommitish(commitish string) error
+
+	// ReadStagedContent returns path's content exactly as `git show
+	// :path` would - the blob currently in the index - without reading
+	// the working tree copy, which may differ from what's staged.
+	ReadStagedContent(path string) ([]byte, error)
+
+	// StageFiles adds paths' current working-tree content to the index,
+	// as `git add` would. Used to re-stage files a `--staged --fix` run
+	// just rewrote, so formatter output lands in the same commit instead
+	// of being left as an unstaged change the commit silently excludes.
+	StageFiles(paths []string) error
+}
+
+// Options configures NewGitUtilsWithOptions.
+type Options struct {
+	// Backend selects which Backend implementation GitUtils delegates
+	// to:
+	//
+	//   - "exec" always shells out to the git binary. It alone supports
+	//     -M/-C rename detection and .gitattributes linguist-generated
+	//     filtering, so it's also what "auto" prefers.
+	//   - "gogit" always reads the repository in-process via
+	//     github.com/go-git/go-git, with no dependency on a git binary
+	//     being installed at all - useful in minimal containers and CI
+	//     images that don't ship one.
+	//   - "auto" (the default, and what NewGitUtils uses) picks exec
+	//     when the git binary is on PATH, falling back to gogit only
+	//     when it isn't.
+	Backend string
+}
+
+// selectBackend resolves name ("auto" when empty) to a concrete Backend
+// for projectRoot, falling back to the exec backend if gogit was
+// requested (or auto fell through to it) but go-git couldn't open
+// projectRoot as a repository.
+func selectBackend(projectRoot, name string) Backend {
+	newExecBackend := func() Backend { return &execBackend{projectRoot: projectRoot} }
+
+	switch name {
+	case "gogit":
+		if b, err := newGogitBackend(projectRoot); err == nil {
+			return b
+		}
+		return newExecBackend()
+	case "exec":
+		return newExecBackend()
+	default: // "auto", ""
+		if _, err := exec.LookPath("git"); err == nil {
+			return newExecBackend()
+		}
+		if b, err := newGogitBackend(projectRoot); err == nil {
+			return b
+		}
+		return newExecBackend()
+	}
+}
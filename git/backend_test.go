@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backendConstructors lists the Backend implementations every
+// TestBackend_* case below runs against, so a behavior regression in
+// either backend is caught regardless of which one NewGitUtils would
+// have picked in a given environment.
+var backendConstructors = []struct {
+	name string
+	new  func(projectRoot string) Backend
+}{
+	{name: "exec", new: func(projectRoot string) Backend { return &execBackend{projectRoot: projectRoot} }},
+	{
+		name: "gogit",
+		new: func(projectRoot string) Backend {
+			b, err := newGogitBackend(projectRoot)
+			if err != nil {
+				panic(err)
+			}
+			return b
+		},
+	},
+}
+
+func TestBackend_IsGitRepository(t *testing.T) {
+	for _, backend := range backendConstructors {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+			assert.True(t, backend.new(repoDir).IsGitRepository())
+		})
+	}
+}
+
+func TestBackend_ValidateCommitish(t *testing.T) {
+	for _, backend := range backendConstructors {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+			b := backend.new(repoDir)
+
+			assert.NoError(t, b.ValidateCommitish("HEAD"))
+			assert.Error(t, b.ValidateCommitish("nonexistent"))
+		})
+	}
+}
+
+func TestBackend_StagedFiles(t *testing.T) {
+	for _, backend := range backendConstructors {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+			stagedFile := filepath.Join(repoDir, "staged.txt")
+			require.NoError(t, os.WriteFile(stagedFile, []byte("staged"), 0o644))
+			cmd := exec.Command("git", "add", "staged.txt")
+			cmd.Dir = repoDir
+			require.NoError(t, cmd.Run())
+
+			files, err := backend.new(repoDir).StagedFiles()
+
+			require.NoError(t, err)
+			assert.Contains(t, files, "staged.txt")
+		})
+	}
+}
+
+func TestBackend_StageFiles(t *testing.T) {
+	for _, backend := range backendConstructors {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+			unstaged := filepath.Join(repoDir, "file1.txt")
+			require.NoError(t, os.WriteFile(unstaged, []byte("rewritten"), 0o644))
+
+			b := backend.new(repoDir)
+			require.NoError(t, b.StageFiles([]string{unstaged}))
+
+			files, err := b.StagedFiles()
+			require.NoError(t, err)
+			assert.Contains(t, files, "file1.txt")
+		})
+	}
+}
+
+func TestBackend_CurrentBranch(t *testing.T) {
+	for _, backend := range backendConstructors {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+			branch, err := backend.new(repoDir).CurrentBranch()
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, branch)
+		})
+	}
+}
+
+func TestBackend_ReadStagedContent(t *testing.T) {
+	for _, backend := range backendConstructors {
+		t.Run(backend.name, func(t *testing.T) {
+			repoDir := setupGitRepo(t)
+			createAndCommitFile(t, repoDir, "file1.txt", "original")
+
+			filePath := filepath.Join(repoDir, "file1.txt")
+			require.NoError(t, os.WriteFile(filePath, []byte("staged version"), 0o644))
+			cmd := exec.Command("git", "add", "file1.txt")
+			cmd.Dir = repoDir
+			require.NoError(t, cmd.Run())
+
+			require.NoError(t, os.WriteFile(filePath, []byte("unstaged working tree edit"), 0o644))
+
+			content, err := backend.new(repoDir).ReadStagedContent("file1.txt")
+
+			require.NoError(t, err)
+			assert.Equal(t, "staged version", string(content))
+		})
+	}
+}
+
+func TestSelectBackend(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	t.Run("exec", func(t *testing.T) {
+		b := selectBackend(repoDir, "exec")
+		_, ok := b.(*execBackend)
+		assert.True(t, ok)
+	})
+
+	t.Run("gogit", func(t *testing.T) {
+		b := selectBackend(repoDir, "gogit")
+		_, ok := b.(*gogitBackend)
+		assert.True(t, ok)
+	})
+
+	t.Run("auto prefers exec when git is on PATH", func(t *testing.T) {
+		b := selectBackend(repoDir, "auto")
+		_, ok := b.(*execBackend)
+		assert.True(t, ok)
+	})
+
+	t.Run("gogit falls back to exec when not a repository", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		b := selectBackend(tmpDir, "gogit")
+		_, ok := b.(*execBackend)
+		assert.True(t, ok)
+	})
+}
+
+func TestGitUtils_ReadStagedContent(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "original")
+
+	filePath := filepath.Join(repoDir, "file1.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("staged version"), 0o644))
+	cmd := exec.Command("git", "add", "file1.txt")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	gitUtils := NewGitUtils(repoDir)
+
+	content, err := gitUtils.ReadStagedContent("file1.txt")
+
+	require.NoError(t, err)
+	assert.Equal(t, "staged version", string(content))
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// execBackend is the Backend GitUtils uses by default: every method
+// shells out to the git binary in projectRoot. It requires git on PATH
+// and a real working directory.
+type execBackend struct {
+	projectRoot string
+}
+
+var _ Backend = (*execBackend)(nil)
+
+func (b *execBackend) IsGitRepository() bool {
+	gitDir := filepath.Join(b.projectRoot, ".git")
+	if stat, err := os.Stat(gitDir); err == nil && stat.IsDir() {
+		return true
+	}
+
+	// .git is a file (a gitlink, e.g. inside a submodule or linked
+	// worktree) or missing entirely - ask git itself rather than
+	// concluding "not a repository" from the stat alone.
+	cmd := exec.Command("git", "rev-parse", "--git-dir")
+	cmd.Dir = b.projectRoot
+	return cmd.Run() == nil
+}
+
+func (b *execBackend) ChangedFiles(since string) ([]string, error) {
+	files, _, err := b.ChangedFilesWithRenames(since)
+	return files, err
+}
+
+// ChangedFilesWithRenames diffs since against the working tree (or,
+// given an explicit "A..B" range, commit-to-commit) with -M -C
+// rename/copy detection, excluding anything .gitattributes marks
+// linguist-generated.
+func (b *execBackend) ChangedFilesWithRenames(since string) ([]string, map[string]string, error) {
+	if !b.IsGitRepository() {
+		return nil, nil, fmt.Errorf("not a git repository")
+	}
+
+	if since == "" {
+		since = "HEAD~1"
+	}
+
+	cmd := exec.Command("git", "diff", "--name-status", "-M", "-C", since)
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get git diff: %w", err)
+	}
+
+	var files []string
+	renames := make(map[string]string)
+
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			continue
+		}
+
+		status := fields[0]
+		var newPath string
+
+		switch {
+		case strings.HasPrefix(status, "R"), strings.HasPrefix(status, "C"):
+			// "R100\told\tnew"
+			if len(fields) < 3 {
+				continue
+			}
+			oldPath, movedTo := fields[1], fields[2]
+			newPath = movedTo
+			renames[newPath] = oldPath
+		default:
+			newPath = fields[1]
+		}
+
+		if b.isGeneratedFile(newPath) {
+			continue
+		}
+
+		files = append(files, newPath)
+	}
+
+	return files, renames, nil
+}
+
+// isGeneratedFile reports whether path is marked linguist-generated via
+// .gitattributes, so incremental diff modes can skip vendored/generated
+// files.
+func (b *execBackend) isGeneratedFile(path string) bool {
+	cmd := exec.Command("git", "check-attr", "linguist-generated", "--", path)
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(output), "linguist-generated: true") ||
+		strings.Contains(string(output), "linguist-generated: set")
+}
+
+func (b *execBackend) StagedFiles() ([]string, error) {
+	if !b.IsGitRepository() {
+		return nil, fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "diff", "--cached", "--name-only")
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staged files: %w", err)
+	}
+
+	return parseFileList(string(output)), nil
+}
+
+func (b *execBackend) ModifiedFiles() ([]string, error) {
+	if !b.IsGitRepository() {
+		return nil, fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "diff", "--name-only")
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modified files: %w", err)
+	}
+
+	return parseFileList(string(output)), nil
+}
+
+func (b *execBackend) UntrackedFiles() ([]string, error) {
+	if !b.IsGitRepository() {
+		return nil, fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "ls-files", "--others", "--exclude-standard")
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get untracked files: %w", err)
+	}
+
+	return parseFileList(string(output)), nil
+}
+
+func (b *execBackend) AllChangedFiles() ([]string, error) {
+	var allFiles []string
+
+	staged, err := b.StagedFiles()
+	if err != nil {
+		return nil, err
+	}
+	allFiles = append(allFiles, staged...)
+
+	modified, err := b.ModifiedFiles()
+	if err != nil {
+		return nil, err
+	}
+	allFiles = append(allFiles, modified...)
+
+	untracked, err := b.UntrackedFiles()
+	if err != nil {
+		return nil, err
+	}
+	allFiles = append(allFiles, untracked...)
+
+	return deduplicateAndMakeAbsolute(b.projectRoot, allFiles), nil
+}
+
+func (b *execBackend) CurrentBranch() (string, error) {
+	if !b.IsGitRepository() {
+		return "", fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "branch", "--show-current")
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func (b *execBackend) ValidateCommitish(commitish string) error {
+	if !b.IsGitRepository() {
+		return fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "rev-parse", "--verify", commitish+"^{commit}")
+	cmd.Dir = b.projectRoot
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("invalid commit reference '%s': %w", commitish, err)
+	}
+
+	return nil
+}
+
+// ReadStagedContent reads path's content from the index via `git show
+// :path`, the same blob a commit would capture right now regardless of
+// what's since changed in the working tree.
+func (b *execBackend) ReadStagedContent(path string) ([]byte, error) {
+	if !b.IsGitRepository() {
+		return nil, fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "show", ":"+path)
+	cmd.Dir = b.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read staged content for %s: %w", path, err)
+	}
+
+	return output, nil
+}
+
+// StageFiles runs `git add --` on paths, adding their current
+// working-tree content to the index.
+func (b *execBackend) StageFiles(paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	if !b.IsGitRepository() {
+		return fmt.Errorf("not a git repository")
+	}
+
+	args := append([]string{"add", "--"}, paths...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = b.projectRoot
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to stage files: %w (%s)", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// parseFileList parses git command output into file list
+func parseFileList(output string) []string {
+	var files []string
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+
+	return files
+}
+
+// deduplicateAndMakeAbsolute removes duplicates and converts to absolute paths
+func deduplicateAndMakeAbsolute(projectRoot string, files []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+
+	for _, file := range files {
+		if seen[file] {
+			continue
+		}
+		seen[file] = true
+
+		absPath := filepath.Join(projectRoot, file)
+		if _, err := os.Stat(absPath); err == nil {
+			result = append(result, absPath)
+		}
+	}
+
+	return result
+}
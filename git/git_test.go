@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -240,6 +241,64 @@ func TestGetChangedFiles_SpecificCommit(t *testing.T) {
 	assert.GreaterOrEqual(t, len(files), 1, "Should have changed files")
 }
 
+func TestGetChangedFiles_CommitRange(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = repoDir
+	startOut, err := cmd.Output()
+	require.NoError(t, err)
+	start := strings.TrimSpace(string(startOut))
+
+	createAndCommitFile(t, repoDir, "file2.txt", "content2")
+	createAndCommitFile(t, repoDir, "file3.txt", "content3")
+
+	gitUtils := NewGitUtils(repoDir)
+
+	files, err := gitUtils.GetChangedFiles(start + "..HEAD")
+	require.NoError(t, err)
+
+	assert.Contains(t, files, "file2.txt")
+	assert.Contains(t, files, "file3.txt")
+	assert.NotContains(t, files, "file1.txt", "file1.txt was committed before the range start")
+}
+
+func TestGetChangedFilesWithRenames_DetectsRename(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "original.txt", strings.Repeat("identical content\n", 20))
+
+	cmd := exec.Command("git", "mv", "original.txt", "renamed.txt")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	cmd = exec.Command("git", "commit", "-m", "rename file")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	gitUtils := NewGitUtils(repoDir)
+
+	files, renames, err := gitUtils.GetChangedFilesWithRenames("HEAD~1")
+	require.NoError(t, err)
+
+	assert.Contains(t, files, "renamed.txt")
+	assert.Equal(t, "original.txt", renames["renamed.txt"])
+}
+
+func TestGetChangedFilesWithRenames_NoRenames(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.txt", "content1")
+	createAndCommitFile(t, repoDir, "file2.txt", "content2")
+
+	gitUtils := NewGitUtils(repoDir)
+
+	files, renames, err := gitUtils.GetChangedFilesWithRenames("HEAD~1")
+	require.NoError(t, err)
+
+	assert.Contains(t, files, "file2.txt")
+	assert.Empty(t, renames)
+}
+
 func TestGetCurrentBranch(t *testing.T) {
 	repoDir := setupGitRepo(t)
 	createAndCommitFile(t, repoDir, "file.txt", "content")
@@ -503,3 +562,75 @@ func TestGetAllChangedFiles_Deduplication(t *testing.T) {
 	}
 	assert.Equal(t, 1, count, "File should appear only once after deduplication")
 }
+
+func TestGetChangedFilesSinceMergeBase(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "base.txt", "base content")
+
+	cmd := exec.Command("git", "checkout", "-b", "feature")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	createAndCommitFile(t, repoDir, "feature.txt", "feature content")
+
+	gitUtils := NewGitUtils(repoDir)
+	files, err := gitUtils.GetChangedFilesSinceMergeBase("master")
+	if err != nil {
+		// Default branch may be "main" depending on the git installation.
+		files, err = gitUtils.GetChangedFilesSinceMergeBase("main")
+	}
+	require.NoError(t, err)
+
+	assert.Len(t, files, 1)
+	assert.Contains(t, files, filepath.Join(repoDir, "feature.txt"))
+}
+
+func TestGetChangedFilesSinceMergeBase_IncludesStagedAndUntracked(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "base.txt", "base content")
+
+	cmd := exec.Command("git", "checkout", "-b", "feature")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	createAndCommitFile(t, repoDir, "feature.txt", "feature content")
+
+	stagedPath := filepath.Join(repoDir, "staged.txt")
+	require.NoError(t, os.WriteFile(stagedPath, []byte("staged"), 0o644))
+	cmd = exec.Command("git", "add", "staged.txt")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	untrackedPath := filepath.Join(repoDir, "untracked.txt")
+	require.NoError(t, os.WriteFile(untrackedPath, []byte("untracked"), 0o644))
+
+	gitUtils := NewGitUtils(repoDir)
+	files, err := gitUtils.GetChangedFilesSinceMergeBase("master")
+	if err != nil {
+		files, err = gitUtils.GetChangedFilesSinceMergeBase("main")
+	}
+	require.NoError(t, err)
+
+	assert.Len(t, files, 3)
+	assert.Contains(t, files, filepath.Join(repoDir, "feature.txt"))
+	assert.Contains(t, files, filepath.Join(repoDir, "staged.txt"))
+	assert.Contains(t, files, filepath.Join(repoDir, "untracked.txt"))
+}
+
+func TestGetChangedFilesSinceMergeBase_InvalidBranch(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file.txt", "content")
+
+	gitUtils := NewGitUtils(repoDir)
+	_, err := gitUtils.GetChangedFilesSinceMergeBase("does-not-exist")
+	require.Error(t, err)
+}
+
+func TestGetChangedFilesSinceUpstream_NoUpstreamConfigured(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file.txt", "content")
+
+	gitUtils := NewGitUtils(repoDir)
+	_, err := gitUtils.GetChangedFilesSinceUpstream()
+	require.Error(t, err, "Should fail when the branch has no upstream and no @{upstream} is resolvable")
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// gogitBackend implements Backend on top of github.com/go-git/go-git,
+// resolving refs, diffing trees, and reading the index entirely
+// in-process - no dependency on a git binary being installed at all.
+// This trades away two things the exec backend gets for free: -M/-C
+// rename/copy detection (a rename surfaces as a delete plus an add) and
+// .gitattributes linguist-generated filtering, neither of which go-git
+// exposes directly. Its ChangedFiles also differs in what it diffs: since
+// must be a single commit-ish (no "A..B" range, and no HEAD~1 default
+// for ""), and it is compared against HEAD's tree, not the working tree.
+type gogitBackend struct {
+	projectRoot string
+	repo        *gogit.Repository
+}
+
+var _ Backend = (*gogitBackend)(nil)
+
+// newGogitBackend opens projectRoot as a go-git repository. It returns
+// an error for anything go-git can't handle itself - not a git
+// repository, a partial/shallow clone missing objects go-git needs, or
+// any other repository layout go-git doesn't support - so selectBackend
+// can fall back to execBackend instead of failing outright.
+func newGogitBackend(projectRoot string) (*gogitBackend, error) {
+	repo, err := gogit.PlainOpenWithOptions(projectRoot, &gogit.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("go-git cannot open %s: %w", projectRoot, err)
+	}
+
+	return &gogitBackend{projectRoot: projectRoot, repo: repo}, nil
+}
+
+// IsGitRepository always reports true: construction already failed if
+// go-git couldn't open projectRoot as a repository.
+func (b *gogitBackend) IsGitRepository() bool {
+	return true
+}
+
+// ValidateCommitish reports whether commitish resolves to a commit.
+func (b *gogitBackend) ValidateCommitish(commitish string) error {
+	if _, err := b.resolveCommit(commitish); err != nil {
+		return fmt.Errorf("invalid commit reference '%s': %w", commitish, err)
+	}
+	return nil
+}
+
+// ChangedFiles returns files that differ between since and HEAD, by
+// diffing their commit trees.
+func (b *gogitBackend) ChangedFiles(since string) ([]string, error) {
+	files, _, err := b.ChangedFilesWithRenames(since)
+	return files, err
+}
+
+// ChangedFilesWithRenames diffs since against HEAD by comparing their
+// commit trees. The returned renames map is always empty: unlike
+// execBackend, this can't apply -M/-C rename detection, so a rename
+// surfaces as a delete plus an add.
+func (b *gogitBackend) ChangedFilesWithRenames(since string) ([]string, map[string]string, error) {
+	sinceCommit, err := b.resolveCommit(since)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve %q: %w", since, err)
+	}
+
+	headCommit, err := b.resolveCommit("HEAD")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	sinceTree, err := sinceCommit.Tree()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read tree for %q: %w", since, err)
+	}
+
+	headTree, err := headCommit.Tree()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read tree for HEAD: %w", err)
+	}
+
+	changes, err := sinceTree.Diff(headTree)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to diff %q..HEAD: %w", since, err)
+	}
+
+	var files []string
+	for _, change := range changes {
+		if change.To.Name != "" {
+			files = append(files, change.To.Name)
+		} else if change.From.Name != "" {
+			files = append(files, change.From.Name)
+		}
+	}
+
+	return files, map[string]string{}, nil
+}
+
+// StagedFiles returns files staged in the index relative to HEAD.
+func (b *gogitBackend) StagedFiles() ([]string, error) {
+	status, err := b.worktreeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for path, fileStatus := range status {
+		// go-git reports an untracked file with Staging == Untracked, so
+		// that has to be excluded along with Unmodified.
+		if fileStatus.Staging != gogit.Unmodified && fileStatus.Staging != gogit.Untracked {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// ModifiedFiles returns worktree files that differ from the index.
+func (b *gogitBackend) ModifiedFiles() ([]string, error) {
+	status, err := b.worktreeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for path, fileStatus := range status {
+		if fileStatus.Worktree != gogit.Unmodified && fileStatus.Worktree != gogit.Untracked {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// UntrackedFiles returns files present in the worktree but not tracked
+// by the index.
+func (b *gogitBackend) UntrackedFiles() ([]string, error) {
+	status, err := b.worktreeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for path, fileStatus := range status {
+		if fileStatus.Worktree == gogit.Untracked {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// AllChangedFiles returns every staged, modified, or untracked file in
+// the worktree, as absolute paths (matching execBackend.AllChangedFiles).
+func (b *gogitBackend) AllChangedFiles() ([]string, error) {
+	status, err := b.worktreeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for path, fileStatus := range status {
+		if fileStatus.Staging != gogit.Unmodified || fileStatus.Worktree != gogit.Unmodified {
+			files = append(files, path)
+		}
+	}
+
+	return deduplicateAndMakeAbsolute(b.projectRoot, files), nil
+}
+
+// CurrentBranch returns HEAD's branch name, or "" if HEAD is detached.
+func (b *gogitBackend) CurrentBranch() (string, error) {
+	head, err := b.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	if !head.Name().IsBranch() {
+		return "", nil
+	}
+	return head.Name().Short(), nil
+}
+
+// ReadStagedContent reads path's content directly from the index (stage
+// 0) - the exact blob a commit would capture right now, without
+// touching the working tree copy, which may differ from what's staged.
+func (b *gogitBackend) ReadStagedContent(path string) ([]byte, error) {
+	index, err := b.repo.Storer.Index()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index: %w", err)
+	}
+
+	entry, err := index.Entry(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find staged entry for %s: %w", path, err)
+	}
+
+	blob, err := b.repo.BlobObject(entry.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read staged blob for %s: %w", path, err)
+	}
+
+	reader, err := blob.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open staged blob for %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read staged blob for %s: %w", path, err)
+	}
+
+	return content, nil
+}
+
+// StageFiles adds paths' current working-tree content to the index.
+// paths may be absolute (as the executor passes them); each is made
+// relative to projectRoot since that's what worktree.Add expects.
+func (b *gogitBackend) StageFiles(paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	worktree, err := b.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	for _, path := range paths {
+		rel := path
+		if filepath.IsAbs(path) {
+			rel, err = filepath.Rel(b.projectRoot, path)
+			if err != nil {
+				return fmt.Errorf("failed to resolve %s relative to %s: %w", path, b.projectRoot, err)
+			}
+		}
+
+		if _, err := worktree.Add(rel); err != nil {
+			return fmt.Errorf("failed to stage %s: %w", rel, err)
+		}
+	}
+
+	return nil
+}
+
+// resolveCommit resolves commitish to its *object.Commit.
+func (b *gogitBackend) resolveCommit(commitish string) (*object.Commit, error) {
+	hash, err := b.repo.ResolveRevision(plumbing.Revision(commitish))
+	if err != nil {
+		return nil, err
+	}
+	return b.repo.CommitObject(*hash)
+}
+
+// worktreeStatus returns go-git's worktree status, which backs
+// StagedFiles, ModifiedFiles, UntrackedFiles, and AllChangedFiles.
+func (b *gogitBackend) worktreeStatus() (gogit.Status, error) {
+	worktree, err := b.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LineRange is an inclusive [Start, End] 1-based line interval a diff
+// added or modified.
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Contains reports whether line falls within r.
+func (r LineRange) Contains(line int) bool {
+	return line >= r.Start && line <= r.End
+}
+
+// ChangedHunks maps a file path (relative to the repository root, as
+// `git diff` reports it) to the line ranges a diff added or modified in
+// it, sorted and merged so Contains can binary search rather than scan.
+type ChangedHunks map[string][]LineRange
+
+// Contains reports whether line in file falls within any hunk recorded
+// for it. Ranges within a file are sorted by Start and don't overlap, so
+// this binary searches for the last range starting at or before line
+// rather than scanning every range.
+func (h ChangedHunks) Contains(file string, line int) bool {
+	ranges := h[file]
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].Start > line }) - 1
+	return i >= 0 && ranges[i].Contains(line)
+}
+
+// hunkHeaderRe matches a unified diff hunk header's new-file half, e.g.
+// "@@ -12,3 +15,4 @@" captures start=15, count=4. git omits count when
+// it's 1 ("@@ -1 +1 @@").
+var hunkHeaderRe = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// diffGitHeaderRe matches a diff's "diff --git a/X b/Y" line, capturing Y.
+var diffGitHeaderRe = regexp.MustCompile(`^diff --git a/.+ b/(.+)$`)
+
+// GetChangedHunks returns, per file changed since since, the line ranges
+// the diff added or modified - the same scoping `git diff --unified=0`
+// itself reports, parsed out of its hunk headers rather than relying on
+// a library. This lets a caller restrict lint output to only the lines a
+// PR actually touched, without re-running the diff once per tool.
+func (g *GitUtils) GetChangedHunks(since string) (ChangedHunks, error) {
+	if !g.IsGitRepository() {
+		return nil, fmt.Errorf("not a git repository")
+	}
+
+	cmd := exec.Command("git", "diff", "--unified=0", "--no-color", since)
+	cmd.Dir = g.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff against %s: %w", since, err)
+	}
+
+	hunks := make(ChangedHunks)
+	var currentFile string
+
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			if match := diffGitHeaderRe.FindStringSubmatch(line); match != nil {
+				currentFile = match[1]
+			} else {
+				currentFile = ""
+			}
+		case strings.HasPrefix(line, "+++ "):
+			path := strings.TrimPrefix(line, "+++ ")
+			if path == "/dev/null" {
+				currentFile = ""
+			} else if trimmed := strings.TrimPrefix(path, "b/"); trimmed != path {
+				currentFile = trimmed
+			}
+		case strings.HasPrefix(line, "@@"):
+			if currentFile == "" {
+				continue
+			}
+
+			match := hunkHeaderRe.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+
+			start, _ := strconv.Atoi(match[1])
+			count := 1
+			if match[2] != "" {
+				count, _ = strconv.Atoi(match[2])
+			}
+			if count == 0 {
+				// A pure-deletion hunk adds no lines to the new file, so
+				// there's nothing in it to scope an issue's line against.
+				continue
+			}
+
+			hunks[currentFile] = append(hunks[currentFile], LineRange{Start: start, End: start + count - 1})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse diff against %s: %w", since, err)
+	}
+
+	for file, ranges := range hunks {
+		hunks[file] = mergeLineRanges(ranges)
+	}
+
+	return hunks, nil
+}
+
+// mergeLineRanges sorts ranges by Start and merges any that overlap or
+// touch, so ChangedHunks.Contains can binary search over a set of
+// disjoint, ordered ranges.
+func mergeLineRanges(ranges []LineRange) []LineRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End+1 {
+			if r.End > last.End {
+				last.End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineRange_Contains(t *testing.T) {
+	r := LineRange{Start: 10, End: 12}
+
+	assert.True(t, r.Contains(10))
+	assert.True(t, r.Contains(11))
+	assert.True(t, r.Contains(12))
+	assert.False(t, r.Contains(9))
+	assert.False(t, r.Contains(13))
+}
+
+func TestChangedHunks_Contains(t *testing.T) {
+	hunks := ChangedHunks{
+		"file.go": {{Start: 5, End: 7}, {Start: 20, End: 20}},
+	}
+
+	assert.True(t, hunks.Contains("file.go", 6))
+	assert.True(t, hunks.Contains("file.go", 20))
+	assert.False(t, hunks.Contains("file.go", 15))
+	assert.False(t, hunks.Contains("other.go", 6))
+}
+
+func TestGetChangedHunks(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "file1.go", "line1\nline2\nline3\nline4\nline5\n")
+
+	filePath := filepath.Join(repoDir, "file1.go")
+	require.NoError(t, os.WriteFile(filePath, []byte("line1\nCHANGED\nline3\nline4\nline5\nline6\n"), 0o644))
+	cmd := exec.Command("git", "add", "file1.go")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+	cmd = exec.Command("git", "commit", "-m", "modify file1.go")
+	cmd.Dir = repoDir
+	require.NoError(t, cmd.Run())
+
+	gitUtils := NewGitUtils(repoDir)
+
+	hunks, err := gitUtils.GetChangedHunks("HEAD~1")
+	require.NoError(t, err)
+
+	require.Contains(t, hunks, "file1.go")
+	assert.True(t, hunks.Contains("file1.go", 2), "changed line 2 should be in range")
+	assert.True(t, hunks.Contains("file1.go", 6), "appended line 6 should be in range")
+	assert.False(t, hunks.Contains("file1.go", 3), "untouched line 3 should not be in range")
+}
+
+func TestGetChangedHunks_NotGitRepo(t *testing.T) {
+	tmpDir := t.TempDir()
+	gitUtils := NewGitUtils(tmpDir)
+
+	_, err := gitUtils.GetChangedHunks("HEAD~1")
+
+	require.Error(t, err)
+}
+
+func TestMergeLineRanges(t *testing.T) {
+	merged := mergeLineRanges([]LineRange{
+		{Start: 10, End: 12},
+		{Start: 1, End: 3},
+		{Start: 4, End: 9},
+	})
+
+	assert.Equal(t, []LineRange{{Start: 1, End: 12}}, merged)
+}
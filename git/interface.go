@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+// Utils is the set of Git queries quality processing needs: which files are
+// staged, modified, untracked, or changed since a commit-ish, what branch is
+// checked out, and where hooks live. GitUtils satisfies it, resolving most of
+// these through a pluggable Backend (see NewGitUtilsWithOptions) and the rest
+// by always shelling out to the git binary.
+//
+// Callers that only need these queries (quality_hooks.go, the executor's
+// --since/--staged filtering) should depend on Utils rather than *GitUtils,
+// so a caller that only needs this subset isn't coupled to GitUtils's extra
+// methods (ReadStagedContent) or its Backend selection.
+type Utils interface {
+	IsGitRepository() bool
+	GetChangedFiles(commitRange string) ([]string, error)
+	GetChangedFilesWithRenames(commitRange string) ([]string, map[string]string, error)
+	GetStagedFiles() ([]string, error)
+	GetModifiedFiles() ([]string, error)
+	GetUntrackedFiles() ([]string, error)
+	GetAllChangedFiles() ([]string, error)
+	GetChangedFilesSinceMergeBase(baseBranch string) ([]string, error)
+	GetChangedFilesSinceUpstream() ([]string, error)
+	GetCurrentBranch() (string, error)
+	ValidateCommitish(commitish string) error
+	HooksPath() (string, error)
+	GetChangedHunks(since string) (ChangedHunks, error)
+}
+
+var _ Utils = (*GitUtils)(nil)
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Submodule describes one entry from `git submodule status`: a nested
+// repository checked out at Path, pinned to Commit, cloned from URL (read
+// from .gitmodules since `git submodule status` itself doesn't report it).
+type Submodule struct {
+	// Path is the submodule's location relative to the superproject root.
+	Path string
+
+	// Commit is the SHA the submodule is currently pinned to.
+	Commit string
+
+	// URL is the submodule's configured remote, from .gitmodules.
+	URL string
+
+	// Initialized is false if the submodule hasn't been cloned yet (`git
+	// submodule status` prefixes such entries with "-").
+	Initialized bool
+}
+
+// gitmodulesURLPattern pulls a submodule's "path" and "url" out of
+// .gitmodules, whose format is an INI-style file with one
+// [submodule "name"] section per entry.
+var gitmodulesPathPattern = regexp.MustCompile(`^\s*path\s*=\s*(.+?)\s*$`)
+var gitmodulesURLPattern = regexp.MustCompile(`^\s*url\s*=\s*(.+?)\s*$`)
+
+// GetSubmodules returns every submodule registered in .gitmodules, with
+// its pinned commit and initialization state from `git submodule
+// status`. It returns an empty slice (not an error) for a project with no
+// .gitmodules file - most projects aren't monorepos with nested repos.
+func (g *GitUtils) GetSubmodules() ([]Submodule, error) {
+	urls, order, err := g.parseGitmodulesURLs()
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	status, err := g.submoduleStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	submodules := make([]Submodule, 0, len(order))
+	for _, path := range order {
+		sub := Submodule{Path: path, URL: urls[path]}
+		if s, ok := status[path]; ok {
+			sub.Commit = s.commit
+			sub.Initialized = s.initialized
+		}
+		submodules = append(submodules, sub)
+	}
+
+	return submodules, nil
+}
+
+// parseGitmodulesURLs reads .gitmodules and returns each submodule's path
+// mapped to its configured url, plus the paths in file order (map
+// iteration order isn't stable, and callers want a deterministic list).
+func (g *GitUtils) parseGitmodulesURLs() (map[string]string, []string, error) {
+	data, err := os.ReadFile(filepath.Join(g.projectRoot, ".gitmodules"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to read .gitmodules: %w", err)
+	}
+
+	urls := make(map[string]string)
+	var order []string
+	var currentPath string
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if m := gitmodulesPathPattern.FindStringSubmatch(line); m != nil {
+			currentPath = m[1]
+			order = append(order, currentPath)
+			continue
+		}
+		if m := gitmodulesURLPattern.FindStringSubmatch(line); m != nil && currentPath != "" {
+			urls[currentPath] = m[1]
+		}
+	}
+
+	return urls, order, nil
+}
+
+// submoduleStatusEntry is one parsed line of `git submodule status`.
+type submoduleStatusEntry struct {
+	commit      string
+	initialized bool
+}
+
+// submoduleStatus runs `git submodule status` and parses each line's
+// leading state character (space/+ = initialized and up to date or
+// ahead, "-" = not initialized, "U" = merge conflict) and SHA, keyed by
+// submodule path.
+func (g *GitUtils) submoduleStatus() (map[string]submoduleStatusEntry, error) {
+	cmd := exec.Command("git", "submodule", "status")
+	cmd.Dir = g.projectRoot
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run git submodule status: %w", err)
+	}
+
+	entries := make(map[string]submoduleStatusEntry)
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		state := line[0]
+		fields := strings.Fields(strings.TrimSpace(line))
+		if len(fields) < 2 {
+			continue
+		}
+
+		entries[fields[1]] = submoduleStatusEntry{
+			commit:      strings.TrimLeft(fields[0], "-+U"),
+			initialized: state != '-',
+		}
+	}
+
+	return entries, nil
+}
+
+// GetAllChangedFilesRecursive is GetAllChangedFiles, optionally also
+// collecting changed files from every initialized submodule (recurse
+// true), each still returned as an absolute path but rooted under the
+// submodule's directory so it sits alongside the superproject's own
+// changed files. An uninitialized submodule (not yet cloned) is skipped
+// rather than erroring, since it has no working tree to diff.
+func (g *GitUtils) GetAllChangedFilesRecursive(recurse bool) ([]string, error) {
+	files, err := g.GetAllChangedFiles()
+	if err != nil {
+		return nil, err
+	}
+	if !recurse {
+		return files, nil
+	}
+
+	submodules, err := g.GetSubmodules()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sub := range submodules {
+		if !sub.Initialized {
+			continue
+		}
+
+		subRoot := filepath.Join(g.projectRoot, sub.Path)
+		subUtils := NewGitUtils(subRoot)
+
+		subFiles, err := subUtils.GetAllChangedFiles()
+		if err != nil {
+			return nil, fmt.Errorf("submodule %s: %w", sub.Path, err)
+		}
+
+		for _, f := range subFiles {
+			rel, err := filepath.Rel(subRoot, f)
+			if err != nil {
+				rel = f
+			}
+			files = append(files, filepath.Join(g.projectRoot, sub.Path, rel))
+		}
+	}
+
+	return files, nil
+}
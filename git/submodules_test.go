@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// addSubmodule registers subRepo (itself a git repo created by
+// setupGitRepo) as a submodule of repoDir at relPath, committing the
+// result.
+func addSubmodule(t *testing.T, repoDir, subRepo, relPath string) {
+	t.Helper()
+
+	cmd := exec.Command("git", "-c", "protocol.file.allow=always", "submodule", "add", subRepo, relPath)
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git submodule add failed: %s", out)
+
+	cmd = exec.Command("git", "commit", "-m", "Add submodule "+relPath)
+	cmd.Dir = repoDir
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err, "git commit failed: %s", out)
+}
+
+func TestGetSubmodules_NoGitmodules(t *testing.T) {
+	repoDir := setupGitRepo(t)
+
+	utils := NewGitUtils(repoDir)
+	submodules, err := utils.GetSubmodules()
+
+	require.NoError(t, err)
+	assert.Empty(t, submodules)
+}
+
+func TestGetSubmodules_RegisteredAndInitialized(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "README.md", "root repo")
+
+	subRepo := setupGitRepo(t)
+	createAndCommitFile(t, subRepo, "lib.go", "package lib")
+
+	addSubmodule(t, repoDir, subRepo, "vendor/lib")
+
+	utils := NewGitUtils(repoDir)
+	submodules, err := utils.GetSubmodules()
+
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.Equal(t, "vendor/lib", submodules[0].Path)
+	assert.Equal(t, subRepo, submodules[0].URL)
+	assert.True(t, submodules[0].Initialized)
+	assert.NotEmpty(t, submodules[0].Commit)
+}
+
+func TestGetAllChangedFilesRecursive_IncludesSubmoduleChanges(t *testing.T) {
+	repoDir := setupGitRepo(t)
+	createAndCommitFile(t, repoDir, "README.md", "root repo")
+
+	subRepo := setupGitRepo(t)
+	createAndCommitFile(t, subRepo, "lib.go", "package lib")
+
+	addSubmodule(t, repoDir, subRepo, "vendor/lib")
+
+	// Resolve the submodule's working copy path under the superproject
+	// and make an untracked change inside it.
+	subWorkdir := filepath.Join(repoDir, "vendor", "lib")
+	createUntrackedFile(t, subWorkdir, "new.go", "package lib\n\nfunc New() {}")
+
+	utils := NewGitUtils(repoDir)
+
+	shallow, err := utils.GetAllChangedFilesRecursive(false)
+	require.NoError(t, err)
+	assert.NotContains(t, joinedBase(shallow), "new.go")
+
+	recursive, err := utils.GetAllChangedFilesRecursive(true)
+	require.NoError(t, err)
+	assert.Contains(t, joinedBase(recursive), "new.go")
+}
+
+// createUntrackedFile writes a file into dir without staging or
+// committing it.
+func createUntrackedFile(t *testing.T, dir, filename, content string) {
+	t.Helper()
+
+	filePath := filepath.Join(dir, filename)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0o644))
+}
+
+// joinedBase returns the base names of files, for substring-free
+// membership checks against a list of absolute paths.
+func joinedBase(files []string) []string {
+	bases := make([]string, len(files))
+	for i, f := range files {
+		bases[i] = filepath.Base(f)
+	}
+	return bases
+}
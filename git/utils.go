@@ -4,208 +4,246 @@
 package git
 
 import (
-	"bufio"
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 )
 
-// GitUtils provides Git-related utilities for quality processing
+// GitUtils implements Utils by delegating to a Backend - execBackend by
+// default, or the in-process gogitBackend when NewGitUtilsWithOptions
+// asks for one. Methods Backend doesn't cover (merge-base resolution,
+// hooks path, hunk parsing) always shell out to the git binary directly,
+// regardless of which Backend is selected.
 type GitUtils struct {
 	projectRoot string
+	backend     Backend
 }
 
-// NewGitUtils creates a new GitUtils instance
+// NewGitUtils creates a GitUtils backed by Options{Backend: "auto"}: the
+// exec backend when git is on PATH, falling back to gogit otherwise.
 func NewGitUtils(projectRoot string) *GitUtils {
+	return NewGitUtilsWithOptions(projectRoot, Options{})
+}
+
+// NewGitUtilsWithOptions creates a GitUtils backed by whichever
+// implementation opts.Backend names (see Options.Backend for the
+// precedence "auto" applies).
+func NewGitUtilsWithOptions(projectRoot string, opts Options) *GitUtils {
 	return &GitUtils{
 		projectRoot: projectRoot,
+		backend:     selectBackend(projectRoot, opts.Backend),
 	}
 }
 
 // IsGitRepository checks if the current directory is a Git repository
 func (g *GitUtils) IsGitRepository() bool {
-	gitDir := filepath.Join(g.projectRoot, ".git")
-	if stat, err := os.Stat(gitDir); err == nil {
-		return stat.IsDir()
-	}
+	return g.backend.IsGitRepository()
+}
 
-	// Check if it's a git worktree or submodule
-	cmd := exec.Command("git", "rev-parse", "--git-dir")
-	cmd.Dir = g.projectRoot
-	return cmd.Run() == nil
+// GetChangedFiles returns files changed since a commit-ish, or across an
+// explicit commit range (e.g. "v1.0..v2.0"), per commitRange's meaning to
+// `git diff`. Renamed/copied files are reported under their new path; use
+// GetChangedFilesWithRenames to recover the old path.
+func (g *GitUtils) GetChangedFiles(commitRange string) ([]string, error) {
+	return g.backend.ChangedFiles(commitRange)
 }
 
-// GetChangedFiles returns files changed since a specific commit
-func (g *GitUtils) GetChangedFiles(since string) ([]string, error) {
-	if !g.IsGitRepository() {
-		return nil, fmt.Errorf("not a git repository")
-	}
+// GetChangedFilesWithRenames is GetChangedFiles, additionally returning a
+// map of each renamed/copied file's new path to its old path so callers can
+// reuse a cache entry keyed by the old path when the file's content hash
+// didn't change. commitRange accepts anything `git diff` does for a single
+// rev-spec argument: a single commit-ish (diffed against the working tree)
+// or an explicit "A..B" range (diffed commit-to-commit, working tree not
+// considered). Generated files (per .gitattributes linguist-generated) are
+// excluded - the gogit backend can't apply either of these, so both the
+// rename detection and the exclusion only happen on the exec backend; see
+// execBackend.ChangedFilesWithRenames and gogitBackend.ChangedFilesWithRenames.
+func (g *GitUtils) GetChangedFilesWithRenames(commitRange string) ([]string, map[string]string, error) {
+	return g.backend.ChangedFilesWithRenames(commitRange)
+}
 
-	var cmd *exec.Cmd
-	if since == "" {
-		// Default to comparing with HEAD~1
-		since = "HEAD~1"
-	}
+// GetStagedFiles returns currently staged files
+func (g *GitUtils) GetStagedFiles() ([]string, error) {
+	return g.backend.StagedFiles()
+}
 
-	// Get changed files since the specified commit
-	cmd = exec.Command("git", "diff", "--name-only", since)
-	cmd.Dir = g.projectRoot
+// GetModifiedFiles returns modified files in working directory
+func (g *GitUtils) GetModifiedFiles() ([]string, error) {
+	return g.backend.ModifiedFiles()
+}
 
-	output, err := cmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get git diff: %w", err)
-	}
+// GetUntrackedFiles returns untracked files
+func (g *GitUtils) GetUntrackedFiles() ([]string, error) {
+	return g.backend.UntrackedFiles()
+}
+
+// GetAllChangedFiles returns all changed files (staged + modified + untracked)
+func (g *GitUtils) GetAllChangedFiles() ([]string, error) {
+	return g.backend.AllChangedFiles()
+}
 
-	return g.parseFileList(string(output)), nil
+// GetCurrentBranch returns the current Git branch name
+func (g *GitUtils) GetCurrentBranch() (string, error) {
+	return g.backend.CurrentBranch()
 }
 
-// GetStagedFiles returns currently staged files
-func (g *GitUtils) GetStagedFiles() ([]string, error) {
-	if !g.IsGitRepository() {
-		return nil, fmt.Errorf("not a git repository")
-	}
+// ValidateCommitish checks if a commit-ish reference is valid
+func (g *GitUtils) ValidateCommitish(commitish string) error {
+	return g.backend.ValidateCommitish(commitish)
+}
 
-	cmd := exec.Command("git", "diff", "--cached", "--name-only")
+// ReadStagedContent returns path's content exactly as staged in the
+// index, via whichever Backend is selected - `git show :path` for
+// execBackend, or a direct index/blob read for gogitBackend - without
+// touching the working tree copy, which may differ from what's staged.
+func (g *GitUtils) ReadStagedContent(path string) ([]byte, error) {
+	return g.backend.ReadStagedContent(path)
+}
+
+// StageFiles adds paths' current working-tree content to the index, via
+// whichever Backend is selected - `git add --` for execBackend, or
+// worktree.Add for gogitBackend.
+func (g *GitUtils) StageFiles(paths []string) error {
+	return g.backend.StageFiles(paths)
+}
+
+// parseFileList parses git command output into a file list.
+func (g *GitUtils) parseFileList(output string) []string {
+	return parseFileList(output)
+}
+
+// deduplicateAndMakeAbsolute removes duplicates from files and resolves
+// each to an absolute path under g.projectRoot, dropping any that don't
+// exist on disk.
+func (g *GitUtils) deduplicateAndMakeAbsolute(files []string) []string {
+	return deduplicateAndMakeAbsolute(g.projectRoot, files)
+}
+
+// resolveUpstream returns the ref the current branch tracks, trying the
+// configured tracking branch first and falling back to the
+// branch.<name>.remote/branch.<name>.merge config pair - the same
+// resolution git-lfs's CurrentRemoteRef performs - for a branch that was
+// pushed with --set-upstream but isn't currently checked out against it.
+func (g *GitUtils) resolveUpstream() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}")
 	cmd.Dir = g.projectRoot
+	if output, err := cmd.Output(); err == nil {
+		return strings.TrimSpace(string(output)), nil
+	}
 
-	output, err := cmd.Output()
+	branch, err := g.GetCurrentBranch()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get staged files: %w", err)
+		return "", err
 	}
 
-	return g.parseFileList(string(output)), nil
-}
+	remote, err := g.configValue(fmt.Sprintf("branch.%s.remote", branch))
+	if err != nil || remote == "" {
+		return "", fmt.Errorf("no upstream configured for branch %q", branch)
+	}
 
-// GetModifiedFiles returns modified files in working directory
-func (g *GitUtils) GetModifiedFiles() ([]string, error) {
-	if !g.IsGitRepository() {
-		return nil, fmt.Errorf("not a git repository")
+	merge, err := g.configValue(fmt.Sprintf("branch.%s.merge", branch))
+	if err != nil || merge == "" {
+		return "", fmt.Errorf("no upstream configured for branch %q", branch)
 	}
 
-	cmd := exec.Command("git", "diff", "--name-only")
+	return remote + "/" + strings.TrimPrefix(merge, "refs/heads/"), nil
+}
+
+// configValue reads a single git config value, returning an error if it
+// isn't set.
+func (g *GitUtils) configValue(key string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", key)
 	cmd.Dir = g.projectRoot
 
 	output, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get modified files: %w", err)
+		return "", fmt.Errorf("git config %s not set: %w", key, err)
 	}
 
-	return g.parseFileList(string(output)), nil
+	return strings.TrimSpace(string(output)), nil
 }
 
-// GetUntrackedFiles returns untracked files
-func (g *GitUtils) GetUntrackedFiles() ([]string, error) {
+// GetChangedFilesSinceMergeBase returns files changed on the current branch
+// relative to baseBranch: every added/copied/modified/renamed/type-changed
+// path between their merge-base and HEAD, plus any currently staged or
+// untracked files, so a pre-push run covers the whole PR rather than only
+// what's already committed.
+func (g *GitUtils) GetChangedFilesSinceMergeBase(baseBranch string) ([]string, error) {
 	if !g.IsGitRepository() {
 		return nil, fmt.Errorf("not a git repository")
 	}
 
-	cmd := exec.Command("git", "ls-files", "--others", "--exclude-standard")
+	cmd := exec.Command("git", "merge-base", "HEAD", baseBranch)
 	cmd.Dir = g.projectRoot
-
 	output, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get untracked files: %w", err)
+		return nil, fmt.Errorf("failed to compute merge base with %q: %w", baseBranch, err)
 	}
+	mergeBase := strings.TrimSpace(string(output))
 
-	return g.parseFileList(string(output)), nil
-}
+	cmd = exec.Command("git", "diff", "--name-only", "--diff-filter=ACMRT", mergeBase+"..HEAD")
+	cmd.Dir = g.projectRoot
+	output, err = cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s..HEAD: %w", mergeBase, err)
+	}
 
-// GetAllChangedFiles returns all changed files (staged + modified + untracked)
-func (g *GitUtils) GetAllChangedFiles() ([]string, error) {
 	var allFiles []string
+	allFiles = append(allFiles, parseFileList(string(output))...)
 
-	// Get staged files
 	staged, err := g.GetStagedFiles()
 	if err != nil {
 		return nil, err
 	}
 	allFiles = append(allFiles, staged...)
 
-	// Get modified files
-	modified, err := g.GetModifiedFiles()
-	if err != nil {
-		return nil, err
-	}
-	allFiles = append(allFiles, modified...)
-
-	// Get untracked files
 	untracked, err := g.GetUntrackedFiles()
 	if err != nil {
 		return nil, err
 	}
 	allFiles = append(allFiles, untracked...)
 
-	// Remove duplicates and return absolute paths
-	return g.deduplicateAndMakeAbsolute(allFiles), nil
+	return deduplicateAndMakeAbsolute(g.projectRoot, allFiles), nil
 }
 
-// GetCurrentBranch returns the current Git branch name
-func (g *GitUtils) GetCurrentBranch() (string, error) {
+// GetChangedFilesSinceUpstream is GetChangedFilesSinceMergeBase against the
+// branch's resolved upstream, for `--since-upstream` runs that want the
+// PR's full diff against origin/main (or whatever the branch tracks)
+// without having to name the target branch.
+func (g *GitUtils) GetChangedFilesSinceUpstream() ([]string, error) {
 	if !g.IsGitRepository() {
-		return "", fmt.Errorf("not a git repository")
+		return nil, fmt.Errorf("not a git repository")
 	}
 
-	cmd := exec.Command("git", "branch", "--show-current")
-	cmd.Dir = g.projectRoot
-
-	output, err := cmd.Output()
+	upstream, err := g.resolveUpstream()
 	if err != nil {
-		return "", fmt.Errorf("failed to get current branch: %w", err)
-	}
-
-	return strings.TrimSpace(string(output)), nil
-}
-
-// parseFileList parses git command output into file list
-func (g *GitUtils) parseFileList(output string) []string {
-	var files []string
-	scanner := bufio.NewScanner(strings.NewReader(output))
-
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line != "" {
-			files = append(files, line)
-		}
-	}
-
-	return files
-}
-
-// deduplicateAndMakeAbsolute removes duplicates and converts to absolute paths
-func (g *GitUtils) deduplicateAndMakeAbsolute(files []string) []string {
-	seen := make(map[string]bool)
-	var result []string
-
-	for _, file := range files {
-		if seen[file] {
-			continue
-		}
-		seen[file] = true
-
-		// Convert to absolute path
-		absPath := filepath.Join(g.projectRoot, file)
-		if _, err := os.Stat(absPath); err == nil {
-			result = append(result, absPath)
-		}
+		return nil, err
 	}
 
-	return result
+	return g.GetChangedFilesSinceMergeBase(upstream)
 }
 
-// ValidateCommitish checks if a commit-ish reference is valid
-func (g *GitUtils) ValidateCommitish(commitish string) error {
+// HooksPath returns the directory Git will look in for hooks - a
+// configured core.hooksPath if one is set, otherwise the repository's
+// default .git/hooks.
+func (g *GitUtils) HooksPath() (string, error) {
 	if !g.IsGitRepository() {
-		return fmt.Errorf("not a git repository")
+		return "", fmt.Errorf("not a git repository")
 	}
 
-	cmd := exec.Command("git", "rev-parse", "--verify", commitish+"^{commit}")
+	cmd := exec.Command("git", "rev-parse", "--git-path", "hooks")
 	cmd.Dir = g.projectRoot
 
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("invalid commit reference '%s': %w", commitish, err)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve hooks path: %w", err)
+	}
+
+	path := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(g.projectRoot, path)
 	}
 
-	return nil
+	return path, nil
 }
@@ -1,21 +1,25 @@
-// Package logger provides simple structured logging for quality tools.
+// Package logger provides structured, leveled logging for quality tools,
+// with inheritable key-value context and a pluggable text/JSON formatter.
 package logger
 
 import (
 	"fmt"
 	"io"
-	"log"
 	"os"
-	"strings"
 	"time"
+
+	"github.com/rs/zerolog"
 )
 
-// Level represents logging level.
+// Level is a logger's minimum severity for emitting a record, ordered
+// from the most to least verbose.
 type Level int
 
 const (
+	// LevelTrace is for extra-verbose, per-call tracing below Debug.
+	LevelTrace Level = iota
 	// LevelDebug is for debug messages.
-	LevelDebug Level = iota
+	LevelDebug
 	// LevelInfo is for informational messages.
 	LevelInfo
 	// LevelWarn is for warning messages.
@@ -27,6 +31,8 @@ const (
 // String returns the string representation of the level.
 func (l Level) String() string {
 	switch l {
+	case LevelTrace:
+		return "TRACE"
 	case LevelDebug:
 		return "DEBUG"
 	case LevelInfo:
@@ -40,51 +46,143 @@ func (l Level) String() string {
 	}
 }
 
-// Logger provides structured logging with levels.
+// zerologLevel maps l onto zerolog's own level scale, which Logger uses
+// as its rendering backend.
+func (l Level) zerologLevel() zerolog.Level {
+	switch l {
+	case LevelTrace:
+		return zerolog.TraceLevel
+	case LevelDebug:
+		return zerolog.DebugLevel
+	case LevelWarn:
+		return zerolog.WarnLevel
+	case LevelError:
+		return zerolog.ErrorLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
+
+// Formatter selects how a Logger renders its records onto its output
+// writer. The built-in TextFormatter produces logfmt-style `key=value`
+// lines; JSONFormatter produces one JSON object per record. Both are
+// thin wrappers over zerolog's own writer strategies. Formatter's one
+// method is unexported, so these two are the only Formatters; no other
+// package can implement it.
+type Formatter interface {
+	newWriter(out io.Writer) io.Writer
+}
+
+type textFormatter struct{}
+
+func (textFormatter) newWriter(out io.Writer) io.Writer {
+	return zerolog.ConsoleWriter{Out: out, NoColor: true, TimeFormat: time.RFC3339}
+}
+
+type jsonFormatter struct{}
+
+func (jsonFormatter) newWriter(out io.Writer) io.Writer { return out }
+
+var (
+	// TextFormatter renders logfmt-style `key=value` records.
+	TextFormatter Formatter = textFormatter{}
+	// JSONFormatter renders one JSON object per record.
+	JSONFormatter Formatter = jsonFormatter{}
+)
+
+// Logger provides structured, leveled logging with inheritable
+// key-value context. The zero value is not usable; use New.
 type Logger struct {
 	component string
 	level     Level
-	logger    *log.Logger
+	format    Formatter
 	output    io.Writer
+	zl        zerolog.Logger
 }
 
-// New creates a new logger for a component.
+// New creates a new logger for a component, defaulting to LevelInfo and
+// TextFormatter on os.Stderr.
 func New(component string) *Logger {
-	return &Logger{
-		component: component,
-		level:     LevelInfo,
-		logger:    log.New(os.Stderr, "", 0),
-		output:    os.Stderr,
-	}
+	l := &Logger{component: component, level: LevelInfo, format: TextFormatter, output: os.Stderr}
+	l.rebuild()
+	return l
+}
+
+// rebuild reconstructs zl from l's current level/format/output/component.
+// Only called from New and the Set* methods - WithField/WithFields
+// instead extend the existing zl context directly, so rebuilding here
+// never needs to replay accumulated fields.
+func (l *Logger) rebuild() {
+	l.zl = zerolog.New(l.format.newWriter(l.output)).
+		Level(l.level.zerologLevel()).
+		With().
+		Timestamp().
+		Str("component", l.component).
+		Logger()
 }
 
 // SetLevel sets the minimum logging level.
 func (l *Logger) SetLevel(level Level) {
 	l.level = level
+	l.rebuild()
 }
 
 // SetOutput sets the output writer.
 func (l *Logger) SetOutput(w io.Writer) {
 	l.output = w
-	l.logger.SetOutput(w)
+	l.rebuild()
+}
+
+// SetFormat sets the formatter used to render records.
+func (l *Logger) SetFormat(format Formatter) {
+	l.format = format
+	l.rebuild()
+}
+
+// WithField returns a child logger that inherits l's accumulated context
+// plus the key-value field, e.g.
+// log.WithField("tool", "golangci-lint").Info("execution starting"), so
+// a caller threading a value through several log lines doesn't have to
+// repeat it on each one.
+func (l *Logger) WithField(key string, value interface{}) *Logger {
+	child := *l
+	child.zl = l.zl.With().Interface(key, value).Logger()
+	return &child
+}
+
+// WithFields is WithField for multiple key-value pairs at once.
+func (l *Logger) WithFields(fields map[string]interface{}) *Logger {
+	child := *l
+	ctx := l.zl.With()
+	for k, v := range fields {
+		ctx = ctx.Interface(k, v)
+	}
+	child.zl = ctx.Logger()
+	return &child
 }
 
 // log writes a log message if the level is enabled.
 func (l *Logger) log(level Level, format string, args ...interface{}) {
-	if level < l.level {
-		return
+	var ev *zerolog.Event
+	switch level {
+	case LevelTrace:
+		ev = l.zl.Trace()
+	case LevelDebug:
+		ev = l.zl.Debug()
+	case LevelWarn:
+		ev = l.zl.Warn()
+	case LevelError:
+		ev = l.zl.Error()
+	default:
+		ev = l.zl.Info()
 	}
+	ev.Msg(fmt.Sprintf(format, args...))
+}
 
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
-	msg := fmt.Sprintf(format, args...)
-	logLine := fmt.Sprintf("[%s] %s [%s] %s",
-		timestamp,
-		level.String(),
-		l.component,
-		msg,
-	)
-
-	l.logger.Println(logLine)
+// Trace logs a trace message - more verbose than Debug, for per-call
+// detail that's too noisy to keep on even during routine debugging.
+func (l *Logger) Trace(format string, args ...interface{}) {
+	l.log(LevelTrace, format, args...)
 }
 
 // Debug logs a debug message.
@@ -107,20 +205,6 @@ func (l *Logger) Error(format string, args ...interface{}) {
 	l.log(LevelError, format, args...)
 }
 
-// WithField returns a message with a key-value field.
-func (l *Logger) WithField(key, value string) string {
-	return fmt.Sprintf("%s=%s", key, value)
-}
-
-// WithFields returns a message with multiple key-value fields.
-func (l *Logger) WithFields(fields map[string]string) string {
-	var parts []string
-	for k, v := range fields {
-		parts = append(parts, fmt.Sprintf("%s=%s", k, v))
-	}
-	return strings.Join(parts, " ")
-}
-
 // Global logger instance.
 var defaultLogger = New("default")
 
@@ -134,6 +218,16 @@ func SetDefaultOutput(w io.Writer) {
 	defaultLogger.SetOutput(w)
 }
 
+// SetDefaultFormat sets the formatter for the default logger.
+func SetDefaultFormat(format Formatter) {
+	defaultLogger.SetFormat(format)
+}
+
+// Trace logs a trace message using the default logger.
+func Trace(format string, args ...interface{}) {
+	defaultLogger.Trace(format, args...)
+}
+
 // Debug logs a debug message using the default logger.
 func Debug(format string, args ...interface{}) {
 	defaultLogger.Debug(format, args...)
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package logx provides optional, structured debug logging for gz
+// quality. It sits alongside the emoji-decorated human output rather
+// than replacing it: by default every method is a no-op, and
+// --debug/QUALITY_DEBUG=1 turns on zerolog events carrying the fields CI
+// triage actually needs (tool, project root, file counts, git filter,
+// cache hits, durations, exit codes).
+package logx
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Gizzahub/gzh-cli-quality/redact"
+)
+
+// Logger emits structured debug/trace events. The zero value is safe to
+// use and behaves as a no-op logger (all levels disabled).
+type Logger struct {
+	zl zerolog.Logger
+}
+
+// New creates a Logger. debug enables Debug-level events; trace (which
+// implies debug) additionally enables Trace-level events used for
+// per-call Git filter and plan-decision tracing. console selects a
+// human-readable colorized writer instead of raw JSON.
+func New(debug, trace, console bool) *Logger {
+	level := zerolog.Disabled
+	switch {
+	case trace:
+		level = zerolog.TraceLevel
+	case debug:
+		level = zerolog.DebugLevel
+	}
+
+	var writer io.Writer = os.Stderr
+	if console {
+		writer = zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}
+	}
+
+	return &Logger{zl: zerolog.New(writer).Level(level).With().Timestamp().Logger()}
+}
+
+// DebugFromEnv reports whether QUALITY_DEBUG is set to a truthy value,
+// so a logger can be seeded before command-line flags are parsed.
+func DebugFromEnv() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("QUALITY_DEBUG"))
+	return enabled
+}
+
+// ToolStart logs the inputs that decided which files a tool run will
+// touch.
+func (l *Logger) ToolStart(tool, projectRoot string, filesCount int, gitFilter string) {
+	l.zl.Debug().
+		Str("tool", tool).
+		Str("project_root", projectRoot).
+		Int("files_count", filesCount).
+		Str("git_filter", gitFilter).
+		Msg("tool execution starting")
+}
+
+// ToolArgv logs the exact argv a tool invocation received, redacting any
+// secret tokens in individual arguments first so a --debug run doesn't
+// leak them into logs.
+func (l *Logger) ToolArgv(tool string, argv []string) {
+	redacted := make([]string, len(argv))
+	for i, arg := range argv {
+		redacted[i] = redact.Redact(arg)
+	}
+	l.zl.Debug().Str("tool", tool).Strs("argv", redacted).Msg("tool argv")
+}
+
+// ToolDone logs a completed tool invocation's duration and exit code.
+func (l *Logger) ToolDone(tool string, duration time.Duration, exitCode int, err error) {
+	ev := l.zl.Debug().
+		Str("tool", tool).
+		Int64("duration_ms", duration.Milliseconds()).
+		Int("exit_code", exitCode)
+	if err != nil {
+		ev = ev.Err(err)
+	}
+	ev.Msg("tool execution finished")
+}
+
+// CacheEvent logs a cache subsystem action (clear, stats, hit/miss).
+func (l *Logger) CacheEvent(action, key string, hit bool) {
+	l.zl.Debug().Str("action", action).Str("key", key).Bool("cache_hit", hit).Msg("cache")
+}
+
+// Trace logs an extra-verbose event (Git filter calls, plan decisions)
+// that's only emitted when trace mode is on.
+func (l *Logger) Trace(msg string, fields map[string]interface{}) {
+	ev := l.zl.Trace()
+	for k, v := range fields {
+		ev = ev.Interface(k, v)
+	}
+	ev.Msg(msg)
+}
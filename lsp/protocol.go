@@ -0,0 +1,172 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package lsp implements a small subset of the Language Server Protocol so
+// `gz quality serve` can act as an on-save diagnostics backend for editors.
+package lsp
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// JSON-RPC 2.0 envelope types.
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type notification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// JSON-RPC error codes. errCodeParse is not sent: a message that fails to
+// parse is skipped rather than answered.
+const (
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInternal       = -32603
+)
+
+// textDocumentIdentifier identifies a document by URI.
+type textDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// textDocumentItem is the full document sent on didOpen.
+type textDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+type didOpenParams struct {
+	TextDocument textDocumentItem `json:"textDocument"`
+}
+
+type didSaveParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+	Text         string                 `json:"text,omitempty"`
+}
+
+type didChangeParams struct {
+	TextDocument   textDocumentIdentifier `json:"textDocument"`
+	ContentChanges []contentChange        `json:"contentChanges"`
+}
+
+type contentChange struct {
+	Text string `json:"text"`
+}
+
+// runFileParams is the parameter shape for the custom quality/runFile request.
+type runFileParams struct {
+	URI string `json:"uri"`
+}
+
+// documentFormattingParams is textDocument/formatting's request shape.
+// FormattingOptions (tabSize, insertSpaces, ...) is accepted by the spec
+// but unused here - every formatter already has its own config file
+// (.prettierrc, .editorconfig, ...) that takes precedence over whatever
+// the editor would otherwise dictate.
+type documentFormattingParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+}
+
+// codeActionParams is textDocument/codeAction's request shape. Range and
+// Context are accepted by the spec for scoping actions to a selection,
+// but codeActionsForFile returns every fixable issue in the file rather
+// than filtering by them - editors already only show actions overlapping
+// the cursor/selection client-side.
+type codeActionParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+	Range        lspRange               `json:"range"`
+}
+
+// textEdit is a single replacement of Range with NewText, per the LSP
+// spec. Used both for textDocument/formatting's result and inside the
+// WorkspaceEdits codeActions carry.
+type textEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+// workspaceEdit carries per-document edits a codeAction applies, keyed by
+// document URI.
+type workspaceEdit struct {
+	Changes map[string][]textEdit `json:"changes"`
+}
+
+// codeAction is a single quick-fix offered for a document, derived from
+// one Issue's FixInfo/Suggestion.
+type codeAction struct {
+	Title string         `json:"title"`
+	Kind  string         `json:"kind"`
+	Edit  *workspaceEdit `json:"edit,omitempty"`
+}
+
+// position and range, per the LSP spec (0-based line/character).
+type position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start position `json:"start"`
+	End   position `json:"end"`
+}
+
+// diagnosticSeverity mirrors the LSP DiagnosticSeverity enum.
+type diagnosticSeverity int
+
+const (
+	severityError       diagnosticSeverity = 1
+	severityWarning     diagnosticSeverity = 2
+	severityInformation diagnosticSeverity = 3
+	severityHint        diagnosticSeverity = 4
+)
+
+type diagnostic struct {
+	Range    lspRange           `json:"range"`
+	Severity diagnosticSeverity `json:"severity"`
+	Code     string             `json:"code,omitempty"`
+	Source   string             `json:"source,omitempty"`
+	Message  string             `json:"message"`
+}
+
+type publishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []diagnostic `json:"diagnostics"`
+}
+
+// severityFromIssue maps a tools.Issue severity string onto the LSP scale.
+func severityFromIssue(severity string) diagnosticSeverity {
+	switch strings.ToLower(severity) {
+	case "error", "critical", "high":
+		return severityError
+	case "warning", "medium", "moderate":
+		return severityWarning
+	case "info", "low":
+		return severityInformation
+	default:
+		return severityHint
+	}
+}
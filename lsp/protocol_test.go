@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package lsp
+
+import (
+	"testing"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+func TestTextEditForIssue_PrefersFixInfoOverSuggestion(t *testing.T) {
+	issue := tools.Issue{
+		Line:       5,
+		Suggestion: "fallback text",
+		FixInfo: &tools.IssueFixInfo{
+			StartLine:   3,
+			StartColumn: 2,
+			EndLine:     3,
+			EndColumn:   8,
+			InsertText:  "fixed",
+		},
+	}
+
+	edit, ok := textEditForIssue(issue)
+	if !ok {
+		t.Fatal("expected a TextEdit for an issue with FixInfo")
+	}
+	if edit.NewText != "fixed" {
+		t.Errorf("NewText = %q, want %q", edit.NewText, "fixed")
+	}
+	if edit.Range.Start.Line != 2 || edit.Range.Start.Character != 1 {
+		t.Errorf("Start = %+v, want line 2 char 1 (0-based)", edit.Range.Start)
+	}
+	if edit.Range.End.Line != 2 || edit.Range.End.Character != 7 {
+		t.Errorf("End = %+v, want line 2 char 7 (0-based)", edit.Range.End)
+	}
+}
+
+func TestTextEditForIssue_FallsBackToSuggestionAsWholeLineReplace(t *testing.T) {
+	issue := tools.Issue{
+		Line:       4,
+		Suggestion: "corrected line",
+	}
+
+	edit, ok := textEditForIssue(issue)
+	if !ok {
+		t.Fatal("expected a TextEdit for an issue with a Suggestion")
+	}
+	if edit.NewText != "corrected line\n" {
+		t.Errorf("NewText = %q, want %q", edit.NewText, "corrected line\n")
+	}
+	if edit.Range.Start.Line != 3 || edit.Range.End.Line != 4 {
+		t.Errorf("Range = %+v, want whole line 3 (0-based)", edit.Range)
+	}
+}
+
+func TestTextEditForIssue_NoFixReturnsFalse(t *testing.T) {
+	_, ok := textEditForIssue(tools.Issue{Line: 1, Message: "no fix available"})
+	if ok {
+		t.Error("expected ok=false for an issue with neither FixInfo nor Suggestion")
+	}
+}
+
+func TestWholeDocumentReplace_SpansEntireOriginalText(t *testing.T) {
+	original := []byte("line one\nline two\nline three")
+
+	edit := wholeDocumentReplace(original, "new content\n")
+
+	if edit.Range.Start.Line != 0 || edit.Range.Start.Character != 0 {
+		t.Errorf("Start = %+v, want (0,0)", edit.Range.Start)
+	}
+	if edit.Range.End.Line != 2 || edit.Range.End.Character != len("line three") {
+		t.Errorf("End = %+v, want (2, %d)", edit.Range.End, len("line three"))
+	}
+	if edit.NewText != "new content\n" {
+		t.Errorf("NewText = %q, want %q", edit.NewText, "new content\n")
+	}
+}
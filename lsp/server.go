@@ -0,0 +1,475 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/detector"
+	"github.com/Gizzahub/gzh-cli-quality/executor"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// debounceDelay is how long the server waits after the last didChange
+// notification for a document before actually re-running its linters.
+const debounceDelay = 300 * time.Millisecond
+
+// Server is a minimal LSP server that runs quality tools on save/change and
+// publishes results as textDocument/publishDiagnostics notifications. It
+// reuses the registry's tools and the shared ParallelExecutor (and
+// therefore its result cache) so repeated saves of unchanged content are
+// effectively instant.
+type Server struct {
+	registry    tools.ToolRegistry
+	analyzer    *detector.ProjectAnalyzer
+	executor    *executor.ParallelExecutor
+	projectRoot string
+	workers     int
+
+	out *messageWriter
+
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+	exited bool
+}
+
+// NewServer creates an LSP server backed by the given tool registry,
+// language analyzer, and executor.
+func NewServer(registry tools.ToolRegistry, analyzer *detector.ProjectAnalyzer, exec *executor.ParallelExecutor, projectRoot string, workers int) *Server {
+	return &Server{
+		registry:    registry,
+		analyzer:    analyzer,
+		executor:    exec,
+		projectRoot: projectRoot,
+		workers:     workers,
+		timers:      make(map[string]*time.Timer),
+	}
+}
+
+// Run reads JSON-RPC requests from r and writes responses/notifications to
+// w until the client sends `exit`, or r is closed.
+func (s *Server) Run(r io.Reader, w io.Writer) error {
+	s.out = newMessageWriter(w)
+	reader := bufio.NewReader(r)
+
+	for {
+		data, err := readMessage(reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			continue
+		}
+
+		s.dispatch(req)
+
+		if s.isExited() {
+			return nil
+		}
+	}
+}
+
+func (s *Server) isExited() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.exited
+}
+
+// dispatch handles one JSON-RPC request or notification.
+func (s *Server) dispatch(req request) {
+	switch req.Method {
+	case "initialize":
+		s.reply(req.ID, map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": map[string]interface{}{
+					"openClose": true,
+					"change":    1, // incremental not required - we only use full text
+					"save":      map[string]interface{}{"includeText": true},
+				},
+				"documentFormattingProvider": true,
+				"codeActionProvider":         true,
+			},
+		}, nil)
+
+	case "initialized":
+		// notification, nothing to do
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(req.Params, &params); err == nil {
+			s.runAndPublish(params.TextDocument.URI)
+		}
+
+	case "textDocument/didSave":
+		var params didSaveParams
+		if err := json.Unmarshal(req.Params, &params); err == nil {
+			s.runAndPublish(params.TextDocument.URI)
+		}
+
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(req.Params, &params); err == nil {
+			s.scheduleDebouncedRun(params.TextDocument.URI)
+		}
+
+	case "textDocument/formatting":
+		var params documentFormattingParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeInvalidRequest, Message: err.Error()})
+			return
+		}
+
+		edits, err := s.formatFile(params.TextDocument.URI)
+		if err != nil {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeInternal, Message: err.Error()})
+			return
+		}
+
+		s.reply(req.ID, edits, nil)
+
+	case "textDocument/codeAction":
+		var params codeActionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeInvalidRequest, Message: err.Error()})
+			return
+		}
+
+		actions, err := s.codeActionsForFile(params.TextDocument.URI)
+		if err != nil {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeInternal, Message: err.Error()})
+			return
+		}
+
+		s.reply(req.ID, actions, nil)
+
+	case "quality/runFile":
+		var params runFileParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeInvalidRequest, Message: err.Error()})
+			return
+		}
+
+		issues, err := s.runFile(uriToPath(params.URI))
+		if err != nil {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeInternal, Message: err.Error()})
+			return
+		}
+
+		s.publishDiagnostics(params.URI, issues)
+		s.reply(req.ID, diagnosticsFromIssues(issues), nil)
+
+	case "shutdown":
+		s.reply(req.ID, nil, nil)
+
+	case "exit":
+		s.mu.Lock()
+		s.exited = true
+		s.mu.Unlock()
+
+	default:
+		if len(req.ID) > 0 {
+			s.reply(req.ID, nil, &rpcError{Code: errCodeMethodNotFound, Message: "method not found: " + req.Method})
+		}
+	}
+}
+
+// reply sends a JSON-RPC response if id is non-empty (a request, not a
+// notification never gets a reply).
+func (s *Server) reply(id json.RawMessage, result interface{}, rpcErr *rpcError) {
+	if len(id) == 0 {
+		return
+	}
+
+	_ = s.out.write(response{
+		JSONRPC: "2.0",
+		ID:      id,
+		Result:  result,
+		Error:   rpcErr,
+	})
+}
+
+// scheduleDebouncedRun resets the per-document debounce timer so bursts of
+// didChange notifications only trigger one lint run after things settle.
+func (s *Server) scheduleDebouncedRun(uri string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if timer, ok := s.timers[uri]; ok {
+		timer.Stop()
+	}
+
+	s.timers[uri] = time.AfterFunc(debounceDelay, func() {
+		s.runAndPublish(uri)
+	})
+}
+
+// runAndPublish runs quality tools on uri's file and publishes the
+// resulting diagnostics. Errors are swallowed (there's no request to
+// reply to for on-save triggers) - a future request will simply retry.
+func (s *Server) runAndPublish(uri string) {
+	issues, err := s.runFile(uriToPath(uri))
+	if err != nil {
+		return
+	}
+	s.publishDiagnostics(uri, issues)
+}
+
+// runFile looks up path's language, runs its lint-capable tools through
+// the shared executor, and returns the combined issues.
+func (s *Server) runFile(path string) ([]tools.Issue, error) {
+	language := s.analyzer.LanguageForFile(path)
+	if language == "" {
+		return nil, nil
+	}
+
+	var tasks []tools.Task
+	for _, tool := range s.registry.GetToolsByLanguage(language) {
+		if tool.Type() != tools.LINT && tool.Type() != tools.BOTH {
+			continue
+		}
+
+		tasks = append(tasks, tools.Task{
+			Tool:  tool,
+			Files: []string{path},
+			Options: tools.ExecuteOptions{
+				ProjectRoot: s.projectRoot,
+				LintOnly:    true,
+			},
+			Priority: 5,
+		})
+	}
+
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	plan := &tools.ExecutionPlan{Tasks: tasks, TotalFiles: 1}
+
+	results, err := s.executor.ExecuteParallel(context.Background(), plan, s.workers)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []tools.Issue
+	for _, result := range results {
+		issues = append(issues, result.Issues...)
+	}
+
+	return issues, nil
+}
+
+// formatFile runs path's format-capable tools against a scratch copy of
+// its content (the same copy-to-tmpdir-then-diff approach QualityManager
+// runStdin uses) and returns a single whole-document TextEdit if they
+// changed anything, or nil if the file is already formatted or has no
+// format-capable tools registered for its language. Operating on a copy
+// rather than path itself matters here: the editor's buffer may hold
+// unsaved changes that don't match what's on disk, and LSP formatting is
+// expected to return edits for the client to apply, not rewrite the file
+// out from under it.
+func (s *Server) formatFile(uri string) ([]textEdit, error) {
+	path := uriToPath(uri)
+
+	language := s.analyzer.LanguageForFile(path)
+	if language == "" {
+		return nil, nil
+	}
+
+	var formatTools []tools.QualityTool
+	for _, tool := range s.registry.GetToolsByLanguage(language) {
+		if tool.Type() == tools.FORMAT || tool.Type() == tools.BOTH {
+			formatTools = append(formatTools, tool)
+		}
+	}
+	if len(formatTools) == 0 {
+		return nil, nil
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gzquality-lsp-fmt-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpFile := filepath.Join(tmpDir, filepath.Base(path))
+	if err := os.WriteFile(tmpFile, original, 0o644); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]tools.Task, 0, len(formatTools))
+	for _, tool := range formatTools {
+		tasks = append(tasks, tools.Task{
+			Tool:  tool,
+			Files: []string{tmpFile},
+			Options: tools.ExecuteOptions{
+				ProjectRoot: tmpDir,
+				Fix:         true,
+				FormatOnly:  true,
+			},
+			Priority: 5,
+		})
+	}
+
+	plan := &tools.ExecutionPlan{Tasks: tasks, TotalFiles: 1}
+	if _, err := s.executor.ExecuteParallel(context.Background(), plan, s.workers); err != nil {
+		return nil, err
+	}
+
+	formatted, err := os.ReadFile(tmpFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(original, formatted) {
+		return nil, nil
+	}
+
+	return []textEdit{wholeDocumentReplace(original, string(formatted))}, nil
+}
+
+// wholeDocumentReplace builds a TextEdit spanning all of original's text,
+// for formatters that rewrite a file as a whole rather than reporting
+// targeted hunks.
+func wholeDocumentReplace(original []byte, newText string) textEdit {
+	lines := strings.Split(string(original), "\n")
+	lastLine := len(lines) - 1
+
+	return textEdit{
+		Range: lspRange{
+			Start: position{Line: 0, Character: 0},
+			End:   position{Line: lastLine, Character: len(lines[lastLine])},
+		},
+		NewText: newText,
+	}
+}
+
+// codeActionsForFile lints uri's file and returns one quickfix CodeAction
+// per issue that carries a machine-applicable fix, derived from the same
+// FixInfo/Suggestion fields the SARIF/autofix paths already use.
+func (s *Server) codeActionsForFile(uri string) ([]codeAction, error) {
+	issues, err := s.runFile(uriToPath(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]codeAction, 0, len(issues))
+	for _, issue := range issues {
+		edit, ok := textEditForIssue(issue)
+		if !ok {
+			continue
+		}
+
+		actions = append(actions, codeAction{
+			Title: fmt.Sprintf("Fix: %s", issue.Message),
+			Kind:  "quickfix",
+			Edit: &workspaceEdit{
+				Changes: map[string][]textEdit{uri: {edit}},
+			},
+		})
+	}
+
+	return actions, nil
+}
+
+// textEditForIssue derives a TextEdit from an Issue's structured fix data,
+// preferring the line/column-addressed FixInfo when present and falling
+// back to replacing Line wholesale with Suggestion. ok is false if issue
+// carries no machine-applicable fix at all.
+func textEditForIssue(issue tools.Issue) (textEdit, bool) {
+	if issue.FixInfo != nil {
+		return textEdit{
+			Range: lspRange{
+				Start: position{Line: issue.FixInfo.StartLine - 1, Character: issue.FixInfo.StartColumn - 1},
+				End:   position{Line: issue.FixInfo.EndLine - 1, Character: issue.FixInfo.EndColumn - 1},
+			},
+			NewText: issue.FixInfo.InsertText,
+		}, true
+	}
+
+	if issue.Suggestion != "" {
+		line := issue.Line - 1
+		if line < 0 {
+			line = 0
+		}
+
+		return textEdit{
+			Range: lspRange{
+				Start: position{Line: line, Character: 0},
+				End:   position{Line: line + 1, Character: 0},
+			},
+			NewText: issue.Suggestion + "\n",
+		}, true
+	}
+
+	return textEdit{}, false
+}
+
+// publishDiagnostics sends a textDocument/publishDiagnostics notification.
+func (s *Server) publishDiagnostics(uri string, issues []tools.Issue) {
+	_ = s.out.write(notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: publishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnosticsFromIssues(issues),
+		},
+	})
+}
+
+// diagnosticsFromIssues converts tools.Issue values (1-based line/column)
+// into LSP diagnostics (0-based line/character).
+func diagnosticsFromIssues(issues []tools.Issue) []diagnostic {
+	diagnostics := make([]diagnostic, 0, len(issues))
+
+	for _, issue := range issues {
+		line := issue.Line - 1
+		if line < 0 {
+			line = 0
+		}
+		column := issue.Column - 1
+		if column < 0 {
+			column = 0
+		}
+
+		diagnostics = append(diagnostics, diagnostic{
+			Range: lspRange{
+				Start: position{Line: line, Character: column},
+				End:   position{Line: line, Character: column},
+			},
+			Severity: severityFromIssue(issue.Severity),
+			Code:     issue.Rule,
+			Source:   issue.File,
+			Message:  issue.Message,
+		})
+	}
+
+	return diagnostics
+}
+
+// uriToPath converts a `file://` URI to a plain filesystem path. Non-file
+// URIs are returned unchanged since nothing else is supported.
+func uriToPath(uri string) string {
+	return strings.TrimPrefix(uri, "file://")
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// readMessage reads one `Content-Length`-framed JSON-RPC message from r.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	contentLength := -1
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break // blank line separates headers from body
+		}
+
+		if name, value, ok := strings.Cut(line, ":"); ok && strings.EqualFold(strings.TrimSpace(name), "Content-Length") {
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid Content-Length header %q: %w", value, err)
+			}
+			contentLength = n
+		}
+	}
+
+	if contentLength < 0 {
+		return nil, fmt.Errorf("message missing Content-Length header")
+	}
+
+	body := make([]byte, contentLength)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// messageWriter serializes concurrent writes of framed JSON-RPC messages,
+// since diagnostics can be published from debounce timer goroutines while
+// the main read loop is also replying to requests.
+type messageWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newMessageWriter(w io.Writer) *messageWriter {
+	return &messageWriter{w: w}
+}
+
+func (mw *messageWriter) write(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal LSP message: %w", err)
+	}
+
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	if _, err := fmt.Fprintf(mw.w, "Content-Length: %d\r\n\r\n", len(data)); err != nil {
+		return err
+	}
+	_, err = mw.w.Write(data)
+	return err
+}
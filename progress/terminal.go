@@ -0,0 +1,242 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package progress renders live per-worker status for an
+// executor.ParallelExecutor run: one persistent status line per worker,
+// a global progress bar, and a scrolling tail of recently found issues.
+package progress
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/executor"
+)
+
+// maxIssueTail bounds how many recent "tool found N issues" lines Terminal
+// keeps on screen at once, so a noisy run's issue tail scrolls instead of
+// growing without bound.
+const maxIssueTail = 5
+
+// defaultRefreshInterval bounds how often Terminal repaints its TTY
+// block. Events arrive far more often than a terminal can usefully
+// redraw (a fast linter can finish several files a millisecond), so
+// repaints are coalesced to this rate instead of firing one per event.
+const defaultRefreshInterval = 100 * time.Millisecond
+
+// Terminal is an executor.ProgressReporter that renders live progress
+// for a ParallelExecutor run: in a TTY, it repaints an in-place block of
+// one line per worker plus a progress bar and issue tail, at a bounded
+// refresh rate; otherwise (CI logs, a pipe) it falls back to one
+// structured log line per event, since redrawing in place only makes
+// sense on a real terminal.
+type Terminal struct {
+	out        *os.File
+	isTTY      bool
+	refresh    time.Duration
+	totalTasks int
+
+	mu         sync.Mutex
+	workerLine []string
+	issueTail  []string
+	doneTasks  int
+	painted    int // number of lines printed by the last paint, for cursor-up
+	dirty      bool
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewTerminal creates a Terminal that reports on out (typically
+// os.Stderr) for a run of workers concurrent workers and totalTasks
+// tasks overall. In a TTY, it starts a background repaint loop
+// immediately; call Close when the run finishes to stop it and leave
+// the final state on screen.
+func NewTerminal(out *os.File, workers, totalTasks int) *Terminal {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	t := &Terminal{
+		out:        out,
+		isTTY:      isTerminal(out),
+		refresh:    defaultRefreshInterval,
+		totalTasks: totalTasks,
+		workerLine: make([]string, workers),
+		stop:       make(chan struct{}),
+	}
+	for i := range t.workerLine {
+		t.workerLine[i] = idleLine(i)
+	}
+
+	if t.isTTY {
+		t.wg.Add(1)
+		go t.repaintLoop()
+	}
+
+	return t
+}
+
+// HandleEvent updates Terminal's state for evt and, in TTY mode, marks
+// it dirty for the next repaint; in non-TTY mode it logs evt immediately
+// as its own line. Satisfies executor.ProgressReporter.
+func (t *Terminal) HandleEvent(evt executor.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch evt.Phase {
+	case executor.EventStarted:
+		t.setWorkerLine(evt.Worker, fmt.Sprintf("[%d] %s %s", evt.Worker, evt.Tool, firstFile(evt.Files)))
+		t.logNow("[worker %d] started %s %s", evt.Worker, evt.Tool, firstFile(evt.Files))
+
+	case executor.EventCacheHit:
+		t.doneTasks++
+		t.setWorkerLine(evt.Worker, idleLine(evt.Worker))
+		t.logNow("[worker %d] %s cached", evt.Worker, evt.Tool)
+
+	case executor.EventFinished, executor.EventTimedOut, executor.EventCancelled:
+		t.doneTasks++
+		t.setWorkerLine(evt.Worker, idleLine(evt.Worker))
+		if evt.Err != nil {
+			t.logNow("[worker %d] %s %s after %s: %s", evt.Worker, evt.Tool, evt.Phase, evt.Elapsed.Round(time.Millisecond), evt.Err)
+		} else {
+			t.logNow("[worker %d] %s finished in %s", evt.Worker, evt.Tool, evt.Elapsed.Round(time.Millisecond))
+		}
+
+	case executor.EventIssueFound:
+		line := fmt.Sprintf("%s: %d issue(s)", evt.Tool, evt.IssueCount)
+		t.issueTail = append(t.issueTail, line)
+		if len(t.issueTail) > maxIssueTail {
+			t.issueTail = t.issueTail[len(t.issueTail)-maxIssueTail:]
+		}
+		t.logNow("  %s", line)
+
+	case executor.EventWorkerIdle:
+		t.setWorkerLine(evt.Worker, idleLine(evt.Worker))
+	}
+
+	t.dirty = true
+}
+
+// setWorkerLine must be called with t.mu held.
+func (t *Terminal) setWorkerLine(worker int, line string) {
+	if worker < 0 || worker >= len(t.workerLine) {
+		return
+	}
+	t.workerLine[worker] = line
+}
+
+// logNow writes one line directly to t.out, but only in non-TTY mode -
+// in TTY mode the same information is already reflected in the next
+// repaint, and printing both would duplicate it. Must be called with
+// t.mu held.
+func (t *Terminal) logNow(format string, args ...interface{}) {
+	if t.isTTY {
+		return
+	}
+	fmt.Fprintf(t.out, format+"\n", args...)
+}
+
+// repaintLoop redraws the TTY block at most once per t.refresh, only
+// when HandleEvent has marked state dirty since the last paint.
+func (t *Terminal) repaintLoop() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.refresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.mu.Lock()
+			if t.dirty {
+				t.paint()
+				t.dirty = false
+			}
+			t.mu.Unlock()
+		case <-t.stop:
+			t.mu.Lock()
+			t.paint()
+			t.mu.Unlock()
+			return
+		}
+	}
+}
+
+// paint redraws the whole block in place. Must be called with t.mu held.
+func (t *Terminal) paint() {
+	if t.painted > 0 {
+		fmt.Fprintf(t.out, "\033[%dA", t.painted)
+	}
+
+	lines := 0
+	for _, line := range t.workerLine {
+		fmt.Fprintf(t.out, "\033[2K%s\n", line)
+		lines++
+	}
+
+	fmt.Fprintf(t.out, "\033[2K%s\n", t.progressBar())
+	lines++
+
+	for _, line := range t.issueTail {
+		fmt.Fprintf(t.out, "\033[2K  %s\n", line)
+		lines++
+	}
+
+	t.painted = lines
+}
+
+// progressBar renders a "[done/total] XX%" summary line.
+func (t *Terminal) progressBar() string {
+	if t.totalTasks <= 0 {
+		return fmt.Sprintf("[%d done]", t.doneTasks)
+	}
+	pct := t.doneTasks * 100 / t.totalTasks
+	return fmt.Sprintf("[%d/%d] %d%%", t.doneTasks, t.totalTasks, pct)
+}
+
+// Close stops the repaint loop (if running), leaving the final state
+// painted on screen. Safe to call more than once.
+func (t *Terminal) Close() {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+	t.wg.Wait()
+}
+
+func idleLine(worker int) string {
+	return fmt.Sprintf("[%d] idle", worker)
+}
+
+func firstFile(files []string) string {
+	if len(files) == 0 {
+		return ""
+	}
+	if len(files) == 1 {
+		return files[0]
+	}
+	return fmt.Sprintf("%s (+%d more)", files[0], len(files)-1)
+}
+
+// isTerminal reports whether f is connected to a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return (info.Mode() & os.ModeCharDevice) != 0
+}
+
+var _ executor.ProgressReporter = (*Terminal)(nil)
+
+// String renders the current worker lines for debugging/test assertions
+// without needing a real terminal.
+func (t *Terminal) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return strings.Join(t.workerLine, "\n")
+}
@@ -6,20 +6,28 @@ package quality
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v3"
 
 	"github.com/Gizzahub/gzh-cli-quality/cache"
 	"github.com/Gizzahub/gzh-cli-quality/config"
 	"github.com/Gizzahub/gzh-cli-quality/detector"
 	"github.com/Gizzahub/gzh-cli-quality/executor"
+	gitutils "github.com/Gizzahub/gzh-cli-quality/git"
+	"github.com/Gizzahub/gzh-cli-quality/internal/logger"
+	"github.com/Gizzahub/gzh-cli-quality/logx"
+	"github.com/Gizzahub/gzh-cli-quality/redact"
 	"github.com/Gizzahub/gzh-cli-quality/report"
 	"github.com/Gizzahub/gzh-cli-quality/tools"
+	"github.com/Gizzahub/gzh-cli-quality/tools/cmdobj"
 )
 
 const (
@@ -35,6 +43,7 @@ type QualityManager struct {
 	planner      *executor.ExecutionPlanner
 	config       *config.Config
 	cacheManager *cache.CacheManager
+	logger       *logx.Logger
 }
 
 // NewQualityManager creates a new quality manager.
@@ -51,10 +60,30 @@ func NewQualityManager() *QualityManager {
 		cfg = config.DefaultConfig()
 	}
 
+	// Register any third-party tools the config's tool_manifests points
+	// at before validating, so Validate recognizes them as known tools.
+	for _, manifestPath := range cfg.ToolManifests {
+		if err := registry.RegisterFromManifest(manifestPath); err != nil {
+			fmt.Printf("⚠️ 도구 매니페스트 로드 실패: %v\n", err)
+		}
+	}
+
 	analyzer := detector.NewProjectAnalyzer()
+	applyDetectionConfig(analyzer, cfg.Detection)
 	adapter := &ProjectAnalyzerAdapter{analyzer}
 	planner := executor.NewExecutionPlanner(adapter)
 
+	logger := logx.New(logx.DebugFromEnv(), false, true)
+	planner.SetTracer(logger)
+
+	if err := cfg.Validate(toolNames(registry), analyzer.LanguageNames()); err != nil {
+		fmt.Printf("⚠️ 설정 파일 검증 실패: %v\n", err)
+	}
+
+	if err := redact.LoadFromConfig(cfg.Redact.Patterns, cfg.Redact.Values); err != nil {
+		fmt.Printf("⚠️ redact 패턴 로드 실패: %v\n", err)
+	}
+
 	// Initialize cache manager based on config
 	var cacheManager *cache.CacheManager
 	if cfg.Cache.Enabled {
@@ -64,13 +93,22 @@ func NewQualityManager() *QualityManager {
 			// If cache initialization fails, continue without cache
 			fmt.Printf("⚠️ 캐시 초기화 실패: %v (캐시 없이 계속 진행)\n", err)
 			cacheManager = nil
+		} else {
+			applyCacheCompression(cacheManager, cfg.Cache)
+			applyCacheChunking(cacheManager, cfg.Cache)
+			cacheManager.SetMaxEntries(cfg.Cache.MaxEntries)
 		}
 	}
 
-	// Create executor with or without cache
+	// Create executor with or without cache. The executor consults a
+	// TieredManager in front of cacheManager rather than cacheManager
+	// directly, so repeated lookups for the same (tool, file) within a
+	// run - or across runs while the process stays warm, e.g. `quality
+	// watch` - skip the disk read entirely.
 	var parallelExecutor *executor.ParallelExecutor
 	if cacheManager != nil {
-		parallelExecutor = executor.NewParallelExecutorWithCache(runtime.NumCPU(), 10*time.Minute, cacheManager)
+		parallelExecutor = executor.NewParallelExecutorWithCache(
+			runtime.NumCPU(), 10*time.Minute, cache.NewTieredManager(cacheManager, cfg.Cache.MemShardCapacity))
 	} else {
 		parallelExecutor = executor.NewParallelExecutor(runtime.NumCPU(), 10*time.Minute)
 	}
@@ -82,6 +120,100 @@ func NewQualityManager() *QualityManager {
 		planner:      planner,
 		config:       cfg,
 		cacheManager: cacheManager,
+		logger:       logger,
+	}
+}
+
+// reconfigureCacheDir rebuilds the cache manager (and the executor that
+// holds it) to use dir as the cache root, overriding whatever directory
+// the config file pointed at. dir may be a plain path or a Storage URL
+// (mem://, s3://, gs://, azblob://). This backs the --cache-dir flag, which
+// lets CI jobs point the whole cache at a mounted shared volume (e.g.
+// GitLab's $CI_PROJECT_DIR/.quality-cache) or a shared bucket without
+// editing .gzquality.yml, the same way projects share GOLANGCI_LINT_CACHE
+// across pipeline stages.
+func (m *QualityManager) reconfigureCacheDir(dir string) {
+	maxAge := parseDuration(m.config.Cache.MaxAge, 7*24*time.Hour)
+
+	cacheManager, err := cache.NewCacheManager(dir, m.config.Cache.MaxSize, maxAge)
+	if err != nil {
+		fmt.Printf("⚠️ 캐시 디렉토리 재설정 실패: %v (기존 캐시 설정 유지)\n", err)
+		return
+	}
+	applyCacheCompression(cacheManager, m.config.Cache)
+	applyCacheChunking(cacheManager, m.config.Cache)
+	cacheManager.SetMaxEntries(m.config.Cache.MaxEntries)
+
+	m.cacheManager = cacheManager
+	m.executor = executor.NewParallelExecutorWithCache(
+		runtime.NumCPU(), 10*time.Minute, cache.NewTieredManager(cacheManager, m.config.Cache.MemShardCapacity))
+}
+
+// applyCacheCompression installs cacheCfg.Compression on cacheManager if
+// it's set, leaving cache.NewCacheManager's own default (currently zstd)
+// in place otherwise.
+func applyCacheCompression(cacheManager *cache.CacheManager, cacheCfg config.CacheConfig) {
+	if cacheCfg.Compression == "" {
+		return
+	}
+
+	algo := cache.CompressionAlgorithm(cacheCfg.Compression)
+	if err := cacheManager.SetCompression(algo, cacheCfg.CompressionLevel); err != nil {
+		fmt.Printf("⚠️ 캐시 압축 설정 실패: %v (기존 압축 방식 유지)\n", err)
+	}
+}
+
+// applyDetectionConfig copies detectionCfg onto analyzer's
+// FileTypeDetector, so a .gzquality.yml detection block can turn off
+// the naive-Bayes content-classification fallback or tighten/loosen how
+// many leading bytes of a file it peeks at.
+func applyDetectionConfig(analyzer *detector.ProjectAnalyzer, detectionCfg config.DetectionConfig) {
+	d := analyzer.LanguageDetector()
+	d.ContentClassification = detectionCfg.ContentClassification
+	d.MaxBytesScanned = detectionCfg.MaxBytesScanned
+}
+
+// applyShardingDefaults fills in opts.shard/shards/shardStrategy from
+// shardingCfg wherever the CLI left them at their no-sharding defaults
+// (shards <= 1, strategy unset), so a CI matrix can commit its shard
+// layout to .gzquality.yml instead of repeating --shard/--shards/
+// --shard-strategy in every job's command line. An explicit CLI flag
+// always wins.
+func applyShardingDefaults(opts *executionOptions, shardingCfg config.ShardingConfig) {
+	if opts.shards <= 1 && shardingCfg.Total > 1 {
+		opts.shard = shardingCfg.Index
+		opts.shards = shardingCfg.Total
+	}
+	if opts.shardStrategy == "" {
+		opts.shardStrategy = shardingCfg.Strategy
+	}
+}
+
+// applyBaselineDefaults fills in opts.baselinePath/updateBaseline from
+// baselineCfg whenever a CLI flag left them unset, so a legacy codebase can
+// commit its baseline file/mode to .gzquality.yml instead of repeating
+// --baseline/--update-baseline on every run. An explicit CLI flag always
+// wins; baselineCfg.Mode == "ignore" leaves baseline filtering off even if
+// File is set.
+func applyBaselineDefaults(opts *executionOptions, baselineCfg config.BaselineConfig) {
+	if baselineCfg.Mode == "ignore" {
+		return
+	}
+
+	if opts.baselinePath == "" {
+		opts.baselinePath = baselineCfg.File
+	}
+	if !opts.updateBaseline && baselineCfg.Mode == "update" {
+		opts.updateBaseline = true
+	}
+}
+
+// applyCacheChunking turns on content-defined chunking on cacheManager
+// when cacheCfg asks for it; off by default, so existing caches keep
+// writing single compressed blobs per entry unless the config opts in.
+func applyCacheChunking(cacheManager *cache.CacheManager, cacheCfg config.CacheConfig) {
+	if cacheCfg.ChunkingEnabled {
+		cacheManager.EnableChunking()
 	}
 }
 
@@ -146,8 +278,48 @@ func NewQualityCmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			debug, _ := cmd.Flags().GetBool("debug")
+			trace, _ := cmd.Flags().GetBool("trace")
+			format, _ := cmd.Flags().GetString("debug-format")
+
+			debug = debug || trace || logx.DebugFromEnv()
+
+			manager.logger = logx.New(debug, trace, format != "json")
+			manager.planner.SetTracer(manager.logger)
+
+			if cacheDir, _ := cmd.Flags().GetString("cache-dir"); cacheDir != "" {
+				manager.reconfigureCacheDir(cacheDir)
+			}
+
+			if logFile, _ := cmd.Flags().GetString("log-file"); logFile != "" {
+				maxSize, _ := cmd.Flags().GetInt("log-max-size")
+				maxBackups, _ := cmd.Flags().GetInt("log-max-backups")
+
+				sink, err := logger.NewFileSink(logFile, logger.FileSinkConfig{
+					MaxSizeMB:  maxSize,
+					MaxBackups: maxBackups,
+				})
+				if err != nil {
+					return fmt.Errorf("--log-file %s: %w", logFile, err)
+				}
+
+				tools.SetLogOutput(sink)
+				tools.SetLogLevel(logger.LevelDebug)
+			}
+
+			return nil
+		},
 	}
 
+	cmd.PersistentFlags().Bool("debug", false, "구조화된 디버그 로그 활성화 (QUALITY_DEBUG=1로도 설정 가능)")
+	cmd.PersistentFlags().Bool("trace", false, "Git 필터 호출과 계획 결정까지 추적 (--debug 포함)")
+	cmd.PersistentFlags().String("debug-format", "console", "디버그 로그 형식 (console, json)")
+	cmd.PersistentFlags().String("cache-dir", "", "캐시 디렉토리를 재정의 (CI 공유 볼륨 지정용, 예: $CI_PROJECT_DIR/.quality-cache, 또는 mem://, s3://bucket, gs://bucket, azblob://container 같은 URL)")
+	cmd.PersistentFlags().String("log-file", "", "도구 실행 로그를 저장할 파일 경로 (설정 시 --log-max-size/--log-max-backups 기준으로 회전)")
+	cmd.PersistentFlags().Int("log-max-size", 100, "로그 파일 회전 기준 크기 (MB)")
+	cmd.PersistentFlags().Int("log-max-backups", 3, "보관할 회전된 로그 파일 최대 개수")
+
 	// Add subcommands
 	cmd.AddCommand(manager.newRunCmd())
 	cmd.AddCommand(manager.newCheckCmd())
@@ -158,10 +330,23 @@ func NewQualityCmd() *cobra.Command {
 	cmd.AddCommand(manager.newVersionCmd())
 	cmd.AddCommand(manager.newListCmd())
 	cmd.AddCommand(manager.newToolCmd())
+	cmd.AddCommand(manager.newScanCmd())
+	cmd.AddCommand(manager.newBaselineCmd())
+	cmd.AddCommand(manager.newServeCmd())
+	cmd.AddCommand(manager.newWatchCmd())
+	cmd.AddCommand(manager.newHooksCmd())
+	cmd.AddCommand(manager.newPrecommitCmd())
+	cmd.AddCommand(manager.newAutofixCmd())
+	cmd.AddCommand(manager.newDiagnoseCmd())
 
 	// Cache management commands
 	cmd.AddCommand(manager.newCacheClearCmd())
 	cmd.AddCommand(manager.newCacheStatsCmd())
+	cmd.AddCommand(manager.newCacheCmd())
+	cmd.AddCommand(manager.newCacheExportCmd())
+	cmd.AddCommand(manager.newCacheImportCmd())
+	cmd.AddCommand(manager.newReportMergeCmd())
+	cmd.AddCommand(manager.newReportFilterCmd())
 
 	// Language-specific subcommands removed - use direct tool commands instead
 
@@ -172,11 +357,20 @@ func NewQualityCmd() *cobra.Command {
 func addCommonExecutionFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSliceP("files", "f", nil, "특정 파일들만 처리")
 	cmd.Flags().IntP("workers", "w", runtime.NumCPU(), "병렬 실행 워커 수")
+	cmd.Flags().Int("max-workers", 0, "--workers의 별칭 (tflint 등 타 도구와 익숙한 플래그명, 0이면 --workers 값 사용)")
 	cmd.Flags().StringSlice("extra-args", nil, "도구에 전달할 추가 인수")
 	cmd.Flags().Bool("dry-run", false, "실제 실행하지 않고 계획만 표시")
 	cmd.Flags().BoolP("verbose", "v", false, "상세 출력")
-	cmd.Flags().String("report", "", "리포트 생성 (json, html, markdown)")
+	cmd.Flags().String("report", "", "리포트 생성 (json, html, markdown, sarif)")
+	cmd.Flags().String("format", "", "--report의 별칭 (GitHub code scanning 등 외부 도구 연동 시 익숙한 플래그명, 예: --format sarif)")
 	cmd.Flags().String("output", "", "리포트 출력 파일 경로")
+	cmd.Flags().String("report-baseline", "", "비교할 이전 리포트(JSON) 경로 - 지정 시 신규/해결/기존 이슈로 나눈 diff 리포트도 함께 생성 (PR 게이트용)")
+	cmd.Flags().Bool("explain", false, "실행하지 않고 각 작업이 실행할 명령(argv)만 출력")
+	cmd.Flags().Int("shard", 0, "이 실행이 처리할 샤드 번호 (0부터 시작, --shards와 함께 사용)")
+	cmd.Flags().Int("shards", 1, "전체 샤드 개수 (CI 워커 수만큼 설정해 작업을 분할)")
+	cmd.Flags().String("shard-strategy", "", "샤딩 전략: file-hash(기본), package, language, size-balanced")
+	cmd.Flags().Int("slowest", 0, "가장 느린 N개 도구를 요약에 표시 (0이면 비활성화)")
+	cmd.Flags().StringArray("tag", nil, "ToolConfig.When 표현식의 태그 원자(atom)와 매칭할 임의 태그 (여러 번 지정 가능, 예: --tag fast)")
 }
 
 // addGitFilterFlags adds Git-based filtering flags to a command.
@@ -184,12 +378,17 @@ func addGitFilterFlags(cmd *cobra.Command) {
 	cmd.Flags().String("since", "", "특정 커밋 이후 변경된 파일만 처리 (예: HEAD~1, main)")
 	cmd.Flags().Bool("staged", false, "Git staged 파일만 처리")
 	cmd.Flags().Bool("changed", false, "변경된 파일만 처리 (staged + modified + untracked)")
+	cmd.Flags().Bool("since-upstream", false, "브랜치의 업스트림과의 병합 기준점 이후 변경된 파일만 처리 (PR 범위 검사용)")
+	cmd.Flags().String("since-branch", "", "지정한 브랜치와의 병합 기준점 이후 변경된 파일만 처리 (예: main)")
+	cmd.Flags().Bool("changed-only", false, "위 Git 필터 옵션 중 하나를 기준으로 변경된 라인에 해당하는 이슈만 보고 (다른 Git 필터 옵션과 함께 사용)")
 }
 
 // addCacheFlags adds cache control flags to a command.
 func addCacheFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("cache", true, "결과 캐싱 활성화 (기본: 활성)")
 	cmd.Flags().Bool("no-cache", false, "결과 캐싱 비활성화")
+	cmd.Flags().String("cache-backend", "local", "캐시 백엔드 선택 (local, http, s3). QUALITY_CACHE_* 환경 변수로도 설정 가능")
+	cmd.Flags().Bool("cache-remote-readonly", false, "원격 캐시를 읽기 전용으로 사용 (신뢰할 수 없는 PR 빌드가 공유 캐시를 오염시키지 않도록). QUALITY_CACHE_REMOTE_READONLY 환경 변수로도 설정 가능")
 }
 
 // newRunCmd creates the run subcommand.
@@ -200,6 +399,9 @@ func (m *QualityManager) newRunCmd() *cobra.Command {
 		Long: `모든 사용 가능한 포매팅 및 린팅 도구를 자동으로 감지하여 실행합니다.
 프로젝트의 언어를 자동으로 감지하고 적절한 도구들을 병렬로 실행합니다.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if hint, _ := cmd.Flags().GetString("stdin"); hint != "" {
+				return m.runStdin(cmd.Context(), hint)
+			}
 			return m.runQuality(cmd, args)
 		},
 	}
@@ -208,31 +410,122 @@ func (m *QualityManager) newRunCmd() *cobra.Command {
 	addCommonExecutionFlags(cmd)
 	addGitFilterFlags(cmd)
 	addCacheFlags(cmd)
+	addBaselineFlags(cmd)
 
 	// Run-specific flags
 	cmd.Flags().BoolP("fix", "x", false, "자동 수정 적용 (지원하는 도구만)")
 	cmd.Flags().Bool("format-only", false, "포매팅만 실행")
 	cmd.Flags().Bool("lint-only", false, "린팅만 실행")
+	cmd.Flags().String("stdin", "", "표준입력에서 소스 코드를 읽어 <경로 힌트>의 확장자로 포매터를 선택 적용한 뒤 결과를 표준출력에 스트리밍 (예: --stdin main.go, 에디터/사전 커밋 훅 연동용)")
 
 	return cmd
 }
 
+// runStdin implements "gz quality run --stdin <path-hint>": it reads
+// source from stdin into a temp file named after hint's base name so
+// extension-based language detection behaves exactly like a real file,
+// runs every enabled formatter whose ToolConfig.StdinCapable allows it
+// against that lone file, and streams the (possibly rewritten) result to
+// stdout. Tools that aren't StdinCapable are silently skipped, since they
+// rely on project-wide context a single piped-in file can't provide.
+// Mirrors treefmt's --stdin flow for wiring this command into an editor
+// or a pre-commit hook.
+func (m *QualityManager) runStdin(ctx context.Context, hint string) error {
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read stdin: %w", err)
+	}
+
+	if !m.config.ShouldInclude(hint) {
+		_, err := os.Stdout.Write(input)
+		return err
+	}
+
+	var stdinTools []string
+	for _, tool := range m.registry.GetToolsForFile(hint) {
+		toolCfg := m.config.GetToolConfig(tool.Name())
+		if toolCfg.StdinCapable && m.config.IsToolActive(tool.Name()) {
+			stdinTools = append(stdinTools, tool.Name())
+		}
+	}
+
+	if len(stdinTools) == 0 {
+		_, err := os.Stdout.Write(input)
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gzquality-stdin-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpFile := filepath.Join(tmpDir, filepath.Base(hint))
+	if err := os.WriteFile(tmpFile, input, 0o644); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	timeout := parseDuration(m.config.StdinTimeout, 30*time.Second)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	planOptions := executor.PlanOptions{
+		Fix:        true,
+		FormatOnly: true,
+		ToolFilter: stdinTools,
+	}
+
+	plan, err := m.planner.CreatePlan(runCtx, tmpDir, m.registry, planOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create execution plan: %w", err)
+	}
+
+	if len(plan.Tasks) > 0 {
+		if _, err := m.executor.ExecuteParallel(runCtx, plan, 1); err != nil {
+			return fmt.Errorf("failed to format stdin: %w", err)
+		}
+	}
+
+	output, err := os.ReadFile(tmpFile)
+	if err != nil {
+		return fmt.Errorf("failed to read formatted output: %w", err)
+	}
+
+	_, err = os.Stdout.Write(output)
+	return err
+}
+
 // executionOptions holds common options for run/check commands.
 type executionOptions struct {
-	files        []string
-	fix          bool
-	formatOnly   bool
-	lintOnly     bool
-	workers      int
-	extraArgs    []string
-	dryRun       bool
-	verbose      bool
-	reportFormat string
-	outputPath   string
-	since        string
-	staged       bool
-	changed      bool
-	cacheEnabled bool
+	files          []string
+	fix            bool
+	formatOnly     bool
+	lintOnly       bool
+	workers        int
+	extraArgs      []string
+	dryRun         bool
+	explain        bool
+	verbose        bool
+	reportFormat   string
+	outputPath     string
+	reportBaseline string
+	since          string
+	staged         bool
+	changed        bool
+	sinceUpstream  bool
+	sinceBranch    string
+	changedOnly    bool
+	cacheEnabled   bool
+	shard          int
+	shards         int
+	shardStrategy  string
+	slowest        int
+	tags           []string
+	// Baseline suppression
+	baselinePath   string
+	updateBaseline bool
+	strictBaseline bool
+	pruneBaseline  bool
 	// Display customization
 	emptyMessage  string
 	executePrefix string
@@ -247,61 +540,111 @@ func parseExecutionOptions(cmd *cobra.Command) (*executionOptions, error) {
 	opts.formatOnly, _ = cmd.Flags().GetBool("format-only")
 	opts.lintOnly, _ = cmd.Flags().GetBool("lint-only")
 	opts.workers, _ = cmd.Flags().GetInt("workers")
+	if maxWorkers, _ := cmd.Flags().GetInt("max-workers"); maxWorkers > 0 {
+		opts.workers = maxWorkers
+	}
 	opts.extraArgs, _ = cmd.Flags().GetStringSlice("extra-args")
 	opts.dryRun, _ = cmd.Flags().GetBool("dry-run")
+	opts.explain, _ = cmd.Flags().GetBool("explain")
 	opts.verbose, _ = cmd.Flags().GetBool("verbose")
 	opts.reportFormat, _ = cmd.Flags().GetString("report")
+	if opts.reportFormat == "" {
+		opts.reportFormat, _ = cmd.Flags().GetString("format")
+	}
 	opts.outputPath, _ = cmd.Flags().GetString("output")
+	opts.reportBaseline, _ = cmd.Flags().GetString("report-baseline")
+	opts.shard, _ = cmd.Flags().GetInt("shard")
+	opts.shards, _ = cmd.Flags().GetInt("shards")
+	opts.shardStrategy, _ = cmd.Flags().GetString("shard-strategy")
+	opts.slowest, _ = cmd.Flags().GetInt("slowest")
+	opts.tags, _ = cmd.Flags().GetStringArray("tag")
 
 	// Git-based flags
 	opts.since, _ = cmd.Flags().GetString("since")
 	opts.staged, _ = cmd.Flags().GetBool("staged")
 	opts.changed, _ = cmd.Flags().GetBool("changed")
+	opts.sinceUpstream, _ = cmd.Flags().GetBool("since-upstream")
+	opts.sinceBranch, _ = cmd.Flags().GetString("since-branch")
+	opts.changedOnly, _ = cmd.Flags().GetBool("changed-only")
 
 	// Cache control flags
 	cacheEnabled, _ := cmd.Flags().GetBool("cache")
 	noCache, _ := cmd.Flags().GetBool("no-cache")
 	opts.cacheEnabled = cacheEnabled && !noCache
 
+	// Baseline flags (only present on commands that called addBaselineFlags)
+	if cmd.Flags().Lookup("baseline") != nil {
+		opts.baselinePath, _ = cmd.Flags().GetString("baseline")
+	}
+	if cmd.Flags().Lookup("update-baseline") != nil {
+		opts.updateBaseline, _ = cmd.Flags().GetBool("update-baseline")
+	}
+	if cmd.Flags().Lookup("strict-baseline") != nil {
+		opts.strictBaseline, _ = cmd.Flags().GetBool("strict-baseline")
+	}
+
 	return opts, nil
 }
 
 // executeQuality is the common execution logic for run/check commands.
 func (m *QualityManager) executeQuality(ctx context.Context, opts *executionOptions) error {
 	m.updateCacheState(opts.cacheEnabled)
+	m.config.Tags = opts.tags
 
 	projectRoot, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	if err := m.validateGitFlags(opts.since, opts.staged, opts.changed); err != nil {
+	if err := m.validateGitFlags(opts.since, opts.staged, opts.changed, opts.sinceUpstream, opts.sinceBranch); err != nil {
 		return err
 	}
 
-	planOptions := executor.PlanOptions{
-		Files:      opts.files,
-		Fix:        opts.fix,
-		FormatOnly: opts.formatOnly,
-		LintOnly:   opts.lintOnly,
-		ExtraArgs:  opts.extraArgs,
-		Since:      opts.since,
-		Staged:     opts.staged,
-		Changed:    opts.changed,
+	applyShardingDefaults(opts, m.config.Sharding)
+
+	if opts.shards > 1 && (opts.shard < 0 || opts.shard >= opts.shards) {
+		return fmt.Errorf("--shard는 0 이상 --shards(%d) 미만이어야 합니다 (입력값: %d)", opts.shards, opts.shard)
 	}
 
-	plan, err := m.planner.CreatePlan(projectRoot, m.registry, planOptions)
+	planOptions := executor.PlanOptions{
+		Files:         opts.files,
+		Fix:           opts.fix,
+		FormatOnly:    opts.formatOnly,
+		LintOnly:      opts.lintOnly,
+		ExtraArgs:     opts.extraArgs,
+		Since:         opts.since,
+		Staged:        opts.staged,
+		Changed:       opts.changed,
+		SinceUpstream: opts.sinceUpstream,
+		SinceBranch:   opts.sinceBranch,
+		ChangedOnly:   opts.changedOnly,
+		Shard:         opts.shard,
+		Shards:        opts.shards,
+		ShardStrategy: tools.ShardStrategy(opts.shardStrategy),
+	}
+
+	plan, err := m.planner.CreatePlan(ctx, projectRoot, m.registry, planOptions)
 	if err != nil {
 		return fmt.Errorf("failed to create execution plan: %w", err)
 	}
 
+	if err := m.checkUnmatchedPolicy(projectRoot); err != nil {
+		return err
+	}
+
 	if len(plan.Tasks) == 0 {
 		fmt.Println(opts.emptyMessage)
 		return nil
 	}
 
+	applyCacheDisabled(plan, m.config)
+
 	m.displayPlan(plan, opts.verbose)
 
+	if opts.explain {
+		return m.explainPlan(ctx, plan)
+	}
+
 	if opts.dryRun {
 		fmt.Println("✨ 드라이런 모드: 실제 실행하지 않습니다.")
 		return nil
@@ -323,17 +666,234 @@ func (m *QualityManager) executeQuality(ctx context.Context, opts *executionOpti
 		return err
 	}
 
-	m.displayResults(results, duration, opts.verbose)
+	if err := m.applySuppressionFiltering(results, plan, projectRoot); err != nil {
+		return err
+	}
+
+	if opts.staged && opts.fix {
+		if err := m.restageFixedFiles(projectRoot, plan); err != nil {
+			fmt.Printf("⚠️ 수정된 파일을 다시 스테이징하지 못했습니다: %v\n", err)
+		}
+	}
+
+	applyBaselineDefaults(opts, m.config.Baseline)
+
+	newIssueCount, err := m.applyBaselineFiltering(results, opts, projectRoot)
+	if err != nil {
+		return err
+	}
+
+	m.displayResults(results, duration, opts.verbose, opts.slowest)
 
 	if opts.reportFormat != "" {
-		if err := m.generateReport(results, duration, plan.TotalFiles, projectRoot, opts.reportFormat, opts.outputPath); err != nil {
+		if err := m.generateReport(results, duration, plan.TotalFiles, projectRoot, opts.reportFormat, opts.outputPath, opts.reportBaseline); err != nil {
 			fmt.Printf("⚠️ 리포트 생성 실패: %v\n", err)
 		}
 	}
 
+	if newIssueCount > 0 {
+		return fmt.Errorf("%d개의 새로운 이슈가 발견되었습니다", newIssueCount)
+	}
+
+	return nil
+}
+
+// restageFixedFiles re-adds every file the just-finished plan touched
+// back into the Git index via `git add`. A `--staged --fix` run rewrites
+// files in the working tree, but Git never re-stages a file just because
+// its content changed - left alone, those fixes would sit as unstaged
+// changes the commit being built silently excludes, which defeats the
+// point of auto-fixing in a pre-commit hook.
+func (m *QualityManager) restageFixedFiles(projectRoot string, plan *tools.ExecutionPlan) error {
+	seen := make(map[string]struct{})
+	paths := make([]string, 0, plan.TotalFiles)
+
+	for _, task := range plan.Tasks {
+		for _, f := range task.Files {
+			if _, ok := seen[f]; ok {
+				continue
+			}
+			seen[f] = struct{}{}
+			paths = append(paths, f)
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return gitutils.NewGitUtils(projectRoot).StageFiles(paths)
+}
+
+// applyBaselineFiltering loads opts.baselinePath (if set), removes
+// baselined issues from results in place, warns about stale baseline
+// entries, and rewrites the baseline when opts.updateBaseline is set. It
+// returns the number of non-suppressed ("new") issues remaining across all
+// results, which callers use to decide whether to fail the command.
+func (m *QualityManager) applyBaselineFiltering(results []*tools.Result, opts *executionOptions, projectRoot string) (int, error) {
+	if opts.baselinePath == "" && !opts.updateBaseline {
+		return countIssues(results), nil
+	}
+
+	if opts.updateBaseline {
+		entries := buildBaselineEntries(results, projectRoot, m.toolVersionsForResults(results))
+		if err := saveBaselineFile(opts.baselinePath, entries); err != nil {
+			return 0, err
+		}
+		fmt.Printf("📌 베이스라인 갱신 완료: %s (%d개 이슈)\n", opts.baselinePath, len(entries))
+		return 0, nil
+	}
+
+	baseline, err := loadBaselineFile(opts.baselinePath)
+	if err != nil {
+		return 0, err
+	}
+
+	suppressed, seen := applyBaseline(results, baseline, projectRoot)
+	if suppressed > 0 {
+		fmt.Printf("🙈 베이스라인에 포함된 이슈 %d개를 결과에서 제외했습니다.\n", suppressed)
+	}
+
+	if stale := staleBaselineEntries(baseline, seen); len(stale) > 0 {
+		if opts.pruneBaseline {
+			remaining := make([]baselineIssueEntry, 0, len(baseline.Issues)-len(stale))
+			for _, entry := range baseline.Issues {
+				if seen[entry.Fingerprint] {
+					remaining = append(remaining, entry)
+				}
+			}
+
+			if err := saveBaselineFile(opts.baselinePath, remaining); err != nil {
+				return 0, err
+			}
+
+			fmt.Printf("🧹 베이스라인에서 더 이상 발생하지 않는 항목 %d개를 제거했습니다: %s\n", len(stale), opts.baselinePath)
+			return 0, nil
+		}
+
+		fmt.Printf("⚠️ 베이스라인에 더 이상 존재하지 않는 항목 %d개가 있습니다 (정리가 필요할 수 있습니다):\n", len(stale))
+		for _, entry := range stale {
+			fmt.Printf("   %s: %s (%s)\n", entry.Tool, entry.Rule, entry.File)
+		}
+		if opts.strictBaseline {
+			return 0, fmt.Errorf("--strict-baseline: 더 이상 발생하지 않는 베이스라인 항목 %d개를 정리해야 합니다", len(stale))
+		}
+	} else if opts.pruneBaseline {
+		fmt.Println("🧹 베이스라인에 정리할 항목이 없습니다.")
+	}
+
+	return countIssues(results), nil
+}
+
+// checkUnmatchedPolicy applies m.config's OnUnmatched/OnMissingTool
+// policy to every Git-tracked file ShouldInclude accepts: a file no
+// registered tool's GetToolsForFile claims, or whose only candidate
+// tools/language are disabled, is "unmatched"; one where an enabled
+// tool claims it but every such tool's IsAvailable() is false is
+// "missing tool" instead. Skipped entirely - without even listing
+// files - when both policies are config.PolicyIgnore, and when
+// projectRoot isn't a Git repository (the source ListFiles draws from).
+// Returns an error only when a file hits a config.PolicyFatal policy.
+func (m *QualityManager) checkUnmatchedPolicy(projectRoot string) error {
+	unmatchedPolicy := m.config.UnmatchedPolicy()
+	missingToolPolicy := m.config.MissingToolPolicy()
+	if unmatchedPolicy == config.PolicyIgnore && missingToolPolicy == config.PolicyIgnore {
+		return nil
+	}
+
+	source := executor.NewGitFileSource(projectRoot)
+	if !source.IsGitRepository() {
+		return nil
+	}
+
+	files, err := source.ListFiles()
+	if err != nil {
+		return fmt.Errorf("on-unmatched 검사를 위한 파일 목록 조회 실패: %w", err)
+	}
+
+	var unmatched, missingTool []string
+	for _, file := range files {
+		if !m.config.ShouldInclude(file) {
+			continue
+		}
+
+		var enabled []tools.QualityTool
+		for _, tool := range m.registry.GetToolsForFile(file) {
+			if m.config.IsToolActive(tool.Name()) && m.config.IsLanguageEnabled(tool.Language()) {
+				enabled = append(enabled, tool)
+			}
+		}
+
+		if len(enabled) == 0 {
+			unmatched = append(unmatched, file)
+			continue
+		}
+
+		available := false
+		for _, tool := range enabled {
+			if tool.IsAvailable() {
+				available = true
+				break
+			}
+		}
+		if !available {
+			missingTool = append(missingTool, file)
+		}
+	}
+
+	if err := reportUnmatchedFiles(unmatchedPolicy, "담당 도구가 없는 파일", unmatched); err != nil {
+		return err
+	}
+	return reportUnmatchedFiles(missingToolPolicy, "담당 도구가 설치되어 있지 않은 파일", missingTool)
+}
+
+// reportUnmatchedFiles emits files at policy's level: PolicyInfo/
+// PolicyWarn print a message (with a different icon), PolicyFatal
+// prints the same message and then fails the run, and PolicyIgnore (or
+// an empty files slice) does nothing.
+func reportUnmatchedFiles(policy, label string, files []string) error {
+	if len(files) == 0 || policy == config.PolicyIgnore {
+		return nil
+	}
+
+	icon := "⚠️"
+	if policy == config.PolicyInfo {
+		icon = "📋"
+	}
+
+	fmt.Printf("%s %s (%d개):\n", icon, label, len(files))
+	for _, file := range files {
+		fmt.Printf("   %s\n", file)
+	}
+
+	if policy == config.PolicyFatal {
+		return fmt.Errorf("%s %d개 발견 (fatal 정책)", label, len(files))
+	}
+
 	return nil
 }
 
+// applyCacheDisabled marks every task in plan whose tool has
+// ToolConfig.CacheDisabled set in cfg, so the executor's content-hash
+// cache skips it instead of potentially returning a stale cached result
+// for a tool whose output depends on more than its input files' content.
+func applyCacheDisabled(plan *tools.ExecutionPlan, cfg *config.Config) {
+	for i, task := range plan.Tasks {
+		if cfg.GetToolConfig(task.Tool.Name()).CacheDisabled {
+			plan.Tasks[i].Options.CacheDisabled = true
+		}
+	}
+}
+
+// countIssues sums the number of issues across all results.
+func countIssues(results []*tools.Result) int {
+	total := 0
+	for _, result := range results {
+		total += len(result.Issues)
+	}
+	return total
+}
+
 // runQuality executes the main quality command logic.
 func (m *QualityManager) runQuality(cmd *cobra.Command, _ []string) error {
 	opts, err := parseExecutionOptions(cmd)
@@ -371,7 +931,7 @@ func (m *QualityManager) displayPlan(plan *tools.ExecutionPlan, verbose bool) {
 }
 
 // displayResults shows the execution results.
-func (m *QualityManager) displayResults(results []*tools.Result, duration time.Duration, verbose bool) {
+func (m *QualityManager) displayResults(results []*tools.Result, duration time.Duration, verbose bool, slowest int) {
 	fmt.Printf("\n✅ 완료! 총 소요시간: %v\n", duration.Round(time.Millisecond))
 
 	successful := 0
@@ -401,8 +961,8 @@ func (m *QualityManager) displayResults(results []*tools.Result, duration time.D
 			fmt.Printf("%s %s (%s): %d개 파일, %v%s\n",
 				status, result.Tool, result.Language, result.FilesProcessed, result.Duration, cachedLabel)
 
-			if result.Error != "" {
-				fmt.Printf("   오류: %s\n", result.Error)
+			if result.Error != nil {
+				fmt.Printf("   오류: %s\n", redact.Redact(result.Error.Error()))
 			}
 
 			if len(result.Issues) > 0 {
@@ -410,7 +970,7 @@ func (m *QualityManager) displayResults(results []*tools.Result, duration time.D
 				if verbose {
 					for _, issue := range result.Issues {
 						fmt.Printf("     %s:%d:%d: %s (%s)\n",
-							issue.File, issue.Line, issue.Column, issue.Message, issue.Rule)
+							issue.File, issue.Line, issue.Column, redact.Redact(issue.Message), issue.Rule)
 					}
 				}
 			}
@@ -424,6 +984,46 @@ func (m *QualityManager) displayResults(results []*tools.Result, duration time.D
 	}
 	fmt.Printf("\n📊 요약: %d/%d 도구 성공, %d개 이슈 발견%s\n",
 		successful, len(results), totalIssues, cacheInfo)
+
+	if slowest > 0 {
+		displaySlowestTools(results, slowest)
+	}
+}
+
+// displaySlowestTools prints the top n tools by wall time, parsed from
+// each result's Duration string (set via time.Duration.String() in
+// tools/base.go). Results whose Duration doesn't parse are skipped.
+func displaySlowestTools(results []*tools.Result, n int) {
+	type timedResult struct {
+		result   *tools.Result
+		duration time.Duration
+	}
+
+	timed := make([]timedResult, 0, len(results))
+	for _, result := range results {
+		d, err := time.ParseDuration(result.Duration)
+		if err != nil {
+			continue
+		}
+		timed = append(timed, timedResult{result: result, duration: d})
+	}
+
+	sort.Slice(timed, func(i, j int) bool {
+		return timed[i].duration > timed[j].duration
+	})
+
+	if len(timed) > n {
+		timed = timed[:n]
+	}
+
+	if len(timed) == 0 {
+		return
+	}
+
+	fmt.Printf("\n🐢 가장 느린 도구 (상위 %d개):\n", len(timed))
+	for _, t := range timed {
+		fmt.Printf("   %v %s (%s)\n", t.duration.Round(time.Millisecond), t.result.Tool, t.result.Language)
+	}
 }
 
 // newAnalyzeCmd creates the analyze subcommand.
@@ -437,7 +1037,7 @@ func (m *QualityManager) newAnalyzeCmd() *cobra.Command {
 				return fmt.Errorf("failed to get current directory: %w", err)
 			}
 
-			analysis, err := m.analyzer.AnalyzeProject(projectRoot, m.registry)
+			analysis, err := m.analyzer.AnalyzeProject(cmd.Context(), projectRoot, m.registry)
 			if err != nil {
 				return fmt.Errorf("failed to analyze project: %w", err)
 			}
@@ -483,33 +1083,6 @@ func (m *QualityManager) newAnalyzeCmd() *cobra.Command {
 	}
 }
 
-// forEachTool executes an action on specified tools or all tools if none specified.
-func (m *QualityManager) forEachTool(args []string, action func(tools.QualityTool) error, successMsg, failMsg string) {
-	if len(args) == 0 {
-		for _, tool := range m.registry.GetTools() {
-			if err := action(tool); err != nil {
-				fmt.Printf("❌ %s %s: %v\n", tool.Name(), failMsg, err)
-			} else {
-				fmt.Printf("✅ %s %s\n", tool.Name(), successMsg)
-			}
-		}
-		return
-	}
-
-	for _, toolName := range args {
-		tool := m.registry.FindTool(toolName)
-		if tool == nil {
-			fmt.Printf("❌ 도구를 찾을 수 없습니다: %s\n", toolName)
-			continue
-		}
-		if err := action(tool); err != nil {
-			fmt.Printf("❌ %s %s: %v\n", toolName, failMsg, err)
-		} else {
-			fmt.Printf("✅ %s %s\n", toolName, successMsg)
-		}
-	}
-}
-
 // groupToolsByLanguage groups tools by their language.
 func groupToolsByLanguage(toolList []tools.QualityTool) map[string][]tools.QualityTool {
 	langTools := make(map[string][]tools.QualityTool)
@@ -522,34 +1095,38 @@ func groupToolsByLanguage(toolList []tools.QualityTool) map[string][]tools.Quali
 
 // newInstallCmd creates the install subcommand.
 func (m *QualityManager) newInstallCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "install [tool-name...]",
 		Short: "품질 도구 설치",
-		Long:  "지정된 도구를 설치합니다. 도구명을 지정하지 않으면 모든 도구를 설치합니다.",
+		Long:  "지정된 도구를 설치합니다. 도구명을 지정하지 않으면 모든 도구를 병렬로 설치합니다.",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			workers, _ := cmd.Flags().GetInt("workers")
 			if len(args) == 0 {
 				fmt.Println("🔧 모든 품질 도구를 설치합니다...")
 			}
-			m.forEachTool(args, m.installTool, "설치 완료", "설치 실패")
-			return nil
+			return m.runToolsConcurrently(cmd.Context(), workers, args, m.installToolCtx, "설치")
 		},
 	}
+	cmd.Flags().IntP("workers", "w", runtime.NumCPU(), "동시 설치 워커 수")
+	return cmd
 }
 
 // newUpgradeCmd creates the upgrade subcommand.
 func (m *QualityManager) newUpgradeCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "upgrade [tool-name...]",
 		Short: "품질 도구 업그레이드",
-		Long:  "지정된 도구를 최신 버전으로 업그레이드합니다. 도구명을 지정하지 않으면 모든 도구를 업그레이드합니다.",
+		Long:  "지정된 도구를 최신 버전으로 업그레이드합니다. 도구명을 지정하지 않으면 모든 도구를 병렬로 업그레이드합니다.",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			workers, _ := cmd.Flags().GetInt("workers")
 			if len(args) == 0 {
 				fmt.Println("🔄 모든 품질 도구를 업그레이드합니다...")
 			}
-			m.forEachTool(args, m.upgradeTool, "업그레이드 완료", "업그레이드 실패")
-			return nil
+			return m.runToolsConcurrently(cmd.Context(), workers, args, m.upgradeToolCtx, "업그레이드")
 		},
 	}
+	cmd.Flags().IntP("workers", "w", runtime.NumCPU(), "동시 업그레이드 워커 수")
+	return cmd
 }
 
 // newVersionCmd creates the version subcommand.
@@ -604,30 +1181,6 @@ func (m *QualityManager) newListCmd() *cobra.Command {
 	}
 }
 
-// installTool installs a specific tool.
-func (m *QualityManager) installTool(tool tools.QualityTool) error {
-	if tool.IsAvailable() {
-		return nil // Already installed
-	}
-
-	return tool.Install()
-}
-
-// upgradeTool upgrades a specific tool.
-func (m *QualityManager) upgradeTool(tool tools.QualityTool) error {
-	if !tool.IsAvailable() {
-		fmt.Printf("📦 %s is not installed, installing...\n", tool.Name())
-		return tool.Install()
-	}
-
-	// Show current version before upgrade
-	if version, err := tool.GetVersion(); err == nil {
-		fmt.Printf("📦 Current %s version: %s\n", tool.Name(), version)
-	}
-
-	return tool.Upgrade()
-}
-
 // showToolVersion displays the version of a tool.
 func (m *QualityManager) showToolVersion(tool tools.QualityTool) {
 	if !tool.IsAvailable() {
@@ -645,8 +1198,11 @@ func (m *QualityManager) showToolVersion(tool tools.QualityTool) {
 	fmt.Printf("  %s %s: %s\n", status, tool.Name(), version)
 }
 
-// generateReport creates and saves a quality report.
-func (m *QualityManager) generateReport(results []*tools.Result, duration time.Duration, totalFiles int, projectRoot, format, outputPath string) error {
+// generateReport creates and saves a quality report. If baselinePath is
+// set, it also loads the previous report saved there (typically by a prior
+// CI run against the PR's merge-base), diffs it against the current run,
+// and saves a companion diff report next to outputPath.
+func (m *QualityManager) generateReport(results []*tools.Result, duration time.Duration, totalFiles int, projectRoot, format, outputPath, baselinePath string) error {
 	generator := report.NewReportGenerator(projectRoot)
 	qualityReport := generator.GenerateReport(results, duration, totalFiles)
 
@@ -660,27 +1216,87 @@ func (m *QualityManager) generateReport(results []*tools.Result, duration time.D
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	switch format {
-	case "json":
-		if err := generator.SaveJSON(qualityReport, outputPath); err != nil {
-			return err
+	reporter, err := generator.NewReporter(format, m.toolVersions(qualityReport))
+	if err != nil {
+		return err
+	}
+	if err := reporter.Save(qualityReport, outputPath); err != nil {
+		return err
+	}
+
+	fmt.Printf("📄 리포트 생성 완료: %s\n", outputPath)
+
+	if baselinePath != "" {
+		if err := m.generateDiffReport(generator, qualityReport, format, outputPath, baselinePath); err != nil {
+			fmt.Printf("⚠️ diff 리포트 생성 실패: %v\n", err)
 		}
+	}
+
+	return nil
+}
+
+// generateDiffReport loads the report saved at baselinePath, diffs it
+// against head, and saves the result next to outputPath with a "-diff"
+// suffix inserted before the extension. SARIF has no natural diff
+// representation (code-scanning consumers expect a flat result set), so
+// diff output falls back to JSON for that format.
+func (m *QualityManager) generateDiffReport(generator *report.ReportGenerator, head *report.Report, format, outputPath, baselinePath string) error {
+	base, err := report.LoadJSON(baselinePath)
+	if err != nil {
+		return fmt.Errorf("failed to load baseline report %s: %w", baselinePath, err)
+	}
+
+	diff := report.DiffReports(base, head)
+
+	ext := filepath.Ext(outputPath)
+	diffPath := strings.TrimSuffix(outputPath, ext) + "-diff" + ext
+
+	switch format {
 	case "html":
-		if err := generator.SaveHTML(qualityReport, outputPath); err != nil {
+		if err := generator.SaveDiffHTML(diff, diffPath); err != nil {
 			return err
 		}
 	case "markdown", "md":
-		if err := generator.SaveMarkdown(qualityReport, outputPath); err != nil {
+		if err := generator.SaveDiffMarkdown(diff, diffPath); err != nil {
 			return err
 		}
 	default:
-		return fmt.Errorf("unsupported report format: %s (supported: json, html, markdown)", format)
+		diffPath = strings.TrimSuffix(outputPath, ext) + "-diff.json"
+		if err := generator.SaveDiffJSON(diff, diffPath); err != nil {
+			return err
+		}
 	}
 
-	fmt.Printf("📄 리포트 생성 완료: %s\n", outputPath)
+	fmt.Printf("🔀 diff 리포트 생성 완료: %s (신규 %d, 해결 %d, 변화 없음 %d)\n",
+		diffPath, diff.Summary.NewCount, diff.Summary.FixedCount, diff.Summary.UnchangedCount)
+
 	return nil
 }
 
+// toolVersions resolves the installed version of every tool referenced in
+// qualityReport, for SARIF's tool.driver.version field. Tools that can't be
+// found or whose version can't be determined are simply omitted.
+func (m *QualityManager) toolVersions(qualityReport *report.Report) map[string]string {
+	versions := make(map[string]string, len(qualityReport.ToolResults))
+
+	for _, result := range qualityReport.ToolResults {
+		if _, ok := versions[result.Tool]; ok {
+			continue
+		}
+
+		tool := m.registry.FindTool(result.Tool)
+		if tool == nil {
+			continue
+		}
+
+		if version, err := tool.GetVersion(); err == nil {
+			versions[result.Tool] = version
+		}
+	}
+
+	return versions
+}
+
 // newCheckCmd creates the check subcommand.
 func (m *QualityManager) newCheckCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -697,6 +1313,7 @@ func (m *QualityManager) newCheckCmd() *cobra.Command {
 	addCommonExecutionFlags(cmd)
 	addGitFilterFlags(cmd)
 	addCacheFlags(cmd)
+	addBaselineFlags(cmd)
 
 	return cmd
 }
@@ -709,9 +1326,9 @@ func (m *QualityManager) runCheck(cmd *cobra.Command, _ []string) error {
 	}
 
 	// Override for check mode
-	opts.fix = false       // Never fix in check mode
+	opts.fix = false // Never fix in check mode
 	opts.formatOnly = false
-	opts.lintOnly = true   // Only run linters
+	opts.lintOnly = true // Only run linters
 	opts.emptyMessage = "🎯 검사할 작업이 없습니다."
 	opts.executePrefix = "🔍"
 
@@ -732,7 +1349,7 @@ func (m *QualityManager) newInitCmd() *cobra.Command {
 }
 
 // runInit executes the init command.
-func (m *QualityManager) runInit(_ *cobra.Command, _ []string) error {
+func (m *QualityManager) runInit(cmd *cobra.Command, _ []string) error {
 	projectRoot, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get current directory: %w", err)
@@ -748,7 +1365,7 @@ func (m *QualityManager) runInit(_ *cobra.Command, _ []string) error {
 	}
 
 	// Analyze project
-	analysis, err := m.analyzer.AnalyzeProject(projectRoot, m.registry)
+	analysis, err := m.analyzer.AnalyzeProject(cmd.Context(), projectRoot, m.registry)
 	if err != nil {
 		return fmt.Errorf("failed to analyze project: %w", err)
 	}
@@ -756,6 +1373,10 @@ func (m *QualityManager) runInit(_ *cobra.Command, _ []string) error {
 	// Generate configuration based on analysis
 	cfg := m.generateConfig(analysis)
 
+	if err := cfg.Validate(m.registry, m.analyzer.LanguageNames()); err != nil {
+		return fmt.Errorf("generated config is invalid: %w", err)
+	}
+
 	// Write config file
 	configYAML, err := cfg.ToYAML()
 	if err != nil {
@@ -805,6 +1426,14 @@ func (m *QualityManager) generateConfig(analysis *detector.AnalysisResult) *Conf
 					"pylint": {Enabled: true},
 				},
 			},
+			"Julia": {
+				Enabled: contains(analysis.Languages, "Julia"),
+				Tools: map[string]*ToolConfig{
+					"juliaformatter": {Enabled: true},
+					"staticlint":     {Enabled: true},
+					"aqua":           {Enabled: true},
+				},
+			},
 		},
 	}
 }
@@ -829,30 +1458,68 @@ type ToolConfig struct {
 	Enabled bool `yaml:"enabled"`
 }
 
-func (c *Config) ToYAML() (string, error) {
-	// Simple YAML generation - in a real implementation, use yaml package
-	var sb strings.Builder
-
-	sb.WriteString("# gzh-manager Quality Configuration\n")
-	sb.WriteString("# Auto-generated by 'gz quality init'\n\n")
-	sb.WriteString(fmt.Sprintf("enabled: %t\n\n", c.Enabled))
-	sb.WriteString("languages:\n")
+// Validate checks that every language and tool name in the generated
+// config is one this binary actually knows about, so a bug in project
+// analysis can't silently write out a .gzquality.yml referencing tools
+// gz quality doesn't have.
+func (c *Config) Validate(registry tools.ToolRegistry, knownLanguages []string) error {
+	known := make(map[string]bool, len(knownLanguages))
+	for _, lang := range knownLanguages {
+		known[lang] = true
+	}
 
-	for lang, config := range c.Languages {
-		if !config.Enabled {
-			continue
+	var problems []string
+	for lang, langCfg := range c.Languages {
+		if !known[lang] {
+			problems = append(problems, fmt.Sprintf("languages.%s: unknown language", lang))
 		}
-		sb.WriteString(fmt.Sprintf("  %s:\n", lang))
-		sb.WriteString(fmt.Sprintf("    enabled: %t\n", config.Enabled))
-		sb.WriteString("    tools:\n")
-		for tool, toolConfig := range config.Tools {
-			sb.WriteString(fmt.Sprintf("      %s:\n", tool))
-			sb.WriteString(fmt.Sprintf("        enabled: %t\n", toolConfig.Enabled))
+		for toolName := range langCfg.Tools {
+			if registry.FindTool(toolName) == nil {
+				problems = append(problems, fmt.Sprintf("languages.%s.tools.%s: unknown tool (not registered)", lang, toolName))
+			}
 		}
-		sb.WriteString("\n")
 	}
 
-	return sb.String(), nil
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+	return fmt.Errorf("generated configuration references unknown entries:\n  %s", strings.Join(problems, "\n  "))
+}
+
+// ToYAML renders the config as YAML, with only enabled languages included.
+// Marshaling (rather than hand-building the string) gives correct
+// quoting/escaping for tool names and deterministic, alphabetically
+// ordered map keys, so the output round-trips through yaml.Unmarshal.
+func (c *Config) ToYAML() (string, error) {
+	filtered := &Config{
+		Enabled:   c.Enabled,
+		Languages: make(map[string]*LanguageConfig),
+	}
+	for lang, langCfg := range c.Languages {
+		if langCfg.Enabled {
+			filtered.Languages[lang] = langCfg
+		}
+	}
+
+	data, err := yaml.Marshal(filtered)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	header := "# gzh-manager Quality Configuration\n# Auto-generated by 'gz quality init'\n\n"
+	return header + string(data), nil
+}
+
+// toolNames returns the names of every tool in registry.
+func toolNames(registry tools.ToolRegistry) []string {
+	toolList := registry.GetTools()
+	names := make([]string, 0, len(toolList))
+	for _, tool := range toolList {
+		names = append(names, tool.Name())
+	}
+	return names
 }
 
 // registerAllTools registers all available quality tools.
@@ -882,6 +1549,30 @@ func registerAllTools(registry tools.ToolRegistry) {
 	registry.Register(tools.NewRustfmtTool())
 	registry.Register(tools.NewClippyTool())
 	registry.Register(tools.NewCargoFmtTool())
+
+	// Julia tools
+	registry.Register(tools.NewJuliaFormatterTool())
+	registry.Register(tools.NewJuliaLintTool())
+	registry.Register(tools.NewAquaTool())
+
+	// Java tools
+	registry.Register(tools.NewGoogleJavaFormatTool())
+	registry.Register(tools.NewCheckstyleTool())
+	registry.Register(tools.NewSpotbugsTool())
+	registry.Register(tools.NewPMDTool())
+	registry.Register(tools.NewErrorProneTool())
+	registry.Register(tools.NewJUnitTool())
+	registry.Register(tools.NewJaCoCoTool())
+
+	// Test runners (TEST tool type, opt in via PlanOptions.TestOnly)
+	registry.Register(tools.NewGoTestTool())
+	registry.Register(tools.NewPytestTool())
+
+	// Security scanners (govulncheck is registered above alongside the
+	// other Go tools)
+	registry.Register(tools.NewPipAuditTool())
+	registry.Register(tools.NewNpmAuditTool())
+	registry.Register(tools.NewTrivyTool())
 }
 
 // ProjectAnalyzerAdapter adapts detector.ProjectAnalyzer to executor.ProjectAnalyzer interface.
@@ -889,8 +1580,8 @@ type ProjectAnalyzerAdapter struct {
 	analyzer *detector.ProjectAnalyzer
 }
 
-func (a *ProjectAnalyzerAdapter) AnalyzeProject(projectRoot string, registry tools.ToolRegistry) (*executor.AnalysisResult, error) {
-	result, err := a.analyzer.AnalyzeProject(projectRoot, registry)
+func (a *ProjectAnalyzerAdapter) AnalyzeProject(ctx context.Context, projectRoot string, registry tools.ToolRegistry) (*executor.AnalysisResult, error) {
+	result, err := a.analyzer.AnalyzeProject(ctx, projectRoot, registry)
 	if err != nil {
 		return nil, err
 	}
@@ -901,6 +1592,8 @@ func (a *ProjectAnalyzerAdapter) AnalyzeProject(projectRoot string, registry too
 		AvailableTools:   result.AvailableTools,
 		RecommendedTools: result.RecommendedTools,
 		ConfigFiles:      result.ConfigFiles,
+		ToolLocations:    result.ToolLocations,
+		Timings:          result.Timings,
 		Issues:           result.Issues,
 	}, nil
 }
@@ -913,6 +1606,8 @@ func (a *ProjectAnalyzerAdapter) GetOptimalToolSelection(result *executor.Analys
 		AvailableTools:   result.AvailableTools,
 		RecommendedTools: result.RecommendedTools,
 		ConfigFiles:      result.ConfigFiles,
+		ToolLocations:    result.ToolLocations,
+		Timings:          result.Timings,
 		Issues:           result.Issues,
 	}
 
@@ -944,23 +1639,24 @@ func (m *QualityManager) newToolCmd() *cobra.Command {
   gz quality tool gofumpt --staged    # gofumpt로 staged 파일만 처리
   gz quality tool ruff --changed      # ruff로 변경된 파일만 처리
   gz quality tool prettier --fix      # prettier로 자동 수정 적용`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
-				return cmd.Help()
-			}
+	}
 
-			toolName := args[0]
-			tool := m.registry.FindTool(toolName)
-			if tool == nil {
-				return fmt.Errorf("도구를 찾을 수 없습니다: %s. 'gz quality list'로 사용 가능한 도구를 확인하세요", toolName)
-			}
+	// Bind flags once and validate them in PreRunE, same as every
+	// per-tool subcommand added below.
+	flags := m.registerDirectToolFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
 
-			return m.runDirectTool(cmd, args[1:], tool)
-		},
-	}
+		toolName := args[0]
+		tool := m.registry.FindTool(toolName)
+		if tool == nil {
+			return fmt.Errorf("도구를 찾을 수 없습니다: %s. 'gz quality list'로 사용 가능한 도구를 확인하세요", toolName)
+		}
 
-	// Add flags for tool commands
-	m.addDirectToolFlags(cmd)
+		return m.runDirectTool(cmd, flags, tool)
+	}
 
 	// Add individual tool subcommands for better discoverability
 	m.addDirectToolCommands(cmd)
@@ -980,46 +1676,130 @@ func (m *QualityManager) addDirectToolCommands(parentCmd *cobra.Command) {
 				Use:   toolName,
 				Short: fmt.Sprintf("%s %s 도구 실행", currentTool.Language(), currentTool.Type().String()),
 				Long:  fmt.Sprintf("%s 언어의 %s 도구를 직접 실행합니다.", currentTool.Language(), toolName),
-				RunE: func(cmd *cobra.Command, args []string) error {
-					return m.runDirectTool(cmd, args, currentTool)
-				},
 			}
 
-			// Add common flags for direct tool commands
-			m.addDirectToolFlags(toolCmd)
+			// Bind common flags once and validate them in PreRunE, so every
+			// tool subcommand gets the same defaults and checks without
+			// re-registering or re-reading flags by hand.
+			flags := m.registerDirectToolFlags(toolCmd)
+			toolCmd.RunE = func(cmd *cobra.Command, args []string) error {
+				return m.runDirectTool(cmd, flags, currentTool)
+			}
+
 			parentCmd.AddCommand(toolCmd)
 		}(tool)
 	}
 }
 
-// addDirectToolFlags adds flags for direct tool commands.
-func (m *QualityManager) addDirectToolFlags(cmd *cobra.Command) {
-	cmd.Flags().StringSliceP("files", "f", nil, "특정 파일들만 처리")
-	cmd.Flags().BoolP("fix", "x", false, "자동 수정 적용 (지원하는 도구만)")
-	cmd.Flags().IntP("workers", "w", 1, "병렬 실행 워커 수 (기본값: 1, 단일 도구)")
-	cmd.Flags().StringSlice("extra-args", nil, "도구에 전달할 추가 인수")
-	cmd.Flags().Bool("dry-run", false, "실제 실행하지 않고 계획만 표시")
-	cmd.Flags().BoolP("verbose", "v", false, "상세 출력")
+// commonToolFlags holds the flags shared by every direct tool subcommand
+// ("gz quality tool <name>"), bound once via *Var so defaults and reads
+// can't drift between subcommands the way they did when each one
+// re-registered and re-read its own flags by name.
+type commonToolFlags struct {
+	files         []string
+	fix           bool
+	workers       int
+	extraArgs     []string
+	dryRun        bool
+	explain       bool
+	verbose       bool
+	since         string
+	staged        bool
+	changed       bool
+	sinceUpstream bool
+	sinceBranch   string
+	changedOnly   bool
+	shard         int
+	shards        int
+	shardStrategy string
+	slowest       int
+	reportFormat  string
+	outputPath    string
+}
 
-	addGitFilterFlags(cmd)
+// registerDirectToolFlags binds cmd's flags to a fresh commonToolFlags and
+// installs a PreRunE that validates them before runDirectTool runs.
+func (m *QualityManager) registerDirectToolFlags(cmd *cobra.Command) *commonToolFlags {
+	flags := &commonToolFlags{}
+
+	cmd.Flags().StringSliceVarP(&flags.files, "files", "f", nil, "특정 파일들만 처리")
+	cmd.Flags().BoolVarP(&flags.fix, "fix", "x", false, "자동 수정 적용 (지원하는 도구만)")
+	cmd.Flags().IntVarP(&flags.workers, "workers", "w", 1, "병렬 실행 워커 수 (기본값: 1, 단일 도구)")
+	cmd.Flags().StringSliceVar(&flags.extraArgs, "extra-args", nil, "도구에 전달할 추가 인수")
+	cmd.Flags().BoolVar(&flags.dryRun, "dry-run", false, "실제 실행하지 않고 계획만 표시")
+	cmd.Flags().BoolVar(&flags.explain, "explain", false, "실행하지 않고 각 작업이 실행할 명령(argv)만 출력")
+	cmd.Flags().BoolVarP(&flags.verbose, "verbose", "v", false, "상세 출력")
+	cmd.Flags().StringVar(&flags.since, "since", "", "특정 커밋 이후 변경된 파일만 처리 (예: HEAD~1, main)")
+	cmd.Flags().BoolVar(&flags.staged, "staged", false, "Git staged 파일만 처리")
+	cmd.Flags().BoolVar(&flags.changed, "changed", false, "변경된 파일만 처리 (staged + modified + untracked)")
+	cmd.Flags().BoolVar(&flags.sinceUpstream, "since-upstream", false, "브랜치의 업스트림과의 병합 기준점 이후 변경된 파일만 처리 (PR 범위 검사용)")
+	cmd.Flags().StringVar(&flags.sinceBranch, "since-branch", "", "지정한 브랜치와의 병합 기준점 이후 변경된 파일만 처리 (예: main)")
+	cmd.Flags().BoolVar(&flags.changedOnly, "changed-only", false, "위 Git 필터 옵션 중 하나를 기준으로 변경된 라인에 해당하는 이슈만 보고 (다른 Git 필터 옵션과 함께 사용)")
+	cmd.Flags().IntVar(&flags.shard, "shard", 0, "이 실행이 처리할 샤드 번호 (0부터 시작, --shards와 함께 사용)")
+	cmd.Flags().IntVar(&flags.shards, "shards", 1, "전체 샤드 개수 (CI 워커 수만큼 설정해 작업을 분할)")
+	cmd.Flags().StringVar(&flags.shardStrategy, "shard-strategy", "", "샤딩 전략: file-hash(기본), package, language, size-balanced")
+	cmd.Flags().IntVar(&flags.slowest, "slowest", 0, "가장 느린 N개 도구를 요약에 표시 (0이면 비활성화)")
+	cmd.Flags().String("report", "", "리포트 생성 (json, html, markdown, sarif)")
+	cmd.Flags().String("format", "", "--report의 별칭 (GitHub code scanning 등 외부 도구 연동 시 익숙한 플래그명, 예: --format sarif)")
+	cmd.Flags().String("output", "", "리포트 출력 파일 경로")
+
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		flags.reportFormat, _ = cmd.Flags().GetString("report")
+		if flags.reportFormat == "" {
+			flags.reportFormat, _ = cmd.Flags().GetString("format")
+		}
+		flags.outputPath, _ = cmd.Flags().GetString("output")
+
+		return m.validateCommonToolFlags(flags)
+	}
+
+	return flags
 }
 
-// runDirectTool executes a specific tool directly.
-func (m *QualityManager) runDirectTool(cmd *cobra.Command, _ []string, tool tools.QualityTool) error {
-	ctx := cmd.Context()
+// validateCommonToolFlags checks invariants across the bound flags that
+// cobra's own flag parsing can't express: Git flag mutual exclusion,
+// --files pointing at files that actually exist, a sane --workers range,
+// and --dry-run/--fix not being requested together.
+func (m *QualityManager) validateCommonToolFlags(flags *commonToolFlags) error {
+	if err := m.validateGitFlags(flags.since, flags.staged, flags.changed, flags.sinceUpstream, flags.sinceBranch); err != nil {
+		return err
+	}
 
-	// Get flags
-	files, _ := cmd.Flags().GetStringSlice("files")
-	fix, _ := cmd.Flags().GetBool("fix")
-	workers, _ := cmd.Flags().GetInt("workers")
-	extraArgs, _ := cmd.Flags().GetStringSlice("extra-args")
-	dryRun, _ := cmd.Flags().GetBool("dry-run")
-	verbose, _ := cmd.Flags().GetBool("verbose")
+	for _, file := range flags.files {
+		if _, err := os.Stat(file); err != nil {
+			return fmt.Errorf("--files에 지정된 파일을 찾을 수 없습니다: %s", file)
+		}
+	}
 
-	// Git-based flags
-	since, _ := cmd.Flags().GetString("since")
-	staged, _ := cmd.Flags().GetBool("staged")
-	changed, _ := cmd.Flags().GetBool("changed")
+	if flags.workers < 1 {
+		return fmt.Errorf("--workers는 1 이상이어야 합니다 (입력값: %d)", flags.workers)
+	}
+	if maxWorkers := runtime.NumCPU() * 4; flags.workers > maxWorkers {
+		return fmt.Errorf("--workers는 NumCPU*4(%d)를 초과할 수 없습니다 (입력값: %d)", maxWorkers, flags.workers)
+	}
+
+	if flags.dryRun && flags.fix {
+		return fmt.Errorf("--dry-run과 --fix는 함께 사용할 수 없습니다")
+	}
+
+	if flags.shards <= 1 && m.config.Sharding.Total > 1 {
+		flags.shard = m.config.Sharding.Index
+		flags.shards = m.config.Sharding.Total
+	}
+	if flags.shardStrategy == "" {
+		flags.shardStrategy = m.config.Sharding.Strategy
+	}
+
+	if flags.shards > 1 && (flags.shard < 0 || flags.shard >= flags.shards) {
+		return fmt.Errorf("--shard는 0 이상 --shards(%d) 미만이어야 합니다 (입력값: %d)", flags.shards, flags.shard)
+	}
+
+	return nil
+}
+
+// runDirectTool executes a specific tool directly.
+func (m *QualityManager) runDirectTool(cmd *cobra.Command, flags *commonToolFlags, tool tools.QualityTool) error {
+	ctx := cmd.Context()
 
 	// Get project root
 	projectRoot, err := os.Getwd()
@@ -1027,24 +1807,27 @@ func (m *QualityManager) runDirectTool(cmd *cobra.Command, _ []string, tool tool
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	// Validate Git flags
-	if err := m.validateGitFlags(since, staged, changed); err != nil {
-		return err
-	}
-
 	// Create execution plan with specific tool filter
 	planOptions := executor.PlanOptions{
-		Files:      files,
-		Fix:        fix,
-		ExtraArgs:  extraArgs,
-		Language:   tool.Language(),
-		ToolFilter: []string{tool.Name()}, // Only this specific tool
-		Since:      since,
-		Staged:     staged,
-		Changed:    changed,
-	}
-
-	plan, err := m.planner.CreatePlan(projectRoot, m.registry, planOptions)
+		Files:         flags.files,
+		Fix:           flags.fix,
+		ExtraArgs:     flags.extraArgs,
+		Language:      tool.Language(),
+		ToolFilter:    []string{tool.Name()}, // Only this specific tool
+		Since:         flags.since,
+		Staged:        flags.staged,
+		Changed:       flags.changed,
+		SinceUpstream: flags.sinceUpstream,
+		SinceBranch:   flags.sinceBranch,
+		ChangedOnly:   flags.changedOnly,
+		Shard:         flags.shard,
+		Shards:        flags.shards,
+		ShardStrategy: tools.ShardStrategy(flags.shardStrategy),
+	}
+
+	m.logger.ToolStart(tool.Name(), projectRoot, len(flags.files), gitFilterDescription(flags.since, flags.staged, flags.changed, flags.sinceUpstream, flags.sinceBranch))
+
+	plan, err := m.planner.CreatePlan(ctx, projectRoot, m.registry, planOptions)
 	if err != nil {
 		return fmt.Errorf("failed to create execution plan: %w", err)
 	}
@@ -1054,34 +1837,107 @@ func (m *QualityManager) runDirectTool(cmd *cobra.Command, _ []string, tool tool
 		return nil
 	}
 
+	applyCacheDisabled(plan, m.config)
+
 	// Display plan
-	m.displayPlan(plan, verbose)
+	m.displayPlan(plan, flags.verbose)
+
+	if flags.explain {
+		return m.explainPlan(ctx, plan)
+	}
 
-	if dryRun {
+	if flags.dryRun {
 		fmt.Println("✨ 드라이런 모드: 실제 실행하지 않습니다.")
 		return nil
 	}
 
 	// Execute plan
-	fmt.Printf("🚀 %s: %d개 작업을 %d개 워커로 실행합니다...\n", tool.Name(), len(plan.Tasks), workers)
+	fmt.Printf("🚀 %s: %d개 작업을 %d개 워커로 실행합니다...\n", tool.Name(), len(plan.Tasks), flags.workers)
 
 	startTime := time.Now()
-	results, err := m.executor.ExecuteParallel(ctx, plan, workers)
+	results, err := m.executor.ExecuteParallel(ctx, plan, flags.workers)
 	duration := time.Since(startTime)
 
 	if err != nil {
+		m.logger.ToolDone(tool.Name(), duration, 1, err)
 		fmt.Printf("❌ 실행 중 오류 발생: %v\n", err)
 		return err
 	}
 
+	exitCode := 0
+	for _, result := range results {
+		if !result.Success {
+			exitCode = 1
+			break
+		}
+	}
+	m.logger.ToolDone(tool.Name(), duration, exitCode, nil)
+
 	// Display results
-	m.displayResults(results, duration, verbose)
+	m.displayResults(results, duration, flags.verbose, flags.slowest)
+
+	if flags.reportFormat != "" {
+		totalFiles := 0
+		for _, result := range results {
+			totalFiles += result.FilesProcessed
+		}
+		if err := m.generateReport(results, duration, totalFiles, projectRoot, flags.reportFormat, flags.outputPath, ""); err != nil {
+			fmt.Printf("⚠️ 리포트 생성 실패: %v\n", err)
+		}
+	}
+
+	return nil
+}
+
+// explainPlan prints the argv every task in plan would run, without
+// actually running anything. It swaps each task's tool to a
+// cmdobj.ExplainRunner and invokes Execute synchronously (bypassing the
+// parallel executor and cache, which only matter for real runs), then
+// restores the tool's normal runner - tool instances are long-lived
+// singletons in the registry, shared across invocations, so leaving one
+// in explain mode would silently no-op every later real run.
+func (m *QualityManager) explainPlan(ctx context.Context, plan *tools.ExecutionPlan) error {
+	fmt.Println("🔎 --explain: 아래 명령이 실행될 예정입니다 (실제로 실행되지 않습니다)")
+
+	for _, task := range plan.Tasks {
+		settable, ok := task.Tool.(tools.ExplainCapable)
+		if !ok {
+			fmt.Printf("  (argv 표시 미지원: %s)\n", task.Tool.Name())
+			continue
+		}
+
+		settable.SetRunner(cmdobj.NewExplainRunner(os.Stdout))
+		_, err := task.Tool.Execute(ctx, task.Files, task.Options)
+		settable.SetRunner(cmdobj.NewOSRunner())
+		if err != nil {
+			return fmt.Errorf("explain 중 오류 (%s): %w", task.Tool.Name(), err)
+		}
+	}
 
 	return nil
 }
 
+// gitFilterDescription summarizes which Git-based filter (if any) is
+// active, for debug log fields.
+func gitFilterDescription(since string, staged, changed, sinceUpstream bool, sinceBranch string) string {
+	switch {
+	case since != "":
+		return "since:" + since
+	case staged:
+		return "staged"
+	case changed:
+		return "changed"
+	case sinceBranch != "":
+		return "since-branch:" + sinceBranch
+	case sinceUpstream:
+		return "since-upstream"
+	default:
+		return "none"
+	}
+}
+
 // validateGitFlags validates Git-based filtering flags.
-func (m *QualityManager) validateGitFlags(since string, staged, changed bool) error {
+func (m *QualityManager) validateGitFlags(since string, staged, changed, sinceUpstream bool, sinceBranch string) error {
 	// Count how many Git flags are set
 	gitFlagCount := 0
 	if since != "" {
@@ -1093,10 +1949,16 @@ func (m *QualityManager) validateGitFlags(since string, staged, changed bool) er
 	if changed {
 		gitFlagCount++
 	}
+	if sinceUpstream {
+		gitFlagCount++
+	}
+	if sinceBranch != "" {
+		gitFlagCount++
+	}
 
 	// Only one Git flag can be used at a time
 	if gitFlagCount > 1 {
-		return fmt.Errorf("only one of --since, --staged, or --changed can be used at a time")
+		return fmt.Errorf("only one of --since, --staged, --changed, --since-upstream, or --since-branch can be used at a time")
 	}
 
 	return nil
@@ -1125,6 +1987,7 @@ func (m *QualityManager) newCacheClearCmd() *cobra.Command {
 				return fmt.Errorf("캐시 삭제 실패: %w", err)
 			}
 
+			m.logger.CacheEvent("clear", m.config.GetCacheDirectory(), false)
 			fmt.Println("✅ 캐시가 삭제되었습니다.")
 			return nil
 		},
@@ -1144,6 +2007,7 @@ func (m *QualityManager) newCacheStatsCmd() *cobra.Command {
 			}
 
 			stats := m.cacheManager.Stats()
+			m.logger.CacheEvent("stats", m.config.GetCacheDirectory(), stats.HitCount > stats.MissCount)
 
 			fmt.Println("📊 캐시 통계:")
 			fmt.Printf("  캐시 디렉토리: %s\n", m.config.GetCacheDirectory())
@@ -1166,6 +2030,195 @@ func (m *QualityManager) newCacheStatsCmd() *cobra.Command {
 	}
 }
 
+// newCacheExportCmd creates the cache-export subcommand.
+func (m *QualityManager) newCacheExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cache-export <path>",
+		Short: "캐시 전체를 tarball로 내보내기 (CI 캐시 업로드용)",
+		Long: `캐시 항목과 메타데이터를 gzip tar 아카이브 하나로 직렬화합니다.
+GitLab/GitHub Actions의 캐시 업로드 단계에서 바로 저장할 수 있는 형태입니다.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if m.cacheManager == nil {
+				fmt.Println("⚠️ 캐시가 비활성화되어 있습니다.")
+				return nil
+			}
+
+			out, err := os.Create(args[0])
+			if err != nil {
+				return fmt.Errorf("아카이브 파일 생성 실패: %w", err)
+			}
+			defer func() {
+				_ = out.Close()
+			}()
+
+			count, err := m.cacheManager.ExportArchive(out)
+			if err != nil {
+				return fmt.Errorf("캐시 내보내기 실패: %w", err)
+			}
+
+			m.logger.CacheEvent("export", args[0], count > 0)
+			fmt.Printf("✅ 캐시 내보내기 완료: %d개 항목 → %s\n", count, args[0])
+			return nil
+		},
+	}
+}
+
+// newCacheImportCmd creates the cache-import subcommand.
+func (m *QualityManager) newCacheImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cache-import <path>",
+		Short: "tarball로부터 캐시를 병합 (CI 캐시 복원 후 사용)",
+		Long: `cache-export로 만든 아카이브를 기존 캐시에 병합합니다. 이미 있는 항목은
+더 최근에 접근된 쪽이 남고(newer mtime wins), 현재 설치된 도구 버전과 일치하지
+않는 항목(도구 업그레이드 등으로 더 이상 유효하지 않은 캐시)은 건너뜁니다.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if m.cacheManager == nil {
+				fmt.Println("⚠️ 캐시가 비활성화되어 있습니다.")
+				return nil
+			}
+
+			in, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("아카이브 파일 열기 실패: %w", err)
+			}
+			defer func() {
+				_ = in.Close()
+			}()
+
+			count, err := m.cacheManager.ImportArchive(in, m.isCacheEntryCompatible)
+			if err != nil {
+				return fmt.Errorf("캐시 가져오기 실패: %w", err)
+			}
+
+			m.logger.CacheEvent("import", args[0], count > 0)
+			fmt.Printf("✅ 캐시 가져오기 완료: %d개 항목 병합됨\n", count)
+			return nil
+		},
+	}
+}
+
+// isCacheEntryCompatible rejects cache entries whose tool is no longer
+// registered, or whose recorded tool version doesn't match the version
+// currently installed: an imported entry for golangci-lint v1.55 is
+// useless (and potentially misleading) once this runner has v1.60
+// installed, so cache-import refuses it rather than resurrecting a stale
+// result.
+func (m *QualityManager) isCacheEntryCompatible(cached cache.CachedResult) bool {
+	tool := m.registry.FindTool(cached.Key.ToolName)
+	if tool == nil {
+		return false
+	}
+
+	version, err := tool.GetVersion()
+	if err != nil {
+		return false
+	}
+
+	return version == cached.Key.ToolVersion
+}
+
+// newReportMergeCmd merges the JSON reports produced by separate
+// --shard=i runs into a single report, so a CI workflow that fans
+// --shards=N out across parallel jobs can publish one combined result.
+func (m *QualityManager) newReportMergeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "report-merge <output> <shard-report.json>...",
+		Short: "샤드별 JSON 리포트를 하나로 병합",
+		Long: `--shard/--shards로 나눠 실행한 각 작업이 생성한 JSON 리포트를 읽어
+도구 실행 결과를 합치고, 이슈는 (파일, 라인, 컬럼, 규칙) 기준으로
+중복 제거한 뒤 하나의 JSON 리포트로 저장합니다.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputPath := args[0]
+
+			reports := make([]*report.Report, 0, len(args)-1)
+			for _, path := range args[1:] {
+				r, err := report.LoadJSON(path)
+				if err != nil {
+					return fmt.Errorf("리포트 읽기 실패 (%s): %w", path, err)
+				}
+				reports = append(reports, r)
+			}
+
+			merged := report.MergeReports(reports)
+
+			generator := report.NewReportGenerator(merged.ProjectRoot)
+			if err := generator.SaveJSON(merged, outputPath); err != nil {
+				return fmt.Errorf("병합된 리포트 저장 실패: %w", err)
+			}
+
+			fmt.Printf("✅ %d개 샤드 리포트 병합 완료 → %s (이슈 %d개)\n", len(reports), outputPath, merged.Summary.TotalIssues)
+			return nil
+		},
+	}
+}
+
+func (m *QualityManager) newReportFilterCmd() *cobra.Command {
+	var severities, toolNames, rules, paths []string
+	var minSeverity, format string
+
+	cmd := &cobra.Command{
+		Use:   "report-filter <input.json> <output>",
+		Short: "저장된 JSON 리포트를 조건으로 필터링해 새 리포트 생성",
+		Long: `--severity/--tool/--rule/--path/--min-severity로 큰 JSON 리포트를 좁혀
+triage 가능한 크기로 만듭니다. --rule/--path는 "**" 패턴을 지원하는 glob이며,
+--min-severity는 info < warning < error 순서로 그 이상만 남깁니다.
+출력 형식은 --format(json, html, markdown)으로 선택하며, 필터링된 리포트의
+Summary는 남은 이슈 기준으로 다시 계산됩니다.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inputPath, outputPath := args[0], args[1]
+
+			if ext := strings.ToLower(filepath.Ext(inputPath)); ext == ".sarif" || strings.HasSuffix(strings.ToLower(inputPath), ".sarif.json") {
+				return fmt.Errorf("SARIF 입력은 아직 지원하지 않습니다: JSON 리포트(%s --report json)를 사용하세요", "gzh-quality run")
+			}
+
+			r, err := report.LoadJSON(inputPath)
+			if err != nil {
+				return fmt.Errorf("리포트 읽기 실패 (%s): %w", inputPath, err)
+			}
+
+			filtered := report.FilterReport(r, report.Query{
+				Severities:  severities,
+				Tools:       toolNames,
+				Rules:       rules,
+				Paths:       paths,
+				MinSeverity: minSeverity,
+			})
+
+			generator := report.NewReportGenerator(filtered.ProjectRoot)
+
+			switch format {
+			case "", "json":
+				err = generator.SaveJSON(filtered, outputPath)
+			case "html":
+				err = generator.SaveHTML(filtered, outputPath)
+			case "markdown":
+				err = generator.SaveMarkdown(filtered, outputPath)
+			default:
+				return fmt.Errorf("지원하지 않는 --format 값: %s (json, html, markdown 중 선택)", format)
+			}
+			if err != nil {
+				return fmt.Errorf("필터링된 리포트 저장 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 필터링 완료 → %s (이슈 %d개, 파일 %d개)\n", outputPath, filtered.Summary.TotalIssues, filtered.Summary.FilesWithIssues)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&severities, "severity", nil, "심각도 필터 (예: error,warning)")
+	cmd.Flags().StringSliceVar(&toolNames, "tool", nil, "도구 이름 필터 (예: golangci-lint)")
+	cmd.Flags().StringSliceVar(&rules, "rule", nil, "규칙 glob 필터 (예: unused,SA*)")
+	cmd.Flags().StringSliceVar(&paths, "path", nil, "파일 경로 glob 필터 (예: internal/**)")
+	cmd.Flags().StringVar(&minSeverity, "min-severity", "", "이 심각도 이상만 포함 (info, warning, error)")
+	cmd.Flags().StringVar(&format, "format", "json", "출력 형식 (json, html, markdown)")
+
+	return cmd
+}
+
 // formatBytes formats byte size to human readable format.
 func formatBytes(bytes int64) string {
 	const (
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gizzahub/gzh-cli-quality/autofix"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// newAutofixCmd creates the autofix subcommand, which collects Fix
+// proposals from every registered tool that implements tools.FixProposer
+// and shows, confirms, or applies them via autofix.Applier depending on
+// --mode.
+func (m *QualityManager) newAutofixCmd() *cobra.Command {
+	var mode string
+
+	cmd := &cobra.Command{
+		Use:   "autofix",
+		Short: "제안된 수정 사항을 미리보기/확인 후 적용",
+		Long: `FixProposer를 지원하는 도구(예: ktlint)가 제안한 수정 사항을 diff로 보여주고,
+--mode 값에 따라 그대로 미리보기만 하거나, 건별로 확인하거나, 바로 적용합니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var applierMode autofix.Mode
+			switch mode {
+			case "show":
+				applierMode = autofix.Show
+			case "confirm":
+				applierMode = autofix.Confirm
+			case "apply":
+				applierMode = autofix.Apply
+			default:
+				return fmt.Errorf("알 수 없는 --mode 값: %s (show, confirm, apply 중 선택)", mode)
+			}
+
+			projectRoot, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+
+			files, err := cmd.Flags().GetStringSlice("files")
+			if err != nil {
+				return err
+			}
+
+			options := tools.ExecuteOptions{ProjectRoot: projectRoot}
+
+			var fixes []tools.Fix
+			for _, tool := range m.registry.GetTools() {
+				proposer, ok := tool.(tools.FixProposer)
+				if !ok {
+					continue
+				}
+
+				proposed, err := proposer.ProposeFixes(cmd.Context(), files, options)
+				if err != nil {
+					return fmt.Errorf("%s 수정 제안 실패: %w", tool.Name(), err)
+				}
+				fixes = append(fixes, proposed...)
+			}
+
+			if len(fixes) == 0 {
+				fmt.Println("🎯 제안할 수정 사항이 없습니다.")
+				return nil
+			}
+
+			applier := autofix.NewApplier(applierMode)
+			if err := applier.Run(fixes); err != nil {
+				if rollbackErr := applier.Journal().Rollback(); rollbackErr != nil {
+					return fmt.Errorf("%w (롤백도 실패: %v)", err, rollbackErr)
+				}
+				return fmt.Errorf("수정 적용 실패, 적용된 변경 사항을 롤백했습니다: %w", err)
+			}
+
+			fmt.Printf("✅ %d개의 수정 사항을 처리했습니다 (%s 모드)\n", len(fixes), mode)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice("files", nil, "대상 파일 목록 (지정하지 않으면 도구가 전체 프로젝트를 스캔)")
+	cmd.Flags().StringVar(&mode, "mode", "show", "show, confirm, apply 중 선택")
+
+	return cmd
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// baselineIssueEntry identifies one suppressed issue by fingerprint, kept
+// alongside human-readable fields so stale entries can be reported and
+// the file stays reviewable in a diff.
+type baselineIssueEntry struct {
+	Fingerprint string    `json:"fingerprint"`
+	Tool        string    `json:"tool"`
+	ToolVersion string    `json:"tool_version,omitempty"`
+	Rule        string    `json:"rule"`
+	File        string    `json:"file"`
+	AddedAt     time.Time `json:"added_at"`
+}
+
+// baselineFile is the parsed form of a `--baseline` JSON file.
+type baselineFile struct {
+	Issues []baselineIssueEntry `json:"issues"`
+}
+
+// loadBaselineFile reads and parses a baseline file. A missing path is not
+// an error - it simply means no issues are baselined yet.
+func loadBaselineFile(path string) (*baselineFile, error) {
+	if path == "" {
+		return &baselineFile{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &baselineFile{}, nil
+		}
+		return nil, fmt.Errorf("failed to read baseline file %s: %w", path, err)
+	}
+
+	var bf baselineFile
+	if err := json.Unmarshal(data, &bf); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline file %s: %w", path, err)
+	}
+
+	return &bf, nil
+}
+
+// saveBaselineFile writes entries to path as a baseline file.
+func saveBaselineFile(path string, entries []baselineIssueEntry) error {
+	bf := baselineFile{Issues: entries}
+
+	data, err := json.MarshalIndent(bf, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal baseline file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create baseline directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write baseline file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// has reports whether fingerprint is present in the baseline.
+func (bf *baselineFile) has(fingerprint string) bool {
+	for _, entry := range bf.Issues {
+		if entry.Fingerprint == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// issueFingerprint computes a stable fingerprint for an issue from the
+// tool name, rule, project-relative path, the trimmed (not
+// line-numbered) source line, and an occurrence index - so the baseline
+// survives refactors that shift line numbers without changing the
+// issue's surrounding code. occurrence disambiguates two or more issues
+// in the same file that would otherwise produce an identical fingerprint
+// (e.g. two separate "return nil" lines both flagged by the same rule);
+// without it, baselining one occurrence would silently suppress every
+// occurrence, including ones introduced later on a different line that
+// merely happens to read the same.
+func issueFingerprint(toolName, rule, relPath, normalizedLineContext string, occurrence int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%d", toolName, rule, relPath, normalizedLineContext, occurrence)))
+	return hex.EncodeToString(hash[:])
+}
+
+// lineContext returns the trimmed text of the given 1-based line in path,
+// or "" if it can't be read.
+func lineContext(path string, line int) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	current := 0
+	for scanner.Scan() {
+		current++
+		if current == line {
+			return strings.TrimSpace(scanner.Text())
+		}
+	}
+
+	return ""
+}
+
+// fingerprintIssue computes the baseline fingerprint for a single issue.
+// counts tracks how many times each (tool, rule, path, line-context)
+// combination has already been fingerprinted in the current run, so that
+// two otherwise-identical issues (see issueFingerprint) get distinct
+// fingerprints instead of colliding. Callers share one counts map across
+// an entire result set and must visit issues in the same stable order
+// used when the baseline was originally built (the order each tool
+// itself reports them in).
+func fingerprintIssue(projectRoot, toolName string, issue tools.Issue, counts map[string]int) string {
+	relPath := relativeToProjectRoot(projectRoot, issue.File)
+
+	path := issue.File
+	if projectRoot != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(projectRoot, path)
+	}
+
+	lineCtx := lineContext(path, issue.Line)
+
+	key := toolName + "\x00" + issue.Rule + "\x00" + relPath + "\x00" + lineCtx
+	occurrence := counts[key]
+	counts[key] = occurrence + 1
+
+	return issueFingerprint(toolName, issue.Rule, relPath, lineCtx, occurrence)
+}
+
+// relativeToProjectRoot converts file to a path relative to projectRoot,
+// falling back to file if it can't be made relative.
+func relativeToProjectRoot(projectRoot, file string) string {
+	if projectRoot == "" {
+		return file
+	}
+
+	rel, err := filepath.Rel(projectRoot, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return file
+	}
+
+	return rel
+}
+
+// applyBaseline filters baselined issues out of each result's Issues in
+// place, returning the number of issues suppressed and the set of
+// fingerprints seen in the current run (used to detect stale entries).
+func applyBaseline(results []*tools.Result, baseline *baselineFile, projectRoot string) (suppressed int, seen map[string]bool) {
+	seen = make(map[string]bool)
+	counts := make(map[string]int)
+
+	for _, result := range results {
+		var kept []tools.Issue
+		for _, issue := range result.Issues {
+			fp := fingerprintIssue(projectRoot, result.Tool, issue, counts)
+			seen[fp] = true
+
+			if baseline.has(fp) {
+				suppressed++
+				continue
+			}
+			kept = append(kept, issue)
+		}
+		result.Issues = kept
+	}
+
+	return suppressed, seen
+}
+
+// staleBaselineEntries returns baseline entries whose fingerprint was not
+// seen in the current run, i.e. issues that no longer exist.
+func staleBaselineEntries(baseline *baselineFile, seen map[string]bool) []baselineIssueEntry {
+	var stale []baselineIssueEntry
+	for _, entry := range baseline.Issues {
+		if !seen[entry.Fingerprint] {
+			stale = append(stale, entry)
+		}
+	}
+	return stale
+}
+
+// buildBaselineEntries converts the current result set into baseline
+// entries, for `--update-baseline`. versions maps a tool name to its
+// installed version (as returned by QualityTool.GetVersion), so a
+// baseline entry records which version last saw the issue - useful when
+// deciding whether a stale entry went away because the code was fixed or
+// because the tool itself changed.
+func buildBaselineEntries(results []*tools.Result, projectRoot string, versions map[string]string) []baselineIssueEntry {
+	now := time.Now()
+	counts := make(map[string]int)
+
+	var entries []baselineIssueEntry
+	for _, result := range results {
+		for _, issue := range result.Issues {
+			entries = append(entries, baselineIssueEntry{
+				Fingerprint: fingerprintIssue(projectRoot, result.Tool, issue, counts),
+				Tool:        result.Tool,
+				ToolVersion: versions[result.Tool],
+				Rule:        issue.Rule,
+				File:        relativeToProjectRoot(projectRoot, issue.File),
+				AddedAt:     now,
+			})
+		}
+	}
+
+	return entries
+}
+
+// addBaselineFlags adds `--baseline`, `--update-baseline`, and
+// `--strict-baseline` flags to a command.
+func addBaselineFlags(cmd *cobra.Command) {
+	cmd.Flags().String("baseline", "", "베이스라인 파일 경로 (기존에 알려진 이슈를 결과에서 제외)")
+	cmd.Flags().Bool("update-baseline", false, "현재 실행 결과로 베이스라인 파일을 갱신")
+	cmd.Flags().Bool("strict-baseline", false, "더 이상 발생하지 않는 베이스라인 항목이 있으면 실패 처리 (정리 유도)")
+}
+
+// toolVersionsForResults resolves the installed version of every distinct
+// tool referenced in results, for recording alongside baseline entries.
+// Tools that can't be found or whose version can't be determined are
+// simply omitted, mirroring toolVersions' handling for SARIF output.
+func (m *QualityManager) toolVersionsForResults(results []*tools.Result) map[string]string {
+	versions := make(map[string]string, len(results))
+
+	for _, result := range results {
+		if _, ok := versions[result.Tool]; ok {
+			continue
+		}
+
+		tool := m.registry.FindTool(result.Tool)
+		if tool == nil {
+			continue
+		}
+
+		if version, err := tool.GetVersion(); err == nil {
+			versions[result.Tool] = version
+		}
+	}
+
+	return versions
+}
+
+// newBaselineCmd creates the `baseline` subcommand, which runs linting and
+// writes every issue found into a fresh baseline file.
+func (m *QualityManager) newBaselineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "현재 발견된 이슈를 베이스라인으로 저장",
+		Long: `현재 코드베이스에서 발견되는 모든 이슈를 베이스라인 파일로 저장합니다.
+이후 run/check 명령에 --baseline <path>를 지정하면 베이스라인에 있는 이슈는
+결과에서 제외되어, 레거시 코드베이스에 점진적으로 도구를 도입할 수 있습니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := parseExecutionOptions(cmd)
+			if err != nil {
+				return err
+			}
+			opts.lintOnly = true
+			opts.formatOnly = false
+			opts.fix = false
+			opts.emptyMessage = "🎯 검사할 작업이 없습니다."
+			opts.executePrefix = "🔍"
+			opts.updateBaseline = true
+
+			return m.executeQuality(cmd.Context(), opts)
+		},
+	}
+
+	addCommonExecutionFlags(cmd)
+	addGitFilterFlags(cmd)
+	addCacheFlags(cmd)
+	cmd.Flags().String("baseline", ".quality-baseline.json", "베이스라인 파일 경로")
+
+	cmd.AddCommand(m.newBaselinePruneCmd())
+
+	return cmd
+}
+
+// newBaselinePruneCmd creates the `baseline prune` subcommand, which
+// re-lints the codebase and rewrites the baseline file with stale entries
+// (issues that no longer occur) removed, instead of merely warning about
+// them as a plain --baseline run does (or failing, with --strict-baseline).
+func (m *QualityManager) newBaselinePruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "베이스라인에서 더 이상 발생하지 않는 항목 제거",
+		Long: `현재 코드베이스를 다시 검사해 베이스라인 파일의 각 항목이 여전히
+발생하는지 확인하고, 더 이상 발생하지 않는 항목을 베이스라인 파일에서 제거합니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := parseExecutionOptions(cmd)
+			if err != nil {
+				return err
+			}
+			opts.lintOnly = true
+			opts.formatOnly = false
+			opts.fix = false
+			opts.emptyMessage = "🎯 검사할 작업이 없습니다."
+			opts.executePrefix = "🔍"
+			opts.pruneBaseline = true
+
+			return m.executeQuality(cmd.Context(), opts)
+		},
+	}
+
+	addCommonExecutionFlags(cmd)
+	addGitFilterFlags(cmd)
+	addCacheFlags(cmd)
+	cmd.Flags().String("baseline", ".quality-baseline.json", "베이스라인 파일 경로")
+
+	return cmd
+}
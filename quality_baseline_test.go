@@ -0,0 +1,72 @@
+//nolint:testpackage // White-box testing needed for internal function access
+package quality
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+func TestIssueFingerprint_OccurrenceDisambiguates(t *testing.T) {
+	fp0 := issueFingerprint("golangci-lint", "unused", "main.go", "return nil", 0)
+	fp1 := issueFingerprint("golangci-lint", "unused", "main.go", "return nil", 1)
+
+	assert.NotEqual(t, fp0, fp1)
+}
+
+func TestFingerprintIssue_IdenticalLinesGetDistinctFingerprints(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("func f() {\n\treturn nil\n}\n\nfunc g() {\n\treturn nil\n}\n"), 0o644))
+
+	counts := make(map[string]int)
+	issueA := tools.Issue{File: path, Line: 2, Rule: "unused"}
+	issueB := tools.Issue{File: path, Line: 6, Rule: "unused"}
+
+	fpA := fingerprintIssue("", "golangci-lint", issueA, counts)
+	fpB := fingerprintIssue("", "golangci-lint", issueB, counts)
+
+	assert.NotEqual(t, fpA, fpB, "two textually-identical lines flagged by the same rule must not collide")
+}
+
+// TestApplyBaseline_DoesNotSuppressNewOccurrenceOfIdenticalLine reproduces
+// the collision the fingerprint's occurrence index exists to prevent: a
+// file with two textually-identical lines tripping the same rule, where
+// only the first occurrence was baselined. Before the fix, baselining one
+// occurrence silently suppressed both.
+func TestApplyBaseline_DoesNotSuppressNewOccurrenceOfIdenticalLine(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("func f() {\n\treturn nil\n}\n"), 0o644))
+
+	// Baseline only the first (and, at baselining time, only) occurrence.
+	firstRunResults := []*tools.Result{{
+		Tool:   "golangci-lint",
+		Issues: []tools.Issue{{File: path, Line: 2, Rule: "unused"}},
+	}}
+	entries := buildBaselineEntries(firstRunResults, "", nil)
+	baseline := &baselineFile{Issues: entries}
+
+	// A second, textually-identical line is added later and flagged by
+	// the same rule - this must NOT be suppressed even though its
+	// tool/rule/path/line-text match the baselined entry.
+	require.NoError(t, os.WriteFile(path, []byte("func f() {\n\treturn nil\n}\n\nfunc g() {\n\treturn nil\n}\n"), 0o644))
+	secondRunResults := []*tools.Result{{
+		Tool: "golangci-lint",
+		Issues: []tools.Issue{
+			{File: path, Line: 2, Rule: "unused"},
+			{File: path, Line: 6, Rule: "unused"},
+		},
+	}}
+
+	suppressed, _ := applyBaseline(secondRunResults, baseline, "")
+
+	assert.Equal(t, 1, suppressed, "only the originally-baselined occurrence should be suppressed")
+	require.Len(t, secondRunResults[0].Issues, 1)
+	assert.Equal(t, 6, secondRunResults[0].Issues[0].Line, "the new occurrence on line 6 must survive baselining")
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/cache"
+	"github.com/spf13/cobra"
+)
+
+// newCacheCmd creates the `cache` parent command grouping cache lifecycle
+// subcommands (gc, prune, stats).
+func (m *QualityManager) newCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "캐시 관리 (gc, prune, stats)",
+		Long:  "캐시 용량 관리 및 통계 확인을 위한 하위 명령어를 제공합니다.",
+	}
+
+	cmd.AddCommand(m.newCacheGCCmd())
+	cmd.AddCommand(m.newCachePruneCmd())
+	cmd.AddCommand(m.newCacheStatsDetailCmd())
+
+	return cmd
+}
+
+// newCacheGCCmd creates the `cache gc` subcommand.
+func (m *QualityManager) newCacheGCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "오래되었거나 용량/개수 제한을 초과한 캐시 항목 제거",
+		Long: `--max-age보다 오래된 항목을 먼저 제거한 뒤, 남은 항목이 --max-size나
+--max-entries를 초과하면 가장 오래전에 접근한 항목부터 추가로 제거합니다.
+CI 이미지에서 실행 사이에 캐시 용량을 일정하게 유지하는 용도입니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if m.cacheManager == nil {
+				fmt.Println("⚠️ 캐시가 비활성화되어 있습니다.")
+				return nil
+			}
+
+			maxSizeStr, _ := cmd.Flags().GetString("max-size")
+			maxSize, err := parseSize(maxSizeStr)
+			if err != nil {
+				return fmt.Errorf("잘못된 --max-size 값: %w", err)
+			}
+
+			maxAgeStr, _ := cmd.Flags().GetString("max-age")
+			maxAge := parseDuration(maxAgeStr, 0)
+
+			maxEntries, _ := cmd.Flags().GetInt("max-entries")
+
+			evicted, err := m.cacheManager.GC(cache.GCPolicy{
+				MaxAge:     maxAge,
+				MaxBytes:   maxSize,
+				MaxEntries: maxEntries,
+			})
+			if err != nil {
+				return fmt.Errorf("캐시 정리 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 캐시 정리 완료: %d개 항목 제거\n", evicted)
+			return nil
+		},
+	}
+
+	cmd.Flags().String("max-size", "2GB", "캐시 최대 크기 (예: 2GB, 512MB)")
+	cmd.Flags().String("max-age", "", "이 기간보다 오래된 항목 무조건 제거 (예: 30d, 24h, 기본: 미제한)")
+	cmd.Flags().Int("max-entries", 0, "캐시 최대 항목 개수 (기본: 미제한)")
+
+	return cmd
+}
+
+// newCachePruneCmd creates the `cache prune` subcommand.
+func (m *QualityManager) newCachePruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "오래되었거나 특정 도구/버전에 속한 캐시 항목 제거",
+		Long: `--max-age보다 오래된 항목을 제거합니다.
+--tool과 --version을 지정하면 나이와 무관하게 해당 도구(버전)의 모든 캐시 항목을 제거합니다
+(도구를 업그레이드한 뒤 이전 결과를 모두 무효화할 때 유용합니다).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if m.cacheManager == nil {
+				fmt.Println("⚠️ 캐시가 비활성화되어 있습니다.")
+				return nil
+			}
+
+			maxAgeStr, _ := cmd.Flags().GetString("max-age")
+			maxAge := parseDuration(maxAgeStr, 0)
+
+			tool, _ := cmd.Flags().GetString("tool")
+			version, _ := cmd.Flags().GetString("version")
+
+			pruned, err := m.cacheManager.Prune(maxAge, tool, version)
+			if err != nil {
+				return fmt.Errorf("캐시 정리 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 캐시 정리 완료: %d개 항목 제거\n", pruned)
+
+			if recompress, _ := cmd.Flags().GetBool("recompress"); recompress {
+				algo := cache.CompressionAlgorithm(m.config.Cache.Compression)
+				rewritten, err := m.cacheManager.Recompress(algo, m.config.Cache.CompressionLevel)
+				if err != nil {
+					return fmt.Errorf("캐시 재압축 실패: %w", err)
+				}
+				fmt.Printf("✅ 캐시 재압축 완료: %d개 항목\n", rewritten)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("max-age", "30d", "이 기간보다 오래된 항목 제거 (예: 30d, 24h)")
+	cmd.Flags().String("tool", "", "지정한 도구의 모든 캐시 항목 제거")
+	cmd.Flags().String("version", "", "--tool과 함께 사용: 지정한 버전의 캐시 항목만 제거")
+	cmd.Flags().Bool("recompress", false, "정리 후 남은 캐시 항목을 현재 설정된 압축 방식으로 재압축")
+
+	return cmd
+}
+
+// newCacheStatsDetailCmd creates the `cache stats` subcommand, extending
+// the legacy cache-stats command with a top-10 tools-by-footprint table.
+func (m *QualityManager) newCacheStatsDetailCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "캐시 통계 표시 (히트율, 용량, 도구별 상위 10개)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if m.cacheManager == nil {
+				fmt.Println("⚠️ 캐시가 비활성화되어 있습니다.")
+				return nil
+			}
+
+			stats := m.cacheManager.Stats()
+
+			fmt.Println("📊 캐시 통계:")
+			fmt.Printf("  캐시 디렉토리: %s\n", m.config.GetCacheDirectory())
+			fmt.Printf("  캐시 항목: %d개\n", stats.Entries)
+			fmt.Printf("  캐시 크기: %s\n", formatBytes(stats.SizeBytes))
+			fmt.Printf("  캐시 히트: %d회\n", stats.HitCount)
+			fmt.Printf("  캐시 미스: %d회\n", stats.MissCount)
+			fmt.Printf("  히트율: %.1f%%\n", stats.HitRate*100)
+
+			topTools, err := m.cacheManager.TopTools(10)
+			if err != nil {
+				return fmt.Errorf("도구별 통계 조회 실패: %w", err)
+			}
+
+			if len(topTools) > 0 {
+				fmt.Println("  상위 도구 (캐시 용량 기준):")
+				for i, tool := range topTools {
+					fmt.Printf("    %2d. %-20s %10s (%d개 항목)\n", i+1, tool.Tool, formatBytes(tool.SizeBytes), tool.Entries)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// parseSize parses a human-readable byte size like "2GB", "512MB", or a
+// plain byte count, returning the size in bytes.
+func parseSize(s string) (int64, error) {
+	s = strings.TrimSpace(strings.ToUpper(s))
+	if s == "" {
+		return 0, fmt.Errorf("size is empty")
+	}
+
+	units := []struct {
+		suffix string
+		factor int64
+	}{
+		{"GB", 1024 * 1024 * 1024},
+		{"MB", 1024 * 1024},
+		{"KB", 1024},
+		{"B", 1},
+	}
+
+	for _, unit := range units {
+		if strings.HasSuffix(s, unit.suffix) {
+			numStr := strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			value, err := strconv.ParseFloat(numStr, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid size %q: %w", s, err)
+			}
+			return int64(value * float64(unit.factor)), nil
+		}
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return value, nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/Gizzahub/gzh-cli-quality/redact"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// diagnoseSecretEnvPattern matches environment variable names that are
+// almost always secrets (*_TOKEN, *_KEY, *_SECRET), so the diagnose
+// bundle's env snapshot drops their values outright rather than relying
+// on redact's pattern/value matching to catch every shape a token can
+// take.
+var diagnoseSecretEnvPattern = regexp.MustCompile(`(?i)(_TOKEN|_KEY|_SECRET)$`)
+
+// newDiagnoseCmd creates the `diagnose` subcommand, which bundles enough
+// state about the current environment and the last few tool invocations
+// into a single gzip tar archive that a user can attach to a bug report
+// without pasting logs (and their secrets) into an issue by hand.
+func (m *QualityManager) newDiagnoseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnose [path]",
+		Short: "재현 가능한 버그 리포트용 진단 번들 생성",
+		Long: `버전/PATH, 등록된 도구 메타데이터, 적용 중인 설정, 비밀 값을 제거한
+환경 변수 스냅샷, 최근 도구 실행 내역을 gzip tar 아카이브 하나로 묶습니다.
+path를 생략하면 현재 디렉터리에 gzquality-diag-<timestamp>.tar.gz로 저장합니다.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := fmt.Sprintf("gzquality-diag-%s.tar.gz", time.Now().Format("20060102-150405"))
+			if len(args) == 1 {
+				path = args[0]
+			}
+
+			out, err := os.Create(path)
+			if err != nil {
+				return fmt.Errorf("진단 번들 파일 생성 실패: %w", err)
+			}
+			defer func() {
+				_ = out.Close()
+			}()
+
+			if err := m.writeDiagnoseBundle(out); err != nil {
+				return fmt.Errorf("진단 번들 작성 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 진단 번들을 생성했습니다: %s\n", path)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// writeDiagnoseBundle writes the diagnose bundle's sections to w as a
+// gzip-compressed tar archive, following cache.ExportArchive's
+// gzip-then-tar layering.
+func (m *QualityManager) writeDiagnoseBundle(w *os.File) error {
+	gz := gzip.NewWriter(w)
+	defer func() {
+		_ = gz.Close()
+	}()
+
+	tw := tar.NewWriter(gz)
+	defer func() {
+		_ = tw.Close()
+	}()
+
+	sections := map[string]string{
+		"version.txt":     m.diagnoseVersionSection(),
+		"tools.txt":       m.diagnoseToolsSection(),
+		"config.yaml":     m.diagnoseConfigSection(),
+		"env.txt":         diagnoseEnvSection(),
+		"invocations.txt": diagnoseInvocationsSection(),
+	}
+
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		content := sections[name]
+		hdr := &tar.Header{
+			Name: name,
+			Mode: 0o644,
+			Size: int64(len(content)),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("failed to write archive header for %s: %w", name, err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			return fmt.Errorf("failed to write archive entry for %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// diagnoseVersionSection reports the Go runtime that built the binary
+// and the PATH it's resolving tool executables against, since "which
+// golangci-lint did it find" is the first question most bug reports need
+// answered.
+func (m *QualityManager) diagnoseVersionSection() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "go: %s\n", runtime.Version())
+	fmt.Fprintf(&b, "os/arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "PATH: %s\n", os.Getenv("PATH"))
+	return b.String()
+}
+
+// diagnoseToolsSection lists every registered tool's metadata: type,
+// whether it's currently available on PATH, its reported version
+// (best-effort; GetVersion's own "unknown" fallback covers a tool that's
+// installed but doesn't answer any of the common version flags), and any
+// config files it matched in the current project.
+func (m *QualityManager) diagnoseToolsSection() string {
+	projectRoot, err := os.Getwd()
+	if err != nil {
+		projectRoot = "."
+	}
+
+	var b strings.Builder
+	for lang, toolList := range groupToolsByLanguage(m.registry.GetTools()) {
+		fmt.Fprintf(&b, "%s:\n", lang)
+		for _, tool := range toolList {
+			available := tool.IsAvailable()
+			version := "n/a"
+			if available {
+				if v, err := tool.GetVersion(); err == nil {
+					version = v
+				} else {
+					version = "unknown"
+				}
+			}
+			fmt.Fprintf(&b, "  %-20s type=%-6v available=%-5t version=%s\n",
+				tool.Name(), tool.Type(), available, version)
+			for _, configPath := range tool.FindConfigFiles(projectRoot) {
+				fmt.Fprintf(&b, "      config: %s\n", configPath)
+			}
+		}
+	}
+	return b.String()
+}
+
+// diagnoseConfigSection renders the effective config as YAML, the same
+// shape a user's .gzquality.yml takes, so a maintainer can see exactly
+// what settings produced the behavior being reported.
+func (m *QualityManager) diagnoseConfigSection() string {
+	data, err := yaml.Marshal(m.config)
+	if err != nil {
+		return fmt.Sprintf("failed to marshal config: %v\n", err)
+	}
+	return string(data)
+}
+
+// diagnoseEnvSection snapshots the process environment, dropping any
+// variable whose name looks like a secret (diagnoseSecretEnvPattern)
+// outright and redacting the rest through the global redact.Store for
+// good measure, since a token can end up in an unexpectedly-named
+// variable too.
+func diagnoseEnvSection() string {
+	env := os.Environ()
+	sort.Strings(env)
+
+	var b strings.Builder
+	for _, kv := range env {
+		name, value, found := strings.Cut(kv, "=")
+		if !found {
+			continue
+		}
+		if diagnoseSecretEnvPattern.MatchString(name) {
+			fmt.Fprintf(&b, "%s=***REDACTED***\n", name)
+			continue
+		}
+		fmt.Fprintf(&b, "%s=%s\n", name, redact.Redact(value))
+	}
+	return b.String()
+}
+
+// diagnoseInvocationsSection lists the most recent commands run through
+// any BaseTool in this process, in the order they ran.
+func diagnoseInvocationsSection() string {
+	records := tools.RecentInvocations()
+	if len(records) == 0 {
+		return "(no tool invocations recorded in this process)\n"
+	}
+
+	var b strings.Builder
+	for _, rec := range records {
+		status := "ok"
+		if !rec.Success {
+			status = "failed: " + rec.Error
+		}
+		fmt.Fprintf(&b, "[%s] (%s) %s -> %s\n",
+			rec.Started.Format("15:04:05"), rec.Duration.Round(time.Millisecond), rec.Argv, status)
+	}
+	return b.String()
+}
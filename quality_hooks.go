@@ -0,0 +1,368 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	gitutils "github.com/Gizzahub/gzh-cli-quality/git"
+)
+
+// hookMarkerBegin/hookMarkerEnd delimit the block newHooksCmd's install
+// subcommand writes into a hook script, so uninstall can remove exactly
+// (and only) our lines without disturbing anything else in the hook.
+const (
+	hookMarkerBegin = "# >>> gz quality hooks >>>"
+	hookMarkerEnd   = "# <<< gz quality hooks <<<"
+)
+
+// hookCheckCommand returns the shell command the given hook stage runs.
+// pre-commit checks staged files; pre-push checks the working tree's
+// staged, modified and untracked files (--changed), not the commits
+// being pushed relative to the upstream branch.
+func hookCheckCommand(stage string) string {
+	switch stage {
+	case "pre-push":
+		return "gz quality check --changed --report json --output -"
+	default:
+		return "gz quality check --staged --report json --output -"
+	}
+}
+
+// hookBlock builds the managed block written into a hook script for stage.
+func hookBlock(stage string) string {
+	return fmt.Sprintf("%s\n# Managed by `gz quality hooks install` / removed by `gz quality hooks uninstall`.\n%s || exit 1\n%s\n",
+		hookMarkerBegin, hookCheckCommand(stage), hookMarkerEnd)
+}
+
+// stripManagedBlock removes a previously-installed hookBlock from content,
+// reporting whether one was found.
+func stripManagedBlock(content string) (string, bool) {
+	begin := strings.Index(content, hookMarkerBegin)
+	if begin == -1 {
+		return content, false
+	}
+
+	end := strings.Index(content, hookMarkerEnd)
+	if end == -1 || end < begin {
+		return content, false
+	}
+	end += len(hookMarkerEnd)
+
+	// Consume a single trailing newline so repeated install/uninstall
+	// cycles don't accumulate blank lines.
+	if end < len(content) && content[end] == '\n' {
+		end++
+	}
+
+	return content[:begin] + content[end:], true
+}
+
+// newPrecommitCmd creates the top-level `precommit` command: the single
+// entry point a pre-commit hook should call. Unlike `hooks run --stage
+// pre-commit` (lint-only, for reproducing what an installed hook would
+// report), this defaults --fix on and restages whatever it rewrites
+// (see QualityManager.restageFixedFiles) so formatter output actually
+// lands in the commit being made, not just the working tree.
+func (m *QualityManager) newPrecommitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precommit",
+		Short: "스테이징된 파일을 검사/자동 수정하고 수정분을 다시 스테이징 (pre-commit 훅 진입점)",
+		Long: `git commit 직전에 실행할 단일 진입점입니다. 기본적으로 staged 파일만 대상으로
+자동 수정을 적용한 뒤, 수정된 파일을 "git add"로 다시 스테이징합니다.
+
+--install-hook을 지정하면 검사를 실행하는 대신 "hooks install"과 같은
+pre-commit 훅(gz quality check --staged 호출)만 설치하고 종료합니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if installHookFlag, _ := cmd.Flags().GetBool("install-hook"); installHookFlag {
+				projectRoot, err := os.Getwd()
+				if err != nil {
+					return fmt.Errorf("failed to get current directory: %w", err)
+				}
+
+				force, _ := cmd.Flags().GetBool("force")
+
+				hooksDir, err := gitutils.NewGitUtils(projectRoot).HooksPath()
+				if err != nil {
+					return err
+				}
+
+				if err := installHook(hooksDir, "pre-commit", force); err != nil {
+					return err
+				}
+
+				fmt.Printf("✅ pre-commit 훅을 설치했습니다: %s\n", filepath.Join(hooksDir, "pre-commit"))
+				return nil
+			}
+
+			opts, err := parseExecutionOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts.staged = true
+			opts.changed = false
+			opts.emptyMessage = "🎯 검사할 작업이 없습니다."
+			opts.executePrefix = "🔧"
+
+			return m.executeQuality(cmd.Context(), opts)
+		},
+	}
+
+	addCommonExecutionFlags(cmd)
+	addCacheFlags(cmd)
+	addBaselineFlags(cmd)
+	cmd.Flags().BoolP("fix", "x", true, "자동 수정 적용 후 스테이징에 반영 (지원하는 도구만)")
+	cmd.Flags().Bool("format-only", false, "포매팅만 실행")
+	cmd.Flags().Bool("lint-only", false, "린팅만 실행")
+	cmd.Flags().Bool("install-hook", false, "검사 대신 \"hooks install\"과 같은 pre-commit 훅을 설치하고 종료")
+	cmd.Flags().Bool("force", false, "--install-hook과 함께: 기존 훅을 덮어씁니다")
+
+	return cmd
+}
+
+// newHooksCmd creates the `hooks` parent command, which wires quality
+// checks into Git via pre-commit/pre-push hooks (or the pre-commit
+// framework ecosystem).
+func (m *QualityManager) newHooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks",
+		Short: "Git 훅에 품질 검사 연결",
+	}
+
+	cmd.AddCommand(m.newHooksInstallCmd())
+	cmd.AddCommand(m.newHooksUninstallCmd())
+	cmd.AddCommand(m.newHooksRunCmd())
+
+	return cmd
+}
+
+// newHooksInstallCmd creates the `hooks install` subcommand.
+func (m *QualityManager) newHooksInstallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "pre-commit (및 선택적으로 pre-push) 훅 설치",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectRoot, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+
+			force, _ := cmd.Flags().GetBool("force")
+			prePush, _ := cmd.Flags().GetBool("pre-push")
+			preCommitFramework, _ := cmd.Flags().GetBool("pre-commit-framework")
+
+			if preCommitFramework {
+				return m.writePreCommitFrameworkSnippet(projectRoot, force)
+			}
+
+			hooksDir, err := gitutils.NewGitUtils(projectRoot).HooksPath()
+			if err != nil {
+				return err
+			}
+
+			stages := []string{"pre-commit"}
+			if prePush {
+				stages = append(stages, "pre-push")
+			}
+
+			for _, stage := range stages {
+				if err := installHook(hooksDir, stage, force); err != nil {
+					return err
+				}
+				fmt.Printf("✅ %s 훅을 설치했습니다: %s\n", stage, filepath.Join(hooksDir, stage))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("force", false, "기존 훅을 덮어씁니다")
+	cmd.Flags().Bool("pre-push", false, "pre-push 훅도 함께 설치")
+	cmd.Flags().Bool("pre-commit-framework", false, "훅 스크립트 대신 .pre-commit-hooks.yaml 스니펫 생성")
+
+	return cmd
+}
+
+// newHooksUninstallCmd creates the `hooks uninstall` subcommand.
+func (m *QualityManager) newHooksUninstallCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "uninstall",
+		Short: "설치된 품질 검사 훅 제거",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectRoot, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+
+			hooksDir, err := gitutils.NewGitUtils(projectRoot).HooksPath()
+			if err != nil {
+				return err
+			}
+
+			removed := 0
+			for _, stage := range []string{"pre-commit", "pre-push"} {
+				did, err := uninstallHook(hooksDir, stage)
+				if err != nil {
+					return err
+				}
+				if did {
+					fmt.Printf("🗑️  %s 훅을 제거했습니다\n", stage)
+					removed++
+				}
+			}
+
+			if removed == 0 {
+				fmt.Println("ℹ️  설치된 품질 검사 훅이 없습니다.")
+			}
+
+			return nil
+		},
+	}
+}
+
+// newHooksRunCmd creates the `hooks run` subcommand, which runs exactly
+// what an installed hook would run - useful for testing a hook locally
+// without committing/pushing.
+func (m *QualityManager) newHooksRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "설치된 훅이 실행할 검사를 수동으로 실행",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stage, _ := cmd.Flags().GetString("stage")
+
+			opts, err := parseExecutionOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts.fix = false
+			opts.formatOnly = false
+			opts.lintOnly = true
+			opts.emptyMessage = "🎯 검사할 작업이 없습니다."
+			opts.executePrefix = "🔍"
+
+			switch stage {
+			case "pre-push":
+				opts.staged = false
+				opts.changed = true
+			default:
+				opts.staged = true
+				opts.changed = false
+			}
+
+			if opts.reportFormat == "" {
+				opts.reportFormat = "json"
+				opts.outputPath = "-"
+			}
+
+			return m.executeQuality(cmd.Context(), opts)
+		},
+	}
+
+	addCommonExecutionFlags(cmd)
+	addBaselineFlags(cmd)
+	cmd.Flags().String("stage", "pre-commit", "재현할 훅 단계 (pre-commit, pre-push)")
+
+	return cmd
+}
+
+// installHook writes stage's managed block into hooksDir/stage, refusing
+// to touch a pre-existing, unmanaged hook unless force is set. Re-running
+// install (even without force) always succeeds against a hook we already
+// manage, since only our own block is replaced.
+func installHook(hooksDir, stage string, force bool) error {
+	path := filepath.Join(hooksDir, stage)
+
+	existing := ""
+	if data, err := os.ReadFile(path); err == nil {
+		existing = string(data)
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read existing %s hook: %w", stage, err)
+	}
+
+	rest, hadBlock := stripManagedBlock(existing)
+	if existing != "" && !hadBlock && !force {
+		return fmt.Errorf("existing %s hook found at %s; pass --force to append to it", stage, path)
+	}
+
+	if rest == "" {
+		rest = "#!/bin/sh\n"
+	}
+	if !strings.HasSuffix(rest, "\n") {
+		rest += "\n"
+	}
+
+	content := rest + hookBlock(stage)
+
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	return os.WriteFile(path, []byte(content), 0o755)
+}
+
+// uninstallHook removes the managed block from hooksDir/stage. It deletes
+// the hook file entirely if nothing but our block (and the shebang) was
+// left in it, and reports whether anything was removed.
+func uninstallHook(hooksDir, stage string) (bool, error) {
+	path := filepath.Join(hooksDir, stage)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read %s hook: %w", stage, err)
+	}
+
+	rest, hadBlock := stripManagedBlock(string(data))
+	if !hadBlock {
+		return false, nil
+	}
+
+	if strings.TrimSpace(rest) == "" || strings.TrimSpace(rest) == "#!/bin/sh" {
+		if err := os.Remove(path); err != nil {
+			return false, fmt.Errorf("failed to remove %s hook: %w", stage, err)
+		}
+		return true, nil
+	}
+
+	if err := os.WriteFile(path, []byte(rest), 0o755); err != nil {
+		return false, fmt.Errorf("failed to update %s hook: %w", stage, err)
+	}
+
+	return true, nil
+}
+
+// writePreCommitFrameworkSnippet writes a .pre-commit-hooks.yaml so this
+// tool can be consumed as a hook repo by the pre-commit ecosystem
+// (https://pre-commit.com).
+func (m *QualityManager) writePreCommitFrameworkSnippet(projectRoot string, force bool) error {
+	path := filepath.Join(projectRoot, ".pre-commit-hooks.yaml")
+
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%s already exists; pass --force to overwrite", path)
+	}
+
+	snippet := `- id: gz-quality
+  name: gz quality check
+  description: Run gz quality lint checks on staged files
+  entry: gz quality check --staged --report json --output -
+  language: system
+  pass_filenames: false
+`
+
+	if err := os.WriteFile(path, []byte(snippet), 0o644); err != nil {
+		return fmt.Errorf("failed to write .pre-commit-hooks.yaml: %w", err)
+	}
+
+	fmt.Printf("✅ %s 파일을 생성했습니다.\n", path)
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/Gizzahub/gzh-cli-quality/executor"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// scanIgnoreEntry suppresses a single CVE/GHSA finding until it expires.
+type scanIgnoreEntry struct {
+	ID     string    `yaml:"id"`
+	Reason string    `yaml:"reason"`
+	Expiry time.Time `yaml:"expiry"`
+}
+
+// scanIgnoreFile is the parsed form of a `.quality-ignore.yaml` file.
+type scanIgnoreFile struct {
+	Ignore []scanIgnoreEntry `yaml:"ignore"`
+}
+
+// loadScanIgnoreFile reads and parses a `.quality-ignore.yaml` suppression
+// file. A missing path is not an error - it simply means no suppressions.
+func loadScanIgnoreFile(path string) (*scanIgnoreFile, error) {
+	if path == "" {
+		return &scanIgnoreFile{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &scanIgnoreFile{}, nil
+		}
+		return nil, fmt.Errorf("failed to read ignore file %s: %w", path, err)
+	}
+
+	var ignoreFile scanIgnoreFile
+	if err := yaml.Unmarshal(data, &ignoreFile); err != nil {
+		return nil, fmt.Errorf("failed to parse ignore file %s: %w", path, err)
+	}
+
+	return &ignoreFile, nil
+}
+
+// isSuppressed reports whether a CVE is suppressed by the ignore file and
+// hasn't expired yet.
+func (f *scanIgnoreFile) isSuppressed(cve string, now time.Time) bool {
+	for _, entry := range f.Ignore {
+		if entry.ID == cve && (entry.Expiry.IsZero() || now.Before(entry.Expiry)) {
+			return true
+		}
+	}
+	return false
+}
+
+// newScanCmd creates the scan subcommand, running security scanners
+// (govulncheck, pip-audit, npm audit, trivy, ...) as first-class quality
+// tools.
+func (m *QualityManager) newScanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan",
+		Short: "보안 취약점 스캔 실행",
+		Long: `govulncheck, pip-audit, npm audit, trivy 등 보안 스캐너를 품질 도구로 실행합니다.
+--severity-threshold로 지정한 심각도 이상의 발견 사항만 빌드를 실패시킵니다.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return m.runScan(cmd, args)
+		},
+	}
+
+	addCommonExecutionFlags(cmd)
+	addGitFilterFlags(cmd)
+	addCacheFlags(cmd)
+
+	cmd.Flags().String("severity-threshold", "high", "이 심각도 이상인 경우에만 빌드를 실패시킴 (low, medium, high, critical)")
+	cmd.Flags().String("ignore-file", ".quality-ignore.yaml", "CVE 억제 목록 파일 (만료일 지원)")
+
+	return cmd
+}
+
+// runScan executes security-scanner tools and applies severity-threshold
+// gating and ignore-file suppression.
+func (m *QualityManager) runScan(cmd *cobra.Command, _ []string) error {
+	opts, err := parseExecutionOptions(cmd)
+	if err != nil {
+		return err
+	}
+	opts.emptyMessage = "🎯 스캔할 대상이 없습니다."
+	opts.executePrefix = "🔒"
+
+	thresholdStr, _ := cmd.Flags().GetString("severity-threshold")
+	ignorePath, _ := cmd.Flags().GetString("ignore-file")
+
+	threshold := tools.ParseSeverityLevel(thresholdStr)
+
+	ignoreFile, err := loadScanIgnoreFile(ignorePath)
+	if err != nil {
+		return err
+	}
+
+	projectRoot, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	plan, err := m.planner.CreatePlan(cmd.Context(), projectRoot, m.registry, m.scanPlanOptions(opts))
+	if err != nil {
+		return fmt.Errorf("failed to create scan plan: %w", err)
+	}
+
+	if len(plan.Tasks) == 0 {
+		fmt.Println(opts.emptyMessage)
+		return nil
+	}
+
+	m.displayPlan(plan, opts.verbose)
+
+	if opts.dryRun {
+		fmt.Println("✨ 드라이런 모드: 실제 실행하지 않습니다.")
+		return nil
+	}
+
+	startTime := time.Now()
+	results, err := m.executor.ExecuteParallel(cmd.Context(), plan, opts.workers)
+	duration := time.Since(startTime)
+	if err != nil {
+		return err
+	}
+
+	m.displayResults(results, duration, opts.verbose, opts.slowest)
+
+	now := time.Now()
+	failingFound := false
+	for _, result := range results {
+		for _, issue := range result.Issues {
+			if ignoreFile.isSuppressed(issue.CVE, now) {
+				continue
+			}
+			if issue.MeetsThreshold(threshold) {
+				failingFound = true
+			}
+		}
+	}
+
+	if failingFound {
+		return fmt.Errorf("취약점 스캔 실패: %s 이상 심각도의 발견 사항이 있습니다", thresholdStr)
+	}
+
+	return nil
+}
+
+// scanPlanOptions builds executor.PlanOptions restricted to SECURITY tools.
+func (m *QualityManager) scanPlanOptions(opts *executionOptions) executor.PlanOptions {
+	var securityTools []string
+	for _, tool := range m.registry.GetToolsByType(tools.SECURITY) {
+		securityTools = append(securityTools, tool.Name())
+	}
+
+	return executor.PlanOptions{
+		Files:      opts.files,
+		ExtraArgs:  opts.extraArgs,
+		ToolFilter: securityTools,
+	}
+}
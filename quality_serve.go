@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gizzahub/gzh-cli-quality/lsp"
+)
+
+// newServeCmd creates the serve subcommand, which starts a long-lived
+// JSON-RPC 2.0 server over stdio for editor integration (on-save/on-change
+// diagnostics). It reuses the manager's registry, analyzer, and cache-aware
+// executor so the server sees the exact same results as `gz quality run`.
+func (m *QualityManager) newServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serve",
+		Aliases: []string{"lsp"},
+		Short:   "편집기를 위한 LSP 서버 모드 시작 (stdio)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectRoot, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+
+			workers, _ := cmd.Flags().GetInt("workers")
+			if workers <= 0 {
+				workers = runtime.NumCPU()
+			}
+
+			server := lsp.NewServer(m.registry, m.analyzer, m.executor, projectRoot, workers)
+
+			return server.Run(os.Stdin, os.Stdout)
+		},
+	}
+
+	cmd.Flags().IntP("workers", "w", runtime.NumCPU(), "파일 저장 시 병렬 실행 워커 수")
+
+	return cmd
+}
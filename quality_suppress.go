@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Gizzahub/gzh-cli-quality/suppress"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// ignoreFileName is the project-level suppression file, analogous to
+// .quality-baseline.json for the baseline subsystem.
+const ignoreFileName = ".gzh-quality-ignore"
+
+// applySuppressionFiltering loads project-level ignores (.gzh-quality-ignore)
+// and inline `// gzh:ignore` comments scanned from every planned file, and
+// filters them out of each result's Issues in place. A line-scoped ignore
+// that never matched anything across the run is reported as likely stale,
+// so lingering suppressions for already-fixed issues get cleaned up.
+func (m *QualityManager) applySuppressionFiltering(results []*tools.Result, plan *tools.ExecutionPlan, projectRoot string) error {
+	fileIgnores, err := suppress.LoadIgnoreFile(filepath.Join(projectRoot, ignoreFileName))
+	if err != nil {
+		return err
+	}
+
+	var allFiles []string
+	for _, task := range plan.Tasks {
+		allFiles = append(allFiles, task.Files...)
+	}
+	inlineIgnores := suppress.ScanInlineIgnores(allFiles)
+
+	ignores := append(fileIgnores, inlineIgnores...)
+	if len(ignores) == 0 {
+		return nil
+	}
+
+	filter := suppress.NewFilter(ignores)
+	for _, result := range results {
+		result.Issues = filter.Apply(result.Tool, result.Issues)
+	}
+
+	if unused := filter.UnusedLineIgnores(); len(unused) > 0 {
+		fmt.Printf("⚠️ 더 이상 일치하지 않는 인라인 억제 주석 %d개가 있습니다 (정리가 필요할 수 있습니다):\n", len(unused))
+		for _, ignore := range unused {
+			fmt.Printf("   %s\n", ignore)
+		}
+	}
+
+	return nil
+}
@@ -4,13 +4,17 @@ package quality
 import (
 	"context"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Gizzahub/gzh-cli-quality/detector"
+	gitutils "github.com/Gizzahub/gzh-cli-quality/git"
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
 
@@ -84,6 +88,24 @@ func TestQualityManagerCheckCmd(t *testing.T) {
 	}
 }
 
+func TestQualityManagerPrecommitCmd(t *testing.T) {
+	manager := NewQualityManager()
+	cmd := manager.newPrecommitCmd()
+
+	assert.Equal(t, "precommit", cmd.Use)
+	assert.NotEmpty(t, cmd.Long)
+	assert.NotNil(t, cmd.RunE)
+
+	flags := []string{"fix", "format-only", "lint-only", "install-hook", "force", "baseline", "workers"}
+	for _, flagName := range flags {
+		flag := cmd.Flags().Lookup(flagName)
+		assert.NotNil(t, flag, "Flag %s should exist", flagName)
+	}
+
+	fixFlag := cmd.Flags().Lookup("fix")
+	assert.Equal(t, "true", fixFlag.DefValue, "precommit should default --fix on, unlike run")
+}
+
 func TestQualityManagerAnalyzeCmd(t *testing.T) {
 	manager := NewQualityManager()
 	cmd := manager.newAnalyzeCmd()
@@ -93,6 +115,16 @@ func TestQualityManagerAnalyzeCmd(t *testing.T) {
 	assert.NotNil(t, cmd.RunE)
 }
 
+func TestQualityManagerServeCmd(t *testing.T) {
+	manager := NewQualityManager()
+	cmd := manager.newServeCmd()
+
+	assert.Equal(t, "serve", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "lsp")
+	assert.Contains(t, cmd.Short, "LSP")
+	assert.NotNil(t, cmd.RunE)
+}
+
 func TestQualityManagerInstallCmd(t *testing.T) {
 	manager := NewQualityManager()
 	cmd := manager.newInstallCmd()
@@ -214,11 +246,11 @@ func TestDisplayResults(t *testing.T) {
 
 	// This should not panic
 	assert.NotPanics(t, func() {
-		manager.displayResults(results, time.Second, false)
+		manager.displayResults(results, time.Second, false, 0)
 	})
 
 	assert.NotPanics(t, func() {
-		manager.displayResults(results, time.Second, true)
+		manager.displayResults(results, time.Second, true, 0)
 	})
 }
 
@@ -226,25 +258,30 @@ func TestValidateGitFlags(t *testing.T) {
 	manager := NewQualityManager()
 
 	tests := []struct {
-		name     string
-		since    string
-		staged   bool
-		changed  bool
-		hasError bool
+		name          string
+		since         string
+		staged        bool
+		changed       bool
+		sinceUpstream bool
+		sinceBranch   string
+		hasError      bool
 	}{
-		{"no flags", "", false, false, false},
-		{"only since", "HEAD~1", false, false, false},
-		{"only staged", "", true, false, false},
-		{"only changed", "", false, true, false},
-		{"since and staged", "HEAD~1", true, false, true},
-		{"staged and changed", "", true, true, true},
-		{"since and changed", "HEAD~1", false, true, true},
-		{"all flags", "HEAD~1", true, true, true},
+		{"no flags", "", false, false, false, "", false},
+		{"only since", "HEAD~1", false, false, false, "", false},
+		{"only staged", "", true, false, false, "", false},
+		{"only changed", "", false, true, false, "", false},
+		{"only since-upstream", "", false, false, true, "", false},
+		{"only since-branch", "", false, false, false, "main", false},
+		{"since and staged", "HEAD~1", true, false, false, "", true},
+		{"staged and changed", "", true, true, false, "", true},
+		{"since and changed", "HEAD~1", false, true, false, "", true},
+		{"since-upstream and since-branch", "", false, false, true, "main", true},
+		{"all flags", "HEAD~1", true, true, true, "main", true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := manager.validateGitFlags(tt.since, tt.staged, tt.changed)
+			err := manager.validateGitFlags(tt.since, tt.staged, tt.changed, tt.sinceUpstream, tt.sinceBranch)
 			if tt.hasError {
 				assert.Error(t, err)
 			} else {
@@ -254,6 +291,33 @@ func TestValidateGitFlags(t *testing.T) {
 	}
 }
 
+func TestParseExecutionOptions_FormatAliasesReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		report string
+		format string
+		want   string
+	}{
+		{"report only", "sarif", "", "sarif"},
+		{"format only", "", "sarif", "sarif"},
+		{"both set prefers report", "json", "sarif", "json"},
+		{"neither set", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "test"}
+			addCommonExecutionFlags(cmd)
+			require.NoError(t, cmd.Flags().Set("report", tt.report))
+			require.NoError(t, cmd.Flags().Set("format", tt.format))
+
+			opts, err := parseExecutionOptions(cmd)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, opts.reportFormat)
+		})
+	}
+}
+
 func TestConfigGeneration(t *testing.T) {
 	manager := NewQualityManager()
 
@@ -282,6 +346,14 @@ func TestConfigGeneration(t *testing.T) {
 		assert.True(t, pythonConfig.Enabled)
 		assert.NotNil(t, pythonConfig.Tools)
 	}
+
+	// Julia wasn't detected in this analysis, so its block should be
+	// present but disabled.
+	juliaConfig, exists := config.Languages["Julia"]
+	require.True(t, exists)
+	assert.False(t, juliaConfig.Enabled)
+	assert.Contains(t, juliaConfig.Tools, "juliaformatter")
+	assert.Contains(t, juliaConfig.Tools, "staticlint")
 }
 
 func TestConfigToYAML(t *testing.T) {
@@ -348,8 +420,8 @@ func (m *mockTool) Name() string                { return m.name }
 func (m *mockTool) Language() string            { return m.language }
 func (m *mockTool) Type() tools.ToolType        { return m.toolType }
 func (m *mockTool) IsAvailable() bool           { return true }
-func (m *mockTool) Install() error              { return nil }
-func (m *mockTool) Upgrade() error              { return nil }
+func (m *mockTool) Install(ctx context.Context) error { return nil }
+func (m *mockTool) Upgrade(ctx context.Context) error { return nil }
 func (m *mockTool) GetVersion() (string, error) { return "1.0.0", nil }
 func (m *mockTool) Execute(ctx context.Context, files []string, options tools.ExecuteOptions) (*tools.Result, error) {
 	return &tools.Result{
@@ -366,6 +438,10 @@ func (m *mockTool) FindConfigFiles(projectRoot string) []string {
 	return []string{}
 }
 
+func (m *mockTool) SupportedExtensions() []string {
+	return []string{}
+}
+
 func TestRunQuality_DryRun(t *testing.T) {
 	manager := NewQualityManager()
 	cmd := manager.newRunCmd()
@@ -491,3 +567,80 @@ func TestRunInit_Execution(t *testing.T) {
 		assert.NotNil(t, err)
 	}
 }
+
+func TestRestageFixedFiles_AddsTaskFilesBackToIndex(t *testing.T) {
+	repoDir := t.TempDir()
+
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		require.NoError(t, cmd.Run())
+	}
+
+	runGit("init")
+	runGit("config", "user.email", "test@example.com")
+	runGit("config", "user.name", "Test")
+
+	filePath := filepath.Join(repoDir, "main.go")
+	require.NoError(t, os.WriteFile(filePath, []byte("package main\n"), 0o644))
+	runGit("add", "main.go")
+	runGit("commit", "-m", "initial")
+
+	// Simulate a formatter rewriting the staged file after it was staged
+	// for commit, the way `--staged --fix` would.
+	require.NoError(t, os.WriteFile(filePath, []byte("package main\n\nfunc main() {}\n"), 0o644))
+
+	manager := NewQualityManager()
+	plan := &tools.ExecutionPlan{
+		Tasks:      []tools.Task{{Name: "gofmt", Files: []string{filePath}}},
+		TotalFiles: 1,
+	}
+
+	require.NoError(t, manager.restageFixedFiles(repoDir, plan))
+
+	staged, err := gitutils.NewGitUtils(repoDir).GetStagedFiles()
+	require.NoError(t, err)
+	assert.Contains(t, staged, "main.go")
+}
+
+func TestQualityManagerBaselinePruneCmd(t *testing.T) {
+	manager := NewQualityManager()
+	baselineCmd := manager.newBaselineCmd()
+
+	var pruneCmd *cobra.Command
+	for _, sub := range baselineCmd.Commands() {
+		if sub.Use == "prune" {
+			pruneCmd = sub
+		}
+	}
+	require.NotNil(t, pruneCmd, "baseline command should register a prune subcommand")
+
+	assert.NotEmpty(t, pruneCmd.Long)
+	assert.NotNil(t, pruneCmd.RunE)
+	assert.NotNil(t, pruneCmd.Flags().Lookup("baseline"))
+}
+
+func TestApplyBaselineFiltering_PruneRemovesStaleEntries(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+	stillPresent := baselineIssueEntry{Fingerprint: issueFingerprint("golangci-lint", "unused", "main.go", "", 0), Tool: "golangci-lint", Rule: "unused", File: "main.go"}
+	stale := baselineIssueEntry{Fingerprint: issueFingerprint("golangci-lint", "unused", "old.go", "", 0), Tool: "golangci-lint", Rule: "unused", File: "old.go"}
+	require.NoError(t, saveBaselineFile(baselinePath, []baselineIssueEntry{stillPresent, stale}))
+
+	manager := NewQualityManager()
+	opts := &executionOptions{baselinePath: baselinePath, pruneBaseline: true}
+	results := []*tools.Result{{
+		Tool: "golangci-lint",
+		Issues: []tools.Issue{
+			{File: "main.go", Rule: "unused"},
+		},
+	}}
+
+	_, err := manager.applyBaselineFiltering(results, opts, "")
+	require.NoError(t, err)
+
+	rewritten, err := loadBaselineFile(baselinePath)
+	require.NoError(t, err)
+	require.Len(t, rewritten.Issues, 1)
+	assert.Equal(t, stillPresent.Fingerprint, rewritten.Issues[0].Fingerprint)
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// toolOpStatus is the live status of a single tool operation, used to render
+// progress both in the TTY spinner and the CI-friendly line output.
+type toolOpStatus struct {
+	name  string
+	state string // "대기", "진행 중", "완료", "실패: <err>", "취소됨"
+
+	// detail is the most recent line a ProgressCapable tool's install
+	// streamed (e.g. "downloading v1.2.3…"), shown alongside state
+	// ("진행 중") in the TTY spinner. Empty for tools that don't stream
+	// progress, or once state reaches a terminal value. Never surfaced in
+	// CI mode, to keep that output one line per tool rather than one
+	// line per subprocess output line.
+	detail string
+}
+
+// toolOpAction performs one tool's install/upgrade. onLine is called with
+// each line of the underlying subprocess's output as it runs, for tools
+// implementing tools.ProgressCapable; it is never nil, and a non-streaming
+// action is free to ignore it.
+type toolOpAction func(ctx context.Context, tool tools.QualityTool, onLine func(string)) error
+
+// runToolsConcurrently runs action against the tools named in args (or every
+// registered tool if args is empty) using a worker pool bounded by workers
+// (defaulting to runtime.NumCPU()). ctx cancellation (e.g. Ctrl+C) stops
+// in-flight installs/upgrades cleanly. Errors from individual tools are
+// aggregated so one failure doesn't abort the others; a combined error is
+// returned at the end if any tool failed.
+func (m *QualityManager) runToolsConcurrently(ctx context.Context, workers int, args []string, action toolOpAction, verb string) error {
+	toolList := m.resolveToolList(args)
+	if len(toolList) == 0 {
+		fmt.Println("❌ 대상 도구를 찾을 수 없습니다.")
+		return nil
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(toolList) {
+		workers = len(toolList)
+	}
+
+	statuses := make([]*toolOpStatus, len(toolList))
+	for i, tool := range toolList {
+		statuses[i] = &toolOpStatus{name: tool.Name(), state: "대기"}
+	}
+
+	progress := newToolOpProgress(statuses, verb)
+	progress.Start()
+	defer progress.Stop()
+
+	taskChan := make(chan int, len(toolList))
+	for i := range toolList {
+		taskChan <- i
+	}
+	close(taskChan)
+
+	var mu sync.Mutex
+	var errs []error
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range taskChan {
+				select {
+				case <-ctx.Done():
+					mu.Lock()
+					statuses[idx].state = "취소됨"
+					mu.Unlock()
+					progress.Update(idx)
+					continue
+				default:
+				}
+
+				statuses[idx].state = "진행 중"
+				progress.Update(idx)
+
+				onLine := func(line string) {
+					mu.Lock()
+					statuses[idx].detail = line
+					mu.Unlock()
+					progress.Update(idx)
+				}
+
+				err := action(ctx, toolList[idx], onLine)
+
+				mu.Lock()
+				statuses[idx].detail = ""
+				if err != nil {
+					statuses[idx].state = fmt.Sprintf("실패: %v", err)
+					errs = append(errs, fmt.Errorf("%s: %w", toolList[idx].Name(), err))
+				} else {
+					statuses[idx].state = "완료"
+				}
+				mu.Unlock()
+				progress.Update(idx)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return combineErrors(errs)
+	}
+	return nil
+}
+
+// resolveToolList returns the tools named in args, or every registered tool
+// if args is empty. Unknown names are reported and skipped.
+func (m *QualityManager) resolveToolList(args []string) []tools.QualityTool {
+	if len(args) == 0 {
+		return m.registry.GetTools()
+	}
+
+	var result []tools.QualityTool
+	for _, name := range args {
+		tool := m.registry.FindTool(name)
+		if tool == nil {
+			fmt.Printf("❌ 도구를 찾을 수 없습니다: %s\n", name)
+			continue
+		}
+		result = append(result, tool)
+	}
+	return result
+}
+
+// combineErrors aggregates multiple tool errors into a single error value.
+func combineErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	msg := fmt.Sprintf("%d개 도구 작업 실패:", len(errs))
+	for _, err := range errs {
+		msg += "\n  - " + err.Error()
+	}
+	return fmt.Errorf("%s", msg)
+}
+
+// installToolCtx installs a tool, skipping ones already available, and
+// streams the install's output through onLine when the tool implements
+// tools.ProgressCapable.
+func (m *QualityManager) installToolCtx(ctx context.Context, tool tools.QualityTool, onLine func(string)) error {
+	if tool.IsAvailable() {
+		return nil
+	}
+	return installWithProgressIfCapable(ctx, tool, onLine)
+}
+
+// upgradeToolCtx upgrades a tool, installing it first if missing. Either
+// path streams output through onLine when the tool implements
+// tools.ProgressCapable.
+func (m *QualityManager) upgradeToolCtx(ctx context.Context, tool tools.QualityTool, onLine func(string)) error {
+	if !tool.IsAvailable() {
+		return installWithProgressIfCapable(ctx, tool, onLine)
+	}
+	return tool.Upgrade(ctx)
+}
+
+// installWithProgressIfCapable installs tool via tools.ProgressCapable's
+// streaming InstallWithProgress when tool implements it, falling back to
+// plain Install otherwise.
+func installWithProgressIfCapable(ctx context.Context, tool tools.QualityTool, onLine func(string)) error {
+	if progressTool, ok := tool.(tools.ProgressCapable); ok {
+		return progressTool.InstallWithProgress(ctx, onLine)
+	}
+	return tool.Install(ctx)
+}
+
+// toolOpProgress renders live progress for a concurrent tool operation: a
+// multi-line spinner when stderr is a TTY, or line-buffered "[i/n]"
+// output otherwise (e.g. in CI).
+type toolOpProgress struct {
+	statuses []*toolOpStatus
+	verb     string
+	isTTY    bool
+
+	mu      sync.Mutex
+	printed map[int]bool
+}
+
+func newToolOpProgress(statuses []*toolOpStatus, verb string) *toolOpProgress {
+	return &toolOpProgress{
+		statuses: statuses,
+		verb:     verb,
+		isTTY:    isTerminal(os.Stderr),
+		printed:  make(map[int]bool),
+	}
+}
+
+func (p *toolOpProgress) Start() {
+	if p.isTTY {
+		for _, s := range p.statuses {
+			fmt.Fprintf(os.Stderr, "⏳ %s: %s\n", s.name, s.render())
+		}
+	}
+}
+
+// render formats a status line for the TTY spinner: state alone, or
+// "진행 중 (downloading v1.2.3…)" once a ProgressCapable tool's install has
+// streamed at least one line.
+func (s *toolOpStatus) render() string {
+	if s.detail == "" {
+		return s.state
+	}
+	return fmt.Sprintf("%s (%s)", s.state, s.detail)
+}
+
+// Update redraws status for a single tool. In TTY mode it repaints the whole
+// block in place; in CI mode it only prints a line the first time a tool
+// reaches a terminal state, to keep logs line-buffered and append-only.
+func (p *toolOpProgress) Update(idx int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.statuses[idx]
+
+	if p.isTTY {
+		lines := len(p.statuses)
+		fmt.Fprintf(os.Stderr, "\033[%dA", lines)
+		for _, st := range p.statuses {
+			fmt.Fprintf(os.Stderr, "\033[2K⏳ %s: %s\n", st.name, st.render())
+		}
+		return
+	}
+
+	if s.state == "진행 중" || s.state == "대기" {
+		return
+	}
+	if p.printed[idx] {
+		return
+	}
+	p.printed[idx] = true
+	fmt.Fprintf(os.Stderr, "[%d/%d] %s %s: %s\n", idx+1, len(p.statuses), p.verb, s.name, s.state)
+}
+
+func (p *toolOpProgress) Stop() {}
+
+// isTerminal reports whether f is connected to a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return (info.Mode() & os.ModeCharDevice) != 0
+}
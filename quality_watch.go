@@ -0,0 +1,360 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package quality
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+
+	"github.com/Gizzahub/gzh-cli-quality/config"
+	"github.com/Gizzahub/gzh-cli-quality/executor"
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// binaryPollInterval is how often runWatch re-stats each tool's resolved
+// executable, looking for a mtime change (a rebuild or `go install` of a
+// newer version) that fsnotify can't report since the binary usually
+// lives outside the watched project tree.
+const binaryPollInterval = 2 * time.Second
+
+// defaultWatchDebounce is how long newWatchCmd waits after the last file
+// event before running, so a burst of events (e.g. `git checkout`) coalesces
+// into a single run instead of one per file.
+const defaultWatchDebounce = 300 * time.Millisecond
+
+// watchIgnoredDirs are directory names that are never watched, regardless
+// of .gzquality.yml excludes.
+var watchIgnoredDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+}
+
+// newWatchCmd creates the watch subcommand, which runs quality tools
+// incrementally as files change until interrupted.
+func (m *QualityManager) newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "파일 변경 감시 및 증분 실행 (Ctrl+C로 종료)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectRoot, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+
+			debounceMs, _ := cmd.Flags().GetInt("debounce")
+			workers, _ := cmd.Flags().GetInt("workers")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+
+			debounce := time.Duration(debounceMs) * time.Millisecond
+			if debounce <= 0 {
+				debounce = defaultWatchDebounce
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return m.runWatch(ctx, projectRoot, debounce, workers, verbose)
+		},
+	}
+
+	cmd.Flags().Int("debounce", int(defaultWatchDebounce.Milliseconds()), "변경 이벤트를 묶어 처리할 지연 시간 (ms)")
+	cmd.Flags().IntP("workers", "w", runtime.NumCPU(), "병렬 실행 워커 수")
+	cmd.Flags().BoolP("verbose", "v", false, "상세 출력")
+
+	return cmd
+}
+
+// runWatch watches projectRoot for file changes and runs the affected
+// tools on the changed subset each time the debounce window elapses. It
+// blocks until ctx is canceled (SIGINT/SIGTERM).
+func (m *QualityManager) runWatch(ctx context.Context, projectRoot string, debounce time.Duration, workers int, verbose bool) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start file watcher: %w", err)
+	}
+	defer func() {
+		_ = watcher.Close()
+	}()
+
+	if err := addWatchesRecursively(watcher, projectRoot, m.config); err != nil {
+		return fmt.Errorf("failed to watch project: %w", err)
+	}
+
+	fmt.Printf("👀 %s 변경 사항을 감시합니다... (Ctrl+C로 종료)\n", projectRoot)
+
+	configOwners := buildConfigOwners(m.registry, projectRoot)
+	binaries := collectToolBinaries(m.registry)
+
+	var mu sync.Mutex
+	pending := make(map[string]bool)
+	pendingTools := make(map[string]bool)
+	var timer *time.Timer
+
+	runPending := func() {
+		mu.Lock()
+		files := make([]string, 0, len(pending))
+		for f := range pending {
+			files = append(files, f)
+		}
+		forcedTools := make([]string, 0, len(pendingTools))
+		for t := range pendingTools {
+			forcedTools = append(forcedTools, t)
+		}
+		pending = make(map[string]bool)
+		pendingTools = make(map[string]bool)
+		mu.Unlock()
+
+		if len(forcedTools) > 0 {
+			m.runWatchCycle(ctx, projectRoot, nil, forcedTools, workers, verbose)
+		}
+		if len(files) > 0 {
+			m.runWatchCycle(ctx, projectRoot, files, nil, workers, verbose)
+		}
+	}
+
+	schedule := func() {
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(debounce, runPending)
+	}
+
+	binaryTicker := time.NewTicker(binaryPollInterval)
+	defer binaryTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			fmt.Println("\n👋 감시를 종료합니다.")
+			return nil
+
+		case <-binaryTicker.C:
+			for toolName, changed := range pollToolBinaries(binaries) {
+				if !changed {
+					continue
+				}
+				fmt.Printf("🔧 %s 바이너리 변경 감지, 캐시를 무효화합니다\n", toolName)
+				if cm := m.cacheManager; cm != nil {
+					if tool := m.registry.FindTool(toolName); tool != nil {
+						if version, err := tool.GetVersion(); err == nil {
+							_ = cm.InvalidateByTool(toolName, version)
+						}
+					}
+				}
+
+				mu.Lock()
+				pendingTools[toolName] = true
+				mu.Unlock()
+				schedule()
+			}
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if shouldIgnoreWatchPath(projectRoot, event.Name, m.config) {
+				continue
+			}
+
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					_ = addWatchesRecursively(watcher, event.Name, m.config)
+					continue
+				}
+			}
+
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+
+			if owners, ok := configOwners[event.Name]; ok {
+				fmt.Printf("⚙️  설정 파일 변경 감지: %s\n", event.Name)
+				if cm := m.cacheManager; cm != nil {
+					_ = cm.InvalidateByConfigFile(event.Name)
+				}
+
+				mu.Lock()
+				for _, toolName := range owners {
+					pendingTools[toolName] = true
+				}
+				mu.Unlock()
+				schedule()
+				continue
+			}
+
+			mu.Lock()
+			pending[event.Name] = true
+			mu.Unlock()
+			schedule()
+
+		case watchErr, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Printf("⚠️ 감시 오류: %v\n", watchErr)
+		}
+	}
+}
+
+// runWatchCycle runs one incremental cycle through the shared executor
+// (reusing its cache) and prints a summary. Exactly one of files or
+// forcedTools should be set: files re-runs every tool applicable to that
+// changed subset, the normal per-save path; forcedTools instead re-scans
+// the whole project but restricted to those tool names, for a config or
+// tool-binary change that can affect files that didn't themselves change.
+func (m *QualityManager) runWatchCycle(ctx context.Context, projectRoot string, files, forcedTools []string, workers int, verbose bool) {
+	planOptions := executor.PlanOptions{Files: files, ToolFilter: forcedTools}
+
+	plan, err := m.planner.CreatePlan(ctx, projectRoot, m.registry, planOptions)
+	if err != nil {
+		fmt.Printf("❌ 실행 계획 생성 실패: %v\n", err)
+		return
+	}
+
+	if len(plan.Tasks) == 0 {
+		return
+	}
+
+	applyCacheDisabled(plan, m.config)
+
+	if len(forcedTools) > 0 {
+		fmt.Printf("🔄 %s 설정 변경으로 전체 재검사, %d개 작업 실행 중...\n", strings.Join(forcedTools, ", "), len(plan.Tasks))
+	} else {
+		fmt.Printf("🔄 %d개 파일 변경 감지, %d개 작업 실행 중...\n", len(files), len(plan.Tasks))
+	}
+
+	startTime := time.Now()
+	results, err := m.executor.ExecuteParallel(ctx, plan, workers)
+	duration := time.Since(startTime)
+	if err != nil {
+		fmt.Printf("❌ 실행 중 오류 발생: %v\n", err)
+		return
+	}
+
+	m.displayResults(results, duration, verbose, 0)
+}
+
+// buildConfigOwners maps each config file path any registered tool reads
+// (per FindConfigFiles) back to the tool name(s) that read it, so a save
+// to e.g. pyproject.toml can be resolved directly to "ruff" rather than
+// requiring a project-wide rehash to figure out what it affects.
+func buildConfigOwners(registry tools.ToolRegistry, projectRoot string) map[string][]string {
+	owners := make(map[string][]string)
+	for _, tool := range registry.GetTools() {
+		for _, configFile := range tool.FindConfigFiles(projectRoot) {
+			owners[configFile] = append(owners[configFile], tool.Name())
+		}
+	}
+	return owners
+}
+
+// watchedBinary is a tool's resolved executable path and the mtime last
+// observed for it.
+type watchedBinary struct {
+	path    string
+	modTime time.Time
+}
+
+// collectToolBinaries resolves every BinaryPathCapable tool's executable
+// and records its current mtime, the baseline pollToolBinaries compares
+// future polls against.
+func collectToolBinaries(registry tools.ToolRegistry) map[string]*watchedBinary {
+	binaries := make(map[string]*watchedBinary)
+	for _, tool := range registry.GetTools() {
+		capable, ok := tool.(tools.BinaryPathCapable)
+		if !ok {
+			continue
+		}
+		path, ok := capable.BinaryPath()
+		if !ok {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		binaries[tool.Name()] = &watchedBinary{path: path, modTime: info.ModTime()}
+	}
+	return binaries
+}
+
+// pollToolBinaries re-stats every entry in binaries and reports, per
+// tool name, whether its mtime advanced since the last poll - a rebuild
+// or `go install` of a newer version that fsnotify can't see because the
+// binary typically lives outside the watched project tree.
+func pollToolBinaries(binaries map[string]*watchedBinary) map[string]bool {
+	changed := make(map[string]bool)
+	for toolName, binary := range binaries {
+		info, err := os.Stat(binary.path)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().After(binary.modTime) {
+			binary.modTime = info.ModTime()
+			changed[toolName] = true
+		}
+	}
+	return changed
+}
+
+// addWatchesRecursively adds fsnotify watches for root and every
+// subdirectory, skipping hidden directories, watchIgnoredDirs, and
+// anything matched by cfg's exclude patterns.
+func addWatchesRecursively(watcher *fsnotify.Watcher, root string, cfg *config.Config) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return filepath.SkipDir
+		}
+
+		if !info.IsDir() {
+			return nil
+		}
+
+		name := info.Name()
+		if path != root && (watchIgnoredDirs[name] || strings.HasPrefix(name, ".")) {
+			return filepath.SkipDir
+		}
+
+		rel, relErr := filepath.Rel(root, path)
+		if relErr == nil && cfg.ShouldExclude(rel) {
+			return filepath.SkipDir
+		}
+
+		return watcher.Add(path)
+	})
+}
+
+// shouldIgnoreWatchPath reports whether a watch event for path should be
+// dropped before entering the debounce window.
+func shouldIgnoreWatchPath(projectRoot, path string, cfg *config.Config) bool {
+	rel, err := filepath.Rel(projectRoot, path)
+	if err != nil {
+		rel = path
+	}
+
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if watchIgnoredDirs[part] || strings.HasPrefix(part, ".") {
+			return true
+		}
+	}
+
+	return cfg.ShouldExclude(rel)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package redact scrubs secrets out of tool output before it's printed,
+// cached, or written to a report. It's deliberately a single global store
+// (mirroring anchore's redaction design) rather than something threaded
+// through every call site: tool stdout flows through a lot of code
+// (ExecuteParallel, the cache, --debug argv logging, JSON/SARIF reports)
+// and a single registry that every one of those call sites consults is
+// far less error-prone than passing a *Store down each of those paths.
+package redact
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const mask = "***REDACTED***"
+
+// Store holds the regexp patterns and literal values to redact.
+type Store struct {
+	mu       sync.RWMutex
+	patterns []*regexp.Regexp
+	values   []string
+}
+
+// NewStore creates a Store seeded with DefaultPatterns.
+func NewStore() *Store {
+	s := &Store{}
+	for _, pattern := range DefaultPatterns() {
+		_ = s.AddPattern(pattern)
+	}
+	return s
+}
+
+// DefaultPatterns returns regexps for common token formats, so a fresh
+// Store redacts well-known secret shapes even before any config is
+// loaded.
+func DefaultPatterns() []string {
+	return []string{
+		`gh[pousr]_[A-Za-z0-9]{36}`,       // GitHub personal/OAuth/user/server tokens
+		`glpat-[A-Za-z0-9_-]{20}`,         // GitLab personal access tokens
+		`AKIA[0-9A-Z]{16}`,                // AWS access key ID
+		`xox[baprs]-[A-Za-z0-9-]{10,}`,    // Slack tokens
+		`(?i)bearer\s+[A-Za-z0-9._-]{8,}`, // generic Bearer <token> headers
+	}
+}
+
+// AddPattern compiles pattern and adds it to the store. Returns a
+// descriptive error if pattern isn't a valid regexp, so a typo in
+// .gzquality.yml's redact.patterns fails at load time rather than
+// silently matching nothing.
+func (s *Store) AddPattern(pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.patterns = append(s.patterns, re)
+	return nil
+}
+
+// Add registers a literal value to redact verbatim, e.g. a token read
+// from an env var or passed via a tool's --token flag. Empty values are
+// ignored so an unset env var doesn't turn into a pattern matching
+// every empty string.
+func (s *Store) Add(value string) {
+	if value == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values = append(s.values, value)
+}
+
+// Redact returns text with every registered pattern match and literal
+// value replaced by a fixed mask.
+func (s *Store) Redact(text string) string {
+	if text == "" {
+		return text
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, re := range s.patterns {
+		text = re.ReplaceAllString(text, mask)
+	}
+
+	for _, value := range s.values {
+		text = strings.ReplaceAll(text, value, mask)
+	}
+
+	return text
+}
+
+// global is the process-wide Store most call sites use. Commands that
+// need isolation (tests) can construct their own Store instead.
+var global = NewStore()
+
+// Add registers a literal secret value on the global Store.
+func Add(value string) {
+	global.Add(value)
+}
+
+// AddPattern compiles and registers a regexp pattern on the global Store.
+func AddPattern(pattern string) error {
+	return global.AddPattern(pattern)
+}
+
+// Redact scrubs text using the global Store.
+func Redact(text string) string {
+	return global.Redact(text)
+}
+
+// LoadFromConfig registers config-supplied patterns directly and values
+// indirectly - each entry in envVarNames is an environment variable name
+// whose *current value* is the secret to redact, never a literal secret
+// written into .gzquality.yml itself.
+func LoadFromConfig(patterns, envVarNames []string) error {
+	for _, pattern := range patterns {
+		if err := AddPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range envVarNames {
+		Add(os.Getenv(name))
+	}
+
+	return nil
+}
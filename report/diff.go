@@ -0,0 +1,334 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReportDiff is the result of comparing two quality runs (typically PR
+// head vs. a merge-base report saved earlier in CI). It splits issues into
+// new (introduced by head), fixed (present in base but gone in head), and
+// unchanged (present in both), so a PR gate can fail on New alone instead
+// of every issue the baseline already had.
+type ReportDiff struct {
+	BaseTimestamp time.Time `json:"base_timestamp"`
+	HeadTimestamp time.Time `json:"head_timestamp"`
+
+	New       []Issue `json:"new"`
+	Fixed     []Issue `json:"fixed"`
+	Unchanged []Issue `json:"unchanged"`
+
+	Summary DiffSummary `json:"summary"`
+}
+
+// DiffSummary contains aggregate counts for a ReportDiff.
+type DiffSummary struct {
+	NewCount           int `json:"new_count"`
+	FixedCount         int `json:"fixed_count"`
+	UnchangedCount     int `json:"unchanged_count"`
+	FilesWithNewIssues int `json:"files_with_new_issues"`
+}
+
+// diffLineWindow is how far an issue's line may drift between base and
+// head and still count as the same issue. Unrelated edits elsewhere in the
+// file routinely shift line numbers by a few lines without touching the
+// issue itself; without this tolerance, every such shift would be
+// misreported as one fixed issue plus one new issue.
+const diffLineWindow = 3
+
+// diffIssueKey groups issues that plausibly refer to the same finding:
+// same tool, same file, same rule, and the same message once whitespace
+// is normalized away. Tool is part of the key so two different tools
+// that happen to emit an identically-worded message for an
+// identically-named rule (e.g. a shared linter name reused across
+// languages) aren't matched against each other.
+type diffIssueKey struct {
+	tool    string
+	file    string
+	rule    string
+	message string
+}
+
+func diffKeyFor(issue Issue) diffIssueKey {
+	return diffIssueKey{
+		tool:    issue.Tool,
+		file:    issue.File,
+		rule:    issue.Rule,
+		message: normalizeDiffMessage(issue.Message),
+	}
+}
+
+// normalizeDiffMessage collapses whitespace and lower-cases a message so
+// incidental formatting differences don't defeat fingerprint matching.
+func normalizeDiffMessage(message string) string {
+	return strings.ToLower(strings.Join(strings.Fields(message), " "))
+}
+
+// DiffReports matches every issue in head against the closest still-unmatched
+// issue in base sharing the same diffIssueKey and within diffLineWindow
+// lines, classifying head issues with no such match as new and base issues
+// left unmatched as fixed. base or head may be nil, treated as an empty report.
+func DiffReports(base, head *Report) *ReportDiff {
+	diff := &ReportDiff{}
+
+	if base != nil {
+		diff.BaseTimestamp = base.Timestamp
+	}
+	if head != nil {
+		diff.HeadTimestamp = head.Timestamp
+	}
+
+	baseByKey := make(map[diffIssueKey][]Issue)
+	if base != nil {
+		for _, issues := range base.IssuesByFile {
+			for _, issue := range issues {
+				key := diffKeyFor(issue)
+				baseByKey[key] = append(baseByKey[key], issue)
+			}
+		}
+	}
+
+	matchedBase := make(map[diffIssueKey][]bool, len(baseByKey))
+	for key, issues := range baseByKey {
+		matchedBase[key] = make([]bool, len(issues))
+	}
+
+	filesWithNew := make(map[string]bool)
+
+	if head != nil {
+		for _, issues := range head.IssuesByFile {
+			for _, issue := range issues {
+				key := diffKeyFor(issue)
+				candidates := baseByKey[key]
+				matched := matchedBase[key]
+
+				bestIdx := -1
+				bestDist := diffLineWindow + 1
+				for i, candidate := range candidates {
+					if matched[i] {
+						continue
+					}
+					dist := candidate.Line - issue.Line
+					if dist < 0 {
+						dist = -dist
+					}
+					if dist <= diffLineWindow && dist < bestDist {
+						bestIdx = i
+						bestDist = dist
+					}
+				}
+
+				if bestIdx >= 0 {
+					matched[bestIdx] = true
+					diff.Unchanged = append(diff.Unchanged, issue)
+				} else {
+					diff.New = append(diff.New, issue)
+					filesWithNew[issue.File] = true
+				}
+			}
+		}
+	}
+
+	for key, issues := range baseByKey {
+		matched := matchedBase[key]
+		for i, issue := range issues {
+			if !matched[i] {
+				diff.Fixed = append(diff.Fixed, issue)
+			}
+		}
+	}
+
+	sortDiffIssues(diff.New)
+	sortDiffIssues(diff.Fixed)
+	sortDiffIssues(diff.Unchanged)
+
+	diff.Summary = DiffSummary{
+		NewCount:           len(diff.New),
+		FixedCount:         len(diff.Fixed),
+		UnchangedCount:     len(diff.Unchanged),
+		FilesWithNewIssues: len(filesWithNew),
+	}
+
+	return diff
+}
+
+func sortDiffIssues(issues []Issue) {
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		return issues[i].Line < issues[j].Line
+	})
+}
+
+// groupIssuesByFile buckets issues by File and returns the files sorted by
+// name, for section-by-section diff rendering.
+func groupIssuesByFile(issues []Issue) (byFile map[string][]Issue, files []string) {
+	byFile = make(map[string][]Issue)
+	for _, issue := range issues {
+		if _, ok := byFile[issue.File]; !ok {
+			files = append(files, issue.File)
+		}
+		byFile[issue.File] = append(byFile[issue.File], issue)
+	}
+	sort.Strings(files)
+	return byFile, files
+}
+
+// SaveDiffJSON saves a ReportDiff as JSON.
+func (g *ReportGenerator) SaveDiffJSON(diff *ReportDiff, outputPath string) error {
+	data, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report diff: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write report diff file: %w", err)
+	}
+
+	return nil
+}
+
+// SaveDiffMarkdown saves a ReportDiff as Markdown, grouped by file within
+// each of the new/fixed/unchanged sections.
+func (g *ReportGenerator) SaveDiffMarkdown(diff *ReportDiff, outputPath string) error {
+	md := g.generateDiffMarkdown(diff)
+
+	if err := os.WriteFile(outputPath, []byte(md), 0o644); err != nil {
+		return fmt.Errorf("failed to write report diff markdown: %w", err)
+	}
+
+	return nil
+}
+
+// generateDiffMarkdown creates a Markdown diff report.
+func (g *ReportGenerator) generateDiffMarkdown(diff *ReportDiff) string {
+	var sb strings.Builder
+
+	sb.WriteString("# 🔀 Quality Report Diff\n\n")
+	sb.WriteString("## 📊 Summary\n\n")
+	sb.WriteString(fmt.Sprintf("- **신규 이슈**: %d\n", diff.Summary.NewCount))
+	sb.WriteString(fmt.Sprintf("- **해결된 이슈**: %d\n", diff.Summary.FixedCount))
+	sb.WriteString(fmt.Sprintf("- **기존 이슈 (변화 없음)**: %d\n", diff.Summary.UnchangedCount))
+	sb.WriteString(fmt.Sprintf("- **신규 이슈가 있는 파일**: %d\n\n", diff.Summary.FilesWithNewIssues))
+
+	writeDiffMarkdownSection(&sb, "🆕 New Issues", diff.New)
+	writeDiffMarkdownSection(&sb, "✅ Fixed Issues", diff.Fixed)
+	writeDiffMarkdownSection(&sb, "📌 Still Present", diff.Unchanged)
+
+	return sb.String()
+}
+
+func writeDiffMarkdownSection(sb *strings.Builder, title string, issues []Issue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	sb.WriteString(fmt.Sprintf("## %s (%d)\n\n", title, len(issues)))
+
+	byFile, files := groupIssuesByFile(issues)
+	for _, file := range files {
+		sb.WriteString(fmt.Sprintf("### 📄 %s\n\n", file))
+		sb.WriteString("| Line | Column | Severity | Rule | Message | Tool |\n")
+		sb.WriteString("|------|--------|----------|------|---------|------|\n")
+
+		for _, issue := range byFile[file] {
+			sb.WriteString(fmt.Sprintf("| %d | %d | %s | `%s` | %s | %s |\n",
+				issue.Line, issue.Column, issue.Severity, issue.Rule, issue.Message, issue.Tool))
+		}
+
+		sb.WriteString("\n")
+	}
+}
+
+// SaveDiffHTML saves a ReportDiff as HTML.
+func (g *ReportGenerator) SaveDiffHTML(diff *ReportDiff, outputPath string) error {
+	html := g.generateDiffHTML(diff)
+
+	if err := os.WriteFile(outputPath, []byte(html), 0o644); err != nil {
+		return fmt.Errorf("failed to write report diff HTML: %w", err)
+	}
+
+	return nil
+}
+
+// generateDiffHTML creates an HTML diff report.
+func (g *ReportGenerator) generateDiffHTML(diff *ReportDiff) string {
+	var sb strings.Builder
+
+	sb.WriteString(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Quality Report Diff</title>
+    <style>
+        body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; margin: 0; padding: 20px; background: #f5f5f5; }
+        .container { max-width: 1200px; margin: 0 auto; background: white; border-radius: 8px; padding: 30px; box-shadow: 0 2px 10px rgba(0,0,0,0.1); }
+        .header h1 { margin: 0; color: #333; font-size: 2em; }
+        .summary { display: grid; grid-template-columns: repeat(auto-fit, minmax(200px, 1fr)); gap: 20px; margin: 20px 0 30px; }
+        .stat-card { background: #f8f9fa; padding: 20px; border-radius: 6px; text-align: center; }
+        .stat-value { font-size: 2em; font-weight: bold; }
+        .stat-value.new { color: #dc3545; }
+        .stat-value.fixed { color: #28a745; }
+        .stat-value.unchanged { color: #6c757d; }
+        .stat-label { color: #666; margin-top: 5px; }
+        .section { margin-bottom: 30px; }
+        .section h2 { color: #333; border-bottom: 1px solid #e0e0e0; padding-bottom: 10px; }
+        .issues-table { width: 100%; border-collapse: collapse; margin-top: 15px; }
+        .issues-table th, .issues-table td { padding: 12px; text-align: left; border-bottom: 1px solid #e0e0e0; }
+        .issues-table th { background: #f8f9fa; font-weight: 600; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header"><h1>🔀 Quality Report Diff</h1></div>
+        <div class="summary">
+            <div class="stat-card"><div class="stat-value new">` + fmt.Sprintf("%d", diff.Summary.NewCount) + `</div><div class="stat-label">신규 이슈</div></div>
+            <div class="stat-card"><div class="stat-value fixed">` + fmt.Sprintf("%d", diff.Summary.FixedCount) + `</div><div class="stat-label">해결된 이슈</div></div>
+            <div class="stat-card"><div class="stat-value unchanged">` + fmt.Sprintf("%d", diff.Summary.UnchangedCount) + `</div><div class="stat-label">변화 없음</div></div>
+        </div>`)
+
+	writeDiffHTMLSection(&sb, "🆕 New Issues", diff.New)
+	writeDiffHTMLSection(&sb, "✅ Fixed Issues", diff.Fixed)
+	writeDiffHTMLSection(&sb, "📌 Still Present", diff.Unchanged)
+
+	sb.WriteString(`</div></body></html>`)
+
+	return sb.String()
+}
+
+func writeDiffHTMLSection(sb *strings.Builder, title string, issues []Issue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	sb.WriteString(`<div class="section"><h2>` + title + ` (` + fmt.Sprintf("%d", len(issues)) + `)</h2>`)
+
+	byFile, files := groupIssuesByFile(issues)
+	for _, file := range files {
+		sb.WriteString(`<h3>📄 ` + file + `</h3><table class="issues-table"><thead><tr><th>라인</th><th>열</th><th>심각도</th><th>규칙</th><th>메시지</th><th>도구</th></tr></thead><tbody>`)
+
+		for _, issue := range byFile[file] {
+			sb.WriteString(`<tr>
+                <td>` + fmt.Sprintf("%d", issue.Line) + `</td>
+                <td>` + fmt.Sprintf("%d", issue.Column) + `</td>
+                <td>` + issue.Severity + `</td>
+                <td><code>` + issue.Rule + `</code></td>
+                <td>` + issue.Message + `</td>
+                <td>` + issue.Tool + `</td>
+            </tr>`)
+		}
+
+		sb.WriteString(`</tbody></table>`)
+	}
+
+	sb.WriteString(`</div>`)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffReports_NewIssue(t *testing.T) {
+	base := &Report{IssuesByFile: map[string][]Issue{}}
+	head := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 10, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+
+	diff := DiffReports(base, head)
+
+	require.Len(t, diff.New, 1)
+	assert.Empty(t, diff.Fixed)
+	assert.Empty(t, diff.Unchanged)
+	assert.Equal(t, 1, diff.Summary.NewCount)
+	assert.Equal(t, 1, diff.Summary.FilesWithNewIssues)
+}
+
+func TestDiffReports_FixedIssue(t *testing.T) {
+	base := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 10, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+	head := &Report{IssuesByFile: map[string][]Issue{}}
+
+	diff := DiffReports(base, head)
+
+	assert.Empty(t, diff.New)
+	require.Len(t, diff.Fixed, 1)
+	assert.Empty(t, diff.Unchanged)
+	assert.Equal(t, 1, diff.Summary.FixedCount)
+}
+
+func TestDiffReports_UnchangedIssue_ExactLine(t *testing.T) {
+	issue := Issue{File: "main.go", Line: 10, Rule: "unused-var", Message: "x is unused", Tool: "golint"}
+	base := &Report{IssuesByFile: map[string][]Issue{"main.go": {issue}}}
+	head := &Report{IssuesByFile: map[string][]Issue{"main.go": {issue}}}
+
+	diff := DiffReports(base, head)
+
+	assert.Empty(t, diff.New)
+	assert.Empty(t, diff.Fixed)
+	require.Len(t, diff.Unchanged, 1)
+}
+
+func TestDiffReports_UnchangedIssue_WithinFuzzyLineWindow(t *testing.T) {
+	base := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 10, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+	head := &Report{
+		IssuesByFile: map[string][]Issue{
+			// Two unrelated lines were inserted above this issue, shifting it down.
+			"main.go": {{File: "main.go", Line: 12, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+
+	diff := DiffReports(base, head)
+
+	assert.Empty(t, diff.New)
+	assert.Empty(t, diff.Fixed)
+	require.Len(t, diff.Unchanged, 1)
+}
+
+func TestDiffReports_LineShiftBeyondWindowCountsAsNewAndFixed(t *testing.T) {
+	base := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 10, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+	head := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 50, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+
+	diff := DiffReports(base, head)
+
+	assert.Len(t, diff.New, 1)
+	assert.Len(t, diff.Fixed, 1)
+	assert.Empty(t, diff.Unchanged)
+}
+
+func TestDiffReports_MessageNormalizationIgnoresWhitespace(t *testing.T) {
+	base := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 10, Rule: "unused-var", Message: "x  is   unused", Tool: "golint"}},
+		},
+	}
+	head := &Report{
+		IssuesByFile: map[string][]Issue{
+			"main.go": {{File: "main.go", Line: 10, Rule: "unused-var", Message: "x is unused", Tool: "golint"}},
+		},
+	}
+
+	diff := DiffReports(base, head)
+
+	assert.Empty(t, diff.New)
+	assert.Empty(t, diff.Fixed)
+	assert.Len(t, diff.Unchanged, 1)
+}
+
+func TestDiffReports_NilReports(t *testing.T) {
+	diff := DiffReports(nil, nil)
+
+	assert.Empty(t, diff.New)
+	assert.Empty(t, diff.Fixed)
+	assert.Empty(t, diff.Unchanged)
+}
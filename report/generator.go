@@ -6,24 +6,55 @@ package report
 import (
 	"encoding/json"
 	"fmt"
+	"html"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/Gizzahub/gzh-cli-quality/redact"
 	"github.com/Gizzahub/gzh-cli-quality/tools"
 )
 
 // Report represents a quality report.
 type Report struct {
-	Timestamp    time.Time          `json:"timestamp"`
-	ProjectRoot  string             `json:"project_root"`
-	TotalFiles   int                `json:"total_files"`
-	Duration     time.Duration      `json:"duration"`
-	Summary      Summary            `json:"summary"`
-	ToolResults  []ToolResult       `json:"tool_results"`
-	IssuesByFile map[string][]Issue `json:"issues_by_file"`
+	Timestamp    time.Time           `json:"timestamp"`
+	ProjectRoot  string              `json:"project_root"`
+	TotalFiles   int                 `json:"total_files"`
+	Duration     time.Duration       `json:"duration"`
+	Summary      Summary             `json:"summary"`
+	ToolResults  []ToolResult        `json:"tool_results"`
+	IssuesByFile map[string][]Issue  `json:"issues_by_file"`
+	RuleStats    map[string]RuleStat `json:"rule_stats,omitempty"`
+}
+
+// RuleStat aggregates every issue a single rule produced across the whole
+// report, keyed in Report.RuleStats by "tool:rule" since a rule ID alone
+// isn't unique across tools.
+type RuleStat struct {
+	// Tool is the tool that owns this rule.
+	Tool string `json:"tool"`
+
+	// Severity is the highest severity (error > warning > info) seen
+	// among this rule's issues.
+	Severity string `json:"severity"`
+
+	// Count is the total number of issues this rule produced.
+	Count int `json:"count"`
+
+	// FilesAffected is the number of distinct files this rule fired in.
+	FilesAffected int `json:"files_affected"`
+
+	// FirstSeen is when this rule's stats were last (re)computed -
+	// GenerateReport's run time, or MergeReports'/FilterReport's since
+	// both recompute RuleStats from scratch via calculateRuleStats.
+	FirstSeen time.Time `json:"first_seen"`
+
+	// HelpURI links to the rule's documentation, merged in from
+	// tools.RuleCatalog when the tool adapter registered one.
+	HelpURI string `json:"help_uri,omitempty"`
 }
 
 // Summary contains report summary information.
@@ -51,16 +82,23 @@ type ToolResult struct {
 
 // Issue represents a quality issue.
 type Issue struct {
-	File       string `json:"file"`
-	Line       int    `json:"line"`
-	Column     int    `json:"column"`
-	Severity   string `json:"severity"`
-	Rule       string `json:"rule"`
-	Message    string `json:"message"`
-	Tool       string `json:"tool"`
-	Suggestion string `json:"suggestion,omitempty"`
+	File       string   `json:"file"`
+	Line       int      `json:"line"`
+	Column     int      `json:"column"`
+	Severity   string   `json:"severity"`
+	Rule       string   `json:"rule"`
+	Message    string   `json:"message"`
+	Tool       string   `json:"tool"`
+	Suggestion string   `json:"suggestion,omitempty"`
+	Rendered   string   `json:"rendered,omitempty"`
+	Snippet    []string `json:"snippet,omitempty"`
+	Category   string   `json:"category,omitempty"`
 }
 
+// snippetRadius is how many lines of source context are captured around an
+// issue's line for the HTML report's expandable rows.
+const snippetRadius = 3
+
 // ReportGenerator generates quality reports.
 type ReportGenerator struct {
 	projectRoot string
@@ -86,17 +124,19 @@ func (g *ReportGenerator) GenerateReport(results []*tools.Result, duration time.
 
 	// Process results
 	for _, result := range results {
+		toolDuration, _ := time.ParseDuration(result.Duration)
+
 		toolResult := ToolResult{
 			Tool:           result.Tool,
 			Language:       result.Language,
 			Success:        result.Success,
-			Duration:       result.Duration,
+			Duration:       toolDuration,
 			FilesProcessed: result.FilesProcessed,
 			IssuesFound:    len(result.Issues),
 		}
 
-		if result.Error != "" {
-			toolResult.Error = result.Error
+		if result.Error != nil {
+			toolResult.Error = redact.Redact(result.Error.Error())
 		}
 
 		report.ToolResults = append(report.ToolResults, toolResult)
@@ -109,9 +149,12 @@ func (g *ReportGenerator) GenerateReport(results []*tools.Result, duration time.
 				Column:     issue.Column,
 				Severity:   issue.Severity,
 				Rule:       issue.Rule,
-				Message:    issue.Message,
+				Message:    redact.Redact(issue.Message),
 				Tool:       result.Tool,
-				Suggestion: issue.Suggestion,
+				Suggestion: redact.Redact(issue.Suggestion),
+				Rendered:   redact.Redact(issue.Rendered),
+				Snippet:    g.readSnippet(issue.File, issue.Line),
+				Category:   issue.Category,
 			}
 
 			report.IssuesByFile[issue.File] = append(report.IssuesByFile[issue.File], reportIssue)
@@ -124,6 +167,45 @@ func (g *ReportGenerator) GenerateReport(results []*tools.Result, duration time.
 	return report
 }
 
+// readSnippet reads up to snippetRadius lines of source before and after
+// line from file (resolved against projectRoot if not already absolute), for
+// display in the HTML report's expandable issue rows. It returns nil rather
+// than an error when the file can't be read or line is out of range, since a
+// missing snippet shouldn't stop report generation.
+func (g *ReportGenerator) readSnippet(file string, line int) []string {
+	if line <= 0 {
+		return nil
+	}
+
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(g.projectRoot, file)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	start := line - 1 - snippetRadius
+	if start < 0 {
+		start = 0
+	}
+
+	end := line - 1 + snippetRadius + 1
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	if start >= end || start >= len(lines) {
+		return nil
+	}
+
+	return append([]string{}, lines[start:end]...)
+}
+
 // calculateSummary calculates report summary statistics.
 func (g *ReportGenerator) calculateSummary(report *Report) Summary {
 	summary := Summary{
@@ -155,9 +237,72 @@ func (g *ReportGenerator) calculateSummary(report *Report) Summary {
 
 	summary.FilesWithIssues = len(report.IssuesByFile)
 
+	report.RuleStats = g.calculateRuleStats(report)
+
 	return summary
 }
 
+// calculateRuleStats builds report.RuleStats from report.IssuesByFile,
+// merging in tools.RuleCatalog metadata (currently just HelpURI) for
+// whichever rules a tool adapter has registered.
+func (g *ReportGenerator) calculateRuleStats(report *Report) map[string]RuleStat {
+	type accum struct {
+		tool          string
+		rule          string
+		count         int
+		severityRank  int
+		severity      string
+		filesAffected map[string]bool
+	}
+
+	now := time.Now()
+	byKey := make(map[string]*accum)
+
+	for file, issues := range report.IssuesByFile {
+		for _, issue := range issues {
+			if issue.Rule == "" {
+				continue
+			}
+
+			key := issue.Tool + ":" + issue.Rule
+			a, ok := byKey[key]
+			if !ok {
+				a = &accum{tool: issue.Tool, rule: issue.Rule, filesAffected: make(map[string]bool)}
+				byKey[key] = a
+			}
+
+			a.count++
+			a.filesAffected[file] = true
+
+			if rank := severityRank[strings.ToLower(issue.Severity)]; rank >= a.severityRank || a.severity == "" {
+				a.severityRank = rank
+				a.severity = issue.Severity
+			}
+		}
+	}
+
+	if len(byKey) == 0 {
+		return nil
+	}
+
+	stats := make(map[string]RuleStat, len(byKey))
+	for key, a := range byKey {
+		stat := RuleStat{
+			Tool:          a.tool,
+			Severity:      a.severity,
+			Count:         a.count,
+			FilesAffected: len(a.filesAffected),
+			FirstSeen:     now,
+		}
+		if meta, ok := tools.LookupRule(a.tool, a.rule); ok {
+			stat.HelpURI = meta.HelpURI
+		}
+		stats[key] = stat
+	}
+
+	return stats
+}
+
 // SaveJSON saves the report as JSON.
 func (g *ReportGenerator) SaveJSON(report *Report, outputPath string) error {
 	data, err := json.MarshalIndent(report, "", "  ")
@@ -213,10 +358,25 @@ func (g *ReportGenerator) generateHTML(report *Report) string {
         .tool-result.failed { border-left-color: #dc3545; }
         .issues-table { width: 100%; border-collapse: collapse; margin-top: 15px; }
         .issues-table th, .issues-table td { padding: 12px; text-align: left; border-bottom: 1px solid #e0e0e0; }
-        .issues-table th { background: #f8f9fa; font-weight: 600; }
+        .issues-table th { background: #f8f9fa; font-weight: 600; cursor: pointer; user-select: none; white-space: nowrap; }
+        .issues-table tbody tr.issue-row { cursor: pointer; }
+        .issues-table tbody tr.issue-row:hover { background: #f8f9fa; }
+        .issues-table tbody tr.detail-row td { background: #f1f3f5; }
+        .issues-table tbody tr.detail-row pre { margin: 0; padding: 10px; overflow-x: auto; font-size: 0.85em; }
+        .issues-table tbody tr.detail-row pre .snippet-line { white-space: pre; }
         .severity-error { color: #dc3545; font-weight: bold; }
         .severity-warning { color: #ffc107; font-weight: bold; }
         .severity-info { color: #17a2b8; }
+        .filters { display: flex; flex-wrap: wrap; gap: 12px; margin: 15px 0; align-items: center; }
+        .filters input, .filters select { padding: 6px 10px; border: 1px solid #ccc; border-radius: 4px; font-size: 0.9em; }
+        .filters input[type="text"] { min-width: 180px; }
+        .no-results { color: #666; padding: 15px 0; display: none; }
+        .charts { display: grid; grid-template-columns: repeat(auto-fit, minmax(280px, 1fr)); gap: 20px; align-items: start; }
+        .chart-card { background: #f8f9fa; padding: 20px; border-radius: 6px; }
+        .chart-card h3 { margin-top: 0; font-size: 1em; color: #333; }
+        .chart-legend { display: flex; flex-wrap: wrap; gap: 10px; margin-top: 10px; font-size: 0.85em; }
+        .chart-legend span { display: inline-flex; align-items: center; gap: 5px; }
+        .chart-legend i { width: 10px; height: 10px; border-radius: 2px; display: inline-block; }
     </style>
 </head>
 <body>`)
@@ -264,7 +424,7 @@ func (g *ReportGenerator) generateHTML(report *Report) string {
 		}
 
 		sb.WriteString(`<div class="tool-result ` + status + `">
-                <h3>` + result.Tool + ` (` + result.Language + `)</h3>
+                <h3>` + html.EscapeString(result.Tool) + ` (` + html.EscapeString(result.Language) + `)</h3>
                 <p><strong>상태:</strong> `)
 
 		if result.Success {
@@ -279,7 +439,7 @@ func (g *ReportGenerator) generateHTML(report *Report) string {
                 <p><strong>발견 이슈:</strong> ` + fmt.Sprintf("%d", result.IssuesFound) + `개</p>`)
 
 		if result.Error != "" {
-			sb.WriteString(`<p><strong>오류:</strong> <code>` + result.Error + `</code></p>`)
+			sb.WriteString(`<p><strong>오류:</strong> <code>` + html.EscapeString(result.Error) + `</code></p>`)
 		}
 
 		sb.WriteString(`</div>`)
@@ -287,12 +447,56 @@ func (g *ReportGenerator) generateHTML(report *Report) string {
 
 	sb.WriteString(`</div></div>`)
 
-	// Issues by File
+	// Charts: issues per tool (stacked by severity) and severity distribution
 	if len(report.IssuesByFile) > 0 {
 		sb.WriteString(`<div class="section">
-                <h2>📋 파일별 이슈</h2>`)
+                <h2>📊 이슈 차트</h2>
+                <div class="charts">
+                    <div class="chart-card">
+                        <h3>도구별 이슈</h3>` + g.generateToolBarChartSVG(report) + `
+                    </div>
+                    <div class="chart-card">
+                        <h3>심각도 분포</h3>` + g.generateSeverityPieChartSVG(report) + `
+                    </div>
+                </div>
+            </div>`)
+	}
+
+	// Top rules
+	if len(report.RuleStats) > 0 {
+		sb.WriteString(`<div class="section">
+                <h2>🏆 Top Rules</h2>
+                <table class="issues-table">
+                    <thead>
+                        <tr><th>규칙</th><th>도구</th><th>심각도</th><th>건수</th><th>영향받은 파일</th></tr>
+                    </thead>
+                    <tbody>`)
+
+		for _, rs := range sortedRuleStats(report.RuleStats) {
+			key := html.EscapeString(rs.key)
+			ruleCell := key
+			if rs.stat.HelpURI != "" {
+				ruleCell = `<a href="` + html.EscapeString(rs.stat.HelpURI) + `" target="_blank" rel="noopener">` + key + `</a>`
+			}
+
+			sb.WriteString(`<tr>
+                            <td><code>` + ruleCell + `</code></td>
+                            <td>` + html.EscapeString(rs.stat.Tool) + `</td>
+                            <td><span class="severity-` + html.EscapeString(strings.ToLower(rs.stat.Severity)) + `">` + html.EscapeString(rs.stat.Severity) + `</span></td>
+                            <td>` + fmt.Sprintf("%d", rs.stat.Count) + `</td>
+                            <td>` + fmt.Sprintf("%d", rs.stat.FilesAffected) + `</td>
+                        </tr>`)
+		}
+
+		sb.WriteString(`</tbody></table></div>`)
+	}
 
-		// Sort files by issue count
+	// Issues by File
+	if len(report.IssuesByFile) > 0 {
+		// Sort files by issue count, then flatten so issues from the same
+		// file stay adjacent - JS filtering/sorting operates on this flat
+		// list and a file-substring filter would otherwise have no stable
+		// default ordering to fall back to.
 		type fileIssues struct {
 			file   string
 			issues []Issue
@@ -307,43 +511,401 @@ func (g *ReportGenerator) generateHTML(report *Report) string {
 			return len(sortedFiles[i].issues) > len(sortedFiles[j].issues)
 		})
 
+		toolSet := map[string]bool{}
+		ruleSet := map[string]bool{}
 		for _, fileData := range sortedFiles {
-			sb.WriteString(`<h3>📄 ` + fileData.file + ` (` + fmt.Sprintf("%d", len(fileData.issues)) + `개 이슈)</h3>
-                    <table class="issues-table">
-                        <thead>
-                            <tr>
-                                <th>라인</th>
-                                <th>열</th>
-                                <th>심각도</th>
-                                <th>규칙</th>
-                                <th>메시지</th>
-                                <th>도구</th>
-                            </tr>
-                        </thead>
-                        <tbody>`)
+			for _, issue := range fileData.issues {
+				toolSet[issue.Tool] = true
+				ruleSet[issue.Rule] = true
+			}
+		}
+
+		sb.WriteString(`<div class="section">
+                <h2>📋 파일별 이슈</h2>
+                <div class="filters">
+                    <input type="text" id="filter-file" placeholder="파일 경로 필터" oninput="applyIssueFilters()">
+                    <select id="filter-severity" onchange="applyIssueFilters()">
+                        <option value="">모든 심각도</option>
+                        <option value="error">error</option>
+                        <option value="warning">warning</option>
+                        <option value="info">info</option>
+                    </select>
+                    <select id="filter-tool" onchange="applyIssueFilters()">
+                        <option value="">모든 도구</option>`)
+
+		sb.WriteString(optionsFromSet(toolSet))
+
+		sb.WriteString(`</select>
+                    <select id="filter-rule" onchange="applyIssueFilters()">
+                        <option value="">모든 규칙</option>`)
+
+		sb.WriteString(optionsFromSet(ruleSet))
+
+		sb.WriteString(`</select>
+                </div>
+                <table class="issues-table" id="issues-table">
+                    <thead>
+                        <tr>
+                            <th onclick="sortIssueTable('file')">파일</th>
+                            <th onclick="sortIssueTable('line')">라인</th>
+                            <th onclick="sortIssueTable('column')">열</th>
+                            <th onclick="sortIssueTable('severity')">심각도</th>
+                            <th onclick="sortIssueTable('rule')">규칙</th>
+                            <th>메시지</th>
+                            <th onclick="sortIssueTable('tool')">도구</th>
+                        </tr>
+                    </thead>
+                    <tbody id="issues-tbody">`)
 
+		for _, fileData := range sortedFiles {
 			for _, issue := range fileData.issues {
-				sb.WriteString(`<tr>
+				severity := strings.ToLower(issue.Severity)
+				file := html.EscapeString(issue.File)
+				rule := html.EscapeString(issue.Rule)
+				tool := html.EscapeString(issue.Tool)
+				sb.WriteString(`<tr class="issue-row" data-file="` + file + `" data-line="` + fmt.Sprintf("%d", issue.Line) +
+					`" data-column="` + fmt.Sprintf("%d", issue.Column) + `" data-severity="` + html.EscapeString(severity) +
+					`" data-rule="` + rule + `" data-tool="` + tool + `" onclick="toggleIssueDetail(this)">
+                            <td>` + file + `</td>
                             <td>` + fmt.Sprintf("%d", issue.Line) + `</td>
                             <td>` + fmt.Sprintf("%d", issue.Column) + `</td>
-                            <td><span class="severity-` + strings.ToLower(issue.Severity) + `">` + issue.Severity + `</span></td>
-                            <td><code>` + issue.Rule + `</code></td>
-                            <td>` + issue.Message + `</td>
-                            <td>` + issue.Tool + `</td>
+                            <td><span class="severity-` + html.EscapeString(severity) + `">` + html.EscapeString(issue.Severity) + `</span></td>
+                            <td><code>` + rule + `</code></td>
+                            <td>` + html.EscapeString(issue.Message) + `</td>
+                            <td>` + tool + `</td>
+                        </tr>
+                        <tr class="detail-row" hidden>
+                            <td colspan="7"><pre>` + snippetHTML(issue) + `</pre></td>
                         </tr>`)
 			}
-
-			sb.WriteString(`</tbody></table>`)
 		}
 
-		sb.WriteString(`</div>`)
+		sb.WriteString(`</tbody></table>
+                <div class="no-results" id="issues-no-results">필터와 일치하는 이슈가 없습니다.</div>
+            </div>`)
 	}
 
+	sb.WriteString(issuesTableScript)
+
 	sb.WriteString(`</div></body></html>`)
 
 	return sb.String()
 }
 
+// ruleStatEntry pairs a RuleStats map key with its value, for rendering
+// in a stable, Count-descending order (maps have none of their own).
+type ruleStatEntry struct {
+	key  string
+	stat RuleStat
+}
+
+// sortedRuleStats returns stats sorted by Count descending, breaking ties
+// on key for deterministic output.
+func sortedRuleStats(stats map[string]RuleStat) []ruleStatEntry {
+	entries := make([]ruleStatEntry, 0, len(stats))
+	for key, stat := range stats {
+		entries = append(entries, ruleStatEntry{key, stat})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].stat.Count != entries[j].stat.Count {
+			return entries[i].stat.Count > entries[j].stat.Count
+		}
+		return entries[i].key < entries[j].key
+	})
+
+	return entries
+}
+
+// optionsFromSet renders the distinct values of a set (as produced by
+// scanning a report's issues) as sorted <option> elements, for the
+// severity/tool/rule filter dropdowns.
+func optionsFromSet(set map[string]bool) string {
+	values := make([]string, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+
+	var sb strings.Builder
+	for _, v := range values {
+		escaped := html.EscapeString(v)
+		sb.WriteString(`<option value="` + escaped + `">` + escaped + `</option>`)
+	}
+
+	return sb.String()
+}
+
+// snippetHTML renders an issue's captured source context as HTML, with the
+// issue's own line marked, or a placeholder when no snippet was captured
+// (e.g. the file was unreadable at report-generation time).
+func snippetHTML(issue Issue) string {
+	if len(issue.Snippet) == 0 {
+		return "(소스 미리보기를 사용할 수 없습니다)"
+	}
+
+	start := issue.Line - snippetRadius
+	if start < 1 {
+		start = 1
+	}
+
+	var sb strings.Builder
+	for i, line := range issue.Snippet {
+		lineNo := start + i
+		marker := "  "
+		if lineNo == issue.Line {
+			marker = "▶ "
+		}
+		sb.WriteString(fmt.Sprintf(`<span class="snippet-line">%s%4d | %s</span>`+"\n", marker, lineNo, html.EscapeString(line)))
+	}
+
+	return sb.String()
+}
+
+// severityBucket normalizes a severity string to one of "error", "warning",
+// or "info", matching calculateSummary's bucketing so the HTML report's
+// charts agree with its summary stat cards.
+func severityBucket(severity string) string {
+	switch strings.ToLower(severity) {
+	case "error":
+		return "error"
+	case "warning":
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// toolSeverityCounts returns, for each tool sorted alphabetically, its issue
+// count per severityBucket - the data the bar chart renders.
+func toolSeverityCounts(report *Report) (toolNames []string, counts map[string]map[string]int) {
+	counts = make(map[string]map[string]int)
+
+	for _, issues := range report.IssuesByFile {
+		for _, issue := range issues {
+			if _, ok := counts[issue.Tool]; !ok {
+				counts[issue.Tool] = make(map[string]int)
+			}
+			counts[issue.Tool][severityBucket(issue.Severity)]++
+		}
+	}
+
+	toolNames = make([]string, 0, len(counts))
+	for tool := range counts {
+		toolNames = append(toolNames, tool)
+	}
+	sort.Strings(toolNames)
+
+	return toolNames, counts
+}
+
+// generateToolBarChartSVG renders a horizontal stacked bar chart (one bar
+// per tool, segmented by severity) as inline SVG, so the HTML report needs
+// no external charting library or CDN to stay a single emailable file.
+func (g *ReportGenerator) generateToolBarChartSVG(report *Report) string {
+	toolNames, counts := toolSeverityCounts(report)
+	if len(toolNames) == 0 {
+		return `<p>이슈 없음</p>`
+	}
+
+	const (
+		barMaxWidth = 240.0
+		rowHeight   = 28
+		labelWidth  = 110
+		chartWidth  = labelWidth + barMaxWidth + 50
+	)
+
+	maxTotal := 0
+	for _, tool := range toolNames {
+		total := counts[tool]["error"] + counts[tool]["warning"] + counts[tool]["info"]
+		if total > maxTotal {
+			maxTotal = total
+		}
+	}
+
+	chartHeight := rowHeight * len(toolNames)
+
+	var svg strings.Builder
+	svg.WriteString(fmt.Sprintf(`<svg viewBox="0 0 %d %d" width="100%%" height="%d" xmlns="http://www.w3.org/2000/svg">`,
+		int(chartWidth), chartHeight, chartHeight))
+
+	for i, tool := range toolNames {
+		y := i * rowHeight
+		total := counts[tool]["error"] + counts[tool]["warning"] + counts[tool]["info"]
+
+		svg.WriteString(fmt.Sprintf(`<text x="0" y="%d" font-size="12" dominant-baseline="middle">%s (%d)</text>`,
+			y+rowHeight/2+4, escapeSVGText(tool), total))
+
+		x := labelWidth
+		for _, seg := range []struct {
+			bucket string
+			color  string
+		}{{"error", "#dc3545"}, {"warning", "#ffc107"}, {"info", "#17a2b8"}} {
+			count := counts[tool][seg.bucket]
+			if count == 0 || maxTotal == 0 {
+				continue
+			}
+
+			width := barMaxWidth * float64(count) / float64(maxTotal)
+			svg.WriteString(fmt.Sprintf(`<rect x="%d" y="%d" width="%.1f" height="%d" fill="%s"><title>%s: %d</title></rect>`,
+				x, y+4, width, rowHeight-8, seg.color, seg.bucket, count))
+			x += int(math.Round(width))
+		}
+	}
+
+	svg.WriteString(`</svg>`)
+	svg.WriteString(`<div class="chart-legend">
+        <span><i style="background:#dc3545"></i>error</span>
+        <span><i style="background:#ffc107"></i>warning</span>
+        <span><i style="background:#17a2b8"></i>info</span>
+    </div>`)
+
+	return svg.String()
+}
+
+// generateSeverityPieChartSVG renders a donut chart of the report's overall
+// severity distribution as inline SVG, built from stroke-dasharray segments
+// on a single circle (no path-arc math, no external charting library).
+func (g *ReportGenerator) generateSeverityPieChartSVG(report *Report) string {
+	total := report.Summary.ErrorIssues + report.Summary.WarningIssues + report.Summary.InfoIssues
+	if total == 0 {
+		return `<p>이슈 없음</p>`
+	}
+
+	const (
+		radius      = 60.0
+		strokeWidth = 30.0
+	)
+	circumference := 2 * math.Pi * radius
+
+	segments := []struct {
+		label string
+		count int
+		color string
+	}{
+		{"error", report.Summary.ErrorIssues, "#dc3545"},
+		{"warning", report.Summary.WarningIssues, "#ffc107"},
+		{"info", report.Summary.InfoIssues, "#17a2b8"},
+	}
+
+	var svg strings.Builder
+	svg.WriteString(`<svg viewBox="0 0 160 160" width="100%" height="160" xmlns="http://www.w3.org/2000/svg">`)
+	svg.WriteString(fmt.Sprintf(`<circle cx="80" cy="80" r="%.0f" fill="none" stroke="#e9ecef" stroke-width="%.0f" />`, radius, strokeWidth))
+
+	offset := 0.0
+	for _, seg := range segments {
+		if seg.count == 0 {
+			continue
+		}
+
+		fraction := float64(seg.count) / float64(total)
+		dash := fraction * circumference
+
+		svg.WriteString(fmt.Sprintf(
+			`<circle cx="80" cy="80" r="%.0f" fill="none" stroke="%s" stroke-width="%.0f" stroke-dasharray="%.2f %.2f" stroke-dashoffset="%.2f" transform="rotate(-90 80 80)"><title>%s: %d (%.0f%%)</title></circle>`,
+			radius, seg.color, strokeWidth, dash, circumference-dash, -offset, seg.label, seg.count, fraction*100))
+
+		offset += dash
+	}
+
+	svg.WriteString(fmt.Sprintf(`<text x="80" y="85" font-size="20" text-anchor="middle">%d</text>`, total))
+	svg.WriteString(`</svg>`)
+	svg.WriteString(`<div class="chart-legend">
+        <span><i style="background:#dc3545"></i>error</span>
+        <span><i style="background:#ffc107"></i>warning</span>
+        <span><i style="background:#17a2b8"></i>info</span>
+    </div>`)
+
+	return svg.String()
+}
+
+// escapeSVGText escapes the handful of characters unsafe to place inside an
+// SVG <text> element (tool names are user/config-controlled strings).
+func escapeSVGText(s string) string {
+	replacer := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	return replacer.Replace(s)
+}
+
+// issuesTableScript is the vanilla-JS layer for the HTML report's issues
+// table: live filtering by severity/tool/rule/file substring, column
+// sorting, and expanding a row to show its captured source snippet. It's
+// inlined (no external assets) so the report stays a single file that can be
+// emailed or archived as a CI artifact.
+const issuesTableScript = `<script>
+function toggleIssueDetail(row) {
+    var detail = row.nextElementSibling;
+    if (detail && detail.classList.contains('detail-row')) {
+        detail.hidden = !detail.hidden;
+    }
+}
+
+function applyIssueFilters() {
+    var fileFilter = (document.getElementById('filter-file').value || '').toLowerCase();
+    var severityFilter = document.getElementById('filter-severity').value;
+    var toolFilter = document.getElementById('filter-tool').value;
+    var ruleFilter = document.getElementById('filter-rule').value;
+
+    var rows = document.querySelectorAll('#issues-tbody tr.issue-row');
+    var visibleCount = 0;
+
+    rows.forEach(function (row) {
+        var matches = row.dataset.file.toLowerCase().indexOf(fileFilter) !== -1 &&
+            (!severityFilter || row.dataset.severity === severityFilter) &&
+            (!toolFilter || row.dataset.tool === toolFilter) &&
+            (!ruleFilter || row.dataset.rule === ruleFilter);
+
+        row.style.display = matches ? '' : 'none';
+
+        var detail = row.nextElementSibling;
+        if (detail && detail.classList.contains('detail-row')) {
+            if (!matches) {
+                detail.hidden = true;
+            }
+            detail.style.display = matches ? '' : 'none';
+        }
+
+        if (matches) {
+            visibleCount++;
+        }
+    });
+
+    document.getElementById('issues-no-results').style.display = visibleCount === 0 ? 'block' : 'none';
+}
+
+var issueSortState = {};
+
+function sortIssueTable(key) {
+    var tbody = document.getElementById('issues-tbody');
+    var rows = Array.from(tbody.querySelectorAll('tr.issue-row'));
+
+    var ascending = !issueSortState[key];
+    issueSortState = {};
+    issueSortState[key] = ascending;
+
+    rows.sort(function (a, b) {
+        var av = a.dataset[key];
+        var bv = b.dataset[key];
+        var an = parseFloat(av);
+        var bn = parseFloat(bv);
+        var cmp;
+        if (!isNaN(an) && !isNaN(bn)) {
+            cmp = an - bn;
+        } else {
+            cmp = av.localeCompare(bv);
+        }
+        return ascending ? cmp : -cmp;
+    });
+
+    rows.forEach(function (row) {
+        var detail = row.nextElementSibling;
+        tbody.appendChild(row);
+        if (detail && detail.classList.contains('detail-row')) {
+            tbody.appendChild(detail);
+        }
+    });
+}
+</script>`
+
 // SaveMarkdown saves the report as Markdown.
 func (g *ReportGenerator) SaveMarkdown(report *Report, outputPath string) error {
 	md := g.generateMarkdown(report)
@@ -383,17 +945,35 @@ func (g *ReportGenerator) generateMarkdown(report *Report) string {
 			result.Tool, result.Language, status, result.FilesProcessed, result.IssuesFound, result.Duration.String()))
 	}
 
+	if len(report.RuleStats) > 0 {
+		sb.WriteString("\n## 🏆 Top Rules\n\n")
+		sb.WriteString("| Rule | Tool | Severity | Count | Files Affected |\n")
+		sb.WriteString("|------|------|----------|-------|----------------|\n")
+
+		for _, rs := range sortedRuleStats(report.RuleStats) {
+			key := html.EscapeString(rs.key)
+			ruleCell := "`" + key + "`"
+			if rs.stat.HelpURI != "" {
+				ruleCell = fmt.Sprintf("[`%s`](%s)", key, html.EscapeString(rs.stat.HelpURI))
+			}
+
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %d | %d |\n",
+				ruleCell, html.EscapeString(rs.stat.Tool), html.EscapeString(rs.stat.Severity), rs.stat.Count, rs.stat.FilesAffected))
+		}
+	}
+
 	if len(report.IssuesByFile) > 0 {
 		sb.WriteString("\n## 📋 Issues by File\n\n")
 
 		for file, issues := range report.IssuesByFile {
-			sb.WriteString(fmt.Sprintf("### 📄 %s (%d issues)\n\n", file, len(issues)))
+			sb.WriteString(fmt.Sprintf("### 📄 %s (%d issues)\n\n", html.EscapeString(file), len(issues)))
 			sb.WriteString("| Line | Column | Severity | Rule | Message | Tool |\n")
 			sb.WriteString("|------|--------|----------|------|---------|------|\n")
 
 			for _, issue := range issues {
 				sb.WriteString(fmt.Sprintf("| %d | %d | %s | `%s` | %s | %s |\n",
-					issue.Line, issue.Column, issue.Severity, issue.Rule, issue.Message, issue.Tool))
+					issue.Line, issue.Column, html.EscapeString(issue.Severity), html.EscapeString(issue.Rule),
+					html.EscapeString(issue.Message), html.EscapeString(issue.Tool)))
 			}
 
 			sb.WriteString("\n")
@@ -403,9 +983,17 @@ func (g *ReportGenerator) generateMarkdown(report *Report) string {
 	return sb.String()
 }
 
-// GetReportPath generates a report file path.
+// GetReportPath generates a report file path. "sarif" gets the
+// ".sarif.json" extension SARIF-aware tooling (GitHub code scanning's
+// upload-sarif, IDE SARIF viewers) expects to find, rather than a bare
+// ".sarif" that plain JSON viewers wouldn't recognize.
 func (g *ReportGenerator) GetReportPath(format string) string {
+	ext := format
+	if format == "sarif" {
+		ext = "sarif.json"
+	}
+
 	timestamp := time.Now().Format("20060102-150405")
-	filename := fmt.Sprintf("quality-report-%s.%s", timestamp, format)
+	filename := fmt.Sprintf("quality-report-%s.%s", timestamp, ext)
 	return filepath.Join(g.projectRoot, "tmp", filename)
 }
@@ -547,6 +547,7 @@ func TestGetReportPath(t *testing.T) {
 		{"html", ".html"},
 		{"md", ".md"},
 		{"txt", ".txt"},
+		{"sarif", ".sarif.json"},
 	}
 
 	for _, tt := range tests {
@@ -620,3 +621,88 @@ func TestCalculateSummary_SeverityCaseMixing(t *testing.T) {
 	assert.Equal(t, 1, summary.WarningIssues)
 	assert.Equal(t, 1, summary.InfoIssues)
 }
+
+func TestCalculateSummary_PopulatesRuleStats(t *testing.T) {
+	generator := NewReportGenerator("/test")
+
+	report := &Report{
+		ToolResults: []ToolResult{{Tool: "golangci-lint", Success: true}},
+		IssuesByFile: map[string][]Issue{
+			"a.go": {{Tool: "golangci-lint", Rule: "unused", Severity: "error"}},
+			"b.go": {
+				{Tool: "golangci-lint", Rule: "unused", Severity: "warning"},
+				{Tool: "gofumpt", Rule: "extra-blank", Severity: "info"},
+			},
+		},
+	}
+
+	generator.calculateSummary(report)
+
+	stat, ok := report.RuleStats["golangci-lint:unused"]
+	assert.True(t, ok)
+	assert.Equal(t, 2, stat.Count)
+	assert.Equal(t, 2, stat.FilesAffected)
+	assert.Equal(t, "error", stat.Severity) // highest severity across its issues
+	assert.Equal(t, "golangci-lint", stat.Tool)
+
+	otherStat, ok := report.RuleStats["gofumpt:extra-blank"]
+	assert.True(t, ok)
+	assert.Equal(t, 1, otherStat.Count)
+}
+
+func TestGenerateHTML_EscapesIssueFields(t *testing.T) {
+	generator := NewReportGenerator("/test")
+
+	report := &Report{
+		ToolResults: []ToolResult{{Tool: "golint", Success: true}},
+		IssuesByFile: map[string][]Issue{
+			`"><script>alert(1)</script>.go`: {
+				{
+					File:     `"><script>alert(1)</script>.go`,
+					Line:     1,
+					Severity: "error",
+					Rule:     `rule"><script>`,
+					Message:  `has a "quote" and a </script> tag`,
+					Tool:     `tool<script>`,
+				},
+			},
+		},
+	}
+
+	out := generator.generateHTML(report)
+
+	assert.NotContains(t, out, "<script>alert(1)</script>")
+	assert.NotContains(t, out, `rule"><script>`)
+	assert.NotContains(t, out, "has a \"quote\" and a </script> tag")
+	assert.NotContains(t, out, "tool<script>")
+
+	assert.Contains(t, out, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	assert.Contains(t, out, "&#34;quote&#34;")
+}
+
+func TestGenerateMarkdown_EscapesIssueFields(t *testing.T) {
+	generator := NewReportGenerator("/test")
+
+	report := &Report{
+		ToolResults: []ToolResult{{Tool: "golint", Success: true}},
+		IssuesByFile: map[string][]Issue{
+			"main.go": {
+				{
+					File:     "main.go",
+					Line:     1,
+					Severity: "error",
+					Rule:     `rule<script>`,
+					Message:  `message with </script> and "quotes"`,
+					Tool:     `tool<script>`,
+				},
+			},
+		},
+	}
+
+	out := generator.generateMarkdown(report)
+
+	assert.NotContains(t, out, "<script>")
+	assert.NotContains(t, out, "</script>")
+	assert.Contains(t, out, "&lt;script&gt;")
+	assert.Contains(t, out, "&#34;quotes&#34;")
+}
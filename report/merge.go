@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// issueKey identifies an issue for de-duplication across shard reports.
+// Two shards can both flag the same line (e.g. a file that fell into
+// more than one shard because it's relevant to two separate tools), and
+// (File, Line, Column, Rule) is specific enough that collapsing matches
+// on it won't hide genuinely distinct issues.
+type issueKey struct {
+	file   string
+	line   int
+	column int
+	rule   string
+}
+
+// LoadJSON reads back a report previously written by SaveJSON, so shard
+// reports can be merged after the fact.
+func LoadJSON(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report file: %w", err)
+	}
+
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse report file: %w", err)
+	}
+
+	return &r, nil
+}
+
+// MergeReports combines reports produced by separate --shard=i runs into
+// one: tool results are concatenated (each shard's invocation of a tool
+// is a distinct run worth keeping), while issues are de-duplicated by
+// (File, Line, Column, Rule) in case the same issue was surfaced by more
+// than one shard.
+func MergeReports(reports []*Report) *Report {
+	merged := &Report{
+		IssuesByFile: make(map[string][]Issue),
+	}
+
+	seen := make(map[issueKey]bool)
+
+	for _, r := range reports {
+		if r == nil {
+			continue
+		}
+
+		if merged.ProjectRoot == "" {
+			merged.ProjectRoot = r.ProjectRoot
+		}
+		if r.Timestamp.After(merged.Timestamp) {
+			merged.Timestamp = r.Timestamp
+		}
+
+		merged.TotalFiles += r.TotalFiles
+		merged.Duration += r.Duration
+		merged.ToolResults = append(merged.ToolResults, r.ToolResults...)
+
+		for file, issues := range r.IssuesByFile {
+			for _, issue := range issues {
+				key := issueKey{file, issue.Line, issue.Column, issue.Rule}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				merged.IssuesByFile[file] = append(merged.IssuesByFile[file], issue)
+			}
+		}
+	}
+
+	for file := range merged.IssuesByFile {
+		issues := merged.IssuesByFile[file]
+		sort.Slice(issues, func(i, j int) bool {
+			if issues[i].Line != issues[j].Line {
+				return issues[i].Line < issues[j].Line
+			}
+			return issues[i].Column < issues[j].Column
+		})
+	}
+
+	merged.Summary = (&ReportGenerator{}).calculateSummary(merged)
+
+	return merged
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"regexp"
+	"strings"
+)
+
+// severityRank orders severities from least to most severe, so MinSeverity
+// can be expressed as a single threshold rather than an explicit set.
+var severityRank = map[string]int{
+	"info":    0,
+	"warning": 1,
+	"error":   2,
+}
+
+// Query narrows a Report down to the issues a caller actually wants to
+// triage. Every non-empty field is ANDed together; an empty field imposes
+// no constraint. Built for FilterReport and the `report-filter` CLI
+// command.
+type Query struct {
+	// Severities is a case-insensitive set an issue's Severity must be in.
+	Severities []string
+
+	// Tools is the set of tool names (exact match) an issue's Tool must
+	// be in.
+	Tools []string
+
+	// Rules is a list of globs (gitignore-style "*"/"?"/"**") an issue's
+	// Rule must match at least one of.
+	Rules []string
+
+	// Paths is a list of globs an issue's File must match at least one
+	// of, using the same "**" syntax as Rules.
+	Paths []string
+
+	// MinSeverity, if set, drops issues below this severity on the
+	// info < warning < error ordinal.
+	MinSeverity string
+}
+
+// FilterReport returns a new Report containing only report's issues that
+// match q, with Summary recomputed from the filtered issue set so
+// downstream consumers (the HTML/Markdown renderers) see counts
+// consistent with what's actually in the report.
+// ToolResults is copied as-is: it describes what each tool actually did,
+// which filtering a report after the fact doesn't change.
+func FilterReport(report *Report, q Query) *Report {
+	filtered := &Report{
+		Timestamp:    report.Timestamp,
+		ProjectRoot:  report.ProjectRoot,
+		TotalFiles:   report.TotalFiles,
+		Duration:     report.Duration,
+		ToolResults:  report.ToolResults,
+		IssuesByFile: make(map[string][]Issue),
+	}
+
+	severitySet := toLowerSet(q.Severities)
+	toolSet := toSet(q.Tools)
+
+	for file, issues := range report.IssuesByFile {
+		var kept []Issue
+		for _, issue := range issues {
+			if q.matches(issue, severitySet, toolSet) {
+				kept = append(kept, issue)
+			}
+		}
+		if len(kept) > 0 {
+			filtered.IssuesByFile[file] = kept
+		}
+	}
+
+	filtered.Summary = filteredSummary(filtered)
+	filtered.RuleStats = (&ReportGenerator{}).calculateRuleStats(filtered)
+
+	return filtered
+}
+
+// filteredSummary computes filtered's Summary from its own (already
+// filtered) IssuesByFile rather than ReportGenerator.calculateSummary's
+// ToolResults-based counting, which would otherwise report TotalIssues
+// for the original, unfiltered run - ToolResults itself stays
+// unfiltered (see FilterReport's doc comment), so SuccessfulTools/
+// FailedTools/TotalTools still reflect what each tool actually did.
+func filteredSummary(filtered *Report) Summary {
+	summary := Summary{
+		TotalTools: len(filtered.ToolResults),
+	}
+
+	for _, result := range filtered.ToolResults {
+		if result.Success {
+			summary.SuccessfulTools++
+		} else {
+			summary.FailedTools++
+		}
+	}
+
+	for _, issues := range filtered.IssuesByFile {
+		for _, issue := range issues {
+			summary.TotalIssues++
+			switch strings.ToLower(issue.Severity) {
+			case "error":
+				summary.ErrorIssues++
+			case "warning":
+				summary.WarningIssues++
+			default:
+				summary.InfoIssues++
+			}
+		}
+	}
+
+	summary.FilesWithIssues = len(filtered.IssuesByFile)
+
+	return summary
+}
+
+func (q Query) matches(issue Issue, severitySet, toolSet map[string]bool) bool {
+	if len(severitySet) > 0 && !severitySet[strings.ToLower(issue.Severity)] {
+		return false
+	}
+
+	if len(toolSet) > 0 && !toolSet[issue.Tool] {
+		return false
+	}
+
+	if q.MinSeverity != "" {
+		min, ok := severityRank[strings.ToLower(q.MinSeverity)]
+		if ok && severityRank[strings.ToLower(issue.Severity)] < min {
+			return false
+		}
+	}
+
+	if len(q.Rules) > 0 && !matchesAnyGlob(q.Rules, issue.Rule) {
+		return false
+	}
+
+	if len(q.Paths) > 0 && !matchesAnyGlob(q.Paths, issue.File) {
+		return false
+	}
+
+	return true
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func toLowerSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[strings.ToLower(v)] = true
+	}
+	return set
+}
+
+// matchesAnyGlob reports whether value matches at least one of patterns,
+// each compiled with globToRegexp.
+func matchesAnyGlob(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if re := globToRegexp(pattern); re != nil && re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// globToRegexp compiles a gitignore-style glob ("*" within a path
+// segment, "?" for a single character, "**" across segments) into a
+// regexp matching the whole value - the same subset
+// detector.compileGlobPattern supports, reimplemented here since a rule
+// ID or file path being matched against isn't anchored to a project root
+// the way a .gitignore entry is.
+func globToRegexp(pattern string) *regexp.Regexp {
+	escaped := regexp.QuoteMeta(pattern)
+	escaped = strings.ReplaceAll(escaped, `\*\*`, "\x00")
+	escaped = strings.ReplaceAll(escaped, `\*`, "[^/]*")
+	escaped = strings.ReplaceAll(escaped, `\?`, "[^/]")
+	escaped = strings.ReplaceAll(escaped, "\x00", ".*")
+
+	re, err := regexp.Compile("^" + escaped + "$")
+	if err != nil {
+		return nil
+	}
+	return re
+}
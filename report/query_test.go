@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleReportForQuery() *Report {
+	return &Report{
+		ProjectRoot: "/test/project",
+		ToolResults: []ToolResult{{Tool: "golangci-lint", Success: true}},
+		IssuesByFile: map[string][]Issue{
+			"internal/foo.go": {
+				{File: "internal/foo.go", Severity: "error", Rule: "unused", Tool: "golangci-lint"},
+				{File: "internal/foo.go", Severity: "info", Rule: "gofmt", Tool: "gofumpt"},
+			},
+			"cmd/main.go": {
+				{File: "cmd/main.go", Severity: "warning", Rule: "SA1000", Tool: "golangci-lint"},
+			},
+		},
+	}
+}
+
+func TestFilterReport_BySeverity(t *testing.T) {
+	filtered := FilterReport(sampleReportForQuery(), Query{Severities: []string{"error"}})
+
+	assert.Equal(t, 1, filtered.Summary.TotalIssues)
+	assert.Equal(t, 1, filtered.Summary.FilesWithIssues)
+	assert.Len(t, filtered.IssuesByFile["internal/foo.go"], 1)
+}
+
+func TestFilterReport_ByTool(t *testing.T) {
+	filtered := FilterReport(sampleReportForQuery(), Query{Tools: []string{"gofumpt"}})
+
+	assert.Equal(t, 1, filtered.Summary.TotalIssues)
+	assert.Equal(t, 0, filtered.Summary.ErrorIssues)
+	assert.Equal(t, 1, filtered.Summary.InfoIssues)
+}
+
+func TestFilterReport_ByMinSeverity(t *testing.T) {
+	filtered := FilterReport(sampleReportForQuery(), Query{MinSeverity: "warning"})
+
+	assert.Equal(t, 2, filtered.Summary.TotalIssues)
+	assert.Equal(t, 1, filtered.Summary.ErrorIssues)
+	assert.Equal(t, 1, filtered.Summary.WarningIssues)
+}
+
+func TestFilterReport_ByPathGlob(t *testing.T) {
+	filtered := FilterReport(sampleReportForQuery(), Query{Paths: []string{"internal/**"}})
+
+	assert.Equal(t, 2, filtered.Summary.TotalIssues)
+	_, hasMain := filtered.IssuesByFile["cmd/main.go"]
+	assert.False(t, hasMain)
+}
+
+func TestFilterReport_ByRuleGlob(t *testing.T) {
+	filtered := FilterReport(sampleReportForQuery(), Query{Rules: []string{"SA*"}})
+
+	assert.Equal(t, 1, filtered.Summary.TotalIssues)
+	assert.Len(t, filtered.IssuesByFile["cmd/main.go"], 1)
+}
+
+func TestFilterReport_NoMatches(t *testing.T) {
+	filtered := FilterReport(sampleReportForQuery(), Query{Tools: []string{"nonexistent"}})
+
+	assert.Equal(t, 0, filtered.Summary.TotalIssues)
+	assert.Empty(t, filtered.IssuesByFile)
+}
+
+func TestFilterReport_PreservesToolResults(t *testing.T) {
+	original := sampleReportForQuery()
+	filtered := FilterReport(original, Query{Severities: []string{"error"}})
+
+	assert.Equal(t, original.ToolResults, filtered.ToolResults)
+	assert.Equal(t, original.ProjectRoot, filtered.ProjectRoot)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import "fmt"
+
+// Reporter writes a Report to outputPath in one particular format. It lets
+// callers like QualityManager.generateReport select a formatter by name
+// instead of switching on the format string themselves.
+type Reporter interface {
+	// Format is the name this reporter is registered under (e.g. "json").
+	Format() string
+
+	// Save writes report to outputPath in this reporter's format.
+	Save(report *Report, outputPath string) error
+}
+
+// jsonReporter adapts ReportGenerator.SaveJSON to the Reporter interface.
+type jsonReporter struct{ generator *ReportGenerator }
+
+func (r *jsonReporter) Format() string { return "json" }
+func (r *jsonReporter) Save(report *Report, outputPath string) error {
+	return r.generator.SaveJSON(report, outputPath)
+}
+
+// htmlReporter adapts ReportGenerator.SaveHTML to the Reporter interface.
+type htmlReporter struct{ generator *ReportGenerator }
+
+func (r *htmlReporter) Format() string { return "html" }
+func (r *htmlReporter) Save(report *Report, outputPath string) error {
+	return r.generator.SaveHTML(report, outputPath)
+}
+
+// textReporter adapts ReportGenerator.SaveMarkdown to the Reporter
+// interface, since Markdown is this generator's plain-text report format.
+type textReporter struct{ generator *ReportGenerator }
+
+func (r *textReporter) Format() string { return "markdown" }
+func (r *textReporter) Save(report *Report, outputPath string) error {
+	return r.generator.SaveMarkdown(report, outputPath)
+}
+
+// sarifReporter adapts ReportGenerator.SaveSARIF to the Reporter interface.
+// toolVersions is captured at construction time since Reporter.Save has no
+// room for it in its signature.
+type sarifReporter struct {
+	generator    *ReportGenerator
+	toolVersions map[string]string
+}
+
+func (r *sarifReporter) Format() string { return "sarif" }
+func (r *sarifReporter) Save(report *Report, outputPath string) error {
+	return r.generator.SaveSARIF(report, outputPath, r.toolVersions)
+}
+
+// NewReporter returns the Reporter registered for format, or an error
+// listing the supported formats if format isn't recognized. toolVersions is
+// only consulted by the "sarif" reporter.
+func (g *ReportGenerator) NewReporter(format string, toolVersions map[string]string) (Reporter, error) {
+	switch format {
+	case "json":
+		return &jsonReporter{generator: g}, nil
+	case "html":
+		return &htmlReporter{generator: g}, nil
+	case "markdown", "md", "text":
+		return &textReporter{generator: g}, nil
+	case "sarif":
+		return &sarifReporter{generator: g, toolVersions: toolVersions}, nil
+	default:
+		return nil, fmt.Errorf("unsupported report format: %s (supported: json, html, markdown, sarif)", format)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReporter_ReturnsMatchingFormat(t *testing.T) {
+	generator := NewReportGenerator(t.TempDir())
+
+	cases := map[string]string{
+		"json":     "json",
+		"html":     "html",
+		"markdown": "markdown",
+		"md":       "markdown",
+		"text":     "markdown",
+		"sarif":    "sarif",
+	}
+
+	for format, wantFormat := range cases {
+		reporter, err := generator.NewReporter(format, nil)
+		require.NoError(t, err, format)
+		assert.Equal(t, wantFormat, reporter.Format(), format)
+	}
+}
+
+func TestNewReporter_UnsupportedFormat(t *testing.T) {
+	generator := NewReportGenerator(t.TempDir())
+
+	_, err := generator.NewReporter("xml", nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported report format")
+}
+
+func TestReporter_Save(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	rpt := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{{Tool: "gofmt", Language: "Go", Success: true}},
+	}
+
+	reporter, err := generator.NewReporter("sarif", map[string]string{"gofmt": "1.0.0"})
+	require.NoError(t, err)
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	require.NoError(t, reporter.Save(rpt, outputPath))
+	assert.FileExists(t, outputPath)
+}
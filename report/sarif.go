@@ -0,0 +1,433 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+const sarifVersion = "2.1.0"
+
+const sarifSchemaURI = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+// toolInformationURIs maps a QualityTool.Name() to its upstream homepage,
+// populating driver.informationUri for code-scanning/IDE SARIF viewers
+// that link a rule back to the tool that produced it. A tool missing here
+// (e.g. a user-defined manifest tool) simply gets no informationUri.
+var toolInformationURIs = map[string]string{
+	"gofumpt":            "https://github.com/mvdan/gofumpt",
+	"goimports":          "https://pkg.go.dev/golang.org/x/tools/cmd/goimports",
+	"golangci-lint":      "https://golangci-lint.run",
+	"govulncheck":        "https://pkg.go.dev/golang.org/x/vuln/cmd/govulncheck",
+	"google-java-format": "https://github.com/google/google-java-format",
+	"checkstyle":         "https://checkstyle.org",
+	"spotbugs":           "https://spotbugs.github.io",
+	"prettier":           "https://prettier.io",
+	"eslint":             "https://eslint.org",
+	"tsc":                "https://www.typescriptlang.org",
+	"npm-audit":          "https://docs.npmjs.com/cli/v10/commands/npm-audit",
+	"black":              "https://black.readthedocs.io",
+	"ruff":               "https://docs.astral.sh/ruff",
+	"pylint":             "https://pylint.readthedocs.io",
+	"pip-audit":          "https://pypi.org/project/pip-audit",
+	"rustfmt":            "https://github.com/rust-lang/rustfmt",
+	"clippy":             "https://doc.rust-lang.org/clippy",
+	"cargo-fmt":          "https://github.com/rust-lang/rustfmt",
+	"clang-format":       "https://clang.llvm.org/docs/ClangFormat.html",
+	"clang-tidy":         "https://clang.llvm.org/extra/clang-tidy",
+	"shellcheck":         "https://www.shellcheck.net",
+	"shfmt":              "https://github.com/mvdan/sh",
+	"stylelint":          "https://stylelint.io",
+	"hadolint":           "https://github.com/hadolint/hadolint",
+	"markdownlint":       "https://github.com/DavidAnson/markdownlint",
+	"buf":                "https://buf.build",
+	"sqlfluff":           "https://sqlfluff.com",
+	"taplo":              "https://taplo.tamasfe.dev",
+	"yamllint":           "https://yamllint.readthedocs.io",
+	"trivy":              "https://trivy.dev",
+}
+
+// sarifLog is the top-level SARIF document.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// sarifRun holds one `run` object per tool, as required by code-scanning
+// consumers like GitHub's codeql-action/upload-sarif.
+type sarifRun struct {
+	Tool               sarifToolComponent               `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactLocation `json:"originalUriBaseIds,omitempty"`
+	Results            []sarifResult                    `json:"results"`
+}
+
+type sarifToolComponent struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID              string                 `json:"ruleId"`
+	RuleIndex           *int                   `json:"ruleIndex,omitempty"`
+	Level               string                 `json:"level"`
+	Message             sarifMessage           `json:"message"`
+	Locations           []sarifLocationWrapper `json:"locations"`
+	PartialFingerprints map[string]string      `json:"partialFingerprints,omitempty"`
+	Fixes               []sarifFix             `json:"fixes,omitempty"`
+	Properties          *sarifProperties       `json:"properties,omitempty"`
+}
+
+// sarifProperties carries the propertyBag fields SARIF consumers (GitHub
+// code scanning in particular) read for categorization beyond Level -
+// currently just Tags, populated from Issue.Category when set.
+type sarifProperties struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+// sarifFix carries an issue's Suggestion as a SARIF fix proposing to
+// replace the whole flagged region with the suggested text.
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion          `json:"deletedRegion"`
+	InsertedContent sarifInsertedContent `json:"insertedContent"`
+}
+
+type sarifInsertedContent struct {
+	Text string `json:"text"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocationWrapper struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// SaveSARIF saves the report as a SARIF 2.1.0 document with one `run`
+// object per tool. toolVersions maps a tool name to its installed version
+// (as reported by QualityTool.GetVersion), used to populate
+// tool.driver.version; a missing entry is left blank.
+func (g *ReportGenerator) SaveSARIF(report *Report, outputPath string, toolVersions map[string]string) error {
+	log := g.generateSARIF(report, toolVersions)
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal SARIF report: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write SARIF report: %w", err)
+	}
+
+	return nil
+}
+
+// generateSARIF builds a SARIF log from a quality Report, grouping issues
+// into one run per tool.
+func (g *ReportGenerator) generateSARIF(report *Report, toolVersions map[string]string) sarifLog {
+	byTool := make(map[string][]Issue)
+	var toolNames []string
+
+	for _, issues := range report.IssuesByFile {
+		for _, issue := range issues {
+			if _, ok := byTool[issue.Tool]; !ok {
+				toolNames = append(toolNames, issue.Tool)
+			}
+			byTool[issue.Tool] = append(byTool[issue.Tool], issue)
+		}
+	}
+
+	// Tools with zero issues still get an (empty) run, so CI code-scanning
+	// shows the tool ran even when it found nothing.
+	for _, result := range report.ToolResults {
+		if _, ok := byTool[result.Tool]; !ok {
+			byTool[result.Tool] = nil
+			toolNames = append(toolNames, result.Tool)
+		}
+	}
+
+	sort.Strings(toolNames)
+
+	runs := make([]sarifRun, 0, len(toolNames))
+	for _, toolName := range toolNames {
+		runs = append(runs, g.generateSARIFRun(toolName, byTool[toolName], toolVersions[toolName]))
+	}
+
+	return sarifLog{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs:    runs,
+	}
+}
+
+// generateSARIFRun builds the run object for a single tool.
+func (g *ReportGenerator) generateSARIFRun(toolName string, issues []Issue, version string) sarifRun {
+	rules := make(map[string]bool)
+	for _, issue := range issues {
+		if issue.Rule != "" {
+			rules[issue.Rule] = true
+		}
+	}
+
+	// Assign each rule a stable index into driver.rules so results can
+	// reference it by ruleIndex instead of re-matching on ruleId.
+	ruleIDs := make([]string, 0, len(rules))
+	for id := range rules {
+		ruleIDs = append(ruleIDs, id)
+	}
+	sort.Strings(ruleIDs)
+
+	// A rule's own description isn't data this aggregator has - the
+	// underlying linters don't expose one - so the best available stand-in
+	// is the message of whichever issue for that rule was seen first.
+	firstMessageByRule := make(map[string]string, len(ruleIDs))
+	for _, issue := range issues {
+		if issue.Rule == "" {
+			continue
+		}
+		if _, ok := firstMessageByRule[issue.Rule]; !ok {
+			firstMessageByRule[issue.Rule] = issue.Message
+		}
+	}
+
+	ruleIndexByID := make(map[string]int, len(ruleIDs))
+	sarifRules := make([]sarifRule, 0, len(ruleIDs))
+	for i, id := range ruleIDs {
+		ruleIndexByID[id] = i
+		rule := sarifRule{ID: id, Name: id}
+		if msg := firstMessageByRule[id]; msg != "" {
+			rule.ShortDescription = &sarifMessage{Text: msg}
+		}
+		if meta, ok := tools.LookupRule(toolName, id); ok && meta.HelpURI != "" {
+			rule.HelpURI = meta.HelpURI
+		}
+		sarifRules = append(sarifRules, rule)
+	}
+
+	results := make([]sarifResult, 0, len(issues))
+	for _, issue := range issues {
+		uri := filepath.ToSlash(relativeToRoot(g.projectRoot, issue.File))
+
+		result := sarifResult{
+			RuleID: issue.Rule,
+			Level:  sarifLevel(issue.Severity),
+			Message: sarifMessage{
+				Text: issue.Message,
+			},
+			Locations: []sarifLocationWrapper{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{
+							URI:       uri,
+							URIBaseID: "%SRCROOT%",
+						},
+						Region: sarifRegion{
+							StartLine:   issue.Line,
+							StartColumn: issue.Column,
+						},
+					},
+				},
+			},
+			PartialFingerprints: map[string]string{
+				"primaryLocationLineHash": partialFingerprint(g.projectRoot, issue.File, issue.Line),
+			},
+		}
+
+		if issue.Rule != "" {
+			idx := ruleIndexByID[issue.Rule]
+			result.RuleIndex = &idx
+		}
+
+		if issue.Category != "" {
+			result.Properties = &sarifProperties{Tags: []string{issue.Category}}
+		}
+
+		if issue.Suggestion != "" {
+			result.Fixes = []sarifFix{
+				{
+					Description: sarifMessage{Text: "Apply suggested fix"},
+					ArtifactChanges: []sarifArtifactChange{
+						{
+							ArtifactLocation: sarifArtifactLocation{URI: uri, URIBaseID: "%SRCROOT%"},
+							Replacements: []sarifReplacement{
+								{
+									DeletedRegion:   sarifRegion{StartLine: issue.Line, StartColumn: issue.Column},
+									InsertedContent: sarifInsertedContent{Text: issue.Suggestion},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return sarifRun{
+		Tool: sarifToolComponent{
+			Driver: sarifDriver{
+				Name:           toolName,
+				Version:        version,
+				InformationURI: toolInformationURIs[toolName],
+				Rules:          sarifRules,
+			},
+		},
+		OriginalURIBaseIDs: g.sarifOriginalURIBaseIDs(),
+		Results:            results,
+	}
+}
+
+// sarifOriginalURIBaseIDs resolves the "%SRCROOT%" uriBaseId every result's
+// and fix's artifactLocation references to an absolute file:// URI rooted
+// at g.projectRoot, so a SARIF consumer can resolve the relative paths
+// this report emits without already knowing where the project was checked
+// out. Omitted (nil) when no project root is known.
+func (g *ReportGenerator) sarifOriginalURIBaseIDs() map[string]sarifArtifactLocation {
+	if g.projectRoot == "" {
+		return nil
+	}
+
+	root := filepath.ToSlash(g.projectRoot)
+	if !strings.HasSuffix(root, "/") {
+		root += "/"
+	}
+
+	return map[string]sarifArtifactLocation{
+		"%SRCROOT%": {URI: "file://" + root},
+	}
+}
+
+// sarifLevel maps a quality-tool severity onto the SARIF level vocabulary.
+func sarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "error", "critical", "high":
+		return "error"
+	case "warning", "medium", "moderate":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// relativeToRoot converts an absolute (or root-relative) file path to a
+// path relative to projectRoot, falling back to the original path if it
+// cannot be made relative.
+func relativeToRoot(projectRoot, file string) string {
+	if projectRoot == "" {
+		return file
+	}
+
+	rel, err := filepath.Rel(projectRoot, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return file
+	}
+
+	return rel
+}
+
+// partialFingerprint hashes the source lines surrounding line (not the
+// line number itself) so GitHub's SARIF dedup survives pure reformatting
+// that shifts line numbers without changing nearby content. Falls back to
+// a hash of the file/line when the file can't be read.
+func partialFingerprint(projectRoot, file string, line int) string {
+	path := file
+	if projectRoot != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(projectRoot, path)
+	}
+
+	context, err := surroundingLines(path, line, 2)
+	if err != nil {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", file, line)))
+		return hex.EncodeToString(hash[:])[:16]
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(context, "\n")))
+	return hex.EncodeToString(hash[:])[:16]
+}
+
+// surroundingLines reads up to `radius` lines before and after line
+// (1-based) from path.
+func surroundingLines(path string, line, radius int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	start := line - radius
+	if start < 1 {
+		start = 1
+	}
+	end := line + radius
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	current := 0
+	for scanner.Scan() {
+		current++
+		if current < start {
+			continue
+		}
+		if current > end {
+			break
+		}
+		lines = append(lines, strings.TrimRight(scanner.Text(), "\r"))
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("no lines read from %s around line %d", path, line)
+	}
+
+	return lines, nil
+}
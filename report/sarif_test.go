@@ -0,0 +1,394 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+func TestSaveSARIF_RoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	mainGo := filepath.Join(tmpDir, "main.go")
+	require.NoError(t, os.WriteFile(mainGo, []byte("package main\n\nfunc main() {\n\tx := 1\n\t_ = x\n}\n"), 0o644))
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		TotalFiles:  1,
+		Duration:    1 * time.Second,
+		ToolResults: []ToolResult{
+			{Tool: "golint", Language: "Go", Success: true, FilesProcessed: 1, IssuesFound: 1},
+		},
+		IssuesByFile: map[string][]Issue{
+			mainGo: {
+				{
+					File:     mainGo,
+					Line:     4,
+					Column:   2,
+					Severity: "error",
+					Rule:     "unused-var",
+					Message:  "Variable 'x' is unused",
+					Tool:     "golint",
+				},
+			},
+		},
+	}
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	err := generator.SaveSARIF(report, outputPath, map[string]string{"golint": "1.2.3"})
+	require.NoError(t, err)
+	assert.FileExists(t, outputPath)
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	assert.Equal(t, sarifVersion, log.Version)
+	require.Len(t, log.Runs, 1)
+
+	run := log.Runs[0]
+	assert.Equal(t, "golint", run.Tool.Driver.Name)
+	assert.Equal(t, "1.2.3", run.Tool.Driver.Version)
+	require.Len(t, run.Tool.Driver.Rules, 1)
+	assert.Equal(t, "unused-var", run.Tool.Driver.Rules[0].ID)
+	require.NotNil(t, run.Tool.Driver.Rules[0].ShortDescription)
+	assert.Equal(t, "Variable 'x' is unused", run.Tool.Driver.Rules[0].ShortDescription.Text)
+
+	require.Len(t, run.Results, 1)
+	result := run.Results[0]
+	assert.Equal(t, "unused-var", result.RuleID)
+	assert.Equal(t, "error", result.Level)
+	assert.Equal(t, "Variable 'x' is unused", result.Message.Text)
+
+	require.Len(t, result.Locations, 1)
+	loc := result.Locations[0].PhysicalLocation
+	assert.Equal(t, "main.go", loc.ArtifactLocation.URI)
+	assert.Equal(t, "%SRCROOT%", loc.ArtifactLocation.URIBaseID)
+	assert.Equal(t, 4, loc.Region.StartLine)
+	assert.Equal(t, 2, loc.Region.StartColumn)
+
+	assert.NotEmpty(t, result.PartialFingerprints["primaryLocationLineHash"])
+
+	require.Contains(t, run.OriginalURIBaseIDs, "%SRCROOT%")
+	assert.Equal(t, "file://"+filepath.ToSlash(tmpDir)+"/", run.OriginalURIBaseIDs["%SRCROOT%"].URI)
+}
+
+func TestSaveSARIF_KnownToolGetsInformationURI(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{
+			{Tool: "golangci-lint", Language: "Go", Success: true},
+			{Tool: "my-custom-script", Language: "Go", Success: true},
+		},
+	}
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	require.NoError(t, generator.SaveSARIF(report, outputPath, nil))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	byName := make(map[string]sarifRun)
+	for _, run := range log.Runs {
+		byName[run.Tool.Driver.Name] = run
+	}
+
+	assert.Equal(t, "https://golangci-lint.run", byName["golangci-lint"].Tool.Driver.InformationURI)
+	assert.Empty(t, byName["my-custom-script"].Tool.Driver.InformationURI)
+}
+
+func TestSaveSARIF_YamllintIssuesFlowThroughGenericSARIFRun(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	configYAML := filepath.Join(tmpDir, "config.yml")
+	require.NoError(t, os.WriteFile(configYAML, []byte("key:   value\n"), 0o644))
+
+	yamllint := tools.NewYamllintTool()
+	issues := yamllint.ParseOutput(configYAML + ":1:8: [warning] too many spaces after colon (colons)\n")
+	require.Len(t, issues, 1)
+
+	result := &tools.Result{Tool: "yamllint", Language: "YAML", Success: true, Issues: issues}
+	qualityReport := generator.GenerateReport([]*tools.Result{result}, time.Second, 1)
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	require.NoError(t, generator.SaveSARIF(qualityReport, outputPath, map[string]string{"yamllint": "1.35.1"}))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	assert.Equal(t, "yamllint", run.Tool.Driver.Name)
+	assert.Equal(t, "https://yamllint.readthedocs.io", run.Tool.Driver.InformationURI)
+	require.Len(t, run.Results, 1)
+	assert.Equal(t, "colons", run.Results[0].RuleID)
+	assert.Equal(t, "warning", run.Results[0].Level)
+}
+
+func TestSaveSARIF_SqlfluffAndHadolintIssuesFlowThroughGenericSARIFRun(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	sqlfluff := tools.NewSqlfluffTool()
+	sqlfluffIssues := sqlfluff.ParseOutput(`[{"filepath": "query.sql", "violations": [{"start_line_no": 3, "start_line_pos": 1, "code": "L010", "description": "Keywords must be consistently upper case.", "name": "capitalisation.keywords"}]}]`)
+	require.Len(t, sqlfluffIssues, 1)
+
+	hadolint := tools.NewHadolintTool()
+	hadolintIssues := hadolint.ParseOutput(`[{"file": "Dockerfile", "line": 1, "column": 1, "level": "warning", "code": "DL3006", "message": "Always tag the version of an image explicitly"}]`)
+	require.Len(t, hadolintIssues, 1)
+
+	results := []*tools.Result{
+		{Tool: "sqlfluff", Language: "SQL", Success: true, Issues: sqlfluffIssues},
+		{Tool: "hadolint", Language: "Dockerfile", Success: true, Issues: hadolintIssues},
+	}
+	qualityReport := generator.GenerateReport(results, time.Second, 2)
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	require.NoError(t, generator.SaveSARIF(qualityReport, outputPath, map[string]string{"sqlfluff": "2.3.5", "hadolint": "2.12.0"}))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	byName := make(map[string]sarifRun)
+	for _, run := range log.Runs {
+		byName[run.Tool.Driver.Name] = run
+	}
+	require.Contains(t, byName, "sqlfluff")
+	require.Contains(t, byName, "hadolint")
+
+	sqlfluffRun := byName["sqlfluff"]
+	assert.Equal(t, "2.3.5", sqlfluffRun.Tool.Driver.Version)
+	assert.Equal(t, "https://sqlfluff.com", sqlfluffRun.Tool.Driver.InformationURI)
+	require.Len(t, sqlfluffRun.Results, 1)
+	assert.Equal(t, "L010", sqlfluffRun.Results[0].RuleID)
+
+	hadolintRun := byName["hadolint"]
+	assert.Equal(t, "2.12.0", hadolintRun.Tool.Driver.Version)
+	assert.Equal(t, "https://github.com/hadolint/hadolint", hadolintRun.Tool.Driver.InformationURI)
+	require.Len(t, hadolintRun.Results, 1)
+	assert.Equal(t, "DL3006", hadolintRun.Results[0].RuleID)
+	assert.Equal(t, "warning", hadolintRun.Results[0].Level)
+}
+
+func TestSaveSARIF_ResultsReferenceRuleIndex(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	mainGo := filepath.Join(tmpDir, "main.go")
+	require.NoError(t, os.WriteFile(mainGo, []byte("package main\n"), 0o644))
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{
+			{Tool: "golint", Language: "Go", Success: true, FilesProcessed: 1, IssuesFound: 2},
+		},
+		IssuesByFile: map[string][]Issue{
+			mainGo: {
+				{File: mainGo, Line: 1, Severity: "warning", Rule: "unused-import", Message: "unused import", Tool: "golint"},
+				{File: mainGo, Line: 2, Severity: "error", Rule: "unreachable-code", Message: "unreachable code", Tool: "golint"},
+			},
+		},
+	}
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	require.NoError(t, generator.SaveSARIF(report, outputPath, nil))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	run := log.Runs[0]
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	require.Len(t, run.Results, 2)
+
+	// Rules are sorted by id, so "unreachable-code" sorts before
+	// "unused-import".
+	assert.Equal(t, "unreachable-code", run.Tool.Driver.Rules[0].ID)
+	assert.Equal(t, "unused-import", run.Tool.Driver.Rules[1].ID)
+
+	for _, result := range run.Results {
+		require.NotNil(t, result.RuleIndex)
+		assert.Equal(t, run.Tool.Driver.Rules[*result.RuleIndex].ID, result.RuleID)
+	}
+}
+
+func TestSaveSARIF_ToolWithNoIssuesGetsEmptyRun(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{
+			{Tool: "gofmt", Language: "Go", Success: true, FilesProcessed: 3},
+		},
+		IssuesByFile: map[string][]Issue{},
+	}
+
+	outputPath := filepath.Join(tmpDir, "clean.sarif")
+	require.NoError(t, generator.SaveSARIF(report, outputPath, nil))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	require.Len(t, log.Runs, 1)
+	assert.Equal(t, "gofmt", log.Runs[0].Tool.Driver.Name)
+	assert.Empty(t, log.Runs[0].Results)
+}
+
+func TestSaveSARIF_SuggestionBecomesFix(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	mainGo := filepath.Join(tmpDir, "main.go")
+	require.NoError(t, os.WriteFile(mainGo, []byte("package main\n"), 0o644))
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{
+			{Tool: "eslint", Language: "JavaScript", Success: true, FilesProcessed: 1, IssuesFound: 1},
+		},
+		IssuesByFile: map[string][]Issue{
+			mainGo: {
+				{File: mainGo, Line: 1, Column: 1, Severity: "error", Rule: "semi", Message: "missing semicolon", Tool: "eslint", Suggestion: "const x = 1;"},
+			},
+		},
+	}
+
+	outputPath := filepath.Join(tmpDir, "fix.sarif")
+	require.NoError(t, generator.SaveSARIF(report, outputPath, nil))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	result := log.Runs[0].Results[0]
+	require.Len(t, result.Fixes, 1)
+	require.Len(t, result.Fixes[0].ArtifactChanges, 1)
+	change := result.Fixes[0].ArtifactChanges[0]
+	assert.Equal(t, "main.go", change.ArtifactLocation.URI)
+	require.Len(t, change.Replacements, 1)
+	assert.Equal(t, "const x = 1;", change.Replacements[0].InsertedContent.Text)
+}
+
+func TestSaveSARIF_NoSuggestionMeansNoFixes(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	mainGo := filepath.Join(tmpDir, "main.go")
+	require.NoError(t, os.WriteFile(mainGo, []byte("package main\n"), 0o644))
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{
+			{Tool: "golint", Language: "Go", Success: true, FilesProcessed: 1, IssuesFound: 1},
+		},
+		IssuesByFile: map[string][]Issue{
+			mainGo: {
+				{File: mainGo, Line: 1, Severity: "warning", Rule: "unused-import", Message: "unused import", Tool: "golint"},
+			},
+		},
+	}
+
+	outputPath := filepath.Join(tmpDir, "no-fix.sarif")
+	require.NoError(t, generator.SaveSARIF(report, outputPath, nil))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	assert.Empty(t, log.Runs[0].Results[0].Fixes)
+}
+
+func TestSaveSARIF_CategoryBecomesPropertyTag(t *testing.T) {
+	tmpDir := t.TempDir()
+	generator := NewReportGenerator(tmpDir)
+
+	mainGo := filepath.Join(tmpDir, "main.go")
+	require.NoError(t, os.WriteFile(mainGo, []byte("package main\n"), 0o644))
+
+	report := &Report{
+		Timestamp:   time.Now(),
+		ProjectRoot: tmpDir,
+		ToolResults: []ToolResult{
+			{Tool: "golangci-lint", Language: "Go", Success: true, FilesProcessed: 1, IssuesFound: 2},
+		},
+		IssuesByFile: map[string][]Issue{
+			mainGo: {
+				{File: mainGo, Line: 1, Severity: "error", Rule: "gosec", Message: "G101", Tool: "golangci-lint", Category: "security"},
+				{File: mainGo, Line: 2, Severity: "error", Rule: "custom", Message: "uncategorized", Tool: "golangci-lint"},
+			},
+		},
+	}
+
+	outputPath := filepath.Join(tmpDir, "report.sarif")
+	require.NoError(t, generator.SaveSARIF(report, outputPath, nil))
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	results := log.Runs[0].Results
+	require.Len(t, results, 2)
+
+	byRule := make(map[string]sarifResult)
+	for _, r := range results {
+		byRule[r.RuleID] = r
+	}
+
+	require.NotNil(t, byRule["gosec"].Properties)
+	assert.Equal(t, []string{"security"}, byRule["gosec"].Properties.Tags)
+	assert.Nil(t, byRule["custom"].Properties)
+}
+
+func TestSarifLevel(t *testing.T) {
+	assert.Equal(t, "error", sarifLevel("error"))
+	assert.Equal(t, "error", sarifLevel("critical"))
+	assert.Equal(t, "error", sarifLevel("HIGH"))
+	assert.Equal(t, "warning", sarifLevel("warning"))
+	assert.Equal(t, "warning", sarifLevel("Medium"))
+	assert.Equal(t, "note", sarifLevel("info"))
+	assert.Equal(t, "note", sarifLevel("unknown"))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package suppress
+
+import (
+	"fmt"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// Filter applies a set of Ignores to a tool's Issues and tracks which
+// LineIgnores were actually used, so callers can flag the rest as stale
+// (a suppression that no longer matches anything, usually because the
+// issue it was added for got fixed).
+type Filter struct {
+	ignores []Ignore
+	used    map[int]bool
+}
+
+// NewFilter creates a Filter over ignores.
+func NewFilter(ignores []Ignore) *Filter {
+	return &Filter{
+		ignores: ignores,
+		used:    make(map[int]bool),
+	}
+}
+
+// Apply returns issues with every entry matched by an Ignore removed.
+func (f *Filter) Apply(toolName string, issues []tools.Issue) []tools.Issue {
+	kept := make([]tools.Issue, 0, len(issues))
+
+	for _, issue := range issues {
+		suppressed := false
+		for idx, ignore := range f.ignores {
+			if ignore.Match(toolName, issue) {
+				f.used[idx] = true
+				suppressed = true
+			}
+		}
+		if !suppressed {
+			kept = append(kept, issue)
+		}
+	}
+
+	return kept
+}
+
+// UnusedLineIgnores returns the LineIgnores (only - file/glob ignores are
+// deliberately broad and expected to sit idle for long stretches, so
+// flagging them as unused would just be noise) that never matched any
+// issue across every Apply call made so far.
+func (f *Filter) UnusedLineIgnores() []LineIgnore {
+	var unused []LineIgnore
+	for idx, ignore := range f.ignores {
+		line, ok := ignore.(LineIgnore)
+		if !ok || f.used[idx] {
+			continue
+		}
+		unused = append(unused, line)
+	}
+	return unused
+}
+
+// String renders a LineIgnore for display in an "unused ignore" diagnostic.
+func (i LineIgnore) String() string {
+	return fmt.Sprintf("%s:%d %v", i.File, i.Line, i.Checks)
+}
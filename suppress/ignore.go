@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package suppress filters tool findings against rule-level suppressions,
+// modeled after honnef.co/go/tools' lint ignores: a small set of Ignore
+// implementations (by line, by file, or by glob) that a Filter applies to
+// a QualityTool's Issues before they're reported.
+package suppress
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools"
+)
+
+// Ignore reports whether it suppresses a given issue.
+type Ignore interface {
+	Match(toolName string, issue tools.Issue) bool
+}
+
+// LineIgnore suppresses issues on one exact line of one file, e.g. from
+// an inline `// gzh:ignore <rule>` comment.
+type LineIgnore struct {
+	File   string
+	Line   int
+	Checks []string
+}
+
+// Match implements Ignore.
+func (i LineIgnore) Match(toolName string, issue tools.Issue) bool {
+	if !samePath(i.File, issue.File) || issue.Line != i.Line {
+		return false
+	}
+	return ruleMatches(i.Checks, toolName, issue.Rule)
+}
+
+// FileIgnore suppresses issues anywhere in one file, e.g. from a
+// `// gzh:ignore-file <rule>` comment at the top of the file.
+type FileIgnore struct {
+	File   string
+	Checks []string
+}
+
+// Match implements Ignore.
+func (i FileIgnore) Match(toolName string, issue tools.Issue) bool {
+	if !samePath(i.File, issue.File) {
+		return false
+	}
+	return ruleMatches(i.Checks, toolName, issue.Rule)
+}
+
+// GlobIgnore suppresses issues in any file matching a filepath.Match
+// glob, e.g. an entry in .gzh-quality-ignore covering a vendored or
+// generated directory.
+type GlobIgnore struct {
+	Pattern string
+	Checks  []string
+}
+
+// Match implements Ignore.
+func (i GlobIgnore) Match(toolName string, issue tools.Issue) bool {
+	if ok, err := filepath.Match(i.Pattern, issue.File); err != nil || !ok {
+		// A pattern without a path separator is also tried against the
+		// file's base name (e.g. "*.pb.go" matches "gen/x.pb.go"); it is
+		// not matched against the directory names on the path.
+		if base := filepath.Base(issue.File); !strings.Contains(i.Pattern, "/") {
+			if ok2, err2 := filepath.Match(i.Pattern, base); err2 != nil || !ok2 {
+				return false
+			}
+		} else {
+			return false
+		}
+	}
+	return ruleMatches(i.Checks, toolName, issue.Rule)
+}
+
+// ruleMatches reports whether rule (optionally qualified as
+// "toolName:rule") matches any of checks, each of which is a
+// filepath.Match glob. A "*" check (or an empty check list) matches
+// everything, mirroring how a bare ignore entry with no rule list is
+// meant to suppress the whole file/line regardless of rule.
+func ruleMatches(checks []string, toolName, rule string) bool {
+	if len(checks) == 0 {
+		return true
+	}
+
+	qualified := toolName + ":" + rule
+
+	for _, check := range checks {
+		if check == "*" {
+			return true
+		}
+		if ok, err := filepath.Match(check, rule); err == nil && ok {
+			return true
+		}
+		if ok, err := filepath.Match(check, qualified); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// samePath compares two file paths loosely (exact match, or one as a
+// suffix of the other) so a project-relative ignore entry still matches
+// an Issue.File reported as an absolute path, and vice versa.
+func samePath(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return strings.HasSuffix(a, "/"+b) || strings.HasSuffix(b, "/"+a)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package suppress
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// ignoreFileEntry is one entry of a .gzh-quality-ignore file. Exactly one
+// of File or Glob should be set; Checks is a list of rule globs ("*"
+// suppresses every rule).
+type ignoreFileEntry struct {
+	File   string   `yaml:"file"`
+	Glob   string   `yaml:"glob"`
+	Checks []string `yaml:"checks"`
+}
+
+// ignoreFile is the parsed form of a .gzh-quality-ignore file.
+type ignoreFile struct {
+	Ignores []ignoreFileEntry `yaml:"ignores"`
+}
+
+// LoadIgnoreFile reads a project-level .gzh-quality-ignore YAML file. A
+// missing path is not an error - it just means no ignores are configured.
+func LoadIgnoreFile(path string) ([]Ignore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ignore file %s: %w", path, err)
+	}
+
+	var parsed ignoreFile
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse ignore file %s: %w", path, err)
+	}
+
+	ignores := make([]Ignore, 0, len(parsed.Ignores))
+	for _, entry := range parsed.Ignores {
+		switch {
+		case entry.Glob != "":
+			ignores = append(ignores, GlobIgnore{Pattern: entry.Glob, Checks: entry.Checks})
+		case entry.File != "":
+			ignores = append(ignores, FileIgnore{File: entry.File, Checks: entry.Checks})
+		}
+	}
+
+	return ignores, nil
+}
+
+// inlineIgnoreRe matches a `// gzh:ignore <checks>` comment, optionally
+// with `-file` to make it file-scoped; checks are comma-separated.
+var inlineIgnoreRe = regexp.MustCompile(`//\s*gzh:ignore(-file)?\s+(\S.*)$`)
+
+// ScanInlineIgnores scans each file for `// gzh:ignore <rule>[,<rule>]`
+// (line-scoped) and `// gzh:ignore-file <rule>[,<rule>]` (file-scoped)
+// comments, e.g. `// gzh:ignore ktlint:no-wildcard-imports`. Files that
+// can't be opened are skipped rather than failing the whole scan, since
+// this runs over every file in a plan, some of which may have vanished
+// since the plan was built.
+func ScanInlineIgnores(files []string) []Ignore {
+	var ignores []Ignore
+
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(f)
+		lineNum := 0
+		for scanner.Scan() {
+			lineNum++
+			match := inlineIgnoreRe.FindStringSubmatch(scanner.Text())
+			if match == nil {
+				continue
+			}
+
+			checks := splitChecks(match[2])
+			if match[1] == "-file" {
+				ignores = append(ignores, FileIgnore{File: file, Checks: checks})
+			} else {
+				ignores = append(ignores, LineIgnore{File: file, Line: lineNum, Checks: checks})
+			}
+		}
+		_ = f.Close()
+	}
+
+	return ignores
+}
+
+// splitChecks parses the comma-separated rule list of an inline ignore
+// comment.
+func splitChecks(raw string) []string {
+	parts := strings.Split(raw, ",")
+	checks := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			checks = append(checks, trimmed)
+		}
+	}
+	return checks
+}
@@ -4,6 +4,8 @@
 package integration
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -183,6 +185,46 @@ func TestCLI_DryRun(t *testing.T) {
 	}
 }
 
+func TestCLI_Diagnose(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundlePath := filepath.Join(tmpDir, "diag.tar.gz")
+
+	cmd := exec.Command(gzQualityBinary, "diagnose", bundlePath)
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("gz-quality diagnose failed: %v\nOutput: %s", err, output)
+	}
+
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		t.Fatalf("diagnose bundle not created at %s: %v", bundlePath, err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("diagnose bundle is not gzip: %v", err)
+	}
+	defer gz.Close()
+
+	names := map[string]bool{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names[hdr.Name] = true
+	}
+
+	for _, want := range []string{"version.txt", "tools.txt", "config.yaml", "env.txt", "invocations.txt"} {
+		if !names[want] {
+			t.Errorf("expected diagnose bundle to contain %s, got entries: %v", want, names)
+		}
+	}
+}
+
 func TestCLI_InvalidCommand(t *testing.T) {
 	cmd := exec.Command(gzQualityBinary, "nonexistent")
 	output, err := cmd.CombinedOutput()
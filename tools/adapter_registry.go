@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AdapterRegistry loads third-party tool adapters - TOML (or YAML/JSON)
+// manifests describing an external tool's executable, argument
+// templates, and output parser - from a directory, registering each one
+// into target. It's the directory-scanning counterpart to
+// DefaultRegistry.RegisterFromManifest: a user drops a file under e.g.
+// ~/.gzquality/adapters.d/ to add a whole new QualityTool with no Go
+// code, and every adapter then participates in the same matchesToolType
+// filtering, caching, and execution as a hand-written tool, since it's
+// still just a ManifestTool satisfying QualityTool.
+type AdapterRegistry struct {
+	target *DefaultRegistry
+}
+
+// NewAdapterRegistry creates an AdapterRegistry that registers loaded
+// adapters into target.
+func NewAdapterRegistry(target *DefaultRegistry) *AdapterRegistry {
+	return &AdapterRegistry{target: target}
+}
+
+// LoadDir registers every *.toml, *.yaml, *.yml, and *.manifest.json
+// manifest found directly under dir (non-recursive, matching
+// RegistryLoader's own registry.d layout). Plain *.json is deliberately
+// not treated as a manifest: an adapters.d directory is a natural place
+// for unrelated JSON (golden fixtures, tool output dumps) to sit
+// alongside real adapters, and a bare *.json glob would try to parse all
+// of it as a ToolManifest. A missing dir is not an error - an adapters
+// directory is optional. A single malformed adapter doesn't stop the
+// rest of the directory from loading; its error is collected and
+// returned alongside any others once the whole directory has been
+// walked.
+func (a *AdapterRegistry) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read adapter directory %s: %w", dir, err)
+	}
+
+	var errs []string
+	for _, entry := range entries {
+		if entry.IsDir() || !isAdapterManifest(entry.Name()) {
+			continue
+		}
+
+		if err := a.target.RegisterFromManifest(filepath.Join(dir, entry.Name())); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to load %d adapter(s):\n  %s", len(errs), strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// isAdapterManifest reports whether name is one AdapterRegistry.LoadDir
+// recognizes as an adapter manifest: *.toml, *.yaml, *.yml outright, or
+// *.manifest.json for the JSON form (see LoadDir's doc comment for why
+// plain *.json doesn't qualify).
+func isAdapterManifest(name string) bool {
+	lower := strings.ToLower(name)
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".toml", ".yaml", ".yml":
+		return true
+	case ".json":
+		return strings.HasSuffix(lower, ".manifest.json")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// AnalyzerToolConfig configures an AnalyzerTool run, similar to nogo's
+// only_files/exclude_files and per-check flag overrides.
+type AnalyzerToolConfig struct {
+	// Flags maps an analyzer name to the flag arguments it should be
+	// parsed with via its own flag.FlagSet, e.g. {"printf": {"-funcs=Infof"}}.
+	Flags map[string][]string `yaml:"flags"`
+
+	// Exclude is a list of filepath.Match globs; files matching any of
+	// them are dropped before packages.Load sees them.
+	Exclude []string `yaml:"exclude"`
+}
+
+// AnalyzerTool wraps one or more golang.org/x/tools/go/analysis.Analyzer
+// values as a QualityTool, running them in-process against loaded
+// packages instead of shelling out - the bazel nogo model of plugging
+// project-specific checks into the normal lint pipeline without a
+// separate binary.
+type AnalyzerTool struct {
+	name      string
+	analyzers []*analysis.Analyzer
+	config    AnalyzerToolConfig
+}
+
+// NewAnalyzerTool creates an AnalyzerTool named name that runs analyzers
+// (and whatever they Require) against every package containing the files
+// passed to Execute.
+func NewAnalyzerTool(name string, analyzers ...*analysis.Analyzer) *AnalyzerTool {
+	return &AnalyzerTool{
+		name:      name,
+		analyzers: analyzers,
+	}
+}
+
+// SetConfig installs per-analyzer flags and exclude globs.
+func (t *AnalyzerTool) SetConfig(config AnalyzerToolConfig) {
+	t.config = config
+}
+
+// Name returns the tool name given to NewAnalyzerTool.
+func (t *AnalyzerTool) Name() string {
+	return t.name
+}
+
+// Language always reports Go, since go/analysis only operates on Go
+// source.
+func (t *AnalyzerTool) Language() string {
+	return "Go"
+}
+
+// Type reports LINT - analyzers diagnose, they don't reformat source.
+func (t *AnalyzerTool) Type() ToolType {
+	return LINT
+}
+
+// IsAvailable is always true: the analyzers are compiled into this
+// binary, so there's nothing external to detect.
+func (t *AnalyzerTool) IsAvailable() bool {
+	return true
+}
+
+// Install is a no-op; there is no external binary to install.
+func (t *AnalyzerTool) Install(ctx context.Context) error {
+	return nil
+}
+
+// GetVersion reports that the analyzers run in-process rather than a
+// version string from an external binary.
+func (t *AnalyzerTool) GetVersion() (string, error) {
+	return "in-process", nil
+}
+
+// Upgrade is a no-op; upgrading means recompiling this binary against a
+// newer analyzer, not a runtime action.
+func (t *AnalyzerTool) Upgrade(ctx context.Context) error {
+	return nil
+}
+
+// FindConfigFiles returns nil: AnalyzerTool is configured via
+// AnalyzerToolConfig, not an auto-discovered file.
+func (t *AnalyzerTool) FindConfigFiles(projectRoot string) []string {
+	return nil
+}
+
+// SupportedExtensions returns the Go source extension, since AnalyzerTool
+// always loads and analyzes Go packages.
+func (t *AnalyzerTool) SupportedExtensions() []string {
+	return []string{".go"}
+}
+
+// Execute loads the packages containing files (after applying
+// config.Exclude) via go/packages with enough detail for type-checked
+// analysis, then runs each configured analyzer - and whatever analyzers
+// it Requires - against every loaded package, translating each
+// analysis.Diagnostic into an Issue.
+func (t *AnalyzerTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	result := &Result{
+		Tool:     t.name,
+		Language: t.Language(),
+	}
+
+	goFiles := t.filterFiles(FilterFilesByExtensions(files, []string{".go"}))
+	if len(goFiles) == 0 {
+		result.Success = true
+		return result, nil
+	}
+
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedDeps | packages.NeedTypesSizes,
+		Dir:     options.ProjectRoot,
+	}
+
+	pkgs, err := packages.Load(cfg, goFiles...)
+	if err != nil {
+		result.Error = fmt.Errorf("failed to load packages: %w", err)
+		return result, fmt.Errorf("analyzer tool %s: failed to load packages: %w", t.name, err)
+	}
+
+	var issues []Issue
+	for _, pkg := range pkgs {
+		for _, diagErr := range pkg.Errors {
+			result.Error = diagErr
+		}
+
+		for _, analyzer := range t.analyzers {
+			state := &analyzerState{results: make(map[*analysis.Analyzer]interface{})}
+
+			diags, err := t.runAnalyzer(analyzer, pkg, state)
+			if err != nil {
+				return result, fmt.Errorf("analyzer tool %s: %w", t.name, err)
+			}
+
+			for _, diag := range diags {
+				issues = append(issues, t.toIssue(pkg, analyzer, diag))
+			}
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		return issues[i].Line < issues[j].Line
+	})
+
+	result.Success = true
+	result.FilesProcessed = len(goFiles)
+	result.Issues = issues
+
+	return result, nil
+}
+
+// filterFiles drops any file matching one of config.Exclude's globs,
+// tested against both the full path and the base name (as suppress's
+// GlobIgnore does for the same reason: globs are usually written against
+// a bare filename like "*_test.go").
+func (t *AnalyzerTool) filterFiles(files []string) []string {
+	if len(t.config.Exclude) == 0 {
+		return files
+	}
+
+	kept := make([]string, 0, len(files))
+	for _, file := range files {
+		if t.excluded(file) {
+			continue
+		}
+		kept = append(kept, file)
+	}
+
+	return kept
+}
+
+func (t *AnalyzerTool) excluded(file string) bool {
+	base := filepath.Base(file)
+	for _, pattern := range t.config.Exclude {
+		if ok, err := filepath.Match(pattern, file); err == nil && ok {
+			return true
+		}
+		if ok, err := filepath.Match(pattern, base); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzerState carries the running set of already-executed analyzers
+// (and their results) across a package's analyzer.Requires graph, so a
+// dependency shared by two requested analyzers only runs once.
+type analyzerState struct {
+	results map[*analysis.Analyzer]interface{}
+}
+
+// runAnalyzer runs analyzer (after first running whatever it Requires)
+// against pkg, returning the diagnostics it reported. Already-run
+// analyzers are skipped and return no diagnostics of their own - callers
+// only see an analyzer's diagnostics from the one call that actually ran
+// it.
+func (t *AnalyzerTool) runAnalyzer(analyzer *analysis.Analyzer, pkg *packages.Package, state *analyzerState) ([]analysis.Diagnostic, error) {
+	if _, done := state.results[analyzer]; done {
+		return nil, nil
+	}
+
+	for _, req := range analyzer.Requires {
+		if _, err := t.runAnalyzer(req, pkg, state); err != nil {
+			return nil, err
+		}
+	}
+
+	if flagArgs := t.config.Flags[analyzer.Name]; len(flagArgs) > 0 {
+		if err := analyzer.Flags.Parse(flagArgs); err != nil {
+			return nil, fmt.Errorf("analyzer %s: invalid flags %v: %w", analyzer.Name, flagArgs, err)
+		}
+	}
+
+	var diags []analysis.Diagnostic
+	pass := &analysis.Pass{
+		Analyzer:   analyzer,
+		Fset:       pkg.Fset,
+		Files:      pkg.Syntax,
+		OtherFiles: pkg.OtherFiles,
+		Pkg:        pkg.Types,
+		TypesInfo:  pkg.TypesInfo,
+		TypesSizes: pkg.TypesSizes,
+		ResultOf:   state.results,
+		Report: func(d analysis.Diagnostic) {
+			diags = append(diags, d)
+		},
+	}
+
+	res, err := analyzer.Run(pass)
+	if err != nil {
+		return nil, fmt.Errorf("analyzer %s failed on %s: %w", analyzer.Name, pkg.PkgPath, err)
+	}
+
+	state.results[analyzer] = res
+	return diags, nil
+}
+
+// toIssue translates an analysis.Diagnostic into an Issue, resolving
+// Diag.Pos through pkg.Fset and flattening the first SuggestedFix's
+// TextEdits (if any) into Issue.Suggestion.
+func (t *AnalyzerTool) toIssue(pkg *packages.Package, analyzer *analysis.Analyzer, diag analysis.Diagnostic) Issue {
+	position := pkg.Fset.Position(diag.Pos)
+
+	issue := Issue{
+		File:     position.Filename,
+		Line:     position.Line,
+		Column:   position.Column,
+		Severity: "warning",
+		Rule:     analyzer.Name,
+		Message:  diag.Message,
+	}
+
+	if len(diag.SuggestedFixes) > 0 {
+		issue.Suggestion = t.renderSuggestedFix(pkg, diag.SuggestedFixes[0])
+	}
+
+	return issue
+}
+
+// renderSuggestedFix concatenates a SuggestedFix's TextEdits' replacement
+// text, in file order, as a best-effort stand-in for rendering a real
+// diff/patch - good enough for Issue.Suggestion's free-form display, which
+// every tool populates this way (e.g. GoTool's go vet -fix output,
+// RustTool's clippy spans).
+func (t *AnalyzerTool) renderSuggestedFix(pkg *packages.Package, fix analysis.SuggestedFix) string {
+	edits := append([]analysis.TextEdit{}, fix.TextEdits...)
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Pos < edits[j].Pos })
+
+	parts := make([]string, 0, len(edits))
+	for _, edit := range edits {
+		parts = append(parts, string(edit.NewText))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis/passes/unreachable"
+)
+
+func TestNewAnalyzerTool(t *testing.T) {
+	tool := NewAnalyzerTool("myanalyzers", unreachable.Analyzer)
+
+	assert.Equal(t, "myanalyzers", tool.Name())
+	assert.Equal(t, "Go", tool.Language())
+	assert.Equal(t, LINT, tool.Type())
+	assert.True(t, tool.IsAvailable())
+	assert.NoError(t, tool.Install(context.Background()))
+	assert.NoError(t, tool.Upgrade(context.Background()))
+
+	version, err := tool.GetVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "in-process", version)
+
+	assert.Nil(t, tool.FindConfigFiles(t.TempDir()))
+}
+
+func TestAnalyzerTool_Execute_FindsDiagnostic(t *testing.T) {
+	dir := t.TempDir()
+	src := `package main
+
+func main() {
+	return
+	println("unreachable")
+}
+`
+	file := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(file, []byte(src), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/m\n\ngo 1.21\n"), 0o644))
+
+	tool := NewAnalyzerTool("myanalyzers", unreachable.Analyzer)
+
+	result, err := tool.Execute(context.Background(), []string{file}, ExecuteOptions{ProjectRoot: dir})
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "unreachable", result.Issues[0].Rule)
+	assert.Equal(t, 5, result.Issues[0].Line)
+}
+
+func TestAnalyzerTool_Execute_NoGoFiles(t *testing.T) {
+	tool := NewAnalyzerTool("myanalyzers", unreachable.Analyzer)
+
+	result, err := tool.Execute(context.Background(), []string{"README.md"}, ExecuteOptions{ProjectRoot: t.TempDir()})
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Empty(t, result.Issues)
+}
+
+func TestAnalyzerTool_Excludes(t *testing.T) {
+	dir := t.TempDir()
+	src := `package main
+
+func main() {
+	return
+	println("unreachable")
+}
+`
+	file := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(file, []byte(src), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/m\n\ngo 1.21\n"), 0o644))
+
+	tool := NewAnalyzerTool("myanalyzers", unreachable.Analyzer)
+	tool.SetConfig(AnalyzerToolConfig{Exclude: []string{"*_file.go", "main.go"}})
+
+	result, err := tool.Execute(context.Background(), []string{file}, ExecuteOptions{ProjectRoot: dir})
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Empty(t, result.Issues)
+}
@@ -4,25 +4,82 @@
 package tools
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/internal/logger"
+	"github.com/Gizzahub/gzh-cli-quality/tools/cmdobj"
+	"github.com/Gizzahub/gzh-cli-quality/tools/diffscope"
 )
 
+// log emits structured execution records for every BaseTool-based tool
+// (GofumptTool, GoimportsTool, GolangciLintTool, and the rest - none of
+// them override ExecuteCommand/Execute) at Debug level: command
+// construction, execution timing, exit code, and parsed issue counts.
+// Quiet by default (see logger.New's LevelInfo default), so this costs
+// nothing unless a caller raises the level via SetLogLevel or points it
+// at a sink via SetLogOutput.
+var log = logger.New("tools")
+
+// SetLogOutput points the tools package's structured logger at w, e.g. a
+// rotating logger.FileSink, so a long batch run can persist execution
+// records to disk instead of (or as well as) stderr.
+func SetLogOutput(w io.Writer) {
+	log.SetOutput(w)
+}
+
+// SetLogLevel sets the tools package's structured logger's minimum
+// level. Raise it to logger.LevelDebug to actually see the records
+// ExecuteCommand/Execute emit - they're Debug-level, so SetLogOutput
+// alone doesn't make them visible.
+func SetLogLevel(level logger.Level) {
+	log.SetLevel(level)
+}
+
 // BaseTool provides common functionality for quality tools.
 type BaseTool struct {
-	name           string
-	language       string
-	toolType       ToolType
-	executable     string
-	installCmd     []string
-	configPatterns []string
+	name                string
+	language            string
+	toolType            ToolType
+	executable          string
+	installCmd          []string
+	configPatterns      []string
+	supportedExtensions []string
+	runner              cmdobj.Runner
+
+	// maxFilesPerInvocation caps how many files Execute passes to a
+	// single BuildCommand/ExecuteCommand call. Zero (the default) means
+	// unbounded. Set via SetMaxFilesPerInvocation for tools like
+	// google-java-format and checkstyle that take their whole file list
+	// on argv, so a large changeset doesn't blow past the OS's ARG_MAX.
+	maxFilesPerInvocation int
+
+	// outputFormat selects which of the tool's own machine-readable
+	// output shapes ParseOutput should consume, for tools that support
+	// more than scraping their default text output. Empty means
+	// OutputFormatText. Set via SetOutputFormat.
+	outputFormat OutputFormat
 }
 
+// OutputFormat is one of the machine-parseable output shapes a tool can
+// be asked to emit via SetOutputFormat, on top of its default text
+// output.
+type OutputFormat string
+
+const (
+	OutputFormatText  OutputFormat = "text"
+	OutputFormatJSON  OutputFormat = "json"
+	OutputFormatSARIF OutputFormat = "sarif"
+)
+
 // NewBaseTool creates a new base tool.
 func NewBaseTool(name, language, executable string, toolType ToolType) *BaseTool {
 	return &BaseTool{
@@ -30,9 +87,18 @@ func NewBaseTool(name, language, executable string, toolType ToolType) *BaseTool
 		language:   language,
 		toolType:   toolType,
 		executable: executable,
+		runner:     cmdobj.Chain(cmdobj.NewOSRunner(), WithHistory(globalHistory)),
 	}
 }
 
+// SetRunner overrides the cmdobj.Runner used to execute the tool's
+// command. Tests swap in a cmdobj.FakeRunner to exercise BuildCommand's
+// argv without touching the real binary; --explain swaps in a
+// cmdobj.ExplainRunner to print the planned argv instead of running it.
+func (t *BaseTool) SetRunner(runner cmdobj.Runner) {
+	t.runner = runner
+}
+
 // Name returns the tool name.
 func (t *BaseTool) Name() string {
 	return t.name
@@ -54,21 +120,64 @@ func (t *BaseTool) IsAvailable() bool {
 	return err == nil
 }
 
+// BinaryPath resolves the tool's executable via PATH, for callers (e.g.
+// `quality watch`'s tool-binary mtime check) that need to stat it rather
+// than just confirm it exists. It satisfies BinaryPathCapable.
+func (t *BaseTool) BinaryPath() (string, bool) {
+	path, err := exec.LookPath(t.executable)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
 // SetInstallCommand sets the command to install this tool.
 func (t *BaseTool) SetInstallCommand(cmd []string) {
 	t.installCmd = cmd
 }
 
-// Install attempts to install the tool automatically.
-func (t *BaseTool) Install() error {
+// Install attempts to install the tool automatically. The install command
+// runs under ctx so callers can cancel an in-flight download/build.
+func (t *BaseTool) Install(ctx context.Context) error {
+	return t.InstallWithProgress(ctx, func(string) {})
+}
+
+// InstallWithProgress behaves like Install, but streams the install
+// command's combined stdout/stderr to onLine as each line arrives,
+// instead of only reporting output once the process exits. This is what
+// lets InstallSet show a live "downloading…"/"building…" line per tool
+// rather than a silent multi-minute wait.
+func (t *BaseTool) InstallWithProgress(ctx context.Context, onLine func(line string)) error {
 	if len(t.installCmd) == 0 {
 		return fmt.Errorf("no install command configured for %s", t.name)
 	}
 
-	cmd := exec.Command(t.installCmd[0], t.installCmd[1:]...)
-	output, err := cmd.CombinedOutput()
+	cmd := exec.CommandContext(ctx, t.installCmd[0], t.installCmd[1:]...)
+
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return fmt.Errorf("failed to install %s: %w\nOutput: %s", t.name, err, string(output))
+		return fmt.Errorf("failed to install %s: %w", t.name, err)
+	}
+	cmd.Stderr = cmd.Stdout
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to install %s: %w", t.name, err)
+	}
+
+	var output strings.Builder
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := scanner.Text()
+		output.WriteString(line)
+		output.WriteByte('\n')
+		onLine(line)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("install of %s canceled: %w", t.name, ctx.Err())
+		}
+		return fmt.Errorf("failed to install %s: %w\nOutput: %s", t.name, err, output.String())
 	}
 
 	return nil
@@ -98,13 +207,13 @@ func (t *BaseTool) GetVersion() (string, error) {
 }
 
 // Upgrade attempts to upgrade the tool to the latest version.
-func (t *BaseTool) Upgrade() error {
+func (t *BaseTool) Upgrade(ctx context.Context) error {
 	if !t.IsAvailable() {
 		return fmt.Errorf("tool %s is not installed, use Install() instead", t.name)
 	}
 
 	// For most tools, upgrade is the same as install
-	return t.Install()
+	return t.Install(ctx)
 }
 
 // SetConfigPatterns sets the configuration file patterns to search for.
@@ -112,6 +221,51 @@ func (t *BaseTool) SetConfigPatterns(patterns []string) {
 	t.configPatterns = patterns
 }
 
+// SetSupportedExtensions sets the file extensions (e.g. ".go", ".py")
+// this tool applies to, for Registry.DetectApplicableTools to match
+// against a project's files.
+func (t *BaseTool) SetSupportedExtensions(extensions []string) {
+	t.supportedExtensions = extensions
+}
+
+// SupportedExtensions returns the file extensions this tool applies to.
+func (t *BaseTool) SupportedExtensions() []string {
+	return t.supportedExtensions
+}
+
+// SetOutputFormat selects which output shape BuildCommand/ParseOutput
+// should target: "text" (the default), "json", or "sarif". Returns an
+// error for any other value. A tool that doesn't support a given format
+// can override this to reject it; BaseTool itself just records the
+// choice for the embedding tool's BuildCommand/ParseOutput to consult
+// via OutputFormat.
+func (t *BaseTool) SetOutputFormat(format string) error {
+	switch OutputFormat(format) {
+	case OutputFormatText, OutputFormatJSON, OutputFormatSARIF:
+		t.outputFormat = OutputFormat(format)
+		return nil
+	default:
+		return fmt.Errorf("tool %s: unsupported output format %q (want text, json, or sarif)", t.name, format)
+	}
+}
+
+// OutputFormat returns the format SetOutputFormat last set, defaulting
+// to "text".
+func (t *BaseTool) OutputFormat() string {
+	if t.outputFormat == "" {
+		return string(OutputFormatText)
+	}
+	return string(t.outputFormat)
+}
+
+// SetMaxFilesPerInvocation sets the maximum number of files Execute will
+// pass to a single invocation of this tool's command, splitting a larger
+// file set into sequential batches merged back into one Result. n <= 0
+// means unbounded (the default).
+func (t *BaseTool) SetMaxFilesPerInvocation(n int) {
+	t.maxFilesPerInvocation = n
+}
+
 // FindConfigFiles returns configuration files the tool would use.
 func (t *BaseTool) FindConfigFiles(projectRoot string) []string {
 	var configs []string
@@ -126,7 +280,12 @@ func (t *BaseTool) FindConfigFiles(projectRoot string) []string {
 	return configs
 }
 
-// ExecuteCommand runs a command and returns the result.
+// ExecuteCommand runs cmd (as built by BuildCommand) via the tool's
+// cmdobj.Runner and returns the result. Routing through the runner here,
+// rather than calling cmd.CombinedOutput() directly, is what makes
+// SetRunner's FakeRunner/ExplainRunner swaps apply uniformly to every
+// tool that goes through BaseTool, without each tool's BuildCommand
+// override needing to know about cmdobj at all.
 func (t *BaseTool) ExecuteCommand(ctx context.Context, cmd *exec.Cmd, files []string) (*Result, error) {
 	startTime := time.Now()
 
@@ -137,12 +296,22 @@ func (t *BaseTool) ExecuteCommand(ctx context.Context, cmd *exec.Cmd, files []st
 		Issues:   []Issue{},
 	}
 
-	output, err := cmd.CombinedOutput()
+	toolLog := log.WithFields(map[string]interface{}{"tool": t.name, "phase": "execute"})
+	toolLog.Debug("running %s", strings.Join(cmd.Args, " "))
+
+	output, err := t.runner.RunWithOutput(ctx, cmdObjFromExecCmd(cmd))
 	duration := time.Since(startTime)
 	result.Duration = duration.String()
-	result.Output = string(output)
+	result.Output = output
 	result.FilesProcessed = len(files)
 
+	exitCode := exitCodeFromError(err)
+	toolLog.WithFields(map[string]interface{}{
+		"duration_ms": duration.Milliseconds(),
+		"exit_code":   exitCode,
+		"files":       len(files),
+	}).Debug("execution finished")
+
 	if err != nil {
 		result.Error = err
 		return result, nil //nolint:nilerr // 오류를 결과에 캡처하여 반환하므로 에러는 무시
@@ -152,6 +321,39 @@ func (t *BaseTool) ExecuteCommand(ctx context.Context, cmd *exec.Cmd, files []st
 	return result, nil
 }
 
+// exitCodeFromError extracts a process exit code from err, the way a
+// caller that wants to log/compare it would: 0 for a nil err (success),
+// the process's own code for an *exec.ExitError, or -1 for any other
+// error (e.g. the executable wasn't found).
+func exitCodeFromError(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
+
+// cmdObjFromExecCmd converts an *exec.Cmd built by a tool's BuildCommand
+// into the equivalent cmdobj.CmdObj, carrying over argv, working
+// directory, and environment losslessly via RawEnv (cmd.Env already
+// merges options.Env on top of os.Environ(), so there's nothing left to
+// reconstruct).
+func cmdObjFromExecCmd(cmd *exec.Cmd) *cmdobj.CmdObj {
+	c := cmdobj.NewBuilder().New(cmd.Path, cmd.Args[1:]...)
+	if cmd.Dir != "" {
+		c = c.WithDir(cmd.Dir)
+	}
+	if cmd.Env != nil {
+		c.RawEnv = cmd.Env
+	}
+	return c
+}
+
 // ParseOutput parses tool output into issues (to be implemented by specific tools).
 func (t *BaseTool) ParseOutput(output string) []Issue {
 	// Default implementation returns empty slice
@@ -159,6 +361,32 @@ func (t *BaseTool) ParseOutput(output string) []Issue {
 	return []Issue{}
 }
 
+// parseIssues turns output into Issues, preferring the parser
+// RegisterParser has associated with t.outputFormat over t.ParseOutput -
+// which, called from here, is always BaseTool's own empty default, never
+// an embedding tool's override (Go's embedding promotes BaseTool.Execute
+// as-is; it has no way to call back into the outer struct). Declaring a
+// format via SetOutputFormat is what lets a tool that doesn't write its
+// own Execute still get real Issues out of a failed run. sarifReport is
+// the raw output when it was successfully parsed as OutputFormatSARIF,
+// for re-emitting an aggregated SARIF report later; nil otherwise.
+func (t *BaseTool) parseIssues(output string) (issues []Issue, sarifReport []byte) {
+	parser, ok := ParserFor(t.outputFormat)
+	if !ok {
+		return t.ParseOutput(output), nil
+	}
+
+	parsed, err := parser([]byte(output))
+	if err != nil {
+		return t.ParseOutput(output), nil
+	}
+
+	if t.outputFormat == OutputFormatSARIF {
+		sarifReport = []byte(output)
+	}
+	return parsed, sarifReport
+}
+
 // BuildCommand builds the command to execute (to be implemented by specific tools).
 func (t *BaseTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
 	// Default implementation - specific tools should override
@@ -186,7 +414,10 @@ func (t *BaseTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cm
 	return cmd
 }
 
-// Execute runs the tool on the specified files.
+// Execute runs the tool on the specified files, batching into at most
+// maxFilesPerInvocation files per invocation (see
+// SetMaxFilesPerInvocation) and merging the batches' results back into
+// one before applying ApplyDiffScope.
 func (t *BaseTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
 	if !t.IsAvailable() {
 		return &Result{
@@ -197,20 +428,86 @@ func (t *BaseTool) Execute(ctx context.Context, files []string, options ExecuteO
 		}, nil
 	}
 
-	cmd := t.BuildCommand(files, options)
-	result, err := t.ExecuteCommand(ctx, cmd, files)
-	if err != nil {
-		return result, err
+	batches := batchFiles(files, t.maxFilesPerInvocation)
+	results := make([]*Result, 0, len(batches))
+
+	for _, batch := range batches {
+		cmd := t.BuildCommand(batch, options)
+		result, err := t.ExecuteCommand(ctx, cmd, batch)
+		if err != nil {
+			return result, err
+		}
+
+		// Parse output for issues if the tool failed
+		if !result.Success {
+			result.Issues, result.SARIFReport = t.parseIssues(result.Output)
+			log.WithFields(map[string]interface{}{
+				"tool": t.name, "phase": "parse", "issues": len(result.Issues),
+			}).Debug("parsed issues from output")
+		}
+
+		results = append(results, result)
 	}
 
-	// Parse output for issues if the tool failed
-	if !result.Success {
-		result.Issues = t.ParseOutput(result.Output)
+	result := mergeResults(results)
+
+	if err := ApplyDiffScope(ctx, result, options); err != nil {
+		return result, err
 	}
 
 	return result, nil
 }
 
+// ApplyDiffScope restricts result.Issues to those whose Line falls
+// within a changed hunk, letting any tool's Execute opt into PR-scoped
+// filtering without itself knowing about git. It prefers
+// options.ChangedLines when set (a hunk set some caller already
+// computed once, e.g. via git.GitUtils.GetChangedHunks, shared across
+// every tool's run), falling back to computing one itself with
+// diffscope.Compute against options.DiffBase. A no-op when neither is
+// set or there are no issues to filter.
+func ApplyDiffScope(ctx context.Context, result *Result, options ExecuteOptions) error {
+	if result == nil || len(result.Issues) == 0 {
+		return nil
+	}
+
+	hunks := diffscope.HunkSet(options.ChangedLines)
+	if hunks == nil {
+		if options.DiffBase == "" {
+			return nil
+		}
+
+		var err error
+		hunks, err = diffscope.Compute(ctx, options.ProjectRoot, options.DiffBase, issueFiles(result.Issues))
+		if err != nil {
+			return fmt.Errorf("failed to compute diff scope against %s: %w", options.DiffBase, err)
+		}
+	}
+
+	scoped := result.Issues[:0]
+	for _, issue := range result.Issues {
+		if hunks.Contains(issue.File, issue.Line) {
+			scoped = append(scoped, issue)
+		}
+	}
+	result.Issues = scoped
+
+	return nil
+}
+
+// issueFiles returns the distinct File values across issues, in first-seen order.
+func issueFiles(issues []Issue) []string {
+	seen := make(map[string]bool, len(issues))
+	var files []string
+	for _, issue := range issues {
+		if !seen[issue.File] {
+			seen[issue.File] = true
+			files = append(files, issue.File)
+		}
+	}
+	return files
+}
+
 // FilterFilesByExtensions filters files by supported extensions.
 func FilterFilesByExtensions(files, extensions []string) []string {
 	var filtered []string
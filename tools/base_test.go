@@ -4,10 +4,13 @@
 package tools
 
 import (
+	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 
+	"github.com/Gizzahub/gzh-cli-quality/tools/diffscope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,7 +78,7 @@ func TestBaseTool_SetInstallCommand(t *testing.T) {
 func TestBaseTool_Install_NoCommand(t *testing.T) {
 	tool := NewBaseTool("test", "Go", "test", FORMAT)
 
-	err := tool.Install()
+	err := tool.Install(context.Background())
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no install command configured")
@@ -87,7 +90,7 @@ func TestBaseTool_Install_WithCommand(t *testing.T) {
 	// Use a harmless command that will succeed
 	tool.SetInstallCommand([]string{"echo", "test install"})
 
-	err := tool.Install()
+	err := tool.Install(context.Background())
 
 	assert.NoError(t, err)
 }
@@ -98,12 +101,39 @@ func TestBaseTool_Install_FailedCommand(t *testing.T) {
 	// Use a command that will fail
 	tool.SetInstallCommand([]string{"false"})
 
-	err := tool.Install()
+	err := tool.Install(context.Background())
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to install")
 }
 
+func TestBaseTool_InstallWithProgress_StreamsLines(t *testing.T) {
+	tool := NewBaseTool("test", "Go", "test", FORMAT)
+	tool.SetInstallCommand([]string{"printf", "line1\nline2\n"})
+
+	var lines []string
+	err := tool.InstallWithProgress(context.Background(), func(line string) {
+		lines = append(lines, line)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line1", "line2"}, lines)
+}
+
+func TestBaseTool_InstallWithProgress_FailedCommandIncludesOutput(t *testing.T) {
+	tool := NewBaseTool("test", "Go", "test", FORMAT)
+	tool.SetInstallCommand([]string{"sh", "-c", "echo boom; exit 1"})
+
+	var lines []string
+	err := tool.InstallWithProgress(context.Background(), func(line string) {
+		lines = append(lines, line)
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to install")
+	assert.Contains(t, lines, "boom")
+}
+
 func TestBaseTool_GetVersion_NotInstalled(t *testing.T) {
 	tool := NewBaseTool("nonexistent", "Go", "nonexistent-tool-12345", FORMAT)
 
@@ -128,7 +158,7 @@ func TestBaseTool_GetVersion_Installed(t *testing.T) {
 func TestBaseTool_Upgrade_NoCommand(t *testing.T) {
 	tool := NewBaseTool("nonexistent-test", "Go", "nonexistent-tool-99999", FORMAT)
 
-	err := tool.Upgrade()
+	err := tool.Upgrade(context.Background())
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "is not installed")
@@ -140,7 +170,7 @@ func TestBaseTool_Upgrade_WithCommand(t *testing.T) {
 	// Set both install and upgrade commands
 	tool.SetInstallCommand([]string{"echo", "upgrade"})
 
-	err := tool.Upgrade()
+	err := tool.Upgrade(context.Background())
 
 	assert.NoError(t, err)
 }
@@ -318,3 +348,74 @@ func splitEnv(env string) []string {
 	}
 	return []string{env}
 }
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+}
+
+func TestApplyDiffScope_FiltersToChangedLines(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	runGit(t, dir, "config", "user.name", "test")
+
+	file := filepath.Join(dir, "main.cpp")
+	require.NoError(t, os.WriteFile(file, []byte("int main() {\n  return 0;\n}\n"), 0o644))
+	runGit(t, dir, "add", "main.cpp")
+	runGit(t, dir, "commit", "-q", "-m", "initial")
+
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	base := string(out[:len(out)-1])
+
+	require.NoError(t, os.WriteFile(file, []byte("int main() {\n  int x = 1;\n  return 0;\n}\n"), 0o644))
+
+	result := &Result{
+		Issues: []Issue{
+			{File: "main.cpp", Line: 1, Rule: "unchanged-line"},
+			{File: "main.cpp", Line: 2, Rule: "added-line"},
+		},
+	}
+
+	err = ApplyDiffScope(context.Background(), result, ExecuteOptions{ProjectRoot: dir, DiffBase: base})
+	require.NoError(t, err)
+
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "added-line", result.Issues[0].Rule)
+}
+
+func TestApplyDiffScope_NoOpWithoutDiffBase(t *testing.T) {
+	result := &Result{Issues: []Issue{{File: "main.cpp", Line: 1}}}
+
+	err := ApplyDiffScope(context.Background(), result, ExecuteOptions{})
+	require.NoError(t, err)
+	assert.Len(t, result.Issues, 1)
+}
+
+func TestApplyDiffScope_PrefersPrecomputedChangedLines(t *testing.T) {
+	result := &Result{
+		Issues: []Issue{
+			{File: "main.cpp", Line: 1, Rule: "unchanged-line"},
+			{File: "main.cpp", Line: 2, Rule: "added-line"},
+		},
+	}
+
+	// DiffBase is also set, but ChangedLines should win without ever
+	// shelling out to git.
+	err := ApplyDiffScope(context.Background(), result, ExecuteOptions{
+		DiffBase:     "HEAD~1",
+		ChangedLines: map[string][]diffscope.LineRange{"main.cpp": {{Start: 2, End: 2}}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "added-line", result.Issues[0].Rule)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// batchFiles splits files into consecutive chunks of at most size files
+// each, preserving order. size <= 0 disables batching (a single chunk
+// containing every file).
+func batchFiles(files []string, size int) [][]string {
+	if size <= 0 || len(files) <= size {
+		return [][]string{files}
+	}
+
+	batches := make([][]string, 0, len(files)/size+1)
+	for start := 0; start < len(files); start += size {
+		end := start + size
+		if end > len(files) {
+			end = len(files)
+		}
+		batches = append(batches, files[start:end])
+	}
+	return batches
+}
+
+// mergeResults combines one *Result per batch (as produced by running the
+// same tool invocation against disjoint file batches) into a single
+// *Result: FilesProcessed and Issues are summed/concatenated, Output is
+// joined with a blank line between batches, and Success is false if any
+// batch failed. Errors from failed batches are joined with errors.Join so
+// every batch's failure is still reachable via errors.Is/errors.As.
+// Issues are sorted by (File, Line, Column, Rule) so merging doesn't
+// depend on which batch happened to run first.
+func mergeResults(batches []*Result) *Result {
+	merged := &Result{
+		Tool:     batches[0].Tool,
+		Language: batches[0].Language,
+		Success:  true,
+	}
+
+	var total time.Duration
+	var errs []error
+	for i, batch := range batches {
+		merged.FilesProcessed += batch.FilesProcessed
+		merged.Issues = append(merged.Issues, batch.Issues...)
+		// Raw SARIF documents can't be concatenated like Output - keep
+		// only the first batch's, which is the common case anyway since
+		// SetMaxFilesPerInvocation batching is rare for SARIF-emitting
+		// tools.
+		if merged.SARIFReport == nil && batch.SARIFReport != nil {
+			merged.SARIFReport = batch.SARIFReport
+		}
+		if i > 0 && batch.Output != "" {
+			merged.Output += "\n"
+		}
+		merged.Output += batch.Output
+
+		if !batch.Success {
+			merged.Success = false
+		}
+		if batch.Error != nil {
+			errs = append(errs, batch.Error)
+		}
+		if d, err := time.ParseDuration(batch.Duration); err == nil {
+			total += d
+		}
+	}
+
+	merged.Duration = total.String()
+	merged.Error = errors.Join(errs...)
+	sortIssues(merged.Issues)
+
+	return merged
+}
+
+// sortIssues orders issues by (File, Line, Column, Rule) in place, giving
+// a stable, deterministic ordering regardless of which batch or shard an
+// issue was found in.
+func sortIssues(issues []Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Rule < b.Rule
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools/cmdobj"
+)
+
+func TestBatchFiles_SplitsIntoChunks(t *testing.T) {
+	files := []string{"a", "b", "c", "d", "e"}
+
+	batches := batchFiles(files, 2)
+
+	require.Len(t, batches, 3)
+	assert.Equal(t, []string{"a", "b"}, batches[0])
+	assert.Equal(t, []string{"c", "d"}, batches[1])
+	assert.Equal(t, []string{"e"}, batches[2])
+}
+
+func TestBatchFiles_ZeroSizeIsUnbounded(t *testing.T) {
+	files := []string{"a", "b", "c"}
+
+	batches := batchFiles(files, 0)
+
+	require.Len(t, batches, 1)
+	assert.Equal(t, files, batches[0])
+}
+
+func TestBatchFiles_SizeLargerThanFilesIsUnbounded(t *testing.T) {
+	files := []string{"a", "b"}
+
+	batches := batchFiles(files, 10)
+
+	require.Len(t, batches, 1)
+	assert.Equal(t, files, batches[0])
+}
+
+func TestBaseTool_Execute_BatchesAcrossMaxFilesPerInvocation(t *testing.T) {
+	tool := NewBaseTool("fake-linter", "Java", "sh", LINT)
+	tool.SetMaxFilesPerInvocation(2)
+
+	runner := cmdobj.NewFakeRunner()
+	tool.SetRunner(runner)
+
+	files := []string{"a.java", "b.java", "c.java"}
+	result, err := tool.Execute(context.Background(), files, ExecuteOptions{})
+	require.NoError(t, err)
+
+	assert.True(t, result.Success)
+	assert.Equal(t, 3, result.FilesProcessed)
+
+	calls := runner.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, []string{"a.java", "b.java"}, calls[0].Argv[1:])
+	assert.Equal(t, []string{"c.java"}, calls[1].Argv[1:])
+}
+
+func TestMergeResults_ConcatenatesAndSortsIssues(t *testing.T) {
+	batches := []*Result{
+		{
+			Tool: "fake-linter", Language: "Java", Success: true, Duration: "1s",
+			FilesProcessed: 1,
+			Issues:         []Issue{{File: "b.java", Line: 5, Rule: "z-rule"}},
+		},
+		{
+			Tool: "fake-linter", Language: "Java", Success: true, Duration: "2s",
+			FilesProcessed: 1,
+			Issues:         []Issue{{File: "a.java", Line: 1, Rule: "a-rule"}},
+		},
+	}
+
+	merged := mergeResults(batches)
+
+	assert.True(t, merged.Success)
+	assert.Equal(t, 2, merged.FilesProcessed)
+	assert.Equal(t, "3s", merged.Duration)
+	require.Len(t, merged.Issues, 2)
+	assert.Equal(t, "a.java", merged.Issues[0].File)
+	assert.Equal(t, "b.java", merged.Issues[1].File)
+}
+
+func TestMergeResults_AnyBatchFailureFailsTheMerge(t *testing.T) {
+	batches := []*Result{
+		{Success: true},
+		{Success: false, Error: assertErr{}},
+	}
+
+	merged := mergeResults(batches)
+
+	assert.False(t, merged.Success)
+	require.Error(t, merged.Error)
+}
+
+// assertErr is a minimal error used only to exercise mergeResults' error
+// aggregation without pulling in errors.New repeatedly.
+type assertErr struct{}
+
+func (assertErr) Error() string { return "batch failed" }
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import "runtime"
+
+// DefaultArgMaxBytes returns a conservative per-OS command-line length
+// budget: well under the kernel's real ARG_MAX (getconf ARG_MAX ~131072
+// on Linux, ~262144 on macOS, ~8191 characters for a Windows cmd.exe
+// command line), leaving headroom for environment variables (which
+// share the same OS-enforced limit as argv) and argv pointer/NUL
+// overhead that a plain sum of file path lengths doesn't capture.
+func DefaultArgMaxBytes() int {
+	switch runtime.GOOS {
+	case "darwin":
+		return 131072
+	case "windows":
+		return 4096
+	default:
+		return 65536
+	}
+}
+
+// ChunkFiles splits files into contiguous batches whose summed length,
+// plus a fixed per-file argv overhead, stays under DefaultArgMaxBytes
+// once argvOverhead bytes are set aside for the invocation's own flags
+// (config paths, --fix, format flags, and the like, none of which scale
+// with the file list). This is the same problem lefthook solves by
+// splitting templated commands into chunks before invoking them, so a
+// caller like golangci-lint or eslint never hits E2BIG on a monorepo's
+// thousand-file changeset.
+//
+// A single file whose own length already exceeds the budget still gets
+// its own one-file batch - there's no way to shrink it further, and the
+// underlying command is left to fail on its own terms.
+func ChunkFiles(files []string, argvOverhead int) [][]string {
+	const perFileOverhead = 8 // argv pointer + separator + quoting slack
+
+	budget := DefaultArgMaxBytes() - argvOverhead
+	if budget <= 0 {
+		budget = DefaultArgMaxBytes()
+	}
+
+	var batches [][]string
+	var current []string
+	currentLen := 0
+
+	for _, f := range files {
+		cost := len(f) + perFileOverhead
+		if len(current) > 0 && currentLen+cost > budget {
+			batches = append(batches, current)
+			current = nil
+			currentLen = 0
+		}
+		current = append(current, f)
+		currentLen += cost
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+
+	return batches
+}
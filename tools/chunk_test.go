@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkFiles(t *testing.T) {
+	files := []string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc", "dddddddddd"}
+
+	// Each file costs 10 + 8 = 18 bytes; reserving argvOverhead so the
+	// budget leaves room for exactly two files per batch.
+	argvOverhead := DefaultArgMaxBytes() - 36
+
+	batches := ChunkFiles(files, argvOverhead)
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, []string{"aaaaaaaaaa", "bbbbbbbbbb"}, batches[0])
+	assert.Equal(t, []string{"cccccccccc", "dddddddddd"}, batches[1])
+}
+
+func TestChunkFiles_SingleOversizedFileGetsItsOwnBatch(t *testing.T) {
+	files := []string{strings.Repeat("x", 100), "short.go"}
+	argvOverhead := DefaultArgMaxBytes() - 50
+
+	batches := ChunkFiles(files, argvOverhead)
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, []string{strings.Repeat("x", 100)}, batches[0])
+	assert.Equal(t, []string{"short.go"}, batches[1])
+}
+
+func TestChunkFiles_EverythingFitsInOneBatch(t *testing.T) {
+	files := []string{"a.go", "b.go", "c.go"}
+
+	batches := ChunkFiles(files, 0)
+
+	require.Len(t, batches, 1)
+	assert.Equal(t, files, batches[0])
+}
+
+func TestChunkFiles_NegativeOverheadFallsBackToFullBudget(t *testing.T) {
+	files := []string{"a.go", "b.go"}
+
+	batches := ChunkFiles(files, DefaultArgMaxBytes()*2)
+
+	require.Len(t, batches, 1)
+	assert.Equal(t, files, batches[0])
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package classifier identifies a source file's language the way
+// linguist-style tools do: extension, then filename, then - only for
+// extensions known to be ambiguous - a peek at the file's content for a
+// handful of distinguishing tokens. It returns language names in the
+// same vocabulary QualityTool.Language() uses (e.g. "C/C++", "Go",
+// "Python"), so Registry can match a file to its applicable tools
+// without each tool re-implementing its own extension filter.
+package classifier
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// peekSize is how much of a file classifyAmbiguous/classifyByShebang
+// read to look for a distinguishing token, capped well below a typical
+// source file's size so classification stays cheap even on huge files.
+const peekSize = 4096
+
+// filenameLanguages maps an exact (case-sensitive) base filename to its
+// language, for files identified by name rather than extension.
+var filenameLanguages = map[string]string{
+	"Dockerfile": "Dockerfile",
+}
+
+// extensionLanguages maps an unambiguous lowercased extension straight
+// to a language.
+var extensionLanguages = map[string]string{
+	".c":     "C/C++",
+	".cpp":   "C/C++",
+	".cc":    "C/C++",
+	".cxx":   "C/C++",
+	".hpp":   "C/C++",
+	".hxx":   "C/C++",
+	".go":    "Go",
+	".py":    "Python",
+	".rs":    "Rust",
+	".java":  "Java",
+	".ts":    "TypeScript",
+	".tsx":   "TypeScript",
+	".js":    "JavaScript",
+	".jsx":   "JavaScript",
+	".css":   "CSS",
+	".scss":  "CSS",
+	".md":    "Markdown",
+	".proto": "Protobuf",
+	".sql":   "SQL",
+	".toml":  "TOML",
+	".yaml":  "YAML",
+	".yml":   "YAML",
+	".sh":    "Shell",
+	".bash":  "Shell",
+}
+
+// ambiguousTokens lists, for each ambiguous extension, the tokens that
+// indicate a non-default language when found in the file's first
+// peekSize bytes. Rules are checked in order; the first match wins. An
+// extension with no match keeps its default language.
+var ambiguousTokens = map[string]struct {
+	defaultLang string
+	rules       []struct {
+		tokens []string
+		lang   string
+	}
+}{
+	".h": {
+		defaultLang: "C/C++",
+		rules: []struct {
+			tokens []string
+			lang   string
+		}{
+			{tokens: []string{"@interface", "@implementation", "#import"}, lang: "Objective-C"},
+		},
+	},
+	".m": {
+		defaultLang: "MATLAB",
+		rules: []struct {
+			tokens []string
+			lang   string
+		}{
+			{tokens: []string{"@interface", "@implementation", "#import"}, lang: "Objective-C"},
+		},
+	},
+	".pl": {
+		defaultLang: "Perl",
+		rules: []struct {
+			tokens []string
+			lang   string
+		}{
+			{tokens: []string{":- module", ":-module"}, lang: "Prolog"},
+			{tokens: []string{"use strict", "use warnings"}, lang: "Perl"},
+		},
+	},
+}
+
+// shebangLanguages maps an interpreter name found on a "#!" line to its
+// language, for extensionless scripts.
+var shebangLanguages = map[string]string{
+	"python":  "Python",
+	"python3": "Python",
+	"bash":    "Shell",
+	"sh":      "Shell",
+	"perl":    "Perl",
+	"node":    "JavaScript",
+}
+
+// Classify returns the best-guess language for path. It checks the
+// filename, then the extension, resolving known-ambiguous extensions by
+// peeking the file's content; falls back to a shebang line for
+// extensionless files. Returns "" if nothing matches.
+func Classify(path string) string {
+	base := filepath.Base(path)
+	if lang, ok := filenameLanguages[base]; ok {
+		return lang
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	if rule, ambiguous := ambiguousTokens[ext]; ambiguous {
+		return classifyAmbiguous(path, rule.defaultLang, rule.rules)
+	}
+
+	if lang, ok := extensionLanguages[ext]; ok {
+		return lang
+	}
+
+	return classifyByShebang(path)
+}
+
+// classifyAmbiguous peeks path's content for the first matching rule's
+// tokens, returning its language, or defaultLang if none match (or the
+// file can't be read).
+func classifyAmbiguous(path, defaultLang string, rules []struct {
+	tokens []string
+	lang   string
+}) string {
+	content, err := peekFile(path)
+	if err != nil {
+		return defaultLang
+	}
+
+	for _, rule := range rules {
+		for _, token := range rule.tokens {
+			if strings.Contains(content, token) {
+				return rule.lang
+			}
+		}
+	}
+
+	return defaultLang
+}
+
+// classifyByShebang reads path's first line and, if it's a "#!"
+// shebang, maps its interpreter to a language. Returns "" if path has no
+// shebang line or the interpreter isn't recognized.
+func classifyByShebang(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && line == "" {
+		return ""
+	}
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "#!") {
+		return ""
+	}
+
+	interpreter := filepath.Base(strings.Fields(line)[len(strings.Fields(line))-1])
+	return shebangLanguages[interpreter]
+}
+
+// peekFile reads up to peekSize bytes from the start of path.
+func peekFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := make([]byte, peekSize)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+
+	return string(buf[:n]), nil
+}
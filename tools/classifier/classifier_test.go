@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package classifier
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestClassify_UnambiguousExtension(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "main.go", "package main\n")
+
+	assert.Equal(t, "Go", Classify(path))
+}
+
+func TestClassify_Filename(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "Dockerfile", "FROM scratch\n")
+
+	assert.Equal(t, "Dockerfile", Classify(path))
+}
+
+func TestClassify_AmbiguousHeader_DefaultsToCpp(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "widget.h", "#pragma once\nstruct Widget {};\n")
+
+	assert.Equal(t, "C/C++", Classify(path))
+}
+
+func TestClassify_AmbiguousHeader_ObjectiveC(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "widget.h", "#import <Foundation/Foundation.h>\n@interface Widget : NSObject\n@end\n")
+
+	assert.Equal(t, "Objective-C", Classify(path))
+}
+
+func TestClassify_AmbiguousM_DefaultsToMatlab(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "solve.m", "function y = solve(x)\n  y = x + 1;\nend\n")
+
+	assert.Equal(t, "MATLAB", Classify(path))
+}
+
+func TestClassify_AmbiguousM_ObjectiveC(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "widget.m", "#import \"Widget.h\"\n@implementation Widget\n@end\n")
+
+	assert.Equal(t, "Objective-C", Classify(path))
+}
+
+func TestClassify_AmbiguousPl_Prolog(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "rules.pl", ":- module(rules, [likes/2]).\nlikes(a, b).\n")
+
+	assert.Equal(t, "Prolog", Classify(path))
+}
+
+func TestClassify_AmbiguousPl_Perl(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "script.pl", "use strict;\nuse warnings;\nprint \"hi\\n\";\n")
+
+	assert.Equal(t, "Perl", Classify(path))
+}
+
+func TestClassify_Shebang(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "run", "#!/usr/bin/env python3\nprint('hi')\n")
+
+	assert.Equal(t, "Python", Classify(path))
+}
+
+func TestClassify_Unknown(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "notes.txt", "just some notes\n")
+
+	assert.Equal(t, "", Classify(path))
+}
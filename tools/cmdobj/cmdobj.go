@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package cmdobj provides a lazygit-style ICmdObjBuilder/ICmdObjRunner
+// split for external command invocations: a CmdObj is an immutable
+// description of a command (argv, env, cwd, stdin, timeout), and a Runner
+// is the thing that actually executes it. Separating the two lets
+// QualityTool implementations describe what they want to run without
+// calling exec.Command directly, which in turn makes them testable with
+// a FakeRunner and composable with decorators (retry, rate limiting,
+// pre/post hooks) without touching the tool code itself.
+package cmdobj
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/redact"
+)
+
+// CmdObj is an immutable description of a single external command
+// invocation. Build one via NewBuilder().New(...) and the With* methods;
+// each With* method returns a new CmdObj rather than mutating the
+// receiver, so a base CmdObj can be safely reused as a template.
+type CmdObj struct {
+	// Executable is the program to run (resolved via PATH unless it
+	// contains a path separator).
+	Executable string
+
+	// Args are the arguments passed to Executable, not including
+	// Executable itself.
+	Args []string
+
+	// Env holds additional environment variables merged on top of the
+	// current process environment. Nil means "inherit only".
+	Env map[string]string
+
+	// RawEnv, if non-nil, replaces the process's environment wholesale
+	// (same convention as exec.Cmd.Env) instead of merging Env on top of
+	// it. It exists so code converting an already-built *exec.Cmd into a
+	// CmdObj (e.g. a tool's own BuildCommand) can carry over its env
+	// losslessly instead of reconstructing it as a map.
+	RawEnv []string
+
+	// Dir is the working directory the command runs in. Empty means the
+	// caller's current directory.
+	Dir string
+
+	// Stdin, if set, is piped to the command's standard input.
+	Stdin io.Reader
+
+	// Timeout bounds how long the command may run. Zero means no
+	// additional timeout beyond the caller's context.
+	Timeout time.Duration
+
+	// Redact lists substrings (e.g. tokens embedded in Args or Env) that
+	// String and logging helpers must mask before the command is printed
+	// or logged, so --explain output and debug logs never leak secrets.
+	Redact []string
+}
+
+// Builder assembles CmdObjs. The zero value is ready to use; it exists
+// mainly so call sites read as "b.New(...)" the way lazygit's
+// ICmdObjBuilder does, rather than a bare package function.
+type Builder struct{}
+
+// NewBuilder creates a CmdObj builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// New creates a CmdObj for executable with the given arguments.
+func (b *Builder) New(executable string, args ...string) *CmdObj {
+	return &CmdObj{Executable: executable, Args: args}
+}
+
+// WithEnv returns a copy of c with env merged into its environment.
+func (c *CmdObj) WithEnv(env map[string]string) *CmdObj {
+	clone := *c
+	merged := make(map[string]string, len(c.Env)+len(env))
+	for k, v := range c.Env {
+		merged[k] = v
+	}
+	for k, v := range env {
+		merged[k] = v
+	}
+	clone.Env = merged
+	return &clone
+}
+
+// WithDir returns a copy of c that runs in dir.
+func (c *CmdObj) WithDir(dir string) *CmdObj {
+	clone := *c
+	clone.Dir = dir
+	return &clone
+}
+
+// WithStdin returns a copy of c that pipes r to the command's stdin.
+func (c *CmdObj) WithStdin(r io.Reader) *CmdObj {
+	clone := *c
+	clone.Stdin = r
+	return &clone
+}
+
+// WithTimeout returns a copy of c bounded by d.
+func (c *CmdObj) WithTimeout(d time.Duration) *CmdObj {
+	clone := *c
+	clone.Timeout = d
+	return &clone
+}
+
+// WithRedact returns a copy of c that masks the given substrings (e.g. an
+// API token passed via --extra-args) whenever it's printed or logged, in
+// addition to whatever the global redact.Store already catches.
+func (c *CmdObj) WithRedact(substrings ...string) *CmdObj {
+	clone := *c
+	clone.Redact = append(append([]string{}, c.Redact...), substrings...)
+	return &clone
+}
+
+// String returns the redacted argv as a single shell-ish string, suitable
+// for --explain output and debug logs. Redaction runs in two layers: c's
+// own Redact list (literal substrings a caller knows are secret, e.g. via
+// WithRedact), then the process-wide redact.Store (known token shapes and
+// anything added via .gzquality.yml's redact.patterns/values) - the same
+// store report/generator.go and logx use, so argv logging never leaks
+// more than a tool's own output already wouldn't.
+func (c *CmdObj) String() string {
+	parts := append([]string{c.Executable}, c.Args...)
+	line := strings.Join(parts, " ")
+	for _, secret := range c.Redact {
+		if secret == "" {
+			continue
+		}
+		line = strings.ReplaceAll(line, secret, "***")
+	}
+	return redact.Redact(line)
+}
+
+// Argv returns the full argv (Executable followed by Args), for callers
+// that need the slice form rather than String's shell-ish rendering.
+func (c *CmdObj) Argv() []string {
+	return append([]string{c.Executable}, c.Args...)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cmdobj
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Decorator wraps a Runner to add cross-cutting behavior (retry, rate
+// limiting, pre/post hooks) without the wrapped Runner or its callers
+// knowing about it. Decorators compose: Chain(base, a, b) runs a's
+// behavior around b's around base, in that order.
+type Decorator func(Runner) Runner
+
+// Chain applies decorators to base in order, so the first decorator in
+// the list is the outermost wrapper (the first thing a caller's Run call
+// reaches).
+func Chain(base Runner, decorators ...Decorator) Runner {
+	runner := base
+	for i := len(decorators) - 1; i >= 0; i-- {
+		runner = decorators[i](runner)
+	}
+	return runner
+}
+
+// WithRetry retries a failing RunWithOutput/Run/RunWithSpinner call up to
+// attempts times (attempts including the first try), waiting backoff
+// between attempts. RunAndStream is never retried, since replaying a
+// partially-streamed command would duplicate lines the caller already saw.
+func WithRetry(attempts int, backoff time.Duration) Decorator {
+	return func(next Runner) Runner {
+		return &retryRunner{next: next, attempts: attempts, backoff: backoff}
+	}
+}
+
+type retryRunner struct {
+	next     Runner
+	attempts int
+	backoff  time.Duration
+}
+
+func (r *retryRunner) run(fn func() error) error {
+	var err error
+	for attempt := 1; attempt <= max(r.attempts, 1); attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt < r.attempts && r.backoff > 0 {
+			time.Sleep(r.backoff)
+		}
+	}
+	return err
+}
+
+func (r *retryRunner) Run(ctx context.Context, c *CmdObj) error {
+	return r.run(func() error { return r.next.Run(ctx, c) })
+}
+
+func (r *retryRunner) RunWithOutput(ctx context.Context, c *CmdObj) (string, error) {
+	var output string
+	err := r.run(func() error {
+		var runErr error
+		output, runErr = r.next.RunWithOutput(ctx, c)
+		return runErr
+	})
+	return output, err
+}
+
+func (r *retryRunner) RunAndStream(ctx context.Context, c *CmdObj, onLine func(string)) error {
+	return r.next.RunAndStream(ctx, c, onLine)
+}
+
+func (r *retryRunner) RunWithSpinner(ctx context.Context, c *CmdObj, message string) (string, error) {
+	var output string
+	err := r.run(func() error {
+		var runErr error
+		output, runErr = r.next.RunWithSpinner(ctx, c, message)
+		return runErr
+	})
+	return output, err
+}
+
+// WithRateLimit makes every call wait at least interval since the
+// previous call started, so a flaky or rate-limited remote tool (e.g. a
+// security scanner hitting a vulnerability database) doesn't get hammered
+// across a large file set.
+func WithRateLimit(interval time.Duration) Decorator {
+	return func(next Runner) Runner {
+		return &rateLimitRunner{next: next, interval: interval}
+	}
+}
+
+type rateLimitRunner struct {
+	next     Runner
+	interval time.Duration
+	mu       sync.Mutex
+	last     time.Time
+}
+
+func (r *rateLimitRunner) wait() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.last.IsZero() {
+		if remaining := r.interval - time.Since(r.last); remaining > 0 {
+			time.Sleep(remaining)
+		}
+	}
+	r.last = time.Now()
+}
+
+func (r *rateLimitRunner) Run(ctx context.Context, c *CmdObj) error {
+	r.wait()
+	return r.next.Run(ctx, c)
+}
+
+func (r *rateLimitRunner) RunWithOutput(ctx context.Context, c *CmdObj) (string, error) {
+	r.wait()
+	return r.next.RunWithOutput(ctx, c)
+}
+
+func (r *rateLimitRunner) RunAndStream(ctx context.Context, c *CmdObj, onLine func(string)) error {
+	r.wait()
+	return r.next.RunAndStream(ctx, c, onLine)
+}
+
+func (r *rateLimitRunner) RunWithSpinner(ctx context.Context, c *CmdObj, message string) (string, error) {
+	r.wait()
+	return r.next.RunWithSpinner(ctx, c, message)
+}
+
+// WithHooks runs pre(cmd) before every invocation and post(cmd, output,
+// err) after it, regardless of which Runner method was called. This is
+// the extension point for things like auto-refreshing go.mod before
+// golangci-lint runs, or recording structured per-command logs.
+func WithHooks(pre func(*CmdObj), post func(*CmdObj, string, error)) Decorator {
+	return func(next Runner) Runner {
+		return &hookRunner{next: next, pre: pre, post: post}
+	}
+}
+
+type hookRunner struct {
+	next Runner
+	pre  func(*CmdObj)
+	post func(*CmdObj, string, error)
+}
+
+func (h *hookRunner) call(c *CmdObj, fn func() (string, error)) (string, error) {
+	if h.pre != nil {
+		h.pre(c)
+	}
+	output, err := fn()
+	if h.post != nil {
+		h.post(c, output, err)
+	}
+	return output, err
+}
+
+func (h *hookRunner) Run(ctx context.Context, c *CmdObj) error {
+	_, err := h.call(c, func() (string, error) { return "", h.next.Run(ctx, c) })
+	return err
+}
+
+func (h *hookRunner) RunWithOutput(ctx context.Context, c *CmdObj) (string, error) {
+	return h.call(c, func() (string, error) { return h.next.RunWithOutput(ctx, c) })
+}
+
+func (h *hookRunner) RunAndStream(ctx context.Context, c *CmdObj, onLine func(string)) error {
+	_, err := h.call(c, func() (string, error) { return "", h.next.RunAndStream(ctx, c, onLine) })
+	return err
+}
+
+func (h *hookRunner) RunWithSpinner(ctx context.Context, c *CmdObj, message string) (string, error) {
+	return h.call(c, func() (string, error) { return h.next.RunWithSpinner(ctx, c, message) })
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+var (
+	_ Runner = (*retryRunner)(nil)
+	_ Runner = (*rateLimitRunner)(nil)
+	_ Runner = (*hookRunner)(nil)
+)
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cmdobj
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// ExplainRunner is a Runner that prints the redacted argv of every CmdObj
+// it's given to Out, instead of running anything. It backs the
+// `--explain` flag: swap it in for the real Runner and every planned
+// invocation is printed rather than executed.
+type ExplainRunner struct {
+	Out io.Writer
+}
+
+// NewExplainRunner creates an ExplainRunner that prints to out.
+func NewExplainRunner(out io.Writer) *ExplainRunner {
+	return &ExplainRunner{Out: out}
+}
+
+func (e *ExplainRunner) print(c *CmdObj) {
+	dir := c.Dir
+	if dir == "" {
+		dir = "."
+	}
+	fmt.Fprintf(e.Out, "+ (cwd=%s) %s\n", dir, c.String())
+}
+
+// Run implements Runner.
+func (e *ExplainRunner) Run(_ context.Context, c *CmdObj) error {
+	e.print(c)
+	return nil
+}
+
+// RunWithOutput implements Runner.
+func (e *ExplainRunner) RunWithOutput(_ context.Context, c *CmdObj) (string, error) {
+	e.print(c)
+	return "", nil
+}
+
+// RunAndStream implements Runner.
+func (e *ExplainRunner) RunAndStream(_ context.Context, c *CmdObj, _ func(string)) error {
+	e.print(c)
+	return nil
+}
+
+// RunWithSpinner implements Runner.
+func (e *ExplainRunner) RunWithSpinner(_ context.Context, c *CmdObj, _ string) (string, error) {
+	e.print(c)
+	return "", nil
+}
+
+var _ Runner = (*ExplainRunner)(nil)
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cmdobj
+
+import (
+	"context"
+	"sync"
+)
+
+// FakeCall records a single invocation made through a FakeRunner.
+type FakeCall struct {
+	// Argv is the full argv (executable + args) that was "run".
+	Argv []string
+
+	// Dir is the working directory the CmdObj requested.
+	Dir string
+}
+
+// FakeRunner is a Runner that records every call instead of executing
+// anything, so QualityTool wrappers can be unit-tested deterministically
+// without touching the real binary. Responses are keyed by the joined
+// argv (via CmdObj.String, pre-redaction is irrelevant here since tests
+// supply their own fixtures); an argv with no registered response returns
+// empty output and a nil error.
+type FakeRunner struct {
+	mu        sync.Mutex
+	calls     []FakeCall
+	responses map[string]FakeResponse
+}
+
+// FakeResponse is the canned result a FakeRunner returns for a given argv.
+type FakeResponse struct {
+	Output string
+	Err    error
+}
+
+// NewFakeRunner creates an empty FakeRunner.
+func NewFakeRunner() *FakeRunner {
+	return &FakeRunner{responses: make(map[string]FakeResponse)}
+}
+
+// On registers the response FakeRunner should return when a CmdObj whose
+// argv joins into key (see CmdObj.String) is run.
+func (f *FakeRunner) On(key string, resp FakeResponse) *FakeRunner {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[key] = resp
+	return f
+}
+
+// Calls returns every call recorded so far, in order.
+func (f *FakeRunner) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall{}, f.calls...)
+}
+
+func (f *FakeRunner) record(c *CmdObj) FakeResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, FakeCall{Argv: c.Argv(), Dir: c.Dir})
+	if resp, ok := f.responses[c.String()]; ok {
+		return resp
+	}
+	return FakeResponse{}
+}
+
+// Run implements Runner.
+func (f *FakeRunner) Run(_ context.Context, c *CmdObj) error {
+	resp := f.record(c)
+	return resp.Err
+}
+
+// RunWithOutput implements Runner.
+func (f *FakeRunner) RunWithOutput(_ context.Context, c *CmdObj) (string, error) {
+	resp := f.record(c)
+	return resp.Output, resp.Err
+}
+
+// RunAndStream implements Runner, replaying the canned output one line at
+// a time via fmt.Sscanf-free splitting (simple newline split is enough for
+// test fixtures).
+func (f *FakeRunner) RunAndStream(_ context.Context, c *CmdObj, onLine func(string)) error {
+	resp := f.record(c)
+	for _, line := range splitLines(resp.Output) {
+		onLine(line)
+	}
+	return resp.Err
+}
+
+// RunWithSpinner implements Runner.
+func (f *FakeRunner) RunWithSpinner(_ context.Context, c *CmdObj, _ string) (string, error) {
+	resp := f.record(c)
+	return resp.Output, resp.Err
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	var current string
+	for _, r := range s {
+		if r == '\n' {
+			lines = append(lines, current)
+			current = ""
+			continue
+		}
+		current += string(r)
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+var _ Runner = (*FakeRunner)(nil)
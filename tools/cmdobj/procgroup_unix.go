@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+//go:build !windows
+
+package cmdobj
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup configures cmd to run in its own process group, so
+// killProcessGroup can signal every descendant it spawned (a linter that
+// forks its own workers, say) instead of leaking them when the context
+// driving cmd is cancelled and only the direct child gets killed.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// killProcessGroup sends SIGKILL to cmd's entire process group (the
+// negative of its pid, per kill(2)), rather than only the single process
+// exec.CommandContext's default cancellation would kill.
+func killProcessGroup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
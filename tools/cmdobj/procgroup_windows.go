@@ -0,0 +1,23 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+//go:build windows
+
+package cmdobj
+
+import "os/exec"
+
+// setProcessGroup is a no-op on Windows: job objects (the closest
+// equivalent to a Unix process group) aren't worth the extra surface
+// here, since exec.CommandContext already kills cmd.Process directly on
+// context cancellation.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills cmd's direct process; Windows has no signal
+// equivalent to a process-group SIGKILL.
+func killProcessGroup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return cmd.Process.Kill()
+}
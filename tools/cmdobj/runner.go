@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cmdobj
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// Runner executes CmdObjs. Swapping the Runner a QualityTool uses (e.g.
+// for a FakeRunner in unit tests, or an ExplainRunner behind --explain)
+// is how command execution becomes testable without hitting the real
+// binary.
+type Runner interface {
+	// Run executes cmd, discarding its output, and returns any error.
+	Run(ctx context.Context, cmd *CmdObj) error
+
+	// RunWithOutput executes cmd and returns its combined stdout+stderr.
+	RunWithOutput(ctx context.Context, cmd *CmdObj) (string, error)
+
+	// RunAndStream executes cmd, invoking onLine for each line of combined
+	// output as it's produced, and returns any error once the command exits.
+	RunAndStream(ctx context.Context, cmd *CmdObj, onLine func(string)) error
+
+	// RunWithSpinner executes cmd like RunWithOutput, but prints message
+	// with a simple animated spinner to stderr while it runs (no-op when
+	// stderr isn't a terminal).
+	RunWithSpinner(ctx context.Context, cmd *CmdObj, message string) (string, error)
+}
+
+// OSRunner is the default Runner, executing CmdObjs via os/exec.
+type OSRunner struct{}
+
+// NewOSRunner creates a Runner that actually shells out.
+func NewOSRunner() *OSRunner {
+	return &OSRunner{}
+}
+
+// processKillGrace bounds how long a cancelled command's I/O pipes are
+// held open waiting for it to exit after killProcessGroup signals it, so
+// a process that ignores SIGKILL on its own (rare, but seen with some
+// zombie-reaping wrapper scripts) doesn't hang cmd.Wait forever.
+const processKillGrace = 5 * time.Second
+
+func (r *OSRunner) build(ctx context.Context, c *CmdObj) (*exec.Cmd, context.CancelFunc) {
+	var cancel context.CancelFunc
+	if c.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+	}
+
+	cmd := exec.CommandContext(ctx, c.Executable, c.Args...)
+
+	// Run in its own process group and kill the whole group (not just
+	// cmd.Process) when ctx is cancelled or times out, so a tool that
+	// forks its own workers doesn't leak them.
+	setProcessGroup(cmd)
+	cmd.Cancel = func() error {
+		return killProcessGroup(cmd)
+	}
+	cmd.WaitDelay = processKillGrace
+
+	if c.Dir != "" {
+		cmd.Dir = c.Dir
+	}
+	if c.Stdin != nil {
+		cmd.Stdin = c.Stdin
+	}
+	switch {
+	case c.RawEnv != nil:
+		cmd.Env = c.RawEnv
+	case len(c.Env) > 0:
+		env := os.Environ()
+		for k, v := range c.Env {
+			env = append(env, fmt.Sprintf("%s=%s", k, v))
+		}
+		cmd.Env = env
+	}
+
+	return cmd, cancel
+}
+
+// Run executes cmd, discarding its output.
+func (r *OSRunner) Run(ctx context.Context, c *CmdObj) error {
+	cmd, cancel := r.build(ctx, c)
+	if cancel != nil {
+		defer cancel()
+	}
+	return cmd.Run()
+}
+
+// RunWithOutput executes cmd and returns its combined stdout+stderr.
+func (r *OSRunner) RunWithOutput(ctx context.Context, c *CmdObj) (string, error) {
+	cmd, cancel := r.build(ctx, c)
+	if cancel != nil {
+		defer cancel()
+	}
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+// RunAndStream executes cmd, invoking onLine for each line of combined
+// stdout+stderr output as it arrives.
+func (r *OSRunner) RunAndStream(ctx context.Context, c *CmdObj, onLine func(string)) error {
+	cmd, cancel := r.build(ctx, c)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	pr, pw := io.Pipe()
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+
+	if err := cmd.Start(); err != nil {
+		_ = pw.Close()
+		return fmt.Errorf("failed to start command: %w", err)
+	}
+
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- cmd.Wait()
+		_ = pw.Close()
+	}()
+
+	scanner := bufio.NewScanner(pr)
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+
+	return <-waitErr
+}
+
+// RunWithSpinner executes cmd like RunWithOutput, printing message with a
+// simple animated spinner to stderr while it runs.
+func (r *OSRunner) RunWithSpinner(ctx context.Context, c *CmdObj, message string) (string, error) {
+	if !isTerminal(os.Stderr) {
+		fmt.Fprintln(os.Stderr, message)
+		return r.RunWithOutput(ctx, c)
+	}
+
+	frames := []rune{'|', '/', '-', '\\'}
+	done := make(chan struct{})
+
+	go func() {
+		i := 0
+		ticker := time.NewTicker(120 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				fmt.Fprintf(os.Stderr, "\r%c %s", frames[i%len(frames)], message)
+				i++
+			}
+		}
+	}()
+
+	output, err := r.RunWithOutput(ctx, c)
+	close(done)
+	fmt.Fprint(os.Stderr, "\r\033[2K")
+
+	return output, err
+}
+
+// isTerminal reports whether f is connected to a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return (info.Mode() & os.ModeCharDevice) != 0
+}
+
+var _ Runner = (*OSRunner)(nil)
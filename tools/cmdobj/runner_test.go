@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package cmdobj
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOSRunner_Run_KillsProcessGroupOnCancel(t *testing.T) {
+	runner := NewOSRunner()
+	cmd := NewBuilder().New("sh", "-c", "sleep 30")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- runner.Run(ctx, cmd)
+	}()
+
+	// Give the shell time to start and fork its sleep child before
+	// cancelling, so the test actually exercises process-group cleanup
+	// rather than a command that never started.
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("expected Run to return an error after cancellation killed the process group")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after ctx cancellation; process group was likely not killed")
+	}
+}
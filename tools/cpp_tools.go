@@ -4,12 +4,27 @@
 package tools
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+
+	yaml "gopkg.in/yaml.v3"
 )
 
+// compileDBSearchDirs are the directories, relative to ProjectRoot,
+// FindBuildDatabase checks for a compile_commands.json, in order.
+// cmake-build-* is expanded via filepath.Glob since CLion/CMake presets
+// name it after the build type (cmake-build-debug, cmake-build-release).
+var compileDBSearchDirs = []string{".", "build", "out", "cmake-build-*"}
+
 // ClangFormatTool implements C/C++ formatting using clang-format.
 type ClangFormatTool struct {
 	*BaseTool
@@ -23,6 +38,7 @@ func NewClangFormatTool() *ClangFormatTool {
 
 	tool.SetInstallCommand([]string{"pacman", "-S", "--noconfirm", "clang"})
 	tool.SetConfigPatterns([]string{".clang-format", "_clang-format"})
+	tool.SetSupportedExtensions([]string{".c", ".h", ".cpp", ".hpp", ".cc", ".cxx", ".hxx"})
 
 	return tool
 }
@@ -67,12 +83,21 @@ func NewClangTidyTool() *ClangTidyTool {
 
 	tool.SetInstallCommand([]string{"pacman", "-S", "--noconfirm", "clang"})
 	tool.SetConfigPatterns([]string{".clang-tidy"})
+	tool.SetSupportedExtensions([]string{".c", ".cpp", ".cc", ".cxx"})
 
 	return tool
 }
 
 // BuildCommand builds the clang-tidy command.
 func (t *ClangTidyTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	return t.buildCommand(files, options, "")
+}
+
+// buildCommand builds the clang-tidy command, additionally passing
+// --export-fixes=exportPath when exportPath is non-empty so Execute can
+// recover structured Diagnostics/Replacements alongside the usual text
+// output.
+func (t *ClangTidyTool) buildCommand(files []string, options ExecuteOptions, exportPath string) *exec.Cmd {
 	args := []string{}
 
 	// Add config file if specified
@@ -85,17 +110,35 @@ func (t *ClangTidyTool) BuildCommand(files []string, options ExecuteOptions) *ex
 		args = append(args, "--fix")
 	}
 
+	if exportPath != "" {
+		args = append(args, "--export-fixes="+exportPath)
+	}
+
 	// Add extra flags if provided
 	args = append(args, options.ExtraArgs...)
 
 	// Filter C/C++ files
 	cppFiles := FilterFilesByExtensions(files, []string{".c", ".cpp", ".cc", ".cxx"})
+
+	// Prefer a compilation database over bare CompileFlags: it tells
+	// clang-tidy the real per-file include/define set, and lets us drop
+	// files the database doesn't know about rather than feeding them to
+	// clang-tidy's noisy "no compilation database" fallback.
+	db, dbDir := FindBuildDatabase(options.ProjectRoot)
+	if db != nil {
+		args = append(args, "-p", dbDir)
+		cppFiles = db.FilterKnown(cppFiles, options.ProjectRoot)
+	}
+
 	if len(cppFiles) > 0 {
 		args = append(args, cppFiles...)
 	}
 
 	// Add -- to separate clang-tidy args from compiler args
 	args = append(args, "--")
+	if db == nil {
+		args = append(args, options.CompileFlags...)
+	}
 
 	cmd := exec.Command(t.executable, args...)
 
@@ -106,6 +149,86 @@ func (t *ClangTidyTool) BuildCommand(files []string, options ExecuteOptions) *ex
 	return cmd
 }
 
+// SupportsParallel reports that ClangTidyTool's Execute shards files
+// across workers, since clang-tidy is single-threaded per invocation and
+// dominates lint time on large C/C++ trees.
+func (t *ClangTidyTool) SupportsParallel() bool {
+	return true
+}
+
+// Execute runs clang-tidy, sharding files across options.Parallelism
+// workers via FileShardExecutor, and merges each shard's Result (each
+// carrying --export-fixes Replacements, see executeShard).
+func (t *ClangTidyTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	executor := NewFileShardExecutor()
+	result, err := executor.Execute(ctx, files, options.Parallelism, func(ctx context.Context, shardFiles []string) (*Result, error) {
+		return t.executeShard(ctx, shardFiles, options)
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if err := ApplyDiffScope(ctx, result, options); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// executeShard runs clang-tidy with --export-fixes over one shard's
+// files so each Issue carries the structured Replacements clang-tidy
+// knows about, on top of the message already scraped from the text
+// output by ParseOutput.
+func (t *ClangTidyTool) executeShard(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	exportFile, err := os.CreateTemp("", "clang-tidy-fixes-*.yaml")
+	if err != nil {
+		// Can't get a temp file for --export-fixes - fall back to the
+		// plain text-only run rather than failing the whole lint pass.
+		cmd := t.buildCommand(files, options, "")
+		return t.ExecuteCommand(ctx, cmd, files)
+	}
+	exportPath := exportFile.Name()
+	exportFile.Close()
+	defer os.Remove(exportPath)
+
+	cmd := t.buildCommand(files, options, exportPath)
+	result, err := t.ExecuteCommand(ctx, cmd, files)
+	if err != nil {
+		return result, err
+	}
+
+	if result.Output != "" {
+		result.Issues = t.ParseOutput(result.Output)
+	}
+
+	if data, readErr := os.ReadFile(exportPath); readErr == nil && len(data) > 0 {
+		diagnostics, parseErr := parseClangTidyExportedFixes(data)
+		if parseErr == nil {
+			result.Issues = attachExportedReplacements(result.Issues, diagnostics)
+		}
+	}
+
+	return result, nil
+}
+
 // ParseOutput parses clang-tidy text output.
 func (t *ClangTidyTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
@@ -141,8 +264,246 @@ func (t *ClangTidyTool) ParseOutput(output string) []Issue {
 	return issues
 }
 
+// clangTidyExportedFixes mirrors the structure of clang-tidy's
+// --export-fixes YAML output.
+type clangTidyExportedFixes struct {
+	Diagnostics []clangTidyDiagnostic `yaml:"Diagnostics"`
+}
+
+// clangTidyDiagnostic is one entry of clangTidyExportedFixes.Diagnostics.
+type clangTidyDiagnostic struct {
+	DiagnosticName    string                     `yaml:"DiagnosticName"`
+	DiagnosticMessage clangTidyDiagnosticMessage `yaml:"DiagnosticMessage"`
+	FileOffset        int                        `yaml:"FileOffset"`
+	Replacements      []clangTidyReplacement     `yaml:"Replacements"`
+	Level             string                     `yaml:"Level"`
+}
+
+// clangTidyDiagnosticMessage carries a diagnostic's human-readable text.
+type clangTidyDiagnosticMessage struct {
+	Message string `yaml:"Message"`
+}
+
+// clangTidyReplacement is one byte-offset edit clang-tidy proposes as
+// part of a diagnostic's fix.
+type clangTidyReplacement struct {
+	FilePath        string `yaml:"FilePath"`
+	Offset          int    `yaml:"Offset"`
+	Length          int    `yaml:"Length"`
+	ReplacementText string `yaml:"ReplacementText"`
+}
+
+// parseClangTidyExportedFixes parses the YAML clang-tidy writes to the
+// path passed via --export-fixes.
+func parseClangTidyExportedFixes(data []byte) ([]clangTidyDiagnostic, error) {
+	var exported clangTidyExportedFixes
+	if err := yaml.Unmarshal(data, &exported); err != nil {
+		return nil, fmt.Errorf("failed to parse clang-tidy --export-fixes output: %w", err)
+	}
+	return exported.Diagnostics, nil
+}
+
+// attachExportedReplacements merges each exported diagnostic's
+// Replacements into the Issue ParseOutput already produced for it,
+// matched by file, rule name, and the line FileOffset translates to. A
+// diagnostic with no matching text-output Issue is appended as a new
+// Issue so a replacement is never silently dropped.
+func attachExportedReplacements(issues []Issue, diagnostics []clangTidyDiagnostic) []Issue {
+	for _, diag := range diagnostics {
+		if len(diag.Replacements) == 0 {
+			continue
+		}
+
+		file := diag.Replacements[0].FilePath
+		line, column := offsetToLineColumn(file, diag.FileOffset)
+
+		replacements := make([]IssueReplacement, 0, len(diag.Replacements))
+		for _, r := range diag.Replacements {
+			replacements = append(replacements, IssueReplacement{
+				File:   r.FilePath,
+				Offset: r.Offset,
+				Length: r.Length,
+				Text:   r.ReplacementText,
+			})
+		}
+
+		matched := false
+		for i := range issues {
+			if issues[i].File == file && issues[i].Rule == diag.DiagnosticName && issues[i].Line == line {
+				issues[i].Replacements = replacements
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			issues = append(issues, Issue{
+				File:         file,
+				Line:         line,
+				Column:       column,
+				Severity:     strings.ToLower(diag.Level),
+				Rule:         diag.DiagnosticName,
+				Message:      diag.DiagnosticMessage.Message,
+				Replacements: replacements,
+			})
+		}
+	}
+
+	return issues
+}
+
+// offsetToLineColumn translates a 0-based byte offset into file into a
+// 1-based (line, column) pair, the same convention ParseOutput's regex
+// match uses for file:line:col text output. Returns (0, 0) if file can't
+// be read or offset is out of range.
+func offsetToLineColumn(file string, offset int) (int, int) {
+	data, err := os.ReadFile(file)
+	if err != nil || offset < 0 || offset > len(data) {
+		return 0, 0
+	}
+
+	line := 1 + bytes.Count(data[:offset], []byte("\n"))
+	column := offset - bytes.LastIndexByte(data[:offset], '\n')
+
+	return line, column
+}
+
+// compileDBEntry mirrors one entry of a compile_commands.json
+// compilation database.
+type compileDBEntry struct {
+	Directory string   `json:"directory"`
+	File      string   `json:"file"`
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments"`
+}
+
+// CompileDB is a parsed compile_commands.json, keyed by each entry's
+// absolute source file path.
+type CompileDB struct {
+	files map[string]compileDBEntry
+}
+
+// FindBuildDatabase walks the common build directories under
+// projectRoot (".", "build/", "out/", "cmake-build-*") looking for a
+// compile_commands.json, parses the first one found, and returns it
+// alongside the directory it was found in (the value clang-tidy expects
+// for -p). Returns (nil, "") if none is found or the first match fails
+// to parse.
+func FindBuildDatabase(projectRoot string) (*CompileDB, string) {
+	for _, pattern := range compileDBSearchDirs {
+		matches, err := filepath.Glob(filepath.Join(projectRoot, pattern))
+		if err != nil {
+			continue
+		}
+
+		for _, dir := range matches {
+			dbPath := filepath.Join(dir, "compile_commands.json")
+			data, err := os.ReadFile(dbPath)
+			if err != nil {
+				continue
+			}
+
+			db, err := parseCompileDB(data)
+			if err != nil {
+				continue
+			}
+			return db, dir
+		}
+	}
+
+	return nil, ""
+}
+
+// parseCompileDB parses compile_commands.json's JSON array of
+// {directory, file, command|arguments} entries into a CompileDB.
+func parseCompileDB(data []byte) (*CompileDB, error) {
+	var entries []compileDBEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse compile_commands.json: %w", err)
+	}
+
+	db := &CompileDB{files: make(map[string]compileDBEntry, len(entries))}
+	for _, entry := range entries {
+		path := entry.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(entry.Directory, path)
+		}
+		db.files[filepath.Clean(path)] = entry
+	}
+
+	return db, nil
+}
+
+// FilterKnown returns the subset of files the database has an entry
+// for, resolving each file relative to projectRoot the same way the
+// database's own entries are resolved. Files the database doesn't know
+// about are dropped rather than passed to clang-tidy, which would
+// otherwise fall back to its noisy no-compilation-database warning for
+// each of them.
+func (db *CompileDB) FilterKnown(files []string, projectRoot string) []string {
+	var known []string
+	for _, f := range files {
+		path := f
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(projectRoot, path)
+		}
+		if _, ok := db.files[filepath.Clean(path)]; ok {
+			known = append(known, f)
+		}
+	}
+	return known
+}
+
+// ApplyFixes writes each issue's exported Replacements directly to their
+// files, implementing FixApplier. This is used instead of re-invoking
+// clang-tidy with --fix when the caller wants the already-parsed fixes
+// applied (or previewed) without paying for a second full compile.
+// Issues with no Replacements are left untouched.
+func (t *ClangTidyTool) ApplyFixes(issues []Issue) error {
+	byFile := make(map[string][]IssueReplacement)
+	for _, issue := range issues {
+		for _, r := range issue.Replacements {
+			byFile[r.File] = append(byFile[r.File], r)
+		}
+	}
+
+	for file, replacements := range byFile {
+		// Apply from the end of the file backwards so an earlier fix's
+		// byte offsets aren't shifted by a later one applied first.
+		sort.Slice(replacements, func(i, j int) bool {
+			return replacements[i].Offset > replacements[j].Offset
+		})
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s to apply fix: %w", file, err)
+		}
+
+		for _, r := range replacements {
+			end := r.Offset + r.Length
+			if r.Offset < 0 || end > len(data) || r.Offset > end {
+				continue
+			}
+
+			fixed := make([]byte, 0, len(data)-r.Length+len(r.Text))
+			fixed = append(fixed, data[:r.Offset]...)
+			fixed = append(fixed, r.Text...)
+			fixed = append(fixed, data[end:]...)
+			data = fixed
+		}
+
+		if err := os.WriteFile(file, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write fix to %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
 // Ensure C/C++ tools implement QualityTool interface.
 var (
-	_ QualityTool = (*ClangFormatTool)(nil)
-	_ QualityTool = (*ClangTidyTool)(nil)
+	_ QualityTool      = (*ClangFormatTool)(nil)
+	_ QualityTool      = (*ClangTidyTool)(nil)
+	_ FixApplier       = (*ClangTidyTool)(nil)
+	_ ParallelCapable  = (*ClangTidyTool)(nil)
 )
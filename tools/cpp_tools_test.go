@@ -0,0 +1,215 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClangFormatTool(t *testing.T) {
+	tool := NewClangFormatTool()
+
+	assert.NotNil(t, tool)
+	assert.Equal(t, "clang-format", tool.Name())
+	assert.Equal(t, "C/C++", tool.Language())
+	assert.Equal(t, FORMAT, tool.Type())
+}
+
+func TestNewClangTidyTool(t *testing.T) {
+	tool := NewClangTidyTool()
+
+	assert.NotNil(t, tool)
+	assert.Equal(t, "clang-tidy", tool.Name())
+	assert.Equal(t, "C/C++", tool.Language())
+	assert.Equal(t, LINT, tool.Type())
+}
+
+func TestClangTidyTool_BuildCommand(t *testing.T) {
+	tool := NewClangTidyTool()
+
+	cmd := tool.BuildCommand([]string{"main.cpp", "README.md"}, ExecuteOptions{})
+
+	assert.Equal(t, "clang-tidy", filepath.Base(cmd.Path))
+	assert.Contains(t, cmd.Args, "main.cpp")
+	assert.NotContains(t, cmd.Args, "README.md")
+	assert.NotContains(t, cmd.Args[1:], "--export-fixes")
+}
+
+func TestClangTidyTool_BuildCommand_CompileFlagsWithoutDatabase(t *testing.T) {
+	tool := NewClangTidyTool()
+
+	cmd := tool.BuildCommand([]string{"main.cpp"}, ExecuteOptions{
+		CompileFlags: []string{"-std=c++20", "-Iinclude"},
+	})
+
+	args := cmd.Args
+	dashIdx := -1
+	for i, a := range args {
+		if a == "--" {
+			dashIdx = i
+			break
+		}
+	}
+	require.NotEqual(t, -1, dashIdx)
+	assert.Equal(t, []string{"-std=c++20", "-Iinclude"}, args[dashIdx+1:])
+}
+
+func TestClangTidyTool_BuildCommand_UsesCompileDatabase(t *testing.T) {
+	projectRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(projectRoot, "compile_commands.json"), []byte(`[
+		{"directory": "`+projectRoot+`", "file": "main.cpp", "command": "c++ -c main.cpp"}
+	]`), 0o644))
+
+	tool := NewClangTidyTool()
+	cmd := tool.BuildCommand([]string{"main.cpp", "unknown.cpp"}, ExecuteOptions{ProjectRoot: projectRoot})
+
+	assert.Contains(t, cmd.Args, "-p")
+	assert.Contains(t, cmd.Args, "main.cpp")
+	assert.NotContains(t, cmd.Args, "unknown.cpp")
+}
+
+func TestFindBuildDatabase(t *testing.T) {
+	projectRoot := t.TempDir()
+	buildDir := filepath.Join(projectRoot, "build")
+	require.NoError(t, os.MkdirAll(buildDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(buildDir, "compile_commands.json"), []byte(`[
+		{"directory": "`+buildDir+`", "file": "main.cpp", "command": "c++ -c main.cpp"}
+	]`), 0o644))
+
+	db, dir := FindBuildDatabase(projectRoot)
+	require.NotNil(t, db)
+	assert.Equal(t, buildDir, dir)
+
+	known := db.FilterKnown([]string{"main.cpp", "other.cpp"}, buildDir)
+	assert.Equal(t, []string{"main.cpp"}, known)
+}
+
+func TestFindBuildDatabase_NotFound(t *testing.T) {
+	db, dir := FindBuildDatabase(t.TempDir())
+	assert.Nil(t, db)
+	assert.Equal(t, "", dir)
+}
+
+func TestClangTidyTool_ParseOutput(t *testing.T) {
+	tool := NewClangTidyTool()
+
+	output := "main.cpp:10:5: warning: use nullptr [modernize-use-nullptr]"
+	issues := tool.ParseOutput(output)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "main.cpp", issues[0].File)
+	assert.Equal(t, 10, issues[0].Line)
+	assert.Equal(t, 5, issues[0].Column)
+	assert.Equal(t, "warning", issues[0].Severity)
+	assert.Equal(t, "use nullptr", issues[0].Message)
+	assert.Equal(t, "modernize-use-nullptr", issues[0].Rule)
+}
+
+func TestParseClangTidyExportedFixes(t *testing.T) {
+	data := []byte(`
+Diagnostics:
+  - DiagnosticName: modernize-use-nullptr
+    DiagnosticMessage:
+      Message: use nullptr
+    FileOffset: 42
+    Level: Warning
+    Replacements:
+      - FilePath: main.cpp
+        Offset: 42
+        Length: 1
+        ReplacementText: nullptr
+`)
+
+	diagnostics, err := parseClangTidyExportedFixes(data)
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 1)
+
+	diag := diagnostics[0]
+	assert.Equal(t, "modernize-use-nullptr", diag.DiagnosticName)
+	assert.Equal(t, "use nullptr", diag.DiagnosticMessage.Message)
+	require.Len(t, diag.Replacements, 1)
+	assert.Equal(t, "main.cpp", diag.Replacements[0].FilePath)
+	assert.Equal(t, "nullptr", diag.Replacements[0].ReplacementText)
+}
+
+func TestOffsetToLineColumn(t *testing.T) {
+	tmpDir := t.TempDir()
+	file := filepath.Join(tmpDir, "main.cpp")
+	require.NoError(t, os.WriteFile(file, []byte("int a = 0;\nchar* p = NULL;\n"), 0o644))
+
+	line, column := offsetToLineColumn(file, 11)
+
+	assert.Equal(t, 2, line)
+	assert.Equal(t, 1, column)
+}
+
+func TestAttachExportedReplacements_MatchesExistingIssue(t *testing.T) {
+	tmpDir := t.TempDir()
+	file := filepath.Join(tmpDir, "main.cpp")
+	require.NoError(t, os.WriteFile(file, []byte("int a = 0;\nchar* p = NULL;\n"), 0o644))
+
+	issues := []Issue{
+		{File: file, Line: 2, Column: 11, Severity: "warning", Rule: "modernize-use-nullptr", Message: "use nullptr"},
+	}
+	diagnostics := []clangTidyDiagnostic{
+		{
+			DiagnosticName:    "modernize-use-nullptr",
+			DiagnosticMessage: clangTidyDiagnosticMessage{Message: "use nullptr"},
+			FileOffset:        11,
+			Level:             "Warning",
+			Replacements: []clangTidyReplacement{
+				{FilePath: file, Offset: 11, Length: 4, ReplacementText: "nullptr"},
+			},
+		},
+	}
+
+	merged := attachExportedReplacements(issues, diagnostics)
+
+	require.Len(t, merged, 1)
+	require.Len(t, merged[0].Replacements, 1)
+	assert.Equal(t, "nullptr", merged[0].Replacements[0].Text)
+	assert.Equal(t, 11, merged[0].Replacements[0].Offset)
+}
+
+func TestClangTidyTool_ApplyFixes(t *testing.T) {
+	tmpDir := t.TempDir()
+	file := filepath.Join(tmpDir, "main.cpp")
+	require.NoError(t, os.WriteFile(file, []byte("char* p = NULL;\n"), 0o644))
+
+	tool := NewClangTidyTool()
+	issues := []Issue{
+		{
+			File: file,
+			Replacements: []IssueReplacement{
+				{File: file, Offset: 10, Length: 4, Text: "nullptr"},
+			},
+		},
+	}
+
+	err := tool.ApplyFixes(issues)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "char* p = nullptr;\n", string(content))
+}
+
+func TestClangTidyTool_ApplyFixes_NoReplacements(t *testing.T) {
+	tool := NewClangTidyTool()
+
+	err := tool.ApplyFixes([]Issue{{File: "main.cpp"}})
+	assert.NoError(t, err)
+}
+
+// Ensure C/C++ tools satisfy QualityTool and FixApplier.
+var (
+	_ QualityTool = (*ClangFormatTool)(nil)
+	_ QualityTool = (*ClangTidyTool)(nil)
+	_ FixApplier  = (*ClangTidyTool)(nil)
+)
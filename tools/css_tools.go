@@ -22,6 +22,7 @@ func NewStylelintTool() *StylelintTool {
 
 	tool.SetInstallCommand([]string{"npm", "install", "-g", "stylelint", "stylelint-config-standard"})
 	tool.SetConfigPatterns([]string{".stylelintrc", ".stylelintrc.json", ".stylelintrc.yml", "stylelint.config.js"})
+	tool.SetSupportedExtensions([]string{".css", ".scss", ".sass", ".less"})
 
 	return tool
 }
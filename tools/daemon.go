@@ -0,0 +1,301 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// ToolDaemon is a long-lived subprocess that amortizes a tool's
+// interpreter/AST-cache startup cost across many Analyze calls instead
+// of paying it once per exec.Command - the same idea as eslint_d or
+// blackd. RuffTool and PylintTool reach for one via DaemonPool when the
+// resolved tool version supports it, falling back to the existing
+// one-shot BuildCommand/ExecuteCommand path otherwise (too old a
+// version, or the daemon process died).
+type ToolDaemon interface {
+	// Start spawns the daemon process. ctx cancels an in-flight startup.
+	Start(ctx context.Context) error
+
+	// Analyze sends files to the running daemon and returns the parsed
+	// issues for them.
+	Analyze(files []string, options ExecuteOptions) (*Result, error)
+
+	// Stop terminates the daemon process and releases its pipes.
+	Stop() error
+}
+
+// daemonKey identifies one pooled daemon. A config change (hence a
+// different set of flags/behavior baked into the process at Start) or a
+// tool upgrade both need a fresh process, not the old one reused.
+type daemonKey struct {
+	tool       string
+	version    string
+	configHash string
+}
+
+// DaemonPool manages one ToolDaemon per (tool, version, configHash),
+// starting it lazily on first use and reusing it for as long as the
+// process stays up and that combination keeps being asked for.
+type DaemonPool struct {
+	mu      sync.Mutex
+	daemons map[daemonKey]ToolDaemon
+	factory func(tool, version string) ToolDaemon
+}
+
+// NewDaemonPool creates a pool that builds a fresh ToolDaemon via
+// factory the first time a given (tool, version, configHash) is
+// requested.
+func NewDaemonPool(factory func(tool, version string) ToolDaemon) *DaemonPool {
+	return &DaemonPool{
+		daemons: make(map[daemonKey]ToolDaemon),
+		factory: factory,
+	}
+}
+
+// Get returns the pooled daemon for key, starting a new one via the
+// pool's factory on first request. A Start failure is not cached, so a
+// transient failure (tool briefly missing from PATH) doesn't wedge the
+// pool - the next Get tries again.
+func (p *DaemonPool) Get(ctx context.Context, tool, version, configHash string) (ToolDaemon, error) {
+	key := daemonKey{tool: tool, version: version, configHash: configHash}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if d, ok := p.daemons[key]; ok {
+		return d, nil
+	}
+
+	d := p.factory(tool, version)
+	if err := d.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start %s daemon: %w", tool, err)
+	}
+
+	p.daemons[key] = d
+	return d, nil
+}
+
+// Evict stops and forgets the daemon for (tool, version, configHash), if
+// one is pooled, so a caller that saw Analyze fail because the process
+// died can make the next Get start a fresh one instead of reusing a
+// broken pipe.
+func (p *DaemonPool) Evict(tool, version, configHash string) {
+	key := daemonKey{tool: tool, version: version, configHash: configHash}
+
+	p.mu.Lock()
+	d, ok := p.daemons[key]
+	delete(p.daemons, key)
+	p.mu.Unlock()
+
+	if ok {
+		_ = d.Stop()
+	}
+}
+
+// Close stops every pooled daemon.
+func (p *DaemonPool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var firstErr error
+	for key, d := range p.daemons {
+		if err := d.Stop(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(p.daemons, key)
+	}
+	return firstErr
+}
+
+// daemonRequest/daemonResponse are the line-delimited JSON messages
+// lineProtocolDaemon exchanges with the subprocess over stdin/stdout:
+// one request per file batch, one response back, newline-terminated so
+// both sides can frame with a plain line scanner instead of needing
+// Content-Length headers the way the lsp package's transport does.
+type daemonRequest struct {
+	Files []string `json:"files"`
+}
+
+type daemonResponse struct {
+	Issues []Issue `json:"issues"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// lineProtocolDaemon wraps a subprocess that reads one daemonRequest
+// JSON object per line on stdin and writes one daemonResponse JSON
+// object per line on stdout. Both ruff's server mode and a pylint
+// wrapper that keeps astroid's AST cache warm can speak this same
+// framing, so one implementation backs both tools.
+type lineProtocolDaemon struct {
+	name string
+	cmd  []string
+
+	mu     sync.Mutex
+	proc   *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Scanner
+}
+
+// newLineProtocolDaemon creates a daemon that will run cmd on Start.
+// name is used only for error messages.
+func newLineProtocolDaemon(name string, cmd []string) *lineProtocolDaemon {
+	return &lineProtocolDaemon{name: name, cmd: cmd}
+}
+
+// Start spawns the subprocess and wires up its stdin/stdout pipes.
+func (d *lineProtocolDaemon) Start(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.cmd) == 0 {
+		return fmt.Errorf("%s: no daemon command configured", d.name)
+	}
+
+	proc := exec.CommandContext(ctx, d.cmd[0], d.cmd[1:]...)
+
+	stdin, err := proc.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("%s: failed to open daemon stdin: %w", d.name, err)
+	}
+	stdout, err := proc.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("%s: failed to open daemon stdout: %w", d.name, err)
+	}
+	if err := proc.Start(); err != nil {
+		return fmt.Errorf("%s: failed to start daemon: %w", d.name, err)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	d.proc = proc
+	d.stdin = stdin
+	d.stdout = scanner
+
+	return nil
+}
+
+// Analyze sends one request line and reads one response line. Calls are
+// serialized by mu: this is a single long-lived process with one
+// request in flight at a time, the same as dialing a lone TCP connection
+// to blackd rather than a pool of workers.
+func (d *lineProtocolDaemon) Analyze(files []string, options ExecuteOptions) (*Result, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stdin == nil {
+		return nil, fmt.Errorf("%s: daemon not started", d.name)
+	}
+
+	reqData, err := json.Marshal(daemonRequest{Files: files})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to encode daemon request: %w", d.name, err)
+	}
+	if _, err := d.stdin.Write(append(reqData, '\n')); err != nil {
+		return nil, fmt.Errorf("%s: daemon write failed: %w", d.name, err)
+	}
+
+	if !d.stdout.Scan() {
+		if err := d.stdout.Err(); err != nil {
+			return nil, fmt.Errorf("%s: daemon read failed: %w", d.name, err)
+		}
+		return nil, fmt.Errorf("%s: daemon closed its output", d.name)
+	}
+
+	var resp daemonResponse
+	if err := json.Unmarshal(d.stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("%s: malformed daemon response: %w", d.name, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%s: %s", d.name, resp.Error)
+	}
+
+	return &Result{
+		Tool:           d.name,
+		Success:        true,
+		FilesProcessed: len(files),
+		Issues:         resp.Issues,
+	}, nil
+}
+
+// Stop closes stdin (most daemons exit on EOF) and kills the process if
+// it's still around.
+func (d *lineProtocolDaemon) Stop() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stdin != nil {
+		_ = d.stdin.Close()
+	}
+	if d.proc == nil || d.proc.Process == nil {
+		return nil
+	}
+	return d.proc.Process.Kill()
+}
+
+var _ ToolDaemon = (*lineProtocolDaemon)(nil)
+
+// daemonConfigFingerprint derives a cheap fingerprint of configPaths for
+// DaemonPool's key, from their mtimes rather than a content hash - a
+// coarser check is fine here since the worst case of a stale fingerprint
+// is one extra daemon restart, not a wrong result: CachedTool's own
+// cache key (cache.GenerateKey's content hash) is what guards
+// correctness, this only decides whether to reuse a warm process.
+func daemonConfigFingerprint(configPaths []string) string {
+	if len(configPaths) == 0 {
+		return ""
+	}
+
+	sorted := make([]string, len(configPaths))
+	copy(sorted, configPaths)
+	sort.Strings(sorted)
+
+	fp := ""
+	for _, path := range sorted {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		fp += fmt.Sprintf("%s@%d;", path, info.ModTime().UnixNano())
+	}
+	return fp
+}
+
+// versionNumberPattern extracts the leading dotted-numeric version out
+// of free-form `tool --version` output like "ruff 0.4.2" or "pylint
+// 3.1.0 (astroid 3.1.0)".
+var versionNumberPattern = regexp.MustCompile(`(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// versionAtLeast reports whether version (as returned by GetVersion) is
+// at least min, comparing the leading major.minor.patch numerically. An
+// unparseable version is treated as not meeting the requirement, so an
+// unrecognized `--version` output safely falls back to the one-shot path
+// instead of guessing.
+func versionAtLeast(version, min string) bool {
+	v := versionNumberPattern.FindStringSubmatch(version)
+	m := versionNumberPattern.FindStringSubmatch(min)
+	if v == nil || m == nil {
+		return false
+	}
+
+	for i := 1; i <= 3; i++ {
+		vn, _ := strconv.Atoi(v[i])
+		mn, _ := strconv.Atoi(m[i])
+		if vn != mn {
+			return vn > mn
+		}
+	}
+	return true
+}
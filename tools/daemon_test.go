@@ -0,0 +1,159 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDaemon is a ToolDaemon test double that counts Start/Stop calls and
+// can be made to fail on either, so DaemonPool's caching and eviction
+// paths can be exercised without spawning a real subprocess.
+type fakeDaemon struct {
+	startErr   error
+	analyzeErr error
+	started    int
+	stopped    int
+}
+
+func (d *fakeDaemon) Start(ctx context.Context) error {
+	d.started++
+	return d.startErr
+}
+
+func (d *fakeDaemon) Analyze(files []string, options ExecuteOptions) (*Result, error) {
+	if d.analyzeErr != nil {
+		return nil, d.analyzeErr
+	}
+	return &Result{Success: true, FilesProcessed: len(files)}, nil
+}
+
+func (d *fakeDaemon) Stop() error {
+	d.stopped++
+	return nil
+}
+
+func TestDaemonPool_GetReusesDaemonForSameKey(t *testing.T) {
+	daemon := &fakeDaemon{}
+	calls := 0
+	pool := NewDaemonPool(func(tool, version string) ToolDaemon {
+		calls++
+		return daemon
+	})
+
+	first, err := pool.Get(context.Background(), "ruff", "0.4.0", "")
+	require.NoError(t, err)
+	second, err := pool.Get(context.Background(), "ruff", "0.4.0", "")
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, daemon.started)
+}
+
+func TestDaemonPool_GetStartsFreshDaemonPerConfigHash(t *testing.T) {
+	calls := 0
+	pool := NewDaemonPool(func(tool, version string) ToolDaemon {
+		calls++
+		return &fakeDaemon{}
+	})
+
+	_, err := pool.Get(context.Background(), "ruff", "0.4.0", "hash-a")
+	require.NoError(t, err)
+	_, err = pool.Get(context.Background(), "ruff", "0.4.0", "hash-b")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestDaemonPool_GetPropagatesStartFailureWithoutCaching(t *testing.T) {
+	calls := 0
+	pool := NewDaemonPool(func(tool, version string) ToolDaemon {
+		calls++
+		return &fakeDaemon{startErr: assert.AnError}
+	})
+
+	_, err := pool.Get(context.Background(), "ruff", "0.4.0", "")
+	require.Error(t, err)
+
+	_, err = pool.Get(context.Background(), "ruff", "0.4.0", "")
+	require.Error(t, err)
+
+	assert.Equal(t, 2, calls, "a failed start must not be cached")
+}
+
+func TestDaemonPool_EvictStopsAndForgetsDaemon(t *testing.T) {
+	daemon := &fakeDaemon{}
+	pool := NewDaemonPool(func(tool, version string) ToolDaemon { return daemon })
+
+	_, err := pool.Get(context.Background(), "pylint", "3.0.0", "")
+	require.NoError(t, err)
+
+	pool.Evict("pylint", "3.0.0", "")
+	assert.Equal(t, 1, daemon.stopped)
+
+	_, err = pool.Get(context.Background(), "pylint", "3.0.0", "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, daemon.started, "evicting must force a fresh Start on the next Get")
+}
+
+func TestDaemonPool_CloseStopsAllPooledDaemons(t *testing.T) {
+	pool := NewDaemonPool(func(tool, version string) ToolDaemon { return &fakeDaemon{} })
+
+	_, err := pool.Get(context.Background(), "ruff", "0.4.0", "")
+	require.NoError(t, err)
+	_, err = pool.Get(context.Background(), "pylint", "3.0.0", "")
+	require.NoError(t, err)
+
+	require.NoError(t, pool.Close())
+
+	_, err = pool.Get(context.Background(), "ruff", "0.4.0", "")
+	require.NoError(t, err)
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		min     string
+		want    bool
+	}{
+		{"0.4.2", "0.4.0", true},
+		{"0.4.0", "0.4.0", true},
+		{"0.3.9", "0.4.0", false},
+		{"pylint 3.1.0 (astroid 3.1.0)", "3.0.0", true},
+		{"ruff 0.3.0", "0.4.0", false},
+		{"unknown", "0.4.0", false},
+	}
+
+	for _, tt := range tests {
+		got := versionAtLeast(tt.version, tt.min)
+		assert.Equal(t, tt.want, got, "versionAtLeast(%q, %q)", tt.version, tt.min)
+	}
+}
+
+func TestDaemonConfigFingerprint_EmptyWhenNoConfigPaths(t *testing.T) {
+	assert.Equal(t, "", daemonConfigFingerprint(nil))
+}
+
+func TestDaemonConfigFingerprint_ChangesWhenFileIsModified(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ruff.toml")
+	require.NoError(t, os.WriteFile(path, []byte("line-length = 100"), 0o644))
+
+	before := daemonConfigFingerprint([]string{path})
+	require.NotEmpty(t, before)
+
+	newTime := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(path, newTime, newTime))
+
+	after := daemonConfigFingerprint([]string{path})
+	assert.NotEqual(t, before, after)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"sync"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools/sarif"
+)
+
+// IssueParser converts a tool's raw output - already known to be in a
+// particular OutputFormat (SetOutputFormat's "text"/"json"/"sarif") -
+// into Issues. It returns an error, rather than ok=false like
+// StructuredParser, when output doesn't match the format, since a
+// format-registered parser is never a fallback guess the way
+// ParseOutput's own StructuredParser check is.
+type IssueParser func(output []byte) ([]Issue, error)
+
+var issueParsers = struct {
+	mu   sync.RWMutex
+	byFn map[OutputFormat]IssueParser
+}{byFn: make(map[OutputFormat]IssueParser)}
+
+// RegisterParser associates format with parser, so any BaseTool-based
+// tool that declares its output format via SetOutputFormat gets a
+// working Execute parse path without writing its own ParseOutput -
+// which, for a tool that doesn't override Execute, BaseTool.Execute can
+// never reach anyway (Go's embedding promotes BaseTool.Execute as-is; it
+// has no way to call back into an embedding struct's own override).
+// Called from init() by built-in parsers (see parseSARIFIssues); a
+// custom tool or test may call it directly to add another format.
+func RegisterParser(format OutputFormat, parser IssueParser) {
+	issueParsers.mu.Lock()
+	defer issueParsers.mu.Unlock()
+	issueParsers.byFn[format] = parser
+}
+
+// ParserFor returns the parser RegisterParser last associated with
+// format, if any.
+func ParserFor(format OutputFormat) (IssueParser, bool) {
+	issueParsers.mu.RLock()
+	defer issueParsers.mu.RUnlock()
+	p, ok := issueParsers.byFn[format]
+	return p, ok
+}
+
+func init() {
+	RegisterParser(OutputFormatSARIF, parseSARIFIssues)
+}
+
+// parseSARIFIssues is the built-in parser for OutputFormatSARIF, mapping
+// a SARIF 2.1.0 log's runs[].results[] into Issues via the shared
+// tools/sarif ingestion helper - the same path MarkdownlintTool's own
+// ParseSARIFOutput uses, so severity mapping stays consistent between a
+// tool that declares its format through the registry and one with a
+// bespoke ParseOutput.
+func parseSARIFIssues(output []byte) ([]Issue, error) {
+	findings, err := sarif.Parse(output)
+	if err != nil {
+		return nil, err
+	}
+	return sarifFindingsToIssues(findings), nil
+}
+
+// sarifFindingsToIssues converts sarif.Findings into Issues.
+func sarifFindingsToIssues(findings []sarif.Finding) []Issue {
+	issues := make([]Issue, 0, len(findings))
+	for _, f := range findings {
+		issue := Issue{
+			File:     f.File,
+			Line:     f.StartLine,
+			Column:   f.StartColumn,
+			EndLine:  f.EndLine,
+			Severity: sarifLevelToSeverity(f.Level),
+			Rule:     f.RuleID,
+			Message:  f.Message,
+		}
+
+		if f.Fix != nil {
+			issue.FixInfo = &IssueFixInfo{
+				StartLine:   f.Fix.StartLine,
+				StartColumn: f.Fix.StartColumn,
+				EndLine:     f.Fix.EndLine,
+				EndColumn:   f.Fix.EndColumn,
+				InsertText:  f.Fix.InsertText,
+			}
+		}
+
+		issues = append(issues, issue)
+	}
+	return issues
+}
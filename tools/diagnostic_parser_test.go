@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParserFor_SARIFRegisteredByDefault(t *testing.T) {
+	parser, ok := ParserFor(OutputFormatSARIF)
+	require.True(t, ok)
+	assert.NotNil(t, parser)
+}
+
+func TestParserFor_UnregisteredFormat(t *testing.T) {
+	_, ok := ParserFor(OutputFormat("checkstyle-xml"))
+	assert.False(t, ok)
+}
+
+func TestRegisterParser_OverridesExistingFormat(t *testing.T) {
+	const format = OutputFormat("test-format")
+
+	RegisterParser(format, func(output []byte) ([]Issue, error) {
+		return []Issue{{Rule: "first"}}, nil
+	})
+	RegisterParser(format, func(output []byte) ([]Issue, error) {
+		return []Issue{{Rule: "second"}}, nil
+	})
+
+	parser, ok := ParserFor(format)
+	require.True(t, ok)
+
+	issues, err := parser(nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "second", issues[0].Rule)
+}
+
+func TestParseSARIFIssues(t *testing.T) {
+	data := []byte(`{"runs": [{"results": [
+		{
+			"ruleId": "no-unused-vars",
+			"level": "error",
+			"message": {"text": "'x' is defined but never used"},
+			"locations": [{
+				"physicalLocation": {
+					"artifactLocation": {"uri": "src/index.js"},
+					"region": {"startLine": 3, "startColumn": 7, "endLine": 3, "endColumn": 8}
+				}
+			}]
+		},
+		{
+			"ruleId": "no-console",
+			"level": "warning",
+			"message": {"text": "Unexpected console statement"},
+			"locations": [{
+				"physicalLocation": {
+					"artifactLocation": {"uri": "src/index.js"},
+					"region": {"startLine": 5, "startColumn": 1, "endLine": 5, "endColumn": 20}
+				}
+			}]
+		}
+	]}]}`)
+
+	issues, err := parseSARIFIssues(data)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+
+	assert.Equal(t, "no-unused-vars", issues[0].Rule)
+	assert.Equal(t, "error", issues[0].Severity)
+	assert.Equal(t, 3, issues[0].Line)
+	assert.Equal(t, "src/index.js", issues[0].File)
+
+	assert.Equal(t, "no-console", issues[1].Rule)
+	assert.Equal(t, "warning", issues[1].Severity)
+}
+
+func TestParseSARIFIssues_InvalidJSON(t *testing.T) {
+	_, err := parseSARIFIssues([]byte("not json"))
+	require.Error(t, err)
+}
+
+func TestBaseTool_ParseIssues_SARIFFormatUsesRegisteredParser(t *testing.T) {
+	tool := NewBaseTool("eslint", "JavaScript", "eslint", LINT)
+	require.NoError(t, tool.SetOutputFormat("sarif"))
+
+	data := `{"runs": [{"results": [{
+		"ruleId": "no-console",
+		"level": "warning",
+		"message": {"text": "Unexpected console statement"},
+		"locations": [{
+			"physicalLocation": {
+				"artifactLocation": {"uri": "src/index.js"},
+				"region": {"startLine": 5, "startColumn": 1}
+			}
+		}]
+	}]}]}`
+
+	issues, sarifReport := tool.parseIssues(data)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "no-console", issues[0].Rule)
+	assert.Equal(t, []byte(data), sarifReport)
+}
+
+func TestBaseTool_ParseIssues_InvalidSARIFFallsBackToParseOutput(t *testing.T) {
+	tool := NewBaseTool("eslint", "JavaScript", "eslint", LINT)
+	require.NoError(t, tool.SetOutputFormat("sarif"))
+
+	issues, sarifReport := tool.parseIssues("not sarif at all")
+	assert.Empty(t, issues)
+	assert.Nil(t, sarifReport)
+}
+
+func TestBaseTool_ParseIssues_TextFormatSkipsRegistry(t *testing.T) {
+	tool := NewBaseTool("gofmt", "Go", "gofmt", FORMAT)
+
+	issues, sarifReport := tool.parseIssues("some plain text output")
+	assert.Empty(t, issues)
+	assert.Nil(t, sarifReport)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package diffscope computes, per file, the line ranges a git diff
+// added or modified relative to a base ref, so a lint run can be scoped
+// to only what a PR actually touches. It works purely off the parsed
+// []tools.Issue shape (File, Line) after a tool's ParseOutput has run,
+// so the same scoping applies to every tool in the registry without any
+// of them needing diff awareness themselves.
+package diffscope
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LineRange is an inclusive [Start, End] 1-based line interval.
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Contains reports whether line falls within r.
+func (r LineRange) Contains(line int) bool {
+	return line >= r.Start && line <= r.End
+}
+
+// HunkSet maps a file path to the line ranges a diff added or modified
+// in it. A file with no entry has no recorded changes (either it wasn't
+// part of the diff, or the diff touched nothing but deleted lines).
+type HunkSet map[string][]LineRange
+
+// Contains reports whether line in file falls within any hunk recorded
+// for it.
+func (h HunkSet) Contains(file string, line int) bool {
+	for _, r := range h[file] {
+		if r.Contains(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// hunkHeaderRe matches a unified diff hunk header's new-file half, e.g.
+// "@@ -12,3 +15,4 @@" captures start=15, count=4. count is omitted by
+// git when it's 1 ("@@ -1 +1 @@").
+var hunkHeaderRe = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// Compute runs `git diff --unified=0 --no-color <base> -- <file>` for
+// each of files (paths relative to projectRoot, the same form they
+// appear as in Issue.File) and returns the resulting HunkSet.
+func Compute(ctx context.Context, projectRoot, base string, files []string) (HunkSet, error) {
+	hunks := make(HunkSet, len(files))
+
+	for _, file := range files {
+		ranges, err := hunksForFile(ctx, projectRoot, base, file)
+		if err != nil {
+			return nil, err
+		}
+		if len(ranges) > 0 {
+			hunks[file] = ranges
+		}
+	}
+
+	return hunks, nil
+}
+
+// hunksForFile runs the diff for a single file and parses its hunk
+// headers into LineRanges over the new (post-diff) file.
+func hunksForFile(ctx context.Context, projectRoot, base, file string) ([]LineRange, error) {
+	cmd := exec.CommandContext(ctx, "git", "diff", "--unified=0", "--no-color", base, "--", file)
+	if projectRoot != "" {
+		cmd.Dir = projectRoot
+	}
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s against %s: %w", file, base, err)
+	}
+
+	var ranges []LineRange
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "@@") {
+			continue
+		}
+
+		match := hunkHeaderRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		start, _ := strconv.Atoi(match[1])
+		count := 1
+		if match[2] != "" {
+			count, _ = strconv.Atoi(match[2])
+		}
+		if count == 0 {
+			// A pure-deletion hunk adds no lines to the new file, so
+			// there's nothing in it to scope an Issue's Line against.
+			continue
+		}
+
+		ranges = append(ranges, LineRange{Start: start, End: start + count - 1})
+	}
+
+	return ranges, scanner.Err()
+}
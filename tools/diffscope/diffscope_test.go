@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package diffscope
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initRepo creates a git repo at dir with an initial commit of content,
+// then rewrites the file and returns the commit hash of the initial
+// commit so callers can diff HEAD against it.
+func initRepo(t *testing.T, dir, file, initial, modified string) string {
+	t.Helper()
+
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git %v: %s", args, out)
+	}
+
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	path := filepath.Join(dir, file)
+	require.NoError(t, os.WriteFile(path, []byte(initial), 0o644))
+	run("add", file)
+	run("commit", "-q", "-m", "initial")
+
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	base := string(out)
+	base = base[:len(base)-1] // trim trailing newline
+
+	require.NoError(t, os.WriteFile(path, []byte(modified), 0o644))
+
+	return base
+}
+
+func TestCompute_RecordsAddedLines(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	base := initRepo(t, dir, "main.cpp",
+		"int main() {\n  return 0;\n}\n",
+		"int main() {\n  int x = 1;\n  return 0;\n}\n",
+	)
+
+	hunks, err := Compute(context.Background(), dir, base, []string{"main.cpp"})
+	require.NoError(t, err)
+
+	assert.True(t, hunks.Contains("main.cpp", 2))
+	assert.False(t, hunks.Contains("main.cpp", 1))
+	assert.False(t, hunks.Contains("main.cpp", 3))
+}
+
+func TestCompute_NoEntryForUnchangedFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	base := initRepo(t, dir, "main.cpp", "int main() { return 0; }\n", "int main() { return 0; }\n")
+
+	hunks, err := Compute(context.Background(), dir, base, []string{"main.cpp"})
+	require.NoError(t, err)
+	assert.False(t, hunks.Contains("main.cpp", 1))
+}
+
+func TestHunkSet_Contains_NoFileEntry(t *testing.T) {
+	hunks := HunkSet{}
+	assert.False(t, hunks.Contains("missing.cpp", 10))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import "sync"
+
+// factories holds compile-time tool constructors registered via
+// Register, keyed by tool name - the mechanism a tool file's init() uses
+// to make itself discoverable without NewRegistryFromFactories' caller
+// needing to name every constructor by hand.
+var (
+	factoryMu sync.RWMutex
+	factories = make(map[string]func() QualityTool)
+)
+
+// Register records factory under name for later construction via
+// Factories or NewRegistryFromFactories. Tool files call this from their
+// own init(), e.g.:
+//
+//	func init() { Register("gofumpt", func() QualityTool { return NewGofumptTool() }) }
+//
+// so a new built-in tool becomes part of the default registry just by
+// being compiled in.
+func Register(name string, factory func() QualityTool) {
+	factoryMu.Lock()
+	defer factoryMu.Unlock()
+	factories[name] = factory
+}
+
+// Factories returns a copy of every compile-time-registered factory,
+// keyed by tool name.
+func Factories() map[string]func() QualityTool {
+	factoryMu.RLock()
+	defer factoryMu.RUnlock()
+
+	out := make(map[string]func() QualityTool, len(factories))
+	for name, factory := range factories {
+		out[name] = factory
+	}
+	return out
+}
+
+// NewRegistryFromFactories builds a DefaultRegistry pre-populated with
+// one instance of every compile-time-registered tool (see Register),
+// for a caller that wants the full built-in tool set without listing
+// each constructor by hand.
+func NewRegistryFromFactories() *DefaultRegistry {
+	r := NewRegistry()
+	for _, factory := range Factories() {
+		r.Register(factory())
+	}
+	return r
+}
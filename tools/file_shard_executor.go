@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// ShardRunner runs a single ParallelCapable tool invocation over one
+// shard of its file list and returns that shard's Result, the same way
+// the tool's own Execute would for the full set.
+type ShardRunner func(ctx context.Context, shardFiles []string) (*Result, error)
+
+// FileShardExecutor partitions a tool's file list across workers and
+// runs run concurrently, merging the per-shard Results into one. Unlike
+// the executor package's ParallelExecutor (which runs different tools
+// concurrently), FileShardExecutor parallelizes a single tool over its
+// own files.
+type FileShardExecutor struct{}
+
+// NewFileShardExecutor creates a new FileShardExecutor.
+func NewFileShardExecutor() *FileShardExecutor {
+	return &FileShardExecutor{}
+}
+
+// Execute shards files across workers (runtime.NumCPU() if workers <= 0)
+// and runs run once per non-empty shard. If ctx is cancelled or any
+// shard's run returns an error, the remaining in-flight shards are
+// cancelled and the first error is returned. On success the shards'
+// Issues are merged, deduplicated by (File, Line, Column, Rule), and
+// sorted so the aggregated Result is ordered deterministically
+// regardless of which shard finished first.
+func (e *FileShardExecutor) Execute(ctx context.Context, files []string, workers int, run ShardRunner) (*Result, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(files) {
+		workers = len(files)
+	}
+	if workers <= 1 {
+		return run(ctx, files)
+	}
+
+	shards := make([][]string, workers)
+	for i, f := range files {
+		shards[i%workers] = append(shards[i%workers], f)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*Result, workers)
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for i, shard := range shards {
+		if len(shard) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, shard []string) {
+			defer wg.Done()
+
+			result, err := run(ctx, shard)
+			if err != nil {
+				errs[i] = err
+				cancel()
+				return
+			}
+			results[i] = result
+		}(i, shard)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mergeShardResults(results), nil
+}
+
+// mergeShardResults combines each shard's Result into one, deduplicating
+// Issues by (File, Line, Column, Rule) and sorting the merged list for a
+// deterministic, shard-order-independent Result.
+func mergeShardResults(results []*Result) *Result {
+	merged := &Result{Success: true, Issues: []Issue{}}
+
+	seen := make(map[string]bool)
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+
+		if r.Tool != "" {
+			merged.Tool = r.Tool
+		}
+		if r.Language != "" {
+			merged.Language = r.Language
+		}
+		if !r.Success {
+			merged.Success = false
+		}
+		if r.Error != nil && merged.Error == nil {
+			merged.Error = r.Error
+		}
+		merged.FilesProcessed += r.FilesProcessed
+
+		for _, issue := range r.Issues {
+			key := fmt.Sprintf("%s:%d:%d:%s", issue.File, issue.Line, issue.Column, issue.Rule)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged.Issues = append(merged.Issues, issue)
+		}
+	}
+
+	sort.Slice(merged.Issues, func(i, j int) bool {
+		a, b := merged.Issues[i], merged.Issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Rule < b.Rule
+	})
+
+	return merged
+}
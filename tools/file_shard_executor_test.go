@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileShardExecutor_Execute_ShardsAndMerges(t *testing.T) {
+	executor := NewFileShardExecutor()
+	files := []string{"a.cpp", "b.cpp", "c.cpp", "d.cpp"}
+
+	var calls int32
+	result, err := executor.Execute(context.Background(), files, 2, func(_ context.Context, shardFiles []string) (*Result, error) {
+		atomic.AddInt32(&calls, 1)
+		issues := make([]Issue, 0, len(shardFiles))
+		for _, f := range shardFiles {
+			issues = append(issues, Issue{File: f, Line: 1, Column: 1, Rule: "rule"})
+		}
+		return &Result{Tool: "clang-tidy", Success: true, FilesProcessed: len(shardFiles), Issues: issues}, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls)
+	assert.True(t, result.Success)
+	assert.Equal(t, 4, result.FilesProcessed)
+	require.Len(t, result.Issues, 4)
+	assert.Equal(t, "a.cpp", result.Issues[0].File)
+	assert.Equal(t, "d.cpp", result.Issues[3].File)
+}
+
+func TestFileShardExecutor_Execute_DeduplicatesIssues(t *testing.T) {
+	executor := NewFileShardExecutor()
+	files := []string{"a.cpp", "b.cpp"}
+
+	result, err := executor.Execute(context.Background(), files, 2, func(_ context.Context, shardFiles []string) (*Result, error) {
+		return &Result{
+			Success: true,
+			Issues: []Issue{
+				{File: "a.cpp", Line: 1, Column: 1, Rule: "dup-rule"},
+			},
+		}, nil
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Issues, 1)
+}
+
+func TestFileShardExecutor_Execute_PropagatesSiblingError(t *testing.T) {
+	executor := NewFileShardExecutor()
+	files := []string{"a.cpp", "b.cpp", "c.cpp", "d.cpp"}
+
+	_, err := executor.Execute(context.Background(), files, 4, func(_ context.Context, shardFiles []string) (*Result, error) {
+		if shardFiles[0] == "b.cpp" {
+			return nil, errors.New("shard failed")
+		}
+		return &Result{Success: true}, nil
+	})
+
+	assert.Error(t, err)
+}
+
+func TestFileShardExecutor_Execute_SingleWorkerRunsOnce(t *testing.T) {
+	executor := NewFileShardExecutor()
+	files := []string{"a.cpp", "b.cpp"}
+
+	var calls int32
+	_, err := executor.Execute(context.Background(), files, 1, func(_ context.Context, shardFiles []string) (*Result, error) {
+		atomic.AddInt32(&calls, 1)
+		assert.Equal(t, files, shardFiles)
+		return &Result{Success: true}, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestClangTidyTool_SupportsParallel(t *testing.T) {
+	tool := NewClangTidyTool()
+	assert.True(t, tool.SupportsParallel())
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ProposeFromInPlaceFormat runs an in-place formatter against a scratch
+// copy of file and diffs the result against the original, for tools (like
+// ktlint's -F) whose fix mode has no native dry-run/diff of its own.
+// format rewrites the file at scratchPath; a nil Fix with a nil error
+// means format made no changes.
+func ProposeFromInPlaceFormat(file, rule string, format func(scratchPath string) error) (*Fix, error) {
+	original, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	scratch, err := os.CreateTemp("", "gzh-autofix-*"+filepath.Ext(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scratch file: %w", err)
+	}
+	scratchPath := scratch.Name()
+	defer os.Remove(scratchPath)
+
+	if _, err := scratch.Write(original); err != nil {
+		_ = scratch.Close()
+		return nil, fmt.Errorf("failed to seed scratch file: %w", err)
+	}
+	if err := scratch.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close scratch file: %w", err)
+	}
+
+	if err := format(scratchPath); err != nil {
+		return nil, fmt.Errorf("formatter failed on scratch copy of %s: %w", file, err)
+	}
+
+	formatted, err := os.ReadFile(scratchPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read formatted scratch copy of %s: %w", file, err)
+	}
+
+	if string(formatted) == string(original) {
+		return nil, nil
+	}
+
+	return &Fix{
+		File:        file,
+		Range:       FixRange{StartLine: 1, EndLine: len(strings.Split(string(original), "\n"))},
+		Before:      string(original),
+		After:       string(formatted),
+		Rule:        rule,
+		Explanation: "formatter rewrote the file in place",
+	}, nil
+}
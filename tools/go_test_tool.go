@@ -0,0 +1,325 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// goTestDefaultPackageTimeout bounds a single `go test` invocation's own
+// -timeout flag, independent of whatever per-tool timeout the caller's
+// executor applies around the whole task - a hung package shouldn't be
+// able to starve the other shards running concurrently in the same task.
+const goTestDefaultPackageTimeout = 2 * time.Minute
+
+// GoTestTool runs `go test -json` across a project's packages and turns
+// failed tests into Issues, the Go analogue of JUnitTool for Java.
+type GoTestTool struct {
+	*BaseTool
+	packageTimeout time.Duration
+	cache          TestPackageCache
+}
+
+// NewGoTestTool creates a new go test tool.
+func NewGoTestTool() *GoTestTool {
+	tool := &GoTestTool{
+		BaseTool:       NewBaseTool("go-test", "Go", "go", TEST),
+		packageTimeout: goTestDefaultPackageTimeout,
+	}
+
+	tool.SetConfigPatterns([]string{"go.mod"})
+	tool.SetSupportedExtensions([]string{".go"})
+
+	return tool
+}
+
+// SetPackageTimeout overrides the -timeout passed to each `go test`
+// invocation. d <= 0 restores goTestDefaultPackageTimeout.
+func (t *GoTestTool) SetPackageTimeout(d time.Duration) {
+	if d <= 0 {
+		d = goTestDefaultPackageTimeout
+	}
+	t.packageTimeout = d
+}
+
+// SetTestCache installs c as the package-result cache Execute consults
+// before re-running a package, and updates after a package passes.
+func (t *GoTestTool) SetTestCache(c TestPackageCache) {
+	t.cache = c
+}
+
+// SupportsParallel reports that GoTestTool shards packages across
+// workers, since `go test` itself runs each package's tests serially
+// relative to the other packages passed on its argv.
+func (t *GoTestTool) SupportsParallel() bool {
+	return true
+}
+
+// goPackage is one entry of `go list`'s package output: its import path
+// (what gets passed to `go test`) alongside the directory its hash is
+// computed from.
+type goPackage struct {
+	ImportPath string
+	Dir        string
+}
+
+// Execute lists the project's packages, skips any whose hash is already
+// cached as passing, shards the rest across options.Parallelism workers
+// via FileShardExecutor, and runs `go test -json` over each shard. files
+// is accepted for QualityTool-interface symmetry but ignored in favor of
+// the package list: test failures are reported per-package, not per
+// source file.
+func (t *GoTestTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	packages, err := t.listPackages(ctx, options)
+	if err != nil {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("failed to list Go packages: %w", err),
+		}, nil
+	}
+
+	if len(packages) == 0 {
+		return &Result{Tool: t.name, Language: t.language, Success: true, Issues: []Issue{}}, nil
+	}
+
+	hashes := make(map[string]string, len(packages))
+	var toRun []string
+
+	for _, pkg := range packages {
+		hash, hashErr := packageHash(pkg.Dir)
+		if hashErr != nil {
+			// Can't hash this package's source (e.g. it vanished) - run
+			// it for real rather than guessing at its cache state.
+			toRun = append(toRun, pkg.ImportPath)
+			continue
+		}
+		hashes[pkg.ImportPath] = hash
+
+		if t.cache != nil {
+			if passed, found := t.cache.Get(pkg.ImportPath, hash); found && passed {
+				continue
+			}
+		}
+		toRun = append(toRun, pkg.ImportPath)
+	}
+
+	if len(toRun) == 0 {
+		return &Result{
+			Tool:           t.name,
+			Language:       t.language,
+			Success:        true,
+			FilesProcessed: len(packages),
+			Issues:         []Issue{},
+			Cached:         true,
+		}, nil
+	}
+
+	shardExecutor := NewFileShardExecutor()
+
+	result, err := shardExecutor.Execute(ctx, toRun, options.Parallelism, func(ctx context.Context, shardPackages []string) (*Result, error) {
+		return t.executeShard(ctx, shardPackages, hashes, options)
+	})
+	if result != nil {
+		result.FilesProcessed = len(packages)
+	}
+
+	return result, err
+}
+
+// listPackages returns every package under options.ProjectRoot via `go
+// list -f` with its import path and directory.
+func (t *GoTestTool) listPackages(ctx context.Context, options ExecuteOptions) ([]goPackage, error) {
+	cmd := exec.CommandContext(ctx, t.executable, "list", "-f", "{{.ImportPath}}\t{{.Dir}}", "./...")
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []goPackage
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.SplitN(line, "\t", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		packages = append(packages, goPackage{ImportPath: parts[0], Dir: parts[1]})
+	}
+
+	return packages, nil
+}
+
+// packageHash hashes the name and content of every .go file directly in
+// dir (not transitive dependencies - a deliberate simplification: a
+// changed dependency usually also touches its own package's go.sum or
+// the importer's source, both of which invalidate the importer's own
+// entry on their next build anyway). Test files are included, so editing
+// a _test.go file invalidates the cache the same as editing production
+// code.
+func packageHash(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".go") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		data, readErr := os.ReadFile(filepath.Join(dir, name))
+		if readErr != nil {
+			return "", readErr
+		}
+		h.Write([]byte(name))
+		h.Write(data)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// executeShard runs `go test -json -timeout <packageTimeout>` over one
+// shard of packages, turns its event stream into a Result, and records
+// every package that passed in t.cache so a later unchanged run can skip
+// it.
+func (t *GoTestTool) executeShard(ctx context.Context, packages []string, hashes map[string]string, options ExecuteOptions) (*Result, error) {
+	args := []string{"test", "-json", "-timeout", t.packageTimeout.String()}
+	args = append(args, options.ExtraArgs...)
+	args = append(args, packages...)
+
+	cmd := exec.CommandContext(ctx, t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	runErr := cmd.Run()
+
+	issues := parseGoTestJSON(stdout.Bytes())
+
+	if t.cache != nil {
+		failed := make(map[string]bool, len(issues))
+		for _, issue := range issues {
+			failed[issue.File] = true
+		}
+		for _, pkg := range packages {
+			if failed[pkg] {
+				continue
+			}
+			if hash, ok := hashes[pkg]; ok {
+				t.cache.Put(pkg, hash)
+			}
+		}
+	}
+
+	return &Result{
+		Tool:           t.name,
+		Language:       t.language,
+		Success:        runErr == nil,
+		FilesProcessed: len(packages),
+		Issues:         issues,
+		Output:         stdout.String(),
+	}, nil
+}
+
+// goTestEvent is one line of `go test -json`'s event stream.
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Output  string
+}
+
+// parseGoTestJSON decodes a `go test -json` event stream, accumulating
+// each test's "output" events and turning every "fail" action (test- or
+// package-level) into an Issue carrying that accumulated output.
+func parseGoTestJSON(data []byte) []Issue {
+	output := make(map[string]*strings.Builder)
+	key := func(pkg, test string) string { return pkg + "\x00" + test }
+
+	var issues []Issue
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var event goTestEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+
+		k := key(event.Package, event.Test)
+
+		switch event.Action {
+		case "output":
+			if output[k] == nil {
+				output[k] = &strings.Builder{}
+			}
+			output[k].WriteString(event.Output)
+		case "fail":
+			message := ""
+			if b := output[k]; b != nil {
+				message = strings.TrimSpace(b.String())
+			}
+
+			rule := event.Test
+			if rule == "" {
+				rule = "build failed"
+			}
+
+			issues = append(issues, Issue{
+				File:     event.Package,
+				Severity: "error",
+				Rule:     rule,
+				Message:  message,
+			})
+		}
+	}
+
+	return issues
+}
+
+// Ensure GoTestTool implements QualityTool, ParallelCapable and
+// TestCacheable.
+var (
+	_ QualityTool     = (*GoTestTool)(nil)
+	_ ParallelCapable = (*GoTestTool)(nil)
+	_ TestCacheable   = (*GoTestTool)(nil)
+)
@@ -5,11 +5,15 @@ package tools
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+
+	yaml "gopkg.in/yaml.v3"
 )
 
 // GofumptTool implements Go formatting using gofumpt.
@@ -25,6 +29,7 @@ func NewGofumptTool() *GofumptTool {
 
 	tool.SetInstallCommand([]string{"go", "install", "mvdan.cc/gofumpt@latest"})
 	tool.SetConfigPatterns([]string{".gofumpt"})
+	tool.SetSupportedExtensions([]string{".go"})
 
 	return tool
 }
@@ -61,6 +66,8 @@ func NewGoimportsTool() *GoimportsTool {
 	}
 
 	tool.SetInstallCommand([]string{"go", "install", "golang.org/x/tools/cmd/goimports@latest"})
+	tool.SetConfigPatterns([]string{MetaRunnerConfigFile})
+	tool.SetSupportedExtensions([]string{".go"})
 
 	return tool
 }
@@ -69,12 +76,56 @@ func NewGoimportsTool() *GoimportsTool {
 func (t *GoimportsTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
 	args := []string{"-w"} // Always write changes
 
-	// Add local import setting if project root is available
+	if prefixes := localImportPrefixes(options); len(prefixes) > 0 {
+		args = append(args, "-local", strings.Join(prefixes, ","))
+	}
+
+	// Add extra flags if provided
+	args = append(args, options.ExtraArgs...)
+
+	// Filter only Go files
+	goFiles := FilterFilesByExtensions(files, []string{".go"})
+	args = append(args, goFiles...)
+
+	cmd := exec.Command(t.executable, args...)
+
 	if options.ProjectRoot != "" {
-		// Try to determine module name from go.mod
-		if modName := getGoModuleName(options.ProjectRoot); modName != "" {
-			args = append(args, "-local", modName)
-		}
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// GciTool implements Go import grouping/ordering using gci, an
+// alternative to goimports' single -local prefix for monorepos wanting
+// explicit, multi-section import ordering (standard / default /
+// prefix(<module>) / blank / dot).
+type GciTool struct {
+	*BaseTool
+}
+
+// NewGciTool creates a new gci tool.
+func NewGciTool() *GciTool {
+	tool := &GciTool{
+		BaseTool: NewBaseTool("gci", "Go", "gci", FORMAT),
+	}
+
+	tool.SetInstallCommand([]string{"go", "install", "github.com/daixiang0/gci@latest"})
+	tool.SetConfigPatterns([]string{MetaRunnerConfigFile})
+	tool.SetSupportedExtensions([]string{".go"})
+
+	return tool
+}
+
+// BuildCommand builds the gci command. Section ordering comes from
+// resolveGciSections: an explicit .gzh-quality.yaml imports.sections
+// wins, otherwise it's gci's own conventional standard/default order
+// plus a prefix() section per detected local import prefix.
+func (t *GciTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"write"}
+
+	for _, section := range resolveGciSections(options) {
+		args = append(args, "--section", section)
 	}
 
 	// Add extra flags if provided
@@ -93,6 +144,135 @@ func (t *GoimportsTool) BuildCommand(files []string, options ExecuteOptions) *ex
 	return cmd
 }
 
+// GolinesTool reflows Go source lines longer than a configured max width
+// using golines, a formatter that runs after gofumpt/goimports in a
+// typical chain since it works on already-gofmt'd source.
+type GolinesTool struct {
+	*BaseTool
+}
+
+// golinesDefaultMaxLineLength is golines' own --max-len default.
+const golinesDefaultMaxLineLength = 100
+
+// NewGolinesTool creates a new golines tool.
+func NewGolinesTool() *GolinesTool {
+	tool := &GolinesTool{
+		BaseTool: NewBaseTool("golines", "Go", "golines", FORMAT),
+	}
+
+	tool.SetInstallCommand([]string{"go", "install", "github.com/segmentio/golines@latest"})
+	tool.SetSupportedExtensions([]string{".go"})
+
+	return tool
+}
+
+// BuildCommand builds the golines command.
+func (t *GolinesTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-w", "-m", strconv.Itoa(golinesDefaultMaxLineLength)}
+
+	// Add extra flags if provided
+	args = append(args, options.ExtraArgs...)
+
+	// Filter only Go files
+	goFiles := FilterFilesByExtensions(files, []string{".go"})
+	args = append(args, goFiles...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// importsConfig is the Go import-grouping configuration read from a
+// project's .gzh-quality.yaml (see MetaRunnerConfigFile), under the
+// top-level "imports" key - shared by GoimportsTool and GciTool so both
+// pick up the same project-level prefix/section settings.
+type importsConfig struct {
+	// Local overrides the auto-detected module name as the set of
+	// import-grouping prefixes, e.g. ["github.com/acme/myproject"]. A
+	// monorepo with several modules under one root typically needs more
+	// than the single prefix `go list -m` would find from ProjectRoot.
+	Local []string `yaml:"local"`
+
+	// Sections orders gci's import sections, e.g. ["standard", "default",
+	// "prefix(github.com/acme/myproject)", "blank", "dot"]. Ignored by
+	// GoimportsTool.
+	Sections []string `yaml:"sections"`
+}
+
+// loadImportsConfig reads the "imports" section of projectRoot's
+// .gzh-quality.yaml, returning a zero value (no error) when the file or
+// the section is absent - the same missing-is-defaults behavior as
+// LoadMetaRunnerConfig.
+func loadImportsConfig(projectRoot string) importsConfig {
+	var doc struct {
+		Imports importsConfig `yaml:"imports"`
+	}
+
+	data, err := os.ReadFile(filepath.Join(projectRoot, MetaRunnerConfigFile))
+	if err != nil {
+		return importsConfig{}
+	}
+
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return importsConfig{}
+	}
+
+	return doc.Imports
+}
+
+// localImportPrefixes resolves the import-grouping prefixes GoimportsTool
+// and GciTool group as "local": an explicit options.LocalPrefixes wins,
+// then .gzh-quality.yaml's imports.local, falling back to the project's
+// own module name via `go list -m` when neither is set.
+func localImportPrefixes(options ExecuteOptions) []string {
+	if len(options.LocalPrefixes) > 0 {
+		return options.LocalPrefixes
+	}
+
+	if options.ProjectRoot == "" {
+		return nil
+	}
+
+	if cfg := loadImportsConfig(options.ProjectRoot); len(cfg.Local) > 0 {
+		return cfg.Local
+	}
+
+	if modName := getGoModuleName(options.ProjectRoot); modName != "" {
+		return []string{modName}
+	}
+
+	return nil
+}
+
+// gciDefaultSections are gci's own conventional section order, used as
+// the base that a detected local prefix's own prefix() section is
+// appended to when .gzh-quality.yaml's imports.sections doesn't override
+// it outright.
+var gciDefaultSections = []string{"standard", "default"}
+
+// resolveGciSections resolves GciTool's --section flags: an explicit
+// .gzh-quality.yaml imports.sections wins outright, otherwise it's
+// gciDefaultSections plus one prefix(<prefix>) section per prefix
+// localImportPrefixes resolves.
+func resolveGciSections(options ExecuteOptions) []string {
+	if options.ProjectRoot != "" {
+		if cfg := loadImportsConfig(options.ProjectRoot); len(cfg.Sections) > 0 {
+			return cfg.Sections
+		}
+	}
+
+	sections := append([]string{}, gciDefaultSections...)
+	for _, prefix := range localImportPrefixes(options) {
+		sections = append(sections, fmt.Sprintf("prefix(%s)", prefix))
+	}
+
+	return sections
+}
+
 // GolangciLintTool implements Go linting using golangci-lint.
 type GolangciLintTool struct {
 	*BaseTool
@@ -106,6 +286,7 @@ func NewGolangciLintTool() *GolangciLintTool {
 
 	tool.SetInstallCommand([]string{"go", "install", "github.com/golangci/golangci-lint/cmd/golangci-lint@latest"})
 	tool.SetConfigPatterns([]string{".golangci.yml", ".golangci.yaml", "golangci.yml", "golangci.yaml"})
+	tool.SetSupportedExtensions([]string{".go"})
 
 	return tool
 }
@@ -127,6 +308,11 @@ func (t *GolangciLintTool) BuildCommand(files []string, options ExecuteOptions)
 	// Output format for parsing
 	args = append(args, "--out-format", "json")
 
+	// Only report issues introduced since BaseRef for incremental runs
+	if options.BaseRef != "" {
+		args = append(args, "--new-from-rev", options.BaseRef)
+	}
+
 	// Add extra flags if provided
 	args = append(args, options.ExtraArgs...)
 
@@ -158,12 +344,24 @@ func (t *GolangciLintTool) BuildCommand(files []string, options ExecuteOptions)
 	return cmd
 }
 
-// ParseOutput parses golangci-lint JSON output.
+// ParseOutput parses golangci-lint JSON output, falling back to plain
+// text parsing when it isn't JSON at all.
 func (t *GolangciLintTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
 		return []Issue{}
 	}
 
+	if issues, ok := t.ParseStructured([]byte(output)); ok {
+		return issues
+	}
+
+	return t.parseTextOutput(output)
+}
+
+// ParseStructured implements StructuredParser, parsing golangci-lint's
+// `--out-format json` output. ok is false if output isn't a golangci-lint
+// JSON result, so ParseOutput can fall back to parseTextOutput.
+func (t *GolangciLintTool) ParseStructured(output []byte) ([]Issue, bool) {
 	var lintResults struct {
 		Issues []struct {
 			FromLinter  string   `json:"FromLinter"`
@@ -182,9 +380,8 @@ func (t *GolangciLintTool) ParseOutput(output string) []Issue {
 		} `json:"Issues"`
 	}
 
-	if err := json.Unmarshal([]byte(output), &lintResults); err != nil {
-		// Fallback to plain text parsing
-		return t.parseTextOutput(output)
+	if err := json.Unmarshal(output, &lintResults); err != nil {
+		return nil, false
 	}
 
 	issues := make([]Issue, 0, len(lintResults.Issues))
@@ -196,6 +393,7 @@ func (t *GolangciLintTool) ParseOutput(output string) []Issue {
 			Severity: item.Severity,
 			Rule:     item.FromLinter,
 			Message:  item.Text,
+			Category: golangciLinterCategory(item.FromLinter),
 		}
 
 		if item.Replacement != nil && len(item.Replacement.NewLines) > 0 {
@@ -205,7 +403,7 @@ func (t *GolangciLintTool) ParseOutput(output string) []Issue {
 		issues = append(issues, issue)
 	}
 
-	return issues
+	return issues, true
 }
 
 // parseTextOutput parses plain text output as fallback.
@@ -234,6 +432,7 @@ func (t *GolangciLintTool) parseTextOutput(output string) []Issue {
 				Severity: "error", // Default severity
 				Rule:     matches[5],
 				Message:  matches[4],
+				Category: golangciLinterCategory(matches[5]),
 			})
 		}
 	}
@@ -241,6 +440,57 @@ func (t *GolangciLintTool) parseTextOutput(output string) []Issue {
 	return issues
 }
 
+// golangciLinterCategoryMap maps a golangci-lint "FromLinter" name to the
+// Issue.Category it belongs to. Linters not listed here (including
+// project-specific custom linters) leave Category empty rather than
+// guessing.
+var golangciLinterCategoryMap = map[string]string{
+	"gosec":       "security",
+	"govet":       "bug",
+	"staticcheck": "bug",
+	"errcheck":    "bug",
+	"ineffassign": "bug",
+	"gofmt":       "style",
+	"gofumpt":     "style",
+	"goimports":   "style",
+	"whitespace":  "style",
+	"gocritic":    "style",
+	"revive":      "style",
+	"stylecheck":  "style",
+	"lll":         "style",
+	"gocyclo":     "perf",
+	"prealloc":    "perf",
+	"maintidx":    "perf",
+}
+
+// golangciLinterCategory returns the Issue.Category for a golangci-lint
+// linter name, or "" if unknown.
+func golangciLinterCategory(linter string) string {
+	return golangciLinterCategoryMap[linter]
+}
+
+// golangciLinterHelpURI maps a golangci-lint "FromLinter" name (the same
+// string ParseStructured puts in Issue.Rule) to that linter's upstream
+// documentation, for RuleCatalog registration in init() below.
+var golangciLinterHelpURI = map[string]string{
+	"gosec":       "https://github.com/securego/gosec",
+	"govet":       "https://pkg.go.dev/cmd/vet",
+	"staticcheck": "https://staticcheck.dev/docs/checks",
+	"errcheck":    "https://github.com/kisielk/errcheck",
+	"ineffassign": "https://github.com/gordonklaus/ineffassign",
+	"gofmt":       "https://pkg.go.dev/cmd/gofmt",
+	"gofumpt":     "https://github.com/mvdan/gofumpt",
+	"goimports":   "https://pkg.go.dev/golang.org/x/tools/cmd/goimports",
+	"whitespace":  "https://github.com/ultraware/whitespace",
+	"gocritic":    "https://github.com/go-critic/go-critic",
+	"revive":      "https://github.com/mgechev/revive",
+	"stylecheck":  "https://staticcheck.dev/docs/checks#stylecheck",
+	"lll":         "https://github.com/walle/lll",
+	"gocyclo":     "https://github.com/fzipp/gocyclo",
+	"prealloc":    "https://github.com/alexkohler/prealloc",
+	"maintidx":    "https://github.com/yagipy/maintidx",
+}
+
 // getGoModuleName extracts module name from go.mod file.
 func getGoModuleName(projectRoot string) string {
 	cmd := exec.Command("go", "list", "-m")
@@ -256,7 +506,31 @@ func getGoModuleName(projectRoot string) string {
 
 // Ensure Go tools implement QualityTool interface.
 var (
-	_ QualityTool = (*GofumptTool)(nil)
-	_ QualityTool = (*GoimportsTool)(nil)
-	_ QualityTool = (*GolangciLintTool)(nil)
+	_ QualityTool      = (*GofumptTool)(nil)
+	_ QualityTool      = (*GoimportsTool)(nil)
+	_ QualityTool      = (*GciTool)(nil)
+	_ QualityTool      = (*GolinesTool)(nil)
+	_ QualityTool      = (*GolangciLintTool)(nil)
+	_ StructuredParser = (*GolangciLintTool)(nil)
 )
+
+// init self-registers the Go tools' compile-time factories (see
+// Register), so NewRegistryFromFactories picks them up without
+// quality.go's registry setup needing to name each constructor by hand.
+func init() {
+	Register("gofumpt", func() QualityTool { return NewGofumptTool() })
+	Register("goimports", func() QualityTool { return NewGoimportsTool() })
+	Register("gci", func() QualityTool { return NewGciTool() })
+	Register("golines", func() QualityTool { return NewGolinesTool() })
+	Register("golangci-lint", func() QualityTool { return NewGolangciLintTool() })
+
+	// golangci-lint's Issue.Rule is the wrapped linter's name (see
+	// ParseStructured/parseTextOutput above), so the catalog key is
+	// ("golangci-lint", <linter name>) for every linter it's known to wrap.
+	for linter, helpURI := range golangciLinterHelpURI {
+		RegisterRule("golangci-lint", linter, RuleMeta{
+			Title:   linter,
+			HelpURI: helpURI,
+		})
+	}
+}
@@ -334,6 +334,47 @@ func TestGolangciLintTool_ParseOutput(t *testing.T) {
 	}
 }
 
+func TestGolangciLintTool_ParseOutput_PopulatesCategory(t *testing.T) {
+	tool := NewGolangciLintTool()
+
+	output := `{
+		"Issues": [
+			{"FromLinter": "gosec", "Text": "G101", "Severity": "error", "Pos": {"Filename": "a.go", "Line": 1, "Column": 1}},
+			{"FromLinter": "errcheck", "Text": "unchecked", "Severity": "error", "Pos": {"Filename": "a.go", "Line": 2, "Column": 1}},
+			{"FromLinter": "gofmt", "Text": "not formatted", "Severity": "error", "Pos": {"Filename": "a.go", "Line": 3, "Column": 1}},
+			{"FromLinter": "some-custom-linter", "Text": "custom", "Severity": "error", "Pos": {"Filename": "a.go", "Line": 4, "Column": 1}}
+		]
+	}`
+
+	issues := tool.ParseOutput(output)
+	assert.Len(t, issues, 4)
+
+	assert.Equal(t, "security", issues[0].Category)
+	assert.Equal(t, "bug", issues[1].Category)
+	assert.Equal(t, "style", issues[2].Category)
+	assert.Empty(t, issues[3].Category)
+}
+
+func TestInit_RegistersGolangciLintRuleCatalog(t *testing.T) {
+	for linter, helpURI := range golangciLinterHelpURI {
+		meta, ok := LookupRule("golangci-lint", linter)
+		assert.True(t, ok, "expected %q to be registered", linter)
+		assert.Equal(t, helpURI, meta.HelpURI)
+	}
+}
+
+func TestGolangciLintTool_ParseStructured_NonJSONFallsBack(t *testing.T) {
+	tool := NewGolangciLintTool()
+
+	issues, ok := tool.ParseStructured([]byte("main.go:10:5: unused variable x (unused)"))
+	assert.False(t, ok)
+	assert.Nil(t, issues)
+
+	// ParseOutput still returns the issue via its text fallback.
+	issues = tool.ParseOutput("main.go:10:5: unused variable x (unused)")
+	assert.Len(t, issues, 1)
+}
+
 func TestGolangciLintTool_ParseTextOutput(t *testing.T) {
 	tool := NewGolangciLintTool()
 
@@ -375,13 +416,20 @@ func TestGoTools_InterfaceCompliance(t *testing.T) {
 	var _ QualityTool = (*GoimportsTool)(nil)
 	var _ QualityTool = (*GolangciLintTool)(nil)
 
-	tools := []QualityTool{
-		NewGofumptTool(),
-		NewGoimportsTool(),
-		NewGolangciLintTool(),
-	}
+	// Built via the compile-time factory registry (see go_tools.go's
+	// init()) rather than hard-coded constructors, so a tool's own
+	// registration - not this test - is what determines the set under
+	// test.
+	names := []string{"gofumpt", "goimports", "golangci-lint"}
+	registered := Factories()
+
+	for _, name := range names {
+		factory, ok := registered[name]
+		if !assert.True(t, ok, "expected %s to self-register via init()", name) {
+			continue
+		}
 
-	for _, tool := range tools {
+		tool := factory()
 		t.Run(tool.Name(), func(t *testing.T) {
 			assert.NotEmpty(t, tool.Name())
 			assert.NotEmpty(t, tool.Language())
@@ -390,6 +438,22 @@ func TestGoTools_InterfaceCompliance(t *testing.T) {
 	}
 }
 
+func TestFactories_IncludesGoTools(t *testing.T) {
+	registered := Factories()
+
+	for _, name := range []string{"gofumpt", "goimports", "golangci-lint"} {
+		assert.Contains(t, registered, name)
+	}
+}
+
+func TestNewRegistryFromFactories_RegistersGoTools(t *testing.T) {
+	registry := NewRegistryFromFactories()
+
+	for _, name := range []string{"gofumpt", "goimports", "golangci-lint"} {
+		assert.NotNil(t, registry.FindTool(name))
+	}
+}
+
 func TestGoTools_Execute_NotAvailable(t *testing.T) {
 	tool := NewGofumptTool()
 
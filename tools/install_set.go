@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// InstallResult is one QualityTool's outcome from InstallSet.Run.
+type InstallResult struct {
+	// Tool is the QualityTool.Name() that was installed.
+	Tool string
+
+	// Err is nil on success, or whatever the tool's Install/
+	// InstallWithProgress returned.
+	Err error
+}
+
+// InstallSet installs a batch of missing QualityTools concurrently.
+// Unlike FileShardExecutor (which parallelizes one tool over its own
+// files), installs have no dependencies between them - gofumpt's install
+// never needs to wait on ruff's - so InstallSet dispatches the whole
+// list across a bounded worker pool rather than reusing the
+// executor package's task-DAG machinery.
+type InstallSet struct {
+	tools   []QualityTool
+	workers int
+}
+
+// NewInstallSet creates an InstallSet for toolsToInstall. workers <= 0
+// defaults to runtime.NumCPU(), capped to len(toolsToInstall) so Run
+// never starts more goroutines than there are tools.
+func NewInstallSet(toolsToInstall []QualityTool, workers int) *InstallSet {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(toolsToInstall) {
+		workers = len(toolsToInstall)
+	}
+
+	return &InstallSet{tools: toolsToInstall, workers: workers}
+}
+
+// Run installs every tool in the set, calling InstallWithProgress when a
+// tool implements ProgressCapable (falling back to plain Install
+// otherwise) and reporting each progress line via onProgress, which may
+// be nil. Results are returned in the same order as the tools the
+// InstallSet was constructed with, regardless of completion order. ctx
+// cancellation stops dispatching further installs and is reflected in
+// the Err of any tool whose install was in flight or never started.
+func (s *InstallSet) Run(ctx context.Context, onProgress func(toolName, line string)) []InstallResult {
+	if onProgress == nil {
+		onProgress = func(string, string) {}
+	}
+
+	results := make([]InstallResult, len(s.tools))
+	if len(s.tools) == 0 {
+		return results
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < s.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = s.install(ctx, s.tools[i], onProgress)
+			}
+		}()
+	}
+
+	for i := range s.tools {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			results[i] = InstallResult{Tool: s.tools[i].Name(), Err: ctx.Err()}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// install runs a single tool's install, preferring InstallWithProgress
+// when the tool is ProgressCapable.
+func (s *InstallSet) install(ctx context.Context, tool QualityTool, onProgress func(toolName, line string)) InstallResult {
+	name := tool.Name()
+
+	if progressTool, ok := tool.(ProgressCapable); ok {
+		err := progressTool.InstallWithProgress(ctx, func(line string) {
+			onProgress(name, line)
+		})
+		return InstallResult{Tool: name, Err: err}
+	}
+
+	return InstallResult{Tool: name, Err: tool.Install(ctx)}
+}
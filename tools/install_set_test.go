@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// progressMockTool is a mockTool that also implements ProgressCapable,
+// recording whether InstallWithProgress (rather than plain Install) ran
+// and streaming a couple of fixed lines through onLine.
+type progressMockTool struct {
+	mockTool
+	installErr error
+}
+
+func (p *progressMockTool) Install(ctx context.Context) error {
+	return fmt.Errorf("InstallWithProgress should have been used instead")
+}
+
+func (p *progressMockTool) InstallWithProgress(ctx context.Context, onLine func(line string)) error {
+	onLine("downloading " + p.name)
+	onLine("done " + p.name)
+	return p.installErr
+}
+
+func TestInstallSet_UsesInstallWithProgressWhenCapable(t *testing.T) {
+	tool := &progressMockTool{mockTool: mockTool{name: "ruff"}}
+
+	var mu sync.Mutex
+	var lines []string
+	set := NewInstallSet([]QualityTool{tool}, 1)
+	results := set.Run(context.Background(), func(toolName, line string) {
+		mu.Lock()
+		defer mu.Unlock()
+		lines = append(lines, toolName+": "+line)
+	})
+
+	assert.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "ruff", results[0].Tool)
+	assert.Equal(t, []string{"ruff: downloading ruff", "ruff: done ruff"}, lines)
+}
+
+func TestInstallSet_FallsBackToPlainInstall(t *testing.T) {
+	tool := &mockTool{name: "gofumpt"}
+
+	set := NewInstallSet([]QualityTool{tool}, 1)
+	results := set.Run(context.Background(), nil)
+
+	assert.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "gofumpt", results[0].Tool)
+}
+
+func TestInstallSet_RunsConcurrentlyAndPreservesOrder(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e", "f"}
+	toolsToInstall := make([]QualityTool, len(names))
+	for i, name := range names {
+		toolsToInstall[i] = &mockTool{name: name}
+	}
+
+	set := NewInstallSet(toolsToInstall, 3)
+	results := set.Run(context.Background(), nil)
+
+	assert.Len(t, results, len(names))
+	for i, name := range names {
+		assert.Equal(t, name, results[i].Tool)
+		assert.NoError(t, results[i].Err)
+	}
+}
+
+func TestInstallSet_PropagatesInstallError(t *testing.T) {
+	tool := &progressMockTool{mockTool: mockTool{name: "eslint"}, installErr: fmt.Errorf("network error")}
+
+	set := NewInstallSet([]QualityTool{tool}, 1)
+	results := set.Run(context.Background(), nil)
+
+	assert.Len(t, results, 1)
+	assert.EqualError(t, results[0].Err, "network error")
+}
+
+func TestInstallSet_Empty(t *testing.T) {
+	set := NewInstallSet(nil, 4)
+	results := set.Run(context.Background(), nil)
+	assert.Empty(t, results)
+}
@@ -3,7 +3,14 @@
 
 package tools
 
-import "context"
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools/cmdobj"
+	"github.com/Gizzahub/gzh-cli-quality/tools/diffscope"
+)
 
 // ToolType defines the type of quality tool.
 type ToolType int
@@ -12,6 +19,8 @@ const (
 	FORMAT ToolType = iota
 	LINT
 	BOTH
+	SECURITY
+	TEST
 )
 
 func (t ToolType) String() string {
@@ -22,6 +31,10 @@ func (t ToolType) String() string {
 		return "linter"
 	case BOTH:
 		return "formatter+linter"
+	case SECURITY:
+		return "security scanner"
+	case TEST:
+		return "test runner"
 	default:
 		return "unknown"
 	}
@@ -41,22 +54,252 @@ type QualityTool interface {
 	// IsAvailable checks if the tool is installed and available
 	IsAvailable() bool
 
-	// Install attempts to install the tool automatically
-	Install() error
+	// Install attempts to install the tool automatically. ctx allows the
+	// caller to cancel an in-flight download/build (e.g. on Ctrl+C).
+	Install(ctx context.Context) error
 
 	// GetVersion returns the version of the installed tool
 	GetVersion() (string, error)
 
-	// Upgrade attempts to upgrade the tool to the latest version
-	Upgrade() error
+	// Upgrade attempts to upgrade the tool to the latest version. ctx allows
+	// the caller to cancel an in-flight download/build.
+	Upgrade(ctx context.Context) error
 
 	// FindConfigFiles returns configuration files the tool would use
 	FindConfigFiles(projectRoot string) []string
 
+	// SupportedExtensions returns the file extensions (e.g. ".go", ".py")
+	// this tool applies to, used by Registry.DetectApplicableTools to
+	// decide whether the tool is relevant to a project. A tool with no
+	// extension-based signal (e.g. one detected purely by config file,
+	// like a Dockerfile linter) returns nil.
+	SupportedExtensions() []string
+
 	// Execute runs the tool on the specified files
 	Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error)
 }
 
+// ExplainCapable is implemented by QualityTool wrappers (every tool built
+// on BaseTool) that can swap the cmdobj.Runner used to execute their
+// command - e.g. to a cmdobj.ExplainRunner that prints argv instead of
+// running it, or a cmdobj.FakeRunner in tests. It's checked with a type
+// assertion rather than folded into QualityTool itself so hand-rolled
+// QualityTool implementations (mocks, future tools not built on
+// BaseTool) aren't forced to implement it.
+type ExplainCapable interface {
+	SetRunner(runner cmdobj.Runner)
+}
+
+// BinaryPathCapable is implemented by QualityTool wrappers (every tool
+// built on BaseTool) that can resolve their own executable's path on
+// disk, e.g. so `quality watch` can stat its mtime and invalidate cache
+// entries when a rebuilt/upgraded tool changes underneath it. Checked
+// with a type assertion for the same reason as ExplainCapable: a hand-
+// rolled QualityTool with no single resolvable binary (e.g. one that
+// shells out to several) isn't forced to implement it.
+type BinaryPathCapable interface {
+	BinaryPath() (string, bool)
+}
+
+// BreakingCapable is implemented by QualityTool wrappers that support an
+// additional schema-compatibility ("breaking change") check beyond their
+// regular lint/format modes - e.g. BufTool's `buf breaking`. It's checked
+// via type assertion for the same reason as ExplainCapable: only a
+// handful of tools have any notion of "breaking", so it isn't folded
+// into QualityTool itself or modeled as a new ToolType (which selects a
+// tool's primary category, not every mode it supports).
+type BreakingCapable interface {
+	// CheckBreaking runs the tool's breaking-change check of files
+	// against a prior ref or image (e.g. a git ref, or a serialized
+	// schema image path) and returns a Result the same way Execute does.
+	CheckBreaking(ctx context.Context, files []string, against string, options ExecuteOptions) (*Result, error)
+}
+
+// FixApplier is implemented by QualityTool wrappers that can write a
+// previously-parsed Issue's machine-applicable suggestion directly to its
+// file, rather than re-invoking the tool with --fix. This matters for
+// tools whose --fix mode has side requirements the normal Execute path
+// doesn't guard against - e.g. `cargo clippy --fix` needing a clean git
+// tree and a full rebuild. Checked via type assertion since most tools
+// either have no suggestions at all or are safe to re-run with --fix.
+// ParallelCapable is implemented by QualityTool wrappers whose Execute
+// can shard its file list across a FileShardExecutor instead of running
+// once over every file - worthwhile for tools (clang-tidy, clang-format
+// over thousands of files) where per-invocation overhead is small
+// relative to per-file work. Checked via type assertion for the same
+// reason as ExplainCapable/BreakingCapable/FixApplier: most tools gain
+// nothing from sharding their own file list (the caller already runs
+// different tools in parallel via Executor.ExecuteParallel).
+type ParallelCapable interface {
+	// SupportsParallel reports whether this tool's Execute shards files
+	// across workers (see ExecuteOptions.Parallelism) rather than
+	// running once over the full file list.
+	SupportsParallel() bool
+}
+
+// FileListLimited is implemented by QualityTool wrappers whose Execute
+// can't be split across several invocations over the same file list - a
+// tool that operates on a directory or whole project (e.g. a workspace-
+// wide type checker) rather than the explicit Files it's given. Checked
+// via type assertion for the same reason as ExplainCapable/
+// BreakingCapable/...: most tools take a plain per-file list and are
+// fine being chunked by ParallelExecutor to stay under the OS's ARG_MAX;
+// a tool that implements this and returns false opts out entirely.
+type FileListLimited interface {
+	// AcceptsFileList reports whether this tool's Execute may be called
+	// with an arbitrary subset of its originally assigned Files, in any
+	// number of separate invocations, rather than always the full list in
+	// one call.
+	AcceptsFileList() bool
+}
+
+// TestPackageCache lets a test-running tool (GoTestTool, PytestTool) skip
+// re-running a package/module whose source hasn't changed since it last
+// passed. It's narrower than a general per-file tool cache: entries are
+// addressed by (package, hash) instead of file path, and only successes
+// are ever stored - a hit always means "this already passed", never
+// "this already failed", so a newly broken or flaky package is retried
+// every run rather than silently staying green.
+type TestPackageCache interface {
+	// Get reports whether pkg last passed at hash. found is false if
+	// there's no entry for (pkg, hash).
+	Get(pkg, hash string) (passed, found bool)
+
+	// Put records that pkg passed at hash.
+	Put(pkg, hash string)
+}
+
+// TestCacheable is implemented by QualityTool wrappers that can use a
+// TestPackageCache to skip unchanged, previously-passing packages.
+// Checked via type assertion for the same reason as ExplainCapable: only
+// test-running tools have a notion of a "package" to key on.
+type TestCacheable interface {
+	SetTestCache(cache TestPackageCache)
+}
+
+// FixApplier is implemented by QualityTool wrappers that can write a
+// previously-parsed Issue's machine-applicable suggestion directly to its
+// file, rather than re-invoking the tool with --fix. This matters for
+// tools whose --fix mode has side requirements the normal Execute path
+// doesn't guard against - e.g. `cargo clippy --fix` needing a clean git
+// tree and a full rebuild. Checked via type assertion since most tools
+// either have no suggestions at all or are safe to re-run with --fix.
+type FixApplier interface {
+	ApplyFixes(issues []Issue) error
+}
+
+// Fix is a structured, reviewable code change proposed by a tool that
+// implements FixProposer, as an alternative to a tool rewriting files
+// silently via its own --fix/-F flag.
+type Fix struct {
+	File        string
+	Range       FixRange
+	Before      string
+	After       string
+	Rule        string
+	Explanation string
+}
+
+// FixRange is the 1-based, inclusive line range in File that Fix's
+// Before/After text replaces.
+type FixRange struct {
+	StartLine int
+	EndLine   int
+}
+
+// FixProposer is implemented by QualityTool wrappers that can describe a
+// fix as structured before/after text for review, rather than only
+// rewriting files in place when Fix/FormatOnly is set. This matters for
+// formatters like ktlint whose -F mode has no dry-run of its own - a
+// caller that wants to preview or selectively confirm changes otherwise
+// has nothing to show. Checked via type assertion for the same reason as
+// ExplainCapable/BreakingCapable/FixApplier: most tools have no notion of
+// a reviewable fix at all.
+type FixProposer interface {
+	ProposeFixes(ctx context.Context, files []string, options ExecuteOptions) ([]Fix, error)
+}
+
+// VersionConstrained is implemented by QualityTool wrappers that require
+// a minimum (and optionally maximum) installed version beyond simply
+// being present on the system - e.g. a lint rule only shipped in a newer
+// release. Checked via type assertion for the same reason as
+// ExplainCapable/BreakingCapable/FixApplier/FixProposer: most tools work
+// fine with whatever version is found on the system.
+type VersionConstrained interface {
+	// VersionConstraint returns a comma-separated comparison expression,
+	// e.g. ">=0.50.0" or ">=1.2.0,<2.0.0", that the tool's installed
+	// version must satisfy. An empty string means no constraint.
+	VersionConstraint() string
+}
+
+// StructuredParser is implemented by QualityTool wrappers whose
+// ParseOutput prefers a tool-native structured format (JSON, SARIF) over
+// regex text parsing, and want that preference exposed as its own step
+// rather than buried inside ParseOutput. ParseStructured reports ok=false
+// (rather than an error) when output isn't in the expected structured
+// format at all - e.g. the installed tool version predates the flag that
+// requests it, or it printed a plain error string - so the caller's
+// ParseOutput can fall back to its regex TextParseConfig the same way it
+// already does when json.Unmarshal fails. Checked via type assertion for
+// the same reason as ExplainCapable/BreakingCapable: most tools already
+// parse their only output format inline and don't need this split out.
+type StructuredParser interface {
+	// ParseStructured parses output produced by this tool's structured
+	// (non-text) mode into Issues. ok is false if output doesn't look
+	// like that format, in which case issues is always nil.
+	ParseStructured(output []byte) (issues []Issue, ok bool)
+}
+
+// PolicyFiles is implemented by QualityTool wrappers whose results depend
+// on a project-wide policy file beyond the tool's own config - e.g. a
+// formatter that honors .editorconfig on top of its own .prettierrc.
+// Checked via type assertion for the same reason as ExplainCapable: most
+// tools have no notion of a policy file outside their own config.
+type PolicyFiles interface {
+	// PolicyFiles returns extra project-root-relative policy file paths
+	// (beyond .editorconfig and .gitattributes, which cache.GenerateKey
+	// always checks for every tool) whose content should invalidate this
+	// tool's cached results when it changes.
+	PolicyFiles(projectRoot string) []string
+}
+
+// ProgressCapable is implemented by QualityTool wrappers (every tool
+// built on BaseTool) whose Install can stream its subprocess's combined
+// stdout/stderr line by line as it runs, rather than only reporting once
+// the whole install finishes. InstallSet uses this (falling back to a
+// plain Install when a tool doesn't implement it) to drive a live "ruff
+// ✓, eslint (downloading…)" progress display instead of a silent wait.
+type ProgressCapable interface {
+	// InstallWithProgress behaves like Install, but calls onLine once
+	// per line of combined output as the install subprocess produces
+	// it. onLine is never nil.
+	InstallWithProgress(ctx context.Context, onLine func(line string)) error
+}
+
+// VersionParser is implemented by QualityTool wrappers whose GetVersion
+// output doesn't yield a clean version number under the detector's
+// default "first dotted number" extraction - e.g. output with more than
+// one version-shaped token in it. Checked via type assertion; most tools
+// are fine with the default extraction.
+type VersionParser interface {
+	// ParseVersionString pulls the version number out of raw GetVersion
+	// output, returning "" if none could be found.
+	ParseVersionString(raw string) string
+}
+
+// ToolLocation describes how to invoke a tool that isn't a bare
+// executable on PATH - e.g. through a project's own Gradle/Maven
+// wrapper, rather than a system-wide install.
+type ToolLocation struct {
+	// Command is the executable to run instead of the tool's own name,
+	// e.g. "./gradlew" or "node_modules/.bin/eslint".
+	Command string
+
+	// PrefixArgs are arguments placed before the tool's normal
+	// arguments, e.g. a Gradle task or Maven goal name.
+	PrefixArgs []string
+}
+
 // ExecuteOptions contains options for tool execution.
 type ExecuteOptions struct {
 	// ProjectRoot is the root directory of the project
@@ -77,8 +320,90 @@ type ExecuteOptions struct {
 	// ExtraArgs are additional arguments to pass to the tool
 	ExtraArgs []string
 
+	// CompileFlags are compiler arguments (e.g. "-std=c++20", "-I...")
+	// passed after "--" to tools that need them to resolve includes and
+	// defines (e.g. ClangTidyTool) when no compile_commands.json
+	// compilation database can be found for ProjectRoot.
+	CompileFlags []string
+
+	// DiffBase is a git ref (e.g. "origin/main", "HEAD~1") that, when
+	// set, scopes a LINT tool's reported Issues to only those whose Line
+	// falls within a hunk diffscope.Compute finds changed relative to it.
+	// Unlike BaseRef (which asks the tool itself to scan incrementally),
+	// this filtering happens post-ParseOutput on the shared Issue shape,
+	// so it applies uniformly to every tool in the registry regardless
+	// of whether the tool has its own incremental mode.
+	DiffBase string
+
+	// ChangedLines, if set, is a precomputed per-file set of changed line
+	// ranges (e.g. from git.GitUtils.GetChangedHunks) that ApplyDiffScope
+	// uses instead of calling diffscope.Compute against DiffBase. This
+	// lets a caller running many tools over the same DiffBase diff it
+	// once up front rather than once per tool.
+	ChangedLines map[string][]diffscope.LineRange
+
+	// Parallelism is the number of workers a ParallelCapable tool shards
+	// its file list across. <= 0 means "use runtime.NumCPU()"; 1 runs
+	// the tool once over the full file list, the same as a tool that
+	// doesn't implement ParallelCapable at all.
+	Parallelism int
+
 	// Env contains environment variables for the tool
 	Env map[string]string
+
+	// BaseRef is the git ref an incremental ("since <ref>") run is diffing
+	// against, e.g. for golangci-lint's --new-from-rev or ruff's --diff.
+	// Empty when the tool is running a full (non-incremental) scan.
+	BaseRef string
+
+	// Shard is this invocation's 0-based shard index, used by tools that
+	// partition per-project work (e.g. ClippyTool selecting workspace
+	// packages, BufTool selecting proto files) rather than relying solely
+	// on an already-filtered Files list. Meaningless when TotalShards <= 1.
+	Shard int
+
+	// TotalShards is the total number of shards the work is split across.
+	// <= 1 means sharding is disabled and the tool should process
+	// everything it's given.
+	TotalShards int
+
+	// BuildOutput is the path a tool should write a compiled schema
+	// artifact to (e.g. BufTool's `buf build -o`), instead of its usual
+	// lint/format mode. Empty means "don't build, run the normal mode".
+	BuildOutput string
+
+	// Location, if set, means the tool isn't on PATH but was resolved
+	// inside the project's own build environment (a Gradle/Maven
+	// wrapper, a node_modules/.bin shim, a venv) - BuildCommand should
+	// invoke Location.Command/PrefixArgs instead of the tool's own
+	// executable and file-based invocation.
+	Location *ToolLocation
+
+	// CacheDisabled skips the executor's content-hash cache for this
+	// task, set from ToolConfig.CacheDisabled for tools whose output
+	// isn't a pure function of the input files' content (e.g. one that
+	// also reads go.mod or another file FindConfigFiles doesn't report),
+	// where a stale cache hit would silently skip a run that should have
+	// seen the change.
+	CacheDisabled bool
+
+	// SQLDialect overrides SqlfluffTool's auto-detected --dialect (e.g.
+	// "postgres", "bigquery", "snowflake"). Empty means "auto-detect
+	// from .sqlfluff/pyproject.toml/dbt_project.yml, falling back to
+	// sniffing the SQL itself".
+	SQLDialect string
+
+	// SQLTemplater overrides SqlfluffTool's auto-detected --templater
+	// (e.g. "jinja", "dbt"). Empty means "auto-detect, defaulting to
+	// sqlfluff's own templater default when nothing is configured".
+	SQLTemplater string
+
+	// LocalPrefixes overrides GoimportsTool/GciTool's auto-detected
+	// import-grouping prefixes (goimports' -local, gci's prefix()
+	// sections), e.g. ["github.com/acme/myproject"]. Empty means
+	// "auto-detect from .gzh-quality.yaml's imports.local, falling back
+	// to the project's own module name".
+	LocalPrefixes []string
 }
 
 // Result contains the results of tool execution.
@@ -106,6 +431,32 @@ type Result struct {
 
 	// Output contains the raw output from the tool
 	Output string
+
+	// Cached reports whether every file in this result was served from
+	// cache.CachedTool's cache rather than by actually running the tool.
+	// A tool that doesn't go through CachedTool always leaves this false.
+	Cached bool
+
+	// Skipped reports whether this task never ran because its RunIf
+	// condition excluded it given its DependsOn tasks' outcomes. A
+	// skipped Result carries no Issues/Output and Success is always
+	// false.
+	Skipped bool
+
+	// TimedOut reports whether the tool was still running when its
+	// Task.Timeout (or the executor's default) elapsed. Success is
+	// always false when this is set; Error holds the ErrToolTimeout
+	// that caused it.
+	TimedOut bool
+
+	// SARIFReport is the tool's raw SARIF 2.1.0 output, set when Issues
+	// was populated by the OutputFormatSARIF parser RegisterParser
+	// registered (see BaseTool.parseIssues). Nil for any tool that didn't
+	// report in SARIF, so downstream code - e.g. re-emitting an
+	// aggregated report for GitHub code scanning upload - can tell a
+	// real SARIF document from one it would have to reconstruct from
+	// Issues alone.
+	SARIFReport []byte
 }
 
 // Issue represents a code quality issue found by a tool.
@@ -130,6 +481,141 @@ type Issue struct {
 
 	// Suggestion is an optional fix suggestion
 	Suggestion string
+
+	// CVSSScore is the CVSS score of a vulnerability finding (0.0 if not
+	// applicable, e.g. for plain lint issues).
+	CVSSScore float64
+
+	// CVE is the CVE/GHSA identifier of a vulnerability finding, if any.
+	CVE string
+
+	// Category is a machine-readable classification of this issue -
+	// "style", "bug", "security", or "perf" - for dashboards that bucket
+	// findings beyond Severity (e.g. GitHub code scanning's rule tags).
+	// Populated on a best-effort basis from a tool's own rule/linter
+	// taxonomy where one is known (e.g. golangci-lint's FromLinter);
+	// empty when a tool doesn't distinguish or the mapping isn't known.
+	Category string
+
+	// Spans holds every source location a multi-span diagnostic (e.g.
+	// clippy) attached to this issue, in the tool's reported order, with
+	// IsPrimary preserved so callers can tell the primary location
+	// (File/Line/Column above) apart from secondary ones such as "note:
+	// original definition here". Empty for tools that only ever report a
+	// single location.
+	Spans []IssueSpan
+
+	// Rendered is the tool's own fully rendered, human-readable rendition
+	// of the diagnostic with ANSI escapes stripped (e.g. clippy's
+	// `rendered` field), if it provides one. Empty otherwise.
+	Rendered string
+
+	// FixReplacement is a machine-applicable suggested replacement for
+	// the byte range [FixByteStart, FixByteEnd) in File, if the tool
+	// offered one it marked safe to apply automatically (e.g. clippy's
+	// suggestion_applicability == "MachineApplicable"). Empty when there
+	// is no such suggestion.
+	FixReplacement string
+
+	// FixByteStart and FixByteEnd bound the byte range FixReplacement
+	// replaces. Meaningless when FixReplacement is empty.
+	FixByteStart int
+	FixByteEnd   int
+
+	// Replacements holds every byte-offset text replacement a tool
+	// attached to this diagnostic (e.g. clang-tidy's --export-fixes
+	// YAML), which - unlike FixReplacement/FixByteStart/FixByteEnd's
+	// single suggestion - may span several edits across one or more
+	// files. Empty for tools that report at most one replacement.
+	Replacements []IssueReplacement
+
+	// EndLine is the 1-based line the diagnostic's range ends on, for
+	// tools that report a multi-line range (e.g. a SARIF region's
+	// endLine). Zero when the tool only reports a single line.
+	EndLine int
+
+	// FixInfo is a line/column-addressed single edit a tool offered to
+	// fix this issue (e.g. markdownlint-cli2's fixInfo, or a SARIF fix's
+	// deletedRegion/insertedContent), for tools that address edits by
+	// line and column rather than by byte offset like FixReplacement/
+	// Replacements above. Nil when the tool offered no such fix.
+	FixInfo *IssueFixInfo
+}
+
+// IssueFixInfo is a single line/column-addressed text edit belonging to
+// an Issue, replacing the range [StartLine:StartColumn, EndLine:EndColumn)
+// with InsertText.
+type IssueFixInfo struct {
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+	InsertText  string
+}
+
+// IssueReplacement is one byte-offset text replacement belonging to an
+// Issue.
+type IssueReplacement struct {
+	// File is the path the replacement applies to.
+	File string
+
+	// Offset is the 0-based byte offset into File where the replacement
+	// starts.
+	Offset int
+
+	// Length is the number of bytes, starting at Offset, the replacement
+	// removes.
+	Length int
+
+	// Text is the replacement content.
+	Text string
+}
+
+// IssueSpan is one source location attached to a multi-span diagnostic.
+type IssueSpan struct {
+	// File is the path the span points into.
+	File string
+
+	// Line is the 1-based line number.
+	Line int
+
+	// Column is the 1-based column number.
+	Column int
+
+	// IsPrimary marks the span the tool considers the diagnostic's main
+	// location, as opposed to a secondary/contextual one.
+	IsPrimary bool
+}
+
+// SeverityLevel orders vulnerability severities from low to critical so
+// they can be compared against a `--severity-threshold`.
+type SeverityLevel int
+
+const (
+	SeverityLow SeverityLevel = iota
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+// ParseSeverityLevel parses a severity string (case-insensitive) into a
+// SeverityLevel. Unrecognized values map to SeverityLow.
+func ParseSeverityLevel(s string) SeverityLevel {
+	switch strings.ToLower(s) {
+	case "critical":
+		return SeverityCritical
+	case "high":
+		return SeverityHigh
+	case "medium", "moderate":
+		return SeverityMedium
+	default:
+		return SeverityLow
+	}
+}
+
+// MeetsThreshold reports whether the issue's severity is at or above threshold.
+func (i Issue) MeetsThreshold(threshold SeverityLevel) bool {
+	return ParseSeverityLevel(i.Severity) >= threshold
 }
 
 // LanguageDetector detects programming languages in a project.
@@ -157,6 +643,21 @@ type ToolRegistry interface {
 
 	// FindTool finds a tool by name
 	FindTool(name string) QualityTool
+
+	// DetectApplicableTools scans projectRoot and returns only the
+	// registered tools that apply to the project, based on the file
+	// extensions and config files present in the tree
+	DetectApplicableTools(projectRoot string) ([]QualityTool, error)
+
+	// GetToolsForFile returns the registered tools applicable to a single
+	// file, classifying its language (see the classifier package) rather
+	// than relying on each tool's own extension filter.
+	GetToolsForFile(path string) []QualityTool
+
+	// PartitionFiles groups files by the name of each tool GetToolsForFile
+	// returns for it, so callers can dispatch each tool its own
+	// pre-filtered file list.
+	PartitionFiles(files []string) map[string][]string
 }
 
 // ConfigDetector finds configuration files for quality tools.
@@ -185,6 +686,12 @@ type Task struct {
 	// Tool is the quality tool to execute
 	Tool QualityTool
 
+	// Name identifies this task for DependsOn references. CreatePlan
+	// sets it to Tool.Name(); callers building a Task by hand (tests, or
+	// a future non-CLI caller) should do the same so dependencies can
+	// find it.
+	Name string
+
 	// Files are the files to process
 	Files []string
 
@@ -193,6 +700,53 @@ type Task struct {
 
 	// Priority affects execution order (higher = earlier)
 	Priority int
+
+	// DependsOn names the tasks (by Name) that must finish before this
+	// one becomes eligible to run. Empty means eligible immediately.
+	DependsOn []string
+
+	// RunIf decides whether this task actually runs once every task in
+	// DependsOn has finished, based on their outcomes. Defaults to
+	// RunAlways.
+	RunIf RunCondition
+
+	// Timeout bounds this task alone, overriding whatever default an
+	// Executor would otherwise apply. Zero means "use the executor's
+	// default", so a single slow tool doesn't need the whole plan's
+	// timeout raised (or a fast one cut short) to accommodate it.
+	Timeout time.Duration
+}
+
+// RunCondition decides whether a Task with DependsOn actually runs once
+// all of its dependencies have finished, based on whether those
+// dependencies succeeded.
+type RunCondition int
+
+const (
+	// RunAlways runs the task regardless of its dependencies' outcomes.
+	RunAlways RunCondition = iota
+
+	// RunOnSuccess runs the task only if every task it DependsOn
+	// succeeded; otherwise the task is skipped.
+	RunOnSuccess
+
+	// RunOnFailure runs the task only if at least one task it DependsOn
+	// failed; otherwise the task is skipped.
+	RunOnFailure
+)
+
+// String implements fmt.Stringer.
+func (r RunCondition) String() string {
+	switch r {
+	case RunAlways:
+		return "always"
+	case RunOnSuccess:
+		return "on-success"
+	case RunOnFailure:
+		return "on-failure"
+	default:
+		return "unknown"
+	}
 }
 
 // Executor runs quality tools according to an execution plan.
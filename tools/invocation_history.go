@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools/cmdobj"
+)
+
+// historyCapacity bounds how many invocations globalHistory keeps, so a
+// long-running session (e.g. `gzquality quality run` over a huge
+// monorepo) doesn't grow the record unbounded.
+const historyCapacity = 50
+
+// InvocationRecord describes a single external command invocation,
+// kept around only so a diagnostic bundle can show what actually ran
+// without the user needing to reproduce the failure with --debug.
+type InvocationRecord struct {
+	// Argv is the command's redacted argv (cmdobj.CmdObj.String() already
+	// masks anything the tool or config marked for redaction).
+	Argv string
+
+	Dir      string
+	Started  time.Time
+	Duration time.Duration
+	Success  bool
+	Error    string
+}
+
+// History is a fixed-capacity ring buffer of the most recent
+// InvocationRecords, mirroring the redact package's single-global-store
+// design: every BaseTool shares one History instance rather than each
+// tool keeping its own, so a diagnostic bundle can report on the whole
+// run regardless of which tools touched it.
+type History struct {
+	mu      sync.Mutex
+	records []InvocationRecord
+}
+
+// NewHistory creates an empty History.
+func NewHistory() *History {
+	return &History{}
+}
+
+// record appends rec, evicting the oldest entry once historyCapacity is
+// exceeded.
+func (h *History) record(rec InvocationRecord) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.records = append(h.records, rec)
+	if len(h.records) > historyCapacity {
+		h.records = h.records[len(h.records)-historyCapacity:]
+	}
+}
+
+// Recent returns the history's records, oldest first.
+func (h *History) Recent() []InvocationRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	out := make([]InvocationRecord, len(h.records))
+	copy(out, h.records)
+	return out
+}
+
+// WithHistory returns a cmdobj.Decorator that appends an InvocationRecord
+// to h for every command the decorated Runner executes, built on
+// cmdobj.WithHooks rather than a bespoke Runner implementation since
+// recording structured per-command logs is exactly what WithHooks exists
+// for. Start times are keyed by *CmdObj, not a single shared variable, so
+// concurrent invocations through the same Runner (a tool batching several
+// file sets in parallel) don't clobber each other's timing.
+func WithHistory(h *History) cmdobj.Decorator {
+	var starts sync.Map // *cmdobj.CmdObj -> time.Time
+
+	return cmdobj.WithHooks(
+		func(c *cmdobj.CmdObj) {
+			starts.Store(c, time.Now())
+		},
+		func(c *cmdobj.CmdObj, output string, err error) {
+			started, _ := starts.LoadAndDelete(c)
+			start, _ := started.(time.Time)
+
+			rec := InvocationRecord{
+				Argv:     c.String(),
+				Dir:      c.Dir,
+				Started:  start,
+				Duration: time.Since(start),
+				Success:  err == nil,
+			}
+			if err != nil {
+				rec.Error = err.Error()
+			}
+			h.record(rec)
+		},
+	)
+}
+
+// globalHistory is the process-wide History every BaseTool records
+// into, matching redact's single global Store.
+var globalHistory = NewHistory()
+
+// RecentInvocations returns the most recent commands run through any
+// BaseTool in this process, oldest first. The diagnose command uses this
+// to include a command log in its bundle without needing --debug turned
+// on.
+func RecentInvocations() []InvocationRecord {
+	return globalHistory.Recent()
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// jacocoMinLineCoverage is the line-coverage ratio below which JaCoCoTool
+// flags a class, matching the default threshold of the Maven/Gradle
+// JaCoCo rule most Java projects already configure.
+const jacocoMinLineCoverage = 0.80
+
+// JUnitTool runs a project's JUnit test suite via Maven and turns failed
+// tests into Issues, giving Java projects the same pass/fail signal Go
+// projects get from `go test`.
+type JUnitTool struct {
+	*BaseTool
+}
+
+// NewJUnitTool creates a new JUnit tool.
+func NewJUnitTool() *JUnitTool {
+	tool := &JUnitTool{
+		BaseTool: NewBaseTool("junit", "Java", "mvn", LINT),
+	}
+
+	tool.SetConfigPatterns([]string{"pom.xml", "build.gradle", "build.gradle.kts"})
+	tool.SetSupportedExtensions([]string{".java"})
+
+	return tool
+}
+
+// BuildCommand builds the "mvn test" command that exercises the suite and
+// writes Surefire's per-class XML reports to target/surefire-reports.
+func (t *JUnitTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"test"}
+
+	// Add extra flags if provided
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// Execute runs the Maven test goal and then parses the Surefire XML
+// reports it leaves behind, since mvn's own exit code conflates build
+// errors with test failures and its console output doesn't say which
+// test failed or why.
+func (t *JUnitTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	cmd := t.BuildCommand(files, options)
+	result, err := t.ExecuteCommand(ctx, cmd, files)
+	if err != nil {
+		return result, err
+	}
+
+	reportsDir := filepath.Join(options.ProjectRoot, "target", "surefire-reports")
+	if issues, readErr := parseSurefireReports(reportsDir); readErr == nil {
+		result.Issues = issues
+		result.Success = true
+	}
+
+	return result, nil
+}
+
+// parseSurefireReports reads every Surefire TEST-*.xml report under dir
+// and turns each failed or errored testcase into an Issue.
+func parseSurefireReports(dir string) ([]Issue, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "TEST-") || !strings.HasSuffix(entry.Name(), ".xml") {
+			continue
+		}
+
+		data, readErr := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if readErr != nil {
+			continue
+		}
+
+		var suite struct {
+			XMLName   xml.Name `xml:"testsuite"`
+			ClassName string   `xml:"name,attr"`
+			TestCases []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Message string `xml:"message,attr"`
+				} `xml:"failure"`
+				Error *struct {
+					Message string `xml:"message,attr"`
+				} `xml:"error"`
+			} `xml:"testcase"`
+		}
+
+		if xmlErr := xml.Unmarshal(data, &suite); xmlErr != nil {
+			continue
+		}
+
+		for _, tc := range suite.TestCases {
+			message := ""
+			switch {
+			case tc.Failure != nil:
+				message = tc.Failure.Message
+			case tc.Error != nil:
+				message = tc.Error.Message
+			default:
+				continue
+			}
+
+			issues = append(issues, Issue{
+				File:     suite.ClassName,
+				Severity: "error",
+				Rule:     tc.Name,
+				Message:  strings.TrimSpace(message),
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// JaCoCoTool runs Maven's jacoco:report goal and flags classes whose line
+// coverage falls below jacocoMinLineCoverage, giving Java projects the
+// same "is this file adequately tested" signal coverage tooling provides
+// in other languages the registry supports.
+type JaCoCoTool struct {
+	*BaseTool
+}
+
+// NewJaCoCoTool creates a new JaCoCo tool.
+func NewJaCoCoTool() *JaCoCoTool {
+	tool := &JaCoCoTool{
+		BaseTool: NewBaseTool("jacoco", "Java", "mvn", LINT),
+	}
+
+	tool.SetConfigPatterns([]string{"pom.xml", "build.gradle", "build.gradle.kts"})
+	tool.SetSupportedExtensions([]string{".java"})
+
+	return tool
+}
+
+// BuildCommand builds the "mvn jacoco:report" command.
+func (t *JaCoCoTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"jacoco:report"}
+
+	// Add extra flags if provided
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// Execute runs the jacoco:report goal and parses the XML report it
+// leaves under target/site/jacoco, since JaCoCo itself has no console
+// output worth scraping - all the coverage data lives in that report.
+func (t *JaCoCoTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	cmd := t.BuildCommand(files, options)
+	result, err := t.ExecuteCommand(ctx, cmd, files)
+	if err != nil {
+		return result, err
+	}
+
+	reportPath := filepath.Join(options.ProjectRoot, "target", "site", "jacoco", "jacoco.xml")
+	if data, readErr := os.ReadFile(reportPath); readErr == nil {
+		result.Issues = t.ParseOutput(string(data))
+		result.Success = true
+	}
+
+	return result, nil
+}
+
+// ParseOutput parses JaCoCo's XML coverage report, flagging classes whose
+// LINE counter falls below jacocoMinLineCoverage.
+func (t *JaCoCoTool) ParseOutput(output string) []Issue {
+	if strings.TrimSpace(output) == "" {
+		return []Issue{}
+	}
+
+	var report struct {
+		XMLName  xml.Name `xml:"report"`
+		Packages []struct {
+			Name    string `xml:"name,attr"`
+			Classes []struct {
+				Name       string `xml:"name,attr"`
+				SourceFile string `xml:"sourcefilename,attr"`
+				Counters   []struct {
+					Type    string `xml:"type,attr"`
+					Missed  int    `xml:"missed,attr"`
+					Covered int    `xml:"covered,attr"`
+				} `xml:"counter"`
+			} `xml:"class"`
+		} `xml:"package"`
+	}
+
+	if err := xml.Unmarshal([]byte(output), &report); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+
+	for _, pkg := range report.Packages {
+		for _, cls := range pkg.Classes {
+			for _, counter := range cls.Counters {
+				if counter.Type != "LINE" {
+					continue
+				}
+
+				total := counter.Missed + counter.Covered
+				if total == 0 {
+					continue
+				}
+
+				ratio := float64(counter.Covered) / float64(total)
+				if ratio >= jacocoMinLineCoverage {
+					continue
+				}
+
+				issues = append(issues, Issue{
+					File:     filepath.Join(strings.ReplaceAll(pkg.Name, ".", "/"), cls.SourceFile),
+					Severity: "warning",
+					Rule:     "low-coverage",
+					Message: fmt.Sprintf("%s has %.0f%% line coverage, below the %.0f%% threshold",
+						cls.Name, ratio*100, jacocoMinLineCoverage*100),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// Ensure the test-quality tools implement QualityTool interface.
+var (
+	_ QualityTool = (*JUnitTool)(nil)
+	_ QualityTool = (*JaCoCoTool)(nil)
+)
@@ -9,6 +9,13 @@ import (
 	"strings"
 )
 
+// maxJavaFilesPerInvocation bounds how many files google-java-format and
+// checkstyle - both of which take their whole file list on argv - are
+// given per invocation, so a large changeset doesn't risk the OS's
+// ARG_MAX. Chosen well under the common 128KiB Linux limit even for
+// deeply nested module paths.
+const maxJavaFilesPerInvocation = 500
+
 // GoogleJavaFormatTool implements Java formatting using google-java-format.
 type GoogleJavaFormatTool struct {
 	*BaseTool
@@ -21,6 +28,8 @@ func NewGoogleJavaFormatTool() *GoogleJavaFormatTool {
 	}
 
 	tool.SetInstallCommand([]string{"brew", "install", "google-java-format"})
+	tool.SetSupportedExtensions([]string{".java"})
+	tool.SetMaxFilesPerInvocation(maxJavaFilesPerInvocation)
 
 	return tool
 }
@@ -60,6 +69,8 @@ func NewCheckstyleTool() *CheckstyleTool {
 
 	tool.SetInstallCommand([]string{"brew", "install", "checkstyle"})
 	tool.SetConfigPatterns([]string{"checkstyle.xml", ".checkstyle.xml", "config/checkstyle/checkstyle.xml"})
+	tool.SetSupportedExtensions([]string{".java"})
+	tool.SetMaxFilesPerInvocation(maxJavaFilesPerInvocation)
 
 	return tool
 }
@@ -97,6 +108,14 @@ func (t *CheckstyleTool) BuildCommand(files []string, options ExecuteOptions) *e
 
 // ParseOutput parses checkstyle XML output.
 func (t *CheckstyleTool) ParseOutput(output string) []Issue {
+	return parseCheckstyleXML(output)
+}
+
+// parseCheckstyleXML parses checkstyle's `<checkstyle><file><error>` XML
+// schema into Issues. It's shared by CheckstyleTool and ManifestTool's
+// "checkstyle-xml" output_parser, since third-party tools (e.g.
+// spotless) can be configured to emit the same schema.
+func parseCheckstyleXML(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
 		return []Issue{}
 	}
@@ -155,6 +174,7 @@ func NewSpotbugsTool() *SpotbugsTool {
 
 	tool.SetInstallCommand([]string{"brew", "install", "spotbugs"})
 	tool.SetConfigPatterns([]string{"spotbugs.xml", ".spotbugs.xml", "spotbugs-exclude.xml"})
+	tool.SetSupportedExtensions([]string{".java"})
 
 	return tool
 }
@@ -235,9 +255,164 @@ func (t *SpotbugsTool) ParseOutput(output string) []Issue {
 	return issues
 }
 
+// PMDTool implements Java bug/style detection using PMD.
+type PMDTool struct {
+	*BaseTool
+}
+
+// NewPMDTool creates a new PMD tool.
+func NewPMDTool() *PMDTool {
+	tool := &PMDTool{
+		BaseTool: NewBaseTool("pmd", "Java", "pmd", LINT),
+	}
+
+	tool.SetInstallCommand([]string{"brew", "install", "pmd"})
+	tool.SetConfigPatterns([]string{"pmd-ruleset.xml", ".pmd-ruleset.xml", "config/pmd/ruleset.xml"})
+	tool.SetSupportedExtensions([]string{".java"})
+	tool.SetMaxFilesPerInvocation(maxJavaFilesPerInvocation)
+
+	return tool
+}
+
+// BuildCommand builds the pmd command.
+func (t *PMDTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"check", "-f", "xml"}
+
+	// Add ruleset
+	if options.ConfigFile != "" {
+		args = append(args, "-R", options.ConfigFile)
+	} else {
+		args = append(args, "-R", "rulesets/java/quickstart.xml")
+	}
+
+	// Add extra flags if provided
+	args = append(args, options.ExtraArgs...)
+
+	// Filter only Java files
+	javaFiles := FilterFilesByExtensions(files, []string{".java"})
+	if len(javaFiles) > 0 {
+		args = append(args, "-d")
+		args = append(args, javaFiles...)
+	} else {
+		args = append(args, "-d", ".")
+	}
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// ParseOutput parses PMD XML output.
+func (t *PMDTool) ParseOutput(output string) []Issue {
+	if strings.TrimSpace(output) == "" {
+		return []Issue{}
+	}
+
+	var pmdResult struct {
+		XMLName xml.Name `xml:"pmd"`
+		Files   []struct {
+			Name       string `xml:"name,attr"`
+			Violations []struct {
+				BeginLine   int    `xml:"beginline,attr"`
+				BeginColumn int    `xml:"begincolumn,attr"`
+				Rule        string `xml:"rule,attr"`
+				Priority    int    `xml:"priority,attr"`
+				Message     string `xml:",chardata"`
+			} `xml:"violation"`
+		} `xml:"file"`
+	}
+
+	if err := xml.Unmarshal([]byte(output), &pmdResult); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for _, file := range pmdResult.Files {
+		for _, v := range file.Violations {
+			issues = append(issues, Issue{
+				File:     file.Name,
+				Line:     v.BeginLine,
+				Column:   v.BeginColumn,
+				Severity: pmdSeverity(v.Priority),
+				Rule:     v.Rule,
+				Message:  strings.TrimSpace(v.Message),
+			})
+		}
+	}
+
+	return issues
+}
+
+// pmdSeverity maps PMD's 1 (highest) through 5 (lowest) priority scale
+// onto the tool-agnostic severities used elsewhere in this package:
+// 1-2 (error/warning-tier rules such as security and correctness bugs)
+// become "error", 3 becomes "warning", and 4-5 (style/documentation
+// nits) become "info".
+func pmdSeverity(priority int) string {
+	switch {
+	case priority <= 2:
+		return "error"
+	case priority == 3:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// ErrorProneTool implements Java bug detection using Error Prone, run as a
+// javac compiler plugin rather than a standalone binary.
+type ErrorProneTool struct {
+	*BaseTool
+}
+
+// NewErrorProneTool creates a new Error Prone tool.
+func NewErrorProneTool() *ErrorProneTool {
+	tool := &ErrorProneTool{
+		BaseTool: NewBaseTool("error-prone", "Java", "javac", LINT),
+	}
+
+	tool.SetConfigPatterns([]string{".errorprone.conf"})
+	tool.SetSupportedExtensions([]string{".java"})
+	tool.SetMaxFilesPerInvocation(maxJavaFilesPerInvocation)
+
+	return tool
+}
+
+// BuildCommand builds the javac command with the Error Prone plugin enabled.
+func (t *ErrorProneTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-XDcompilePolicy=simple", "-Xplugin:ErrorProne"}
+
+	// Add extra flags if provided
+	args = append(args, options.ExtraArgs...)
+
+	// Filter only Java files
+	javaFiles := FilterFilesByExtensions(files, []string{".java"})
+	args = append(args, javaFiles...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// ParseOutput parses Error Prone's "[RuleName] message" diagnostics, as
+// emitted by javac on stderr alongside ordinary compiler warnings.
+func (t *ErrorProneTool) ParseOutput(output string) []Issue {
+	return ParseTextLines(output, ErrorProneParseConfig)
+}
+
 // Ensure Java tools implement QualityTool interface.
 var (
 	_ QualityTool = (*GoogleJavaFormatTool)(nil)
 	_ QualityTool = (*CheckstyleTool)(nil)
 	_ QualityTool = (*SpotbugsTool)(nil)
+	_ QualityTool = (*PMDTool)(nil)
+	_ QualityTool = (*ErrorProneTool)(nil)
 )
@@ -27,6 +27,10 @@ func NewPrettierTool() *PrettierTool {
 		".prettierrc", ".prettierrc.json", ".prettierrc.js", ".prettierrc.yml", ".prettierrc.yaml",
 		"prettier.config.js", "prettier.config.cjs", "package.json",
 	})
+	tool.SetSupportedExtensions([]string{
+		".js", ".jsx", ".ts", ".tsx", ".json", ".css", ".scss", ".less",
+		".html", ".vue", ".md", ".yaml", ".yml",
+	})
 
 	return tool
 }
@@ -81,6 +85,7 @@ func NewESLintTool() *ESLintTool {
 		".eslintrc", ".eslintrc.json", ".eslintrc.js", ".eslintrc.yml", ".eslintrc.yaml",
 		"eslint.config.js", "eslint.config.mjs", "eslint.config.cjs", "package.json",
 	})
+	tool.SetSupportedExtensions([]string{".js", ".jsx", ".ts", ".tsx", ".vue"})
 
 	return tool
 }
@@ -123,12 +128,24 @@ func (t *ESLintTool) BuildCommand(files []string, options ExecuteOptions) *exec.
 	return cmd
 }
 
-// ParseOutput parses eslint JSON output.
+// ParseOutput parses eslint JSON output, falling back to plain text
+// parsing when it isn't JSON at all.
 func (t *ESLintTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
 		return []Issue{}
 	}
 
+	if issues, ok := t.ParseStructured([]byte(output)); ok {
+		return issues
+	}
+
+	return t.parseTextOutput(output)
+}
+
+// ParseStructured implements StructuredParser, parsing eslint's `--format
+// json` output. ok is false if output isn't a JSON array of eslint
+// results, so ParseOutput can fall back to parseTextOutput.
+func (t *ESLintTool) ParseStructured(output []byte) ([]Issue, bool) {
 	var eslintResults []struct {
 		FilePath string `json:"filePath"`
 		Messages []struct {
@@ -148,9 +165,8 @@ func (t *ESLintTool) ParseOutput(output string) []Issue {
 		WarningCount int `json:"warningCount"`
 	}
 
-	if err := json.Unmarshal([]byte(output), &eslintResults); err != nil {
-		// Fallback to plain text parsing
-		return t.parseTextOutput(output)
+	if err := json.Unmarshal(output, &eslintResults); err != nil {
+		return nil, false
 	}
 
 	var issues []Issue
@@ -186,7 +202,7 @@ func (t *ESLintTool) ParseOutput(output string) []Issue {
 		}
 	}
 
-	return issues
+	return issues, true
 }
 
 // parseTextOutput parses plain text output as fallback.
@@ -240,6 +256,7 @@ func NewTSCTool() *TSCTool {
 
 	tool.SetInstallCommand([]string{"npm", "install", "-g", "typescript"})
 	tool.SetConfigPatterns([]string{"tsconfig.json", "jsconfig.json"})
+	tool.SetSupportedExtensions([]string{".ts", ".tsx"})
 
 	return tool
 }
@@ -314,7 +331,8 @@ func (t *TSCTool) ParseOutput(output string) []Issue {
 
 // Ensure JavaScript tools implement QualityTool interface.
 var (
-	_ QualityTool = (*PrettierTool)(nil)
-	_ QualityTool = (*ESLintTool)(nil)
-	_ QualityTool = (*TSCTool)(nil)
+	_ QualityTool      = (*PrettierTool)(nil)
+	_ QualityTool      = (*ESLintTool)(nil)
+	_ QualityTool      = (*TSCTool)(nil)
+	_ StructuredParser = (*ESLintTool)(nil)
 )
@@ -265,6 +265,18 @@ func TestESLintTool_ParseOutput(t *testing.T) {
 	}
 }
 
+func TestESLintTool_ParseStructured_NonJSONFallsBack(t *testing.T) {
+	tool := NewESLintTool()
+
+	issues, ok := tool.ParseStructured([]byte("main.js:10:5: error Missing semicolon (semi)"))
+	assert.False(t, ok)
+	assert.Nil(t, issues)
+
+	// ParseOutput still returns the issue via its text fallback.
+	issues = tool.ParseOutput("main.js:10:5: error Missing semicolon (semi)")
+	assert.Len(t, issues, 1)
+}
+
 func TestNewTSCTool(t *testing.T) {
 	tool := NewTSCTool()
 
@@ -0,0 +1,269 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// juliaFormatterScript formats each file passed as a positional ARGS
+// entry in place, using JuliaFormatter.jl - the same "shell out to a
+// one-line -e script" approach RuffTool's daemon-less path and
+// SqlfluffTool use for tools with no standalone CLI binary.
+const juliaFormatterScript = `using JuliaFormatter
+for f in ARGS
+    format(f)
+end`
+
+// JuliaFormatterTool implements Julia formatting using JuliaFormatter.jl.
+type JuliaFormatterTool struct {
+	*BaseTool
+}
+
+// NewJuliaFormatterTool creates a new JuliaFormatter tool.
+func NewJuliaFormatterTool() *JuliaFormatterTool {
+	tool := &JuliaFormatterTool{
+		BaseTool: NewBaseTool("juliaformatter", "Julia", "julia", FORMAT),
+	}
+
+	tool.SetInstallCommand([]string{"julia", "-e", `using Pkg; Pkg.add("JuliaFormatter")`})
+	tool.SetConfigPatterns([]string{".JuliaFormatter.toml"})
+	tool.SetSupportedExtensions([]string{".jl"})
+
+	return tool
+}
+
+// BuildCommand builds the JuliaFormatter invocation.
+func (t *JuliaFormatterTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-e", juliaFormatterScript, "--"}
+
+	// Filter only Julia files
+	jlFiles := FilterFilesByExtensions(files, []string{".jl"})
+	args = append(args, jlFiles...)
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// juliaLintScript runs StaticLint.jl against each file passed as a
+// positional ARGS entry and prints one JSON object per diagnostic found,
+// one per line, so ParseOutput can decode them with a plain line scan
+// the way ClippyTool's `--message-format json` output is read.
+const juliaLintScript = `using StaticLint, JSON
+for f in ARGS
+    env = StaticLint.getenvtree()
+    file = StaticLint.loadfile(f)
+    StaticLint.check_all(file, StaticLint.LintOptions(), env)
+    for (offset, diag) in StaticLint.collect_hints(file)
+        line, col = StaticLint.filepos(file, offset)
+        println(JSON.json(Dict(
+            "file" => f,
+            "line" => line,
+            "column" => col,
+            "rule" => string(typeof(diag)),
+            "message" => sprint(show, diag),
+        )))
+    end
+end`
+
+// JuliaLintTool implements Julia linting using StaticLint.jl.
+type JuliaLintTool struct {
+	*BaseTool
+}
+
+// NewJuliaLintTool creates a new StaticLint.jl tool.
+func NewJuliaLintTool() *JuliaLintTool {
+	tool := &JuliaLintTool{
+		BaseTool: NewBaseTool("staticlint", "Julia", "julia", LINT),
+	}
+
+	tool.SetInstallCommand([]string{"julia", "-e", `using Pkg; Pkg.add("StaticLint")`})
+	tool.SetConfigPatterns([]string{".JuliaFormatter.toml", "Project.toml"})
+	tool.SetSupportedExtensions([]string{".jl"})
+
+	return tool
+}
+
+// BuildCommand builds the StaticLint.jl invocation.
+func (t *JuliaLintTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-e", juliaLintScript, "--"}
+
+	jlFiles := FilterFilesByExtensions(files, []string{".jl"})
+	args = append(args, jlFiles...)
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// juliaLintDiagnostic mirrors one JSON line juliaLintScript prints.
+type juliaLintDiagnostic struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// ParseOutput parses StaticLint.jl's one-JSON-object-per-line output.
+func (t *JuliaLintTool) ParseOutput(output string) []Issue {
+	var issues []Issue
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var diag juliaLintDiagnostic
+		if err := json.Unmarshal([]byte(line), &diag); err != nil {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			File:     diag.File,
+			Line:     diag.Line,
+			Column:   diag.Column,
+			Severity: "warning",
+			Rule:     diag.Rule,
+			Message:  diag.Message,
+		})
+	}
+
+	return issues
+}
+
+// aquaPackageNameRe matches the `name = "..."` line in a Project.toml,
+// mirroring cargoPackageNameRe's approach to reading a package name out of
+// its manifest.
+var aquaPackageNameRe = regexp.MustCompile(`(?m)^\s*name\s*=\s*"([^"]+)"`)
+
+// aquaPackageName reads the package name out of projectRoot/Project.toml,
+// so AquaTool's -e script can `using` it by name instead of guessing.
+// Returns "" if projectRoot isn't an Aqua-testable package (no
+// Project.toml, or no top-level name field).
+func aquaPackageName(projectRoot string) string {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "Project.toml"))
+	if err != nil {
+		return ""
+	}
+
+	match := aquaPackageNameRe.FindStringSubmatch(string(data))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// aquaTestFailedRe matches the `Test Failed at <file>:<line>` header Julia's
+// Test stdlib (which Aqua.test_all's @testset blocks build on) prints for
+// each failing assertion.
+var aquaTestFailedRe = regexp.MustCompile(`^\s*Test Failed at (.+):(\d+)$`)
+
+// AquaTool implements Julia package-quality checks using Aqua.jl -
+// ambiguity detection, unbound type parameters, stale deps, and the other
+// checks Aqua.test_all bundles. Unlike JuliaLintTool (per-file syntax/style
+// linting via StaticLint.jl), Aqua operates on the whole package as
+// resolved by its Project.toml, so it's scoped to project roots rather
+// than filtered to individual files.
+type AquaTool struct {
+	*BaseTool
+}
+
+// NewAquaTool creates a new Aqua.jl tool.
+func NewAquaTool() *AquaTool {
+	tool := &AquaTool{
+		BaseTool: NewBaseTool("aqua", "Julia", "julia", LINT),
+	}
+
+	tool.SetInstallCommand([]string{"julia", "-e", `using Pkg; Pkg.add("Aqua")`})
+	tool.SetConfigPatterns([]string{"Project.toml"})
+	tool.SetSupportedExtensions([]string{".jl"})
+
+	return tool
+}
+
+// BuildCommand builds the Aqua.test_all invocation, scoped to
+// options.ProjectRoot's package rather than any individual file - Aqua
+// loads the whole package via `--project` and inspects its resolved
+// dependency graph and method tables, so there's no per-file ARGS list to
+// pass the way JuliaFormatterTool/JuliaLintTool do.
+func (t *AquaTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	pkgName := aquaPackageName(options.ProjectRoot)
+	if pkgName == "" {
+		pkgName = "MyPkg"
+	}
+
+	script := "using Aqua, " + pkgName + "; Aqua.test_all(" + pkgName + ")"
+	args := []string{"--project", "-e", script}
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// ParseOutput parses Aqua.test_all's Test-stdlib-style output: each failing
+// assertion prints a "Test Failed at <file>:<line>" header, so a plain line
+// scan for that header (the same approach JuliaLintTool's JSON-line scan
+// and golangciLintPattern's regex-line scan take) is enough to recover
+// file/line without needing a structured output mode Aqua doesn't offer.
+func (t *AquaTool) ParseOutput(output string) []Issue {
+	var issues []Issue
+
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		match := aquaTestFailedRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		message := "Aqua quality test failed"
+		if i+1 < len(lines) {
+			if next := strings.TrimSpace(lines[i+1]); strings.HasPrefix(next, "Expression:") {
+				message = next
+			}
+		}
+
+		lineNum, _ := strconv.Atoi(match[2])
+
+		issues = append(issues, Issue{
+			File:     match[1],
+			Line:     lineNum,
+			Severity: "error",
+			Rule:     "aqua",
+			Message:  message,
+		})
+	}
+
+	return issues
+}
+
+// Ensure Julia tools implement QualityTool interface.
+var (
+	_ QualityTool = (*JuliaFormatterTool)(nil)
+	_ QualityTool = (*JuliaLintTool)(nil)
+	_ QualityTool = (*AquaTool)(nil)
+)
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJuliaFormatterTool(t *testing.T) {
+	tool := NewJuliaFormatterTool()
+
+	assert.NotNil(t, tool)
+	assert.Equal(t, "juliaformatter", tool.Name())
+	assert.Equal(t, "Julia", tool.Language())
+	assert.Equal(t, FORMAT, tool.Type())
+
+	tmpDir := t.TempDir()
+	configFile := filepath.Join(tmpDir, ".JuliaFormatter.toml")
+	assert.NoError(t, os.WriteFile(configFile, []byte(`style = "blue"`), 0o644))
+
+	configs := tool.FindConfigFiles(tmpDir)
+	assert.Contains(t, configs, configFile)
+}
+
+func TestJuliaFormatterTool_BuildCommand(t *testing.T) {
+	tool := NewJuliaFormatterTool()
+
+	cmd := tool.BuildCommand([]string{"main.jl", "test.go"}, ExecuteOptions{
+		ExtraArgs: []string{"--verbose"},
+	})
+
+	assert.Equal(t, "julia", filepath.Base(cmd.Path))
+	cmdArgs := cmd.Args[1:]
+
+	assert.Contains(t, cmdArgs, "-e")
+	assert.Contains(t, cmdArgs, "main.jl")
+	assert.NotContains(t, cmdArgs, "test.go")
+	assert.Contains(t, cmdArgs, "--verbose")
+}
+
+func TestJuliaFormatterTool_BuildCommand_ProjectRoot(t *testing.T) {
+	tool := NewJuliaFormatterTool()
+
+	cmd := tool.BuildCommand([]string{"main.jl"}, ExecuteOptions{
+		ProjectRoot: "/test/project",
+	})
+
+	assert.Equal(t, "/test/project", cmd.Dir)
+}
+
+func TestNewJuliaLintTool(t *testing.T) {
+	tool := NewJuliaLintTool()
+
+	assert.NotNil(t, tool)
+	assert.Equal(t, "staticlint", tool.Name())
+	assert.Equal(t, "Julia", tool.Language())
+	assert.Equal(t, LINT, tool.Type())
+
+	tmpDir := t.TempDir()
+	configFile := filepath.Join(tmpDir, "Project.toml")
+	assert.NoError(t, os.WriteFile(configFile, []byte(`name = "Test"`), 0o644))
+
+	configs := tool.FindConfigFiles(tmpDir)
+	assert.Contains(t, configs, configFile)
+}
+
+func TestJuliaLintTool_BuildCommand(t *testing.T) {
+	tool := NewJuliaLintTool()
+
+	cmd := tool.BuildCommand([]string{"main.jl"}, ExecuteOptions{})
+
+	assert.Equal(t, "julia", filepath.Base(cmd.Path))
+	cmdArgs := cmd.Args[1:]
+
+	assert.Contains(t, cmdArgs, "-e")
+	assert.Contains(t, cmdArgs, "main.jl")
+}
+
+func TestJuliaLintTool_ParseOutput(t *testing.T) {
+	tool := NewJuliaLintTool()
+
+	tests := []struct {
+		name     string
+		output   string
+		expected int
+		check    func(*testing.T, Issue)
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			expected: 0,
+		},
+		{
+			name:     "single diagnostic",
+			output:   `{"file":"main.jl","line":4,"column":2,"rule":"UndefVarError","message":"'x' is not defined"}`,
+			expected: 1,
+			check: func(t *testing.T, issue Issue) {
+				assert.Equal(t, "main.jl", issue.File)
+				assert.Equal(t, 4, issue.Line)
+				assert.Equal(t, 2, issue.Column)
+				assert.Equal(t, "UndefVarError", issue.Rule)
+				assert.Equal(t, "'x' is not defined", issue.Message)
+				assert.Equal(t, "warning", issue.Severity)
+			},
+		},
+		{
+			name: "multiple diagnostics on separate lines",
+			output: `{"file":"main.jl","line":1,"column":1,"rule":"A","message":"a"}
+{"file":"main.jl","line":2,"column":1,"rule":"B","message":"b"}`,
+			expected: 2,
+		},
+		{
+			name:     "invalid JSON is skipped",
+			output:   "not json\n" + `{"file":"main.jl","line":1,"column":1,"rule":"A","message":"a"}`,
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tool.ParseOutput(tt.output)
+			assert.Len(t, issues, tt.expected)
+			if tt.check != nil && len(issues) > 0 {
+				tt.check(t, issues[0])
+			}
+		})
+	}
+}
+
+func TestNewAquaTool(t *testing.T) {
+	tool := NewAquaTool()
+
+	assert.NotNil(t, tool)
+	assert.Equal(t, "aqua", tool.Name())
+	assert.Equal(t, "Julia", tool.Language())
+	assert.Equal(t, LINT, tool.Type())
+
+	tmpDir := t.TempDir()
+	configFile := filepath.Join(tmpDir, "Project.toml")
+	assert.NoError(t, os.WriteFile(configFile, []byte(`name = "MyPkg"`), 0o644))
+
+	configs := tool.FindConfigFiles(tmpDir)
+	assert.Contains(t, configs, configFile)
+}
+
+func TestAquaTool_BuildCommand_UsesProjectName(t *testing.T) {
+	tool := NewAquaTool()
+	tmpDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, "Project.toml"), []byte(`name = "Frobnicate"`), 0o644))
+
+	cmd := tool.BuildCommand(nil, ExecuteOptions{ProjectRoot: tmpDir})
+
+	assert.Equal(t, "julia", filepath.Base(cmd.Path))
+	assert.Equal(t, tmpDir, cmd.Dir)
+	cmdArgs := cmd.Args[1:]
+	assert.Contains(t, cmdArgs, "--project")
+
+	found := false
+	for _, arg := range cmdArgs {
+		if strings.Contains(arg, "Frobnicate") {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected script to reference the package name read from Project.toml")
+}
+
+func TestAquaTool_BuildCommand_FallsBackWithoutProjectToml(t *testing.T) {
+	tool := NewAquaTool()
+
+	cmd := tool.BuildCommand(nil, ExecuteOptions{ProjectRoot: t.TempDir()})
+
+	cmdArgs := cmd.Args[1:]
+	found := false
+	for _, arg := range cmdArgs {
+		if strings.Contains(arg, "MyPkg") {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected fallback package name when Project.toml is absent")
+}
+
+func TestAquaTool_ParseOutput(t *testing.T) {
+	tool := NewAquaTool()
+
+	tests := []struct {
+		name     string
+		output   string
+		expected int
+		check    func(*testing.T, Issue)
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			expected: 0,
+		},
+		{
+			name: "single failure with expression",
+			output: `Test Failed at /pkg/src/MyPkg.jl:42
+  Expression: isempty(ambiguities)`,
+			expected: 1,
+			check: func(t *testing.T, issue Issue) {
+				assert.Equal(t, "/pkg/src/MyPkg.jl", issue.File)
+				assert.Equal(t, 42, issue.Line)
+				assert.Equal(t, "error", issue.Severity)
+				assert.Equal(t, "aqua", issue.Rule)
+				assert.Equal(t, "Expression: isempty(ambiguities)", issue.Message)
+			},
+		},
+		{
+			name:     "failure without a following expression line",
+			output:   `Test Failed at /pkg/src/MyPkg.jl:7`,
+			expected: 1,
+			check: func(t *testing.T, issue Issue) {
+				assert.Equal(t, "Aqua quality test failed", issue.Message)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tool.ParseOutput(tt.output)
+			assert.Len(t, issues, tt.expected)
+			if tt.check != nil && len(issues) > 0 {
+				tt.check(t, issues[0])
+			}
+		})
+	}
+}
+
+func TestJuliaTools_InterfaceCompliance(t *testing.T) {
+	toolList := []QualityTool{
+		NewJuliaFormatterTool(),
+		NewJuliaLintTool(),
+		NewAquaTool(),
+	}
+
+	for _, tool := range toolList {
+		t.Run(tool.Name(), func(t *testing.T) {
+			assert.NotEmpty(t, tool.Name())
+			assert.NotEmpty(t, tool.Language())
+			assert.NotNil(t, tool.Type())
+		})
+	}
+}
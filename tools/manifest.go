@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+// ToolManifest describes a quality tool's static metadata and how to
+// invoke and parse it. It's the YAML schema loaded by RegistryLoader from
+// tools/registry.d/*.yaml, and the YAML/JSON/TOML schema
+// RegisterFromManifest reads from a standalone file (e.g. a user's
+// ~/.gzquality/tools.d/*.toml), so adding or adjusting a tool's basic
+// wiring is a data change rather than a new Go type.
+type ToolManifest struct {
+	Name       string `yaml:"name" json:"name" toml:"name"`
+	Language   string `yaml:"language" json:"language" toml:"language"`
+	Type       string `yaml:"type" json:"type" toml:"type"` // format, lint, both, security
+	Executable string `yaml:"executable" json:"executable" toml:"executable"`
+
+	// InstallCommands maps a package manager name (brew, apt, npm, pip,
+	// cargo, go, ...) to the command used to install Executable. Only
+	// "brew" is consulted today, matching every hand-written tool's
+	// single SetInstallCommand call.
+	InstallCommands map[string][]string `yaml:"install_commands" json:"install_commands" toml:"install_commands"`
+
+	// InstallCommand is a flat alternative to InstallCommands for
+	// manifests that only need one install method and don't care to name
+	// the package manager (e.g. a curl | sh one-liner). Consulted by
+	// NewManifestTool only when InstallCommands has no "brew" entry.
+	InstallCommand []string `yaml:"install_command" json:"install_command" toml:"install_command"`
+
+	ConfigPatterns []string `yaml:"config_patterns" json:"config_patterns" toml:"config_patterns"`
+
+	// VersionConstraint, if set, is returned by VersionConstraint() so
+	// the manifest tool satisfies VersionConstrained without any Go code.
+	VersionConstraint string `yaml:"version_constraint" json:"version_constraint" toml:"version_constraint"`
+
+	// VersionArgs, if set, overrides BaseTool.GetVersion's trial-and-error
+	// flag probing (--version, -v, -V, version) with one specific argv to
+	// run, e.g. ["--version"] or ["version", "--short"], for a tool whose
+	// version flag doesn't match any of BaseTool's guesses or whose output
+	// needs a fixed invocation to stay cache-stable.
+	VersionArgs []string `yaml:"version_args" json:"version_args" toml:"version_args"`
+
+	// Extensions restricts which files are passed to Executable (e.g.
+	// [".kt", ".kts"]). Empty means every file is passed through.
+	Extensions []string `yaml:"extensions" json:"extensions" toml:"extensions"`
+
+	// GlobFallback is appended as literal args when Extensions filters
+	// every input file out, for tools (like ktlint) that accept glob
+	// patterns of their own rather than failing on an empty file list.
+	GlobFallback []string `yaml:"glob_fallback" json:"glob_fallback" toml:"glob_fallback"`
+
+	// OutputFormat selects how ManifestTool.ParseOutput reads stdout:
+	// "json" for the ktlint-style `[{file, errors:[{line,column,message,rule}]}]`
+	// shape, or "text-regex" for a line-oriented format described by
+	// TextPattern. Ignored once OutputParser is set.
+	OutputFormat string `yaml:"output_format" json:"output_format" toml:"output_format"`
+
+	// TextPattern is a regexp used when OutputFormat is "text-regex",
+	// with named capture groups file, line, column, message, and
+	// optionally rule.
+	TextPattern string `yaml:"text_pattern" json:"text_pattern" toml:"text_pattern"`
+
+	// RuleSeparator splits a leading rule name off the message group when
+	// a text format interleaves them, e.g. detekt's "RuleName - message".
+	RuleSeparator string `yaml:"rule_separator" json:"rule_separator" toml:"rule_separator"`
+
+	// LintArgs/FixArgs are the flags ManifestTool.BuildCommand appends
+	// ahead of ExtraArgs and the file list, chosen by whether
+	// ExecuteOptions requests a fix/format run. Ignored once ArgvTemplate
+	// is set.
+	LintArgs []string `yaml:"lint_args" json:"lint_args" toml:"lint_args"`
+	FixArgs  []string `yaml:"fix_args" json:"fix_args" toml:"fix_args"`
+
+	// ArgvTemplate, if set, is a Go text/template rendered by
+	// ManifestTool.BuildCommand into whitespace-separated argv, giving a
+	// manifest full control over flag placement instead of the fixed
+	// "flags then files" shape LintArgs/FixArgs produce. The template
+	// sees ".Files", ".ConfigFile", and ".ExtraArgs", e.g.
+	// "check --config {{.ConfigFile}} {{range .Files}}{{.}} {{end}}".
+	ArgvTemplate string `yaml:"argv_template" json:"argv_template" toml:"argv_template"`
+
+	// OutputParser selects how ManifestTool.ParseOutput reads stdout for
+	// a manifest loaded via RegisterFromManifest: "checkstyle-xml",
+	// "sarif", "regex:<pattern>" (named groups
+	// file/line/col/severity/rule/message), or "json:<path>" (a dotted
+	// path to the array of issue objects, e.g. "results" for
+	// {"results": [...]}; empty means the array is the top-level JSON
+	// value). Takes precedence over OutputFormat/TextPattern when set.
+	OutputParser string `yaml:"output_parser" json:"output_parser" toml:"output_parser"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdapterRegistry_GoldenFiles is the adapter-manifest analogue of
+// TestRuffTool_ParseOutput/TestPylintTool_ParseOutput: instead of one Go
+// test case per hand-written tool, it loads every *.toml manifest under
+// testdata/adapters, runs ManifestTool.ParseOutput against the matching
+// *.out fixture, and diffs the result against the matching
+// *.golden.json, so adding a new adapter fixture (no Go code) is enough
+// to cover it here too.
+func TestAdapterRegistry_GoldenFiles(t *testing.T) {
+	const dir = "testdata/adapters"
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	var manifests []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".toml") {
+			manifests = append(manifests, entry.Name())
+		}
+	}
+	require.NotEmpty(t, manifests, "expected at least one adapter fixture under %s", dir)
+
+	for _, name := range manifests {
+		name := name
+		base := strings.TrimSuffix(name, ".toml")
+
+		t.Run(base, func(t *testing.T) {
+			registry := NewRegistry()
+			require.NoError(t, registry.RegisterFromManifest(filepath.Join(dir, name)))
+
+			tool := registry.FindTool(base)
+			require.NotNil(t, tool, "adapter %s did not register under its manifest name", base)
+			manifestTool, ok := tool.(*ManifestTool)
+			require.True(t, ok, "adapter %s is not a *ManifestTool", base)
+
+			output, err := os.ReadFile(filepath.Join(dir, base+".out"))
+			require.NoError(t, err)
+
+			goldenRaw, err := os.ReadFile(filepath.Join(dir, base+".golden.json"))
+			require.NoError(t, err)
+
+			var want []Issue
+			require.NoError(t, json.Unmarshal(goldenRaw, &want))
+
+			got := manifestTool.ParseOutput(string(output))
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+// TestAdapterRegistry_LoadDir exercises the directory-scanning path a
+// user's whole ~/.gzquality/adapters.d/ would go through, rather than
+// RegisterFromManifest one file at a time.
+func TestAdapterRegistry_LoadDir(t *testing.T) {
+	registry := NewRegistry()
+	adapters := NewAdapterRegistry(registry)
+
+	require.NoError(t, adapters.LoadDir("testdata/adapters"))
+
+	assert.NotNil(t, registry.FindTool("examplelint"))
+	assert.NotNil(t, registry.FindTool("stylejson"))
+}
+
+// TestAdapterRegistry_LoadDir_MissingDirIsNotError matches os.ReadDir's
+// own contract for an adapters directory a user never created.
+func TestAdapterRegistry_LoadDir_MissingDirIsNotError(t *testing.T) {
+	registry := NewRegistry()
+	adapters := NewAdapterRegistry(registry)
+
+	assert.NoError(t, adapters.LoadDir(filepath.Join(t.TempDir(), "does-not-exist")))
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// parseSARIFOutput parses a SARIF 2.1.0 log (the "sarif" output_parser)
+// into Issues, reading just the subset of the schema every scanner
+// populates: one result per finding, with its rule, message, and primary
+// physical location.
+func parseSARIFOutput(output string) []Issue {
+	if strings.TrimSpace(output) == "" {
+		return []Issue{}
+	}
+
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				RuleID  string `json:"ruleId"`
+				Level   string `json:"level"`
+				Message struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine   int `json:"startLine"`
+							StartColumn int `json:"startColumn"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &log); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for _, run := range log.Runs {
+		for _, res := range run.Results {
+			issue := Issue{
+				Severity: sarifSeverity(res.Level),
+				Rule:     res.RuleID,
+				Message:  res.Message.Text,
+			}
+			if len(res.Locations) > 0 {
+				loc := res.Locations[0].PhysicalLocation
+				issue.File = loc.ArtifactLocation.URI
+				issue.Line = loc.Region.StartLine
+				issue.Column = loc.Region.StartColumn
+			}
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// sarifSeverity maps SARIF's "level" enum (error/warning/note/none) onto
+// the tool-agnostic severities used elsewhere in this package. An empty
+// or unrecognized level defaults to "warning", SARIF's own default when
+// a result omits level.
+func sarifSeverity(level string) string {
+	switch level {
+	case "error":
+		return "error"
+	case "note":
+		return "info"
+	default:
+		return "warning"
+	}
+}
+
+// parseJSONPathOutput parses a JSON document for the "json:<path>"
+// output_parser, walking dot-separated path to the array of issue
+// objects (an empty path means the document itself is that array) and
+// reading each object's file/line/column/severity/rule/message under a
+// few common aliases, so manifests can point at whatever shape a
+// third-party tool's --format json actually produces.
+func parseJSONPathOutput(output, path string) []Issue {
+	if strings.TrimSpace(output) == "" {
+		return []Issue{}
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(output), &doc); err != nil {
+		return []Issue{}
+	}
+
+	node := doc
+	if path != "" {
+		for _, segment := range strings.Split(path, ".") {
+			m, ok := node.(map[string]interface{})
+			if !ok {
+				return []Issue{}
+			}
+			node, ok = m[segment]
+			if !ok {
+				return []Issue{}
+			}
+		}
+	}
+
+	items, ok := node.([]interface{})
+	if !ok {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		issues = append(issues, Issue{
+			File:     jsonPathString(obj, "file", "path"),
+			Line:     jsonPathInt(obj, "line"),
+			Column:   jsonPathInt(obj, "column", "col"),
+			Severity: jsonPathStringOr(obj, "warning", "severity", "level"),
+			Rule:     jsonPathString(obj, "rule", "ruleId", "code"),
+			Message:  jsonPathString(obj, "message", "description"),
+		})
+	}
+	return issues
+}
+
+// jsonPathString returns the first of keys present in obj as a string,
+// or "" if none are.
+func jsonPathString(obj map[string]interface{}, keys ...string) string {
+	return jsonPathStringOr(obj, "", keys...)
+}
+
+// jsonPathStringOr is jsonPathString with a caller-supplied default
+// instead of "".
+func jsonPathStringOr(obj map[string]interface{}, def string, keys ...string) string {
+	for _, key := range keys {
+		if v, ok := obj[key].(string); ok {
+			return v
+		}
+	}
+	return def
+}
+
+// jsonPathInt returns the first of keys present in obj as an int,
+// truncating the float64 encoding/json unmarshals JSON numbers into, or
+// 0 if none are present.
+func jsonPathInt(obj map[string]interface{}, keys ...string) int {
+	for _, key := range keys {
+		if v, ok := obj[key].(float64); ok {
+			return int(v)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// ManifestTool is a QualityTool whose invocation and output-parsing
+// behavior come from a ToolManifest loaded by RegistryLoader, rather
+// than a bespoke BuildCommand/ParseOutput pair. KtlintTool embeds one
+// directly to prove the engine covers a real tool end to end; DetektTool
+// embeds one for metadata and output parsing but keeps its own
+// BuildCommand, since detekt's --input/--report flags don't fit the
+// generic flat arg-list model.
+type ManifestTool struct {
+	*BaseTool
+	manifest   ToolManifest
+	textRegex  *regexp.Regexp
+	sourcePath string
+}
+
+// manifestToolType maps a manifest's "type" string to a ToolType,
+// defaulting to BOTH (matching most hand-written tool constructors)
+// for an unrecognized or empty value.
+func manifestToolType(t string) ToolType {
+	switch t {
+	case "format":
+		return FORMAT
+	case "lint":
+		return LINT
+	case "security":
+		return SECURITY
+	default:
+		return BOTH
+	}
+}
+
+// NewManifestTool builds a QualityTool from a parsed manifest, compiling
+// its text_pattern regex (if any) up front so BuildCommand/ParseOutput
+// never have to handle a compile error.
+func NewManifestTool(manifest ToolManifest) (*ManifestTool, error) {
+	if manifest.Name == "" || manifest.Executable == "" {
+		return nil, fmt.Errorf("manifest missing name or executable")
+	}
+
+	var textRegex *regexp.Regexp
+	switch {
+	case manifest.OutputFormat == "text-regex":
+		if manifest.TextPattern == "" {
+			return nil, fmt.Errorf("%s: output_format text-regex requires text_pattern", manifest.Name)
+		}
+		re, err := regexp.Compile(manifest.TextPattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid text_pattern: %w", manifest.Name, err)
+		}
+		textRegex = re
+	case strings.HasPrefix(manifest.OutputParser, "regex:"):
+		pattern := strings.TrimPrefix(manifest.OutputParser, "regex:")
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid output_parser regex: %w", manifest.Name, err)
+		}
+		textRegex = re
+	}
+
+	if manifest.ArgvTemplate != "" {
+		if _, err := template.New(manifest.Name).Parse(manifest.ArgvTemplate); err != nil {
+			return nil, fmt.Errorf("%s: invalid argv_template: %w", manifest.Name, err)
+		}
+	}
+
+	base := NewBaseTool(manifest.Name, manifest.Language, manifest.Executable, manifestToolType(manifest.Type))
+	if install, ok := manifest.InstallCommands["brew"]; ok {
+		base.SetInstallCommand(install)
+	} else if len(manifest.InstallCommand) > 0 {
+		base.SetInstallCommand(manifest.InstallCommand)
+	}
+	base.SetConfigPatterns(manifest.ConfigPatterns)
+	base.SetSupportedExtensions(manifest.Extensions)
+
+	return &ManifestTool{BaseTool: base, manifest: manifest, textRegex: textRegex}, nil
+}
+
+// SetSourcePath records path as the manifest file this tool was loaded
+// from, so FindConfigFiles can fold its content into the tool's cache
+// key (see FindConfigFiles below). Unset for manifests with no file of
+// their own, e.g. the ones embedded via mustLoadBuiltinManifest.
+func (t *ManifestTool) SetSourcePath(path string) {
+	t.sourcePath = path
+}
+
+// FindConfigFiles returns the manifest's own configured patterns (via
+// BaseTool), plus the adapter manifest file itself when SetSourcePath
+// was called. Folding the manifest file in here - rather than adding a
+// separate cache-key field - means GenerateKey/KeyBuilder's existing
+// configHash (a hash over FindConfigFiles' result) already changes
+// whenever a user edits an adapter's argv template or output parser, so
+// editing the adapter invalidates its cache the same way editing a
+// tool's own lint config does.
+func (t *ManifestTool) FindConfigFiles(projectRoot string) []string {
+	configs := t.BaseTool.FindConfigFiles(projectRoot)
+	if t.sourcePath != "" {
+		configs = append(configs, t.sourcePath)
+	}
+	return configs
+}
+
+// GetVersion runs the manifest's version_args, if set, instead of
+// BaseTool's trial-and-error flag probing - useful when none of
+// --version/-v/-V/version is the right flag, or when a tool's version
+// output depends on which flag was used and a manifest wants a stable
+// cache key.
+func (t *ManifestTool) GetVersion() (string, error) {
+	if len(t.manifest.VersionArgs) == 0 {
+		return t.BaseTool.GetVersion()
+	}
+
+	if !t.IsAvailable() {
+		return "", fmt.Errorf("tool %s is not installed", t.manifest.Name)
+	}
+
+	cmd := exec.Command(t.executable, t.manifest.VersionArgs...)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get version of %s: %w", t.manifest.Name, err)
+	}
+
+	version := strings.TrimSpace(string(output))
+	if version == "" {
+		return "unknown", nil
+	}
+	return version, nil
+}
+
+// BuildCommand builds the tool's command line from the manifest's
+// lint_args/fix_args, plus ExtraArgs and any files the manifest
+// restricts by extension. Manifests that set ArgvTemplate instead render
+// it via buildTemplatedCommand.
+func (t *ManifestTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	if t.manifest.ArgvTemplate != "" {
+		return t.buildTemplatedCommand(files, options)
+	}
+
+	var args []string
+	if options.Fix || options.FormatOnly {
+		args = append(args, t.manifest.FixArgs...)
+	} else {
+		args = append(args, t.manifest.LintArgs...)
+	}
+
+	args = append(args, options.ExtraArgs...)
+
+	executable := t.executable
+	if options.Location != nil {
+		executable = options.Location.Command
+		args = append(append([]string{}, options.Location.PrefixArgs...), args...)
+	} else {
+		targets := files
+		if len(t.manifest.Extensions) > 0 {
+			targets = FilterFilesByExtensions(files, t.manifest.Extensions)
+		}
+		if len(targets) == 0 && len(t.manifest.GlobFallback) > 0 {
+			targets = t.manifest.GlobFallback
+		}
+		args = append(args, targets...)
+	}
+
+	cmd := exec.Command(executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// buildTemplatedCommand renders the manifest's argv_template - a Go
+// text/template seeing ".Files" (extension-filtered, like the
+// lint_args/fix_args path), ".ConfigFile", and ".ExtraArgs" - into a
+// whitespace-separated argv. A render error falls back to a bare
+// invocation of the executable rather than failing Execute outright,
+// since NewManifestTool already validated the template parses.
+func (t *ManifestTool) buildTemplatedCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	targets := files
+	if len(t.manifest.Extensions) > 0 {
+		targets = FilterFilesByExtensions(files, t.manifest.Extensions)
+	}
+
+	data := struct {
+		Files      []string
+		ConfigFile string
+		ExtraArgs  []string
+	}{Files: targets, ConfigFile: options.ConfigFile, ExtraArgs: options.ExtraArgs}
+
+	var rendered bytes.Buffer
+	tmpl, err := template.New(t.manifest.Name).Parse(t.manifest.ArgvTemplate)
+	if err == nil {
+		err = tmpl.Execute(&rendered, data)
+	}
+
+	var cmd *exec.Cmd
+	if err != nil {
+		cmd = exec.Command(t.executable)
+	} else {
+		cmd = exec.Command(t.executable, strings.Fields(rendered.String())...)
+	}
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// ParseOutput dispatches to the manifest's declared output_parser, or
+// (for backward compatibility with registry.d manifests) its older
+// output_format/text_pattern pair when output_parser is unset.
+func (t *ManifestTool) ParseOutput(output string) []Issue {
+	switch {
+	case t.manifest.OutputParser == "checkstyle-xml":
+		return parseCheckstyleXML(output)
+	case t.manifest.OutputParser == "sarif":
+		return parseSARIFOutput(output)
+	case strings.HasPrefix(t.manifest.OutputParser, "regex:"):
+		return t.parseTextOutput(output)
+	case strings.HasPrefix(t.manifest.OutputParser, "json:"):
+		return parseJSONPathOutput(output, strings.TrimPrefix(t.manifest.OutputParser, "json:"))
+	case t.manifest.OutputFormat == "json":
+		return t.parseJSONOutput(output)
+	case t.manifest.OutputFormat == "text-regex":
+		return t.parseTextOutput(output)
+	default:
+		return []Issue{}
+	}
+}
+
+// parseJSONOutput parses the ktlint-style
+// `[{file, errors:[{line,column,message,rule}]}]` shape.
+func (t *ManifestTool) parseJSONOutput(output string) []Issue {
+	if strings.TrimSpace(output) == "" {
+		return []Issue{}
+	}
+
+	var results []struct {
+		File   string `json:"file"`
+		Errors []struct {
+			Line    int    `json:"line"`
+			Column  int    `json:"column"`
+			Message string `json:"message"`
+			Rule    string `json:"rule"`
+		} `json:"errors"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &results); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for _, file := range results {
+		for _, e := range file.Errors {
+			issues = append(issues, Issue{
+				File:     file.File,
+				Line:     e.Line,
+				Column:   e.Column,
+				Severity: "error",
+				Rule:     e.Rule,
+				Message:  e.Message,
+			})
+		}
+	}
+	return issues
+}
+
+// parseTextOutput applies the manifest's text_pattern line by line,
+// splitting a leading "Rule - message" pair out of the message group
+// when rule_separator is set and the pattern didn't already capture a
+// rule group (detekt's format).
+func (t *ManifestTool) parseTextOutput(output string) []Issue {
+	var issues []Issue
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		match := t.textRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		issue := Issue{Severity: "warning"}
+		for i, name := range t.textRegex.SubexpNames() {
+			if i == 0 || name == "" {
+				continue
+			}
+			value := match[i]
+			switch name {
+			case "file":
+				issue.File = value
+			case "line":
+				issue.Line, _ = strconv.Atoi(value)
+			case "column":
+				issue.Column, _ = strconv.Atoi(value)
+			case "message":
+				issue.Message = value
+			case "rule":
+				issue.Rule = value
+			}
+		}
+
+		if issue.Rule == "" && t.manifest.RuleSeparator != "" {
+			if idx := strings.Index(issue.Message, t.manifest.RuleSeparator); idx != -1 {
+				issue.Rule = strings.TrimSpace(issue.Message[:idx])
+				issue.Message = strings.TrimSpace(issue.Message[idx+len(t.manifest.RuleSeparator):])
+			}
+		}
+
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// VersionConstraint implements VersionConstrained, returning the
+// manifest's declared constraint (which may be empty, meaning none).
+func (t *ManifestTool) VersionConstraint() string {
+	return t.manifest.VersionConstraint
+}
+
+var (
+	_ QualityTool        = (*ManifestTool)(nil)
+	_ VersionConstrained = (*ManifestTool)(nil)
+)
@@ -4,10 +4,16 @@
 package tools
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
 	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools/sarif"
 )
 
 // MarkdownlintTool implements Markdown linting using markdownlint-cli2.
@@ -23,16 +29,33 @@ func NewMarkdownlintTool() *MarkdownlintTool {
 
 	tool.SetInstallCommand([]string{"npm", "install", "-g", "markdownlint-cli2"})
 	tool.SetConfigPatterns([]string{".markdownlint.json", ".markdownlint.yaml", ".markdownlint.yml", ".markdownlint-cli2.jsonc"})
+	tool.SetSupportedExtensions([]string{".md", ".markdown"})
 
 	return tool
 }
 
 // BuildCommand builds the markdownlint command.
 func (t *MarkdownlintTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	return t.buildCommand(files, options, "")
+}
+
+// buildCommand builds the markdownlint-cli2 command, additionally
+// pointing it at a generated config overlay (see
+// writeOutputFormatterOverlay) when structuredOutputPath is non-empty,
+// so Execute can recover the JSON/SARIF output ParseJSONOutput/
+// ParseSARIFOutput understand alongside the usual text output.
+//
+// The overlay takes the place of options.ConfigFile when both are set,
+// since markdownlint-cli2 only accepts one --config: structured output
+// wins, at the cost of the user's own rule customization being skipped
+// for that run.
+func (t *MarkdownlintTool) buildCommand(files []string, options ExecuteOptions, structuredOutputPath string) *exec.Cmd {
 	args := []string{}
 
-	// Add config file if specified
-	if options.ConfigFile != "" {
+	switch {
+	case structuredOutputPath != "":
+		args = append(args, "--config", structuredOutputPath)
+	case options.ConfigFile != "":
 		args = append(args, "--config", options.ConfigFile)
 	}
 
@@ -61,6 +84,92 @@ func (t *MarkdownlintTool) BuildCommand(files []string, options ExecuteOptions)
 	return cmd
 }
 
+// Execute runs markdownlint-cli2, and for the "json"/"sarif" output
+// formats (see SetOutputFormat) additionally captures structured output
+// into a local temp file for ParseJSONOutput/ParseSARIFOutput to read -
+// a local variable rather than a field on t, since a future
+// parallel/sharded Execute (see ClangTidyTool.executeShard) must not
+// share one sidecar path across concurrent invocations.
+func (t *MarkdownlintTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	format := t.OutputFormat()
+	if format == string(OutputFormatText) {
+		return t.BaseTool.Execute(ctx, files, options)
+	}
+
+	outputFile, err := os.CreateTemp("", "markdownlint-"+format+"-*.json")
+	if err != nil {
+		// Can't get a temp file for structured output - fall back to the
+		// plain text-only run rather than failing the whole lint pass.
+		cmd := t.buildCommand(files, options, "")
+		return t.ExecuteCommand(ctx, cmd, files)
+	}
+	outputPath := outputFile.Name()
+	outputFile.Close()
+	defer os.Remove(outputPath)
+
+	overlayPath, err := writeOutputFormatterOverlay(format, outputPath)
+	if err != nil {
+		cmd := t.buildCommand(files, options, "")
+		return t.ExecuteCommand(ctx, cmd, files)
+	}
+	defer os.Remove(overlayPath)
+
+	cmd := t.buildCommand(files, options, overlayPath)
+	result, err := t.ExecuteCommand(ctx, cmd, files)
+	if err != nil {
+		return result, err
+	}
+
+	if !result.Success {
+		if data, readErr := os.ReadFile(outputPath); readErr == nil && len(data) > 0 {
+			if format == string(OutputFormatSARIF) {
+				result.Issues = t.ParseSARIFOutput(data)
+			} else {
+				result.Issues = t.ParseJSONOutput(data)
+			}
+		} else {
+			result.Issues = t.ParseOutput(result.Output)
+		}
+	}
+
+	if err := ApplyDiffScope(ctx, result, options); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// writeOutputFormatterOverlay writes a temp .markdownlint-cli2.jsonc
+// config that points markdownlint-cli2's outputFormatters at
+// outputPath, for the given format ("json" or "sarif").
+func writeOutputFormatterOverlay(format, outputPath string) (string, error) {
+	formatterModule := "markdownlint-cli2-formatter-" + format
+
+	overlay := fmt.Sprintf(`{"outputFormatters": [["%s", {"name": %q}]]}`, formatterModule, outputPath)
+
+	f, err := os.CreateTemp("", "*.markdownlint-cli2.jsonc")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(overlay); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
 // ParseOutput parses markdownlint text output.
 func (t *MarkdownlintTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
@@ -94,6 +203,103 @@ func (t *MarkdownlintTool) ParseOutput(output string) []Issue {
 	return issues
 }
 
+// markdownlintJSONEntry mirrors one entry of markdownlint-cli2-formatter-
+// json's output array.
+type markdownlintJSONEntry struct {
+	FileName        string   `json:"fileName"`
+	LineNumber      int      `json:"lineNumber"`
+	RuleNames       []string `json:"ruleNames"`
+	RuleDescription string   `json:"ruleDescription"`
+	ErrorDetail     string   `json:"errorDetail"`
+	ErrorContext    string   `json:"errorContext"`
+	ErrorRange      []int    `json:"errorRange"`
+	FixInfo         *struct {
+		LineNumber  int    `json:"lineNumber"`
+		EditColumn  int    `json:"editColumn"`
+		DeleteCount int    `json:"deleteCount"`
+		InsertText  string `json:"insertText"`
+	} `json:"fixInfo"`
+}
+
+// ParseJSONOutput parses markdownlint-cli2-formatter-json's output,
+// unlike the text ParseOutput preserving Column, EndLine, and FixInfo.
+func (t *MarkdownlintTool) ParseJSONOutput(data []byte) []Issue {
+	var entries []markdownlintJSONEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return []Issue{}
+	}
+
+	issues := make([]Issue, 0, len(entries))
+	for _, e := range entries {
+		issue := Issue{
+			File:     e.FileName,
+			Line:     e.LineNumber,
+			Severity: "warning",
+			Rule:     strings.Join(e.RuleNames, "/"),
+			Message:  markdownlintMessage(e),
+		}
+
+		if len(e.ErrorRange) > 0 {
+			issue.Column = e.ErrorRange[0]
+		}
+
+		if e.FixInfo != nil {
+			fixLine := e.FixInfo.LineNumber
+			if fixLine == 0 {
+				fixLine = e.LineNumber
+			}
+			issue.FixInfo = &IssueFixInfo{
+				StartLine:   fixLine,
+				StartColumn: e.FixInfo.EditColumn,
+				EndLine:     fixLine,
+				EndColumn:   e.FixInfo.EditColumn + e.FixInfo.DeleteCount,
+				InsertText:  e.FixInfo.InsertText,
+			}
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// markdownlintMessage joins entry's description, detail, and context the
+// way the text reporter's single message field reads.
+func markdownlintMessage(e markdownlintJSONEntry) string {
+	msg := e.RuleDescription
+	if e.ErrorDetail != "" {
+		msg += ": " + e.ErrorDetail
+	}
+	if e.ErrorContext != "" {
+		msg += " [" + e.ErrorContext + "]"
+	}
+	return msg
+}
+
+// ParseSARIFOutput parses markdownlint-cli2-formatter-sarif's output via
+// the shared sarif.Parse helper.
+func (t *MarkdownlintTool) ParseSARIFOutput(data []byte) []Issue {
+	findings, err := sarif.Parse(data)
+	if err != nil {
+		return []Issue{}
+	}
+
+	return sarifFindingsToIssues(findings)
+}
+
+// sarifLevelToSeverity maps a SARIF result level to the Issue.Severity
+// vocabulary the rest of the tools package uses.
+func sarifLevelToSeverity(level string) string {
+	switch level {
+	case "error":
+		return "error"
+	case "warning":
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
 // Ensure Markdown tools implement QualityTool interface.
 var (
 	_ QualityTool = (*MarkdownlintTool)(nil)
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMarkdownlintTool(t *testing.T) {
+	tool := NewMarkdownlintTool()
+	assert.Equal(t, "markdownlint", tool.Name())
+	assert.Equal(t, "Markdown", tool.Language())
+}
+
+func TestMarkdownlintTool_ParseOutput(t *testing.T) {
+	tool := NewMarkdownlintTool()
+
+	output := "README.md:10 MD013/line-length Line length"
+	issues := tool.ParseOutput(output)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "README.md", issues[0].File)
+	assert.Equal(t, 10, issues[0].Line)
+	assert.Equal(t, "warning", issues[0].Severity)
+	assert.Equal(t, "MD013/line-length", issues[0].Rule)
+	assert.Equal(t, "Line length", issues[0].Message)
+}
+
+func TestMarkdownlintTool_ParseJSONOutput(t *testing.T) {
+	tool := NewMarkdownlintTool()
+
+	data := []byte(`[{
+		"fileName": "README.md",
+		"lineNumber": 10,
+		"ruleNames": ["MD013", "line-length"],
+		"ruleDescription": "Line length",
+		"errorDetail": "Expected: 80; Actual: 120",
+		"errorRange": [1, 120],
+		"fixInfo": {"lineNumber": 10, "editColumn": 81, "deleteCount": 40, "insertText": ""}
+	}]`)
+
+	issues := tool.ParseJSONOutput(data)
+	require.Len(t, issues, 1)
+
+	issue := issues[0]
+	assert.Equal(t, "README.md", issue.File)
+	assert.Equal(t, 10, issue.Line)
+	assert.Equal(t, 1, issue.Column)
+	assert.Equal(t, "MD013/line-length", issue.Rule)
+	assert.Contains(t, issue.Message, "Line length")
+	assert.Contains(t, issue.Message, "Expected: 80")
+
+	require.NotNil(t, issue.FixInfo)
+	assert.Equal(t, 10, issue.FixInfo.StartLine)
+	assert.Equal(t, 81, issue.FixInfo.StartColumn)
+	assert.Equal(t, 121, issue.FixInfo.EndColumn)
+}
+
+func TestMarkdownlintTool_ParseJSONOutput_InvalidJSON(t *testing.T) {
+	tool := NewMarkdownlintTool()
+	assert.Empty(t, tool.ParseJSONOutput([]byte("not json")))
+}
+
+func TestMarkdownlintTool_ParseSARIFOutput(t *testing.T) {
+	tool := NewMarkdownlintTool()
+
+	data := []byte(`{"runs": [{"results": [{
+		"ruleId": "MD013/line-length",
+		"level": "warning",
+		"message": {"text": "Line length"},
+		"locations": [{
+			"physicalLocation": {
+				"artifactLocation": {"uri": "README.md"},
+				"region": {"startLine": 10, "startColumn": 1, "endLine": 10, "endColumn": 121}
+			}
+		}]
+	}]}]}`)
+
+	issues := tool.ParseSARIFOutput(data)
+	require.Len(t, issues, 1)
+
+	issue := issues[0]
+	assert.Equal(t, "README.md", issue.File)
+	assert.Equal(t, 10, issue.Line)
+	assert.Equal(t, 1, issue.Column)
+	assert.Equal(t, 10, issue.EndLine)
+	assert.Equal(t, "warning", issue.Severity)
+	assert.Equal(t, "MD013/line-length", issue.Rule)
+	assert.Nil(t, issue.FixInfo)
+}
+
+func TestMarkdownlintTool_BuildCommand_StructuredOutputOverlay(t *testing.T) {
+	tool := NewMarkdownlintTool()
+
+	cmd := tool.buildCommand([]string{"README.md"}, ExecuteOptions{ConfigFile: "custom.json"}, "/tmp/overlay.jsonc")
+
+	assert.Contains(t, cmd.Args, "/tmp/overlay.jsonc")
+	assert.NotContains(t, cmd.Args, "custom.json", "the structured-output overlay takes the place of a user config file")
+}
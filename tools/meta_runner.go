@@ -0,0 +1,329 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// MetaRunnerConfigFile is the config file name MetaRunner looks for in a
+// project root, modeled after gometalinter's own aggregation config.
+const MetaRunnerConfigFile = ".gzh-quality.yaml"
+
+// metaSkipDirs are directory names MetaRunner never descends into while
+// discovering files to hand to its selected tools.
+var metaSkipDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// MetaToolConfig overrides how MetaRunner invokes a single tool.
+type MetaToolConfig struct {
+	// Args are appended to the tool's normal ExtraArgs.
+	Args []string `yaml:"args"`
+
+	// Config overrides the tool's auto-discovered ConfigFile.
+	Config string `yaml:"config"`
+}
+
+// MetaRunnerConfig is MetaRunner's declarative `.gzh-quality.yaml`
+// configuration: which registered tools to run and how, modeled after
+// gometalinter's enable/disable-list aggregation approach rather than the
+// planner+ParallelExecutor pipeline's project-analysis-driven selection.
+type MetaRunnerConfig struct {
+	// Enable, if non-empty, restricts the run to exactly these tool names.
+	// An empty Enable means "every registered tool".
+	Enable []string `yaml:"enable"`
+
+	// Disable removes tool names from the selection, applied after Enable.
+	Disable []string `yaml:"disable"`
+
+	// Tools holds per-tool Args/Config overrides, keyed by tool name.
+	Tools map[string]MetaToolConfig `yaml:"tools"`
+
+	// Deadline bounds the whole run (e.g. "30s", "2m"). Invalid or empty
+	// values fall back to 30s.
+	Deadline string `yaml:"deadline"`
+
+	// LineLength and Cyclo are the shared style thresholds gometalinter
+	// itself exposes; kept here for tools that consult them via their own
+	// Config/Args overrides above, since no single flag name applies to
+	// every linter this repo supports.
+	LineLength int `yaml:"line_length"`
+	Cyclo      int `yaml:"cyclo"`
+}
+
+// DefaultMetaRunnerConfig returns a MetaRunnerConfig that runs every
+// registered tool with gometalinter's usual defaults.
+func DefaultMetaRunnerConfig() *MetaRunnerConfig {
+	return &MetaRunnerConfig{
+		Deadline:   "30s",
+		LineLength: 120,
+		Cyclo:      10,
+	}
+}
+
+// LoadMetaRunnerConfig reads a MetaRunnerConfig from path, layered on top
+// of DefaultMetaRunnerConfig. A missing file is not an error - it returns
+// the defaults, the same way config.LoadConfig treats an absent
+// .gzquality.yml.
+func LoadMetaRunnerConfig(path string) (*MetaRunnerConfig, error) {
+	cfg := DefaultMetaRunnerConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read meta-runner config %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse meta-runner config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// deadline returns config.Deadline parsed as a duration, falling back to
+// 30s when it's empty or unparseable.
+func (c *MetaRunnerConfig) deadline() time.Duration {
+	d, err := time.ParseDuration(c.Deadline)
+	if err != nil || d <= 0 {
+		return 30 * time.Second
+	}
+	return d
+}
+
+// MetaRunner aggregates every registered tool selected by its config and
+// runs them concurrently against a project, merging their issues into one
+// deduplicated, file/line-sorted slice - gometalinter's "run everything,
+// merge the output" model, built on top of Registry instead of a fixed set
+// of external linter binaries.
+type MetaRunner struct {
+	registry ToolRegistry
+	config   *MetaRunnerConfig
+	workers  int
+}
+
+// NewMetaRunner creates a MetaRunner selecting tools from registry per
+// config. A nil config falls back to DefaultMetaRunnerConfig.
+func NewMetaRunner(registry ToolRegistry, config *MetaRunnerConfig) *MetaRunner {
+	if config == nil {
+		config = DefaultMetaRunnerConfig()
+	}
+
+	return &MetaRunner{
+		registry: registry,
+		config:   config,
+		workers:  4,
+	}
+}
+
+// SetWorkers overrides the worker pool size bounding concurrent tool runs
+// (default 4).
+func (r *MetaRunner) SetWorkers(workers int) {
+	if workers > 0 {
+		r.workers = workers
+	}
+}
+
+// SelectedTools returns the tools Run will execute: every tool in the
+// registry, narrowed to config.Enable when it's non-empty, then minus
+// config.Disable - sorted by name so selection is deterministic.
+func (r *MetaRunner) SelectedTools() []QualityTool {
+	enable := make(map[string]bool, len(r.config.Enable))
+	for _, name := range r.config.Enable {
+		enable[name] = true
+	}
+
+	disable := make(map[string]bool, len(r.config.Disable))
+	for _, name := range r.config.Disable {
+		disable[name] = true
+	}
+
+	var selected []QualityTool
+	for _, tool := range r.registry.GetTools() {
+		if len(enable) > 0 && !enable[tool.Name()] {
+			continue
+		}
+		if disable[tool.Name()] {
+			continue
+		}
+		selected = append(selected, tool)
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Name() < selected[j].Name()
+	})
+
+	return selected
+}
+
+// buildOptions layers this tool's MetaToolConfig override (if any) on top
+// of base.
+func (r *MetaRunner) buildOptions(toolName string, base ExecuteOptions) ExecuteOptions {
+	override, ok := r.config.Tools[toolName]
+	if !ok {
+		return base
+	}
+
+	opts := base
+	if len(override.Args) > 0 {
+		opts.ExtraArgs = append(append([]string{}, base.ExtraArgs...), override.Args...)
+	}
+	if override.Config != "" {
+		opts.ConfigFile = override.Config
+	}
+
+	return opts
+}
+
+// Run discovers every file under projectRoot, then executes each selected
+// tool against that file set in parallel (bounded by the worker pool)
+// under a context.WithTimeout derived from config.Deadline, merging their
+// issues into one deduplicated, file/line-sorted slice. A per-tool
+// execution error doesn't stop the others from running; the first one
+// encountered is returned alongside whatever issues were gathered.
+func (r *MetaRunner) Run(ctx context.Context, projectRoot string) ([]Issue, error) {
+	selected := r.SelectedTools()
+	if len(selected) == 0 {
+		return nil, nil
+	}
+
+	files, err := discoverFiles(projectRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover files under %s: %w", projectRoot, err)
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx, r.config.deadline())
+	defer cancel()
+
+	type toolResult struct {
+		err error
+		res *Result
+	}
+
+	taskChan := make(chan QualityTool, len(selected))
+	resultChan := make(chan toolResult, len(selected))
+
+	workers := r.workers
+	if workers > len(selected) {
+		workers = len(selected)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tool := range taskChan {
+				opts := r.buildOptions(tool.Name(), ExecuteOptions{ProjectRoot: projectRoot})
+				res, err := tool.Execute(deadlineCtx, files, opts)
+				resultChan <- toolResult{res: res, err: err}
+			}
+		}()
+	}
+
+	for _, tool := range selected {
+		taskChan <- tool
+	}
+	close(taskChan)
+
+	go func() {
+		wg.Wait()
+		close(resultChan)
+	}()
+
+	var firstErr error
+	var allIssues []Issue
+	for tr := range resultChan {
+		if tr.err != nil && firstErr == nil {
+			firstErr = tr.err
+		}
+		if tr.res != nil {
+			allIssues = append(allIssues, tr.res.Issues...)
+		}
+	}
+
+	if firstErr == nil && deadlineCtx.Err() != nil {
+		firstErr = fmt.Errorf("meta-runner deadline of %s exceeded", r.config.deadline())
+	}
+
+	return mergeIssues(allIssues), firstErr
+}
+
+// discoverFiles walks projectRoot collecting every regular file, skipping
+// dotfiles/dot-directories and the usual vendored-code directories, so
+// each selected tool's own FilterFilesByExtensions can narrow the set to
+// whatever extensions it cares about.
+func discoverFiles(projectRoot string) ([]string, error) {
+	var files []string
+
+	err := filepath.Walk(projectRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := info.Name()
+		if info.IsDir() {
+			if path != projectRoot && (metaSkipDirs[name] || (len(name) > 1 && name[0] == '.')) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if len(name) > 0 && name[0] == '.' {
+			return nil
+		}
+
+		files = append(files, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// mergeIssues deduplicates issues reported by more than one tool for the
+// same file/line/column/rule/message, then sorts the result by file and
+// line, so a multi-tool run reads as one coherent report.
+func mergeIssues(issues []Issue) []Issue {
+	type key struct {
+		file, rule, message string
+		line, column        int
+	}
+
+	seen := make(map[key]bool, len(issues))
+	merged := make([]Issue, 0, len(issues))
+
+	for _, issue := range issues {
+		k := key{file: issue.File, rule: issue.Rule, message: issue.Message, line: issue.Line, column: issue.Column}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		merged = append(merged, issue)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].File != merged[j].File {
+			return merged[i].File < merged[j].File
+		}
+		return merged[i].Line < merged[j].Line
+	})
+
+	return merged
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTool is a mockTool variant that remembers the options it was
+// executed with, so tests can assert MetaRunner threaded config overrides
+// through to Execute.
+type recordingTool struct {
+	mockTool
+	gotOptions ExecuteOptions
+	issues     []Issue
+}
+
+func (r *recordingTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	r.gotOptions = options
+	return &Result{Tool: r.name, Success: true, Issues: r.issues}, nil
+}
+
+func TestDefaultMetaRunnerConfig(t *testing.T) {
+	cfg := DefaultMetaRunnerConfig()
+
+	assert.Equal(t, "30s", cfg.Deadline)
+	assert.Equal(t, 120, cfg.LineLength)
+	assert.Equal(t, 10, cfg.Cyclo)
+}
+
+func TestLoadMetaRunnerConfig_MissingFile(t *testing.T) {
+	cfg, err := LoadMetaRunnerConfig(filepath.Join(t.TempDir(), ".gzh-quality.yaml"))
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMetaRunnerConfig(), cfg)
+}
+
+func TestLoadMetaRunnerConfig_ParsesYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".gzh-quality.yaml")
+	content := `
+enable:
+  - gofmt
+disable:
+  - golint
+deadline: 5s
+line_length: 100
+cyclo: 15
+tools:
+  gofmt:
+    args: ["-l"]
+    config: ".gofmt.conf"
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	cfg, err := LoadMetaRunnerConfig(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gofmt"}, cfg.Enable)
+	assert.Equal(t, []string{"golint"}, cfg.Disable)
+	assert.Equal(t, "5s", cfg.Deadline)
+	assert.Equal(t, 100, cfg.LineLength)
+	assert.Equal(t, 15, cfg.Cyclo)
+	assert.Equal(t, []string{"-l"}, cfg.Tools["gofmt"].Args)
+	assert.Equal(t, ".gofmt.conf", cfg.Tools["gofmt"].Config)
+}
+
+func TestMetaRunnerConfig_Deadline(t *testing.T) {
+	cfg := &MetaRunnerConfig{Deadline: "2s"}
+	assert.Equal(t, 2*1e9, float64(cfg.deadline()))
+
+	cfg = &MetaRunnerConfig{Deadline: "not-a-duration"}
+	assert.Equal(t, 30*1e9, float64(cfg.deadline()))
+
+	cfg = &MetaRunnerConfig{}
+	assert.Equal(t, 30*1e9, float64(cfg.deadline()))
+}
+
+func TestMetaRunner_SelectedTools(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT})
+	registry.Register(&mockTool{name: "golint", language: "Go", toolType: LINT})
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT})
+
+	runner := NewMetaRunner(registry, &MetaRunnerConfig{Enable: []string{"gofmt", "golint"}, Disable: []string{"golint"}})
+
+	selected := runner.SelectedTools()
+
+	require.Len(t, selected, 1)
+	assert.Equal(t, "gofmt", selected[0].Name())
+}
+
+func TestMetaRunner_SelectedTools_DefaultsToAll(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT})
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT})
+
+	runner := NewMetaRunner(registry, nil)
+
+	selected := runner.SelectedTools()
+
+	require.Len(t, selected, 2)
+	assert.Equal(t, "black", selected[0].Name())
+	assert.Equal(t, "gofmt", selected[1].Name())
+}
+
+func TestMetaRunner_BuildOptions(t *testing.T) {
+	runner := NewMetaRunner(NewRegistry(), &MetaRunnerConfig{
+		Tools: map[string]MetaToolConfig{
+			"gofmt": {Args: []string{"-l"}, Config: ".gofmt.conf"},
+		},
+	})
+
+	base := ExecuteOptions{ProjectRoot: "/test", ExtraArgs: []string{"-v"}}
+
+	opts := runner.buildOptions("gofmt", base)
+	assert.Equal(t, []string{"-v", "-l"}, opts.ExtraArgs)
+	assert.Equal(t, ".gofmt.conf", opts.ConfigFile)
+
+	unchanged := runner.buildOptions("unknown", base)
+	assert.Equal(t, base, unchanged)
+}
+
+func TestMetaRunner_Run(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT})
+	toolWithIssues := &recordingTool{
+		mockTool: mockTool{name: "golint", language: "Go", toolType: LINT},
+		issues: []Issue{
+			{File: "main.go", Line: 1, Rule: "exported", Message: "missing doc comment"},
+		},
+	}
+	registry.Register(toolWithIssues)
+
+	runner := NewMetaRunner(registry, DefaultMetaRunnerConfig())
+
+	issues, err := runner.Run(context.Background(), dir)
+
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "main.go", issues[0].File)
+	assert.Equal(t, dir, toolWithIssues.gotOptions.ProjectRoot)
+}
+
+func TestMetaRunner_Run_NoToolsSelected(t *testing.T) {
+	runner := NewMetaRunner(NewRegistry(), &MetaRunnerConfig{Enable: []string{"nonexistent"}})
+
+	issues, err := runner.Run(context.Background(), t.TempDir())
+
+	require.NoError(t, err)
+	assert.Nil(t, issues)
+}
+
+func TestMergeIssues_DeduplicatesAndSorts(t *testing.T) {
+	issues := []Issue{
+		{File: "b.go", Line: 5, Rule: "r1", Message: "m1"},
+		{File: "a.go", Line: 2, Rule: "r1", Message: "m1"},
+		{File: "a.go", Line: 2, Rule: "r1", Message: "m1"}, // duplicate
+		{File: "a.go", Line: 1, Rule: "r2", Message: "m2"},
+	}
+
+	merged := mergeIssues(issues)
+
+	require.Len(t, merged, 3)
+	assert.Equal(t, "a.go", merged[0].File)
+	assert.Equal(t, 1, merged[0].Line)
+	assert.Equal(t, "a.go", merged[1].File)
+	assert.Equal(t, 2, merged[1].Line)
+	assert.Equal(t, "b.go", merged[2].File)
+}
+
+func TestDiscoverFiles_SkipsDotDirsAndVendor(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(""), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "vendor"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vendor", "dep.go"), []byte(""), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte(""), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte(""), 0o644))
+
+	files, err := discoverFiles(dir)
+
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, filepath.Join(dir, "main.go"), files[0])
+}
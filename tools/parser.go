@@ -28,6 +28,9 @@ var (
 
 	// Generic: file:line:col: message
 	genericPattern = regexp.MustCompile(`^(.+):(\d+):(\d+):\s*(.+)$`)
+
+	// Error Prone (via javac): file:line: severity: [RuleName] message
+	errorPronePattern = regexp.MustCompile(`^(.+):(\d+):\s*(error|warning)\s*:\s*\[(\w+)\]\s*(.+)$`)
 )
 
 // TextParseConfig configures how to parse text output.
@@ -160,4 +163,15 @@ var (
 		MessageIndex:    5,
 		DefaultSeverity: "error",
 	}
+
+	ErrorProneParseConfig = TextParseConfig{
+		Pattern:         errorPronePattern,
+		FileIndex:       1,
+		LineIndex:       2,
+		ColumnIndex:     0,
+		SeverityIndex:   3,
+		RuleIndex:       4,
+		MessageIndex:    5,
+		DefaultSeverity: "error",
+	}
 )
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// PersistentTool is implemented by QualityTool wrappers that can start a
+// long-running worker process and reuse it across many Execute calls,
+// instead of paying process-startup cost (interpreter/JIT warmup, config
+// parsing, plugin discovery) on every invocation. This is the same idea
+// as git-lfs's filter-process protocol: spawn the worker once, then send
+// it one framed request per file batch over its stdin/stdout pipe.
+// Checked via type assertion for the same reason as
+// ExplainCapable/BreakingCapable/FixApplier/...: most tools have no
+// persistent mode and keep using the one-shot Execute path.
+type PersistentTool interface {
+	// StartSession spawns this tool's long-running worker process (e.g.
+	// `ruff server`, `golangci-lint --fast` kept warm) and returns a
+	// Session for sending it requests. The caller owns the returned
+	// Session and must Close it - typically one Session per (tool,
+	// worker) pair, held for the duration of one ExecuteParallel call.
+	StartSession(ctx context.Context) (Session, error)
+}
+
+// Session is one open connection to a PersistentTool's worker process.
+// Execute may be called many times over its lifetime; each call frames
+// a request and waits for the worker's response on the same pipe, rather
+// than spawning a new process per call.
+type Session interface {
+	// Execute sends files and options to the running worker and returns
+	// the Result it reports, the same shape QualityTool.Execute returns
+	// for a one-shot invocation.
+	Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error)
+
+	// Close signals the worker process to shut down and releases its
+	// pipes. Safe to call once the session is no longer needed;
+	// idempotent.
+	Close() error
+}
+
+// sessionRequest is the payload framed and sent to a persistent worker
+// for one Execute call. Options is passed through as-is; a worker that
+// doesn't understand a given field ignores it, the same way a tool's
+// BuildCommand picks only the ExecuteOptions fields it cares about.
+type sessionRequest struct {
+	Files   []string       `json:"files"`
+	Options ExecuteOptions `json:"options"`
+}
+
+// WriteFrame writes payload to w as a single pkt-line-style frame: a
+// 4-byte hexadecimal length (covering the 4-byte header itself, per
+// git's pkt-line convention) followed by payload. It's the wire format
+// Session implementations backed by a subprocess use to send requests
+// and read responses over stdin/stdout without framing ambiguity.
+func WriteFrame(w io.Writer, payload []byte) error {
+	if len(payload)+4 > 0xffff {
+		return fmt.Errorf("pkt-line frame too large: %d bytes", len(payload))
+	}
+	if _, err := fmt.Fprintf(w, "%04x", len(payload)+4); err != nil {
+		return fmt.Errorf("write frame header: %w", err)
+	}
+	if _, err := w.Write(payload); err != nil {
+		return fmt.Errorf("write frame payload: %w", err)
+	}
+	return nil
+}
+
+// ReadFrame reads one pkt-line-style frame from r, as written by
+// WriteFrame, and returns its payload. A zero-length ("0000") flush
+// frame returns io.EOF, mirroring git's pkt-line flush-pkt convention for
+// signalling the end of a stream.
+func ReadFrame(r io.Reader) ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("read frame header: %w", err)
+	}
+
+	var length int
+	if _, err := fmt.Sscanf(string(header[:]), "%04x", &length); err != nil {
+		return nil, fmt.Errorf("parse frame header %q: %w", header, err)
+	}
+	if length == 0 {
+		return nil, io.EOF
+	}
+	if length < 4 {
+		return nil, fmt.Errorf("invalid frame length %d", length)
+	}
+
+	payload := make([]byte, length-4)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, fmt.Errorf("read frame payload: %w", err)
+	}
+	return payload, nil
+}
+
+// WriteRequestFrame frames files and options as JSON and writes them to
+// w via WriteFrame. It's a convenience for StartSession implementations
+// whose worker process speaks JSON-over-pkt-line, so each one doesn't
+// re-implement the same marshal-then-frame step.
+func WriteRequestFrame(w io.Writer, files []string, options ExecuteOptions) error {
+	payload, err := json.Marshal(sessionRequest{Files: files, Options: options})
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	return WriteFrame(w, payload)
+}
+
+// ReadResultFrame reads one frame from r via ReadFrame and unmarshals it
+// as a *Result. It's the receiving half of WriteRequestFrame's
+// JSON-over-pkt-line convention.
+func ReadResultFrame(r io.Reader) (*Result, error) {
+	payload, err := ReadFrame(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Result
+	if err := json.Unmarshal(payload, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal session result: %w", err)
+	}
+	return &result, nil
+}
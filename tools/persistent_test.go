@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFrameReadFrame_RoundTrips(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteFrame(&buf, []byte("hello")))
+
+	payload, err := ReadFrame(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), payload)
+}
+
+func TestReadFrame_FlushFrameReturnsEOF(t *testing.T) {
+	buf := bytes.NewBufferString("0000")
+
+	_, err := ReadFrame(buf)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestWriteRequestFrameReadResultFrame_RoundTrips(t *testing.T) {
+	var buf bytes.Buffer
+	options := ExecuteOptions{ProjectRoot: "/repo", Fix: true}
+	require.NoError(t, WriteRequestFrame(&buf, []string{"a.go", "b.go"}, options))
+
+	var req sessionRequest
+	payload, err := ReadFrame(&buf)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(payload, &req))
+	assert.Equal(t, []string{"a.go", "b.go"}, req.Files)
+	assert.True(t, req.Options.Fix)
+
+	resultPayload, err := json.Marshal(&Result{Tool: "gofumpt", Success: true, FilesProcessed: 2})
+	require.NoError(t, err)
+	var resultBuf bytes.Buffer
+	require.NoError(t, WriteFrame(&resultBuf, resultPayload))
+
+	result, err := ReadResultFrame(&resultBuf)
+	require.NoError(t, err)
+	assert.Equal(t, "gofumpt", result.Tool)
+	assert.True(t, result.Success)
+	assert.Equal(t, 2, result.FilesProcessed)
+}
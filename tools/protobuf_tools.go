@@ -4,7 +4,9 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"os/exec"
 	"strings"
 )
@@ -22,6 +24,7 @@ func NewBufTool() *BufTool {
 
 	tool.SetInstallCommand([]string{"go", "install", "github.com/bufbuild/buf/cmd/buf@latest"})
 	tool.SetConfigPatterns([]string{"buf.yaml", "buf.gen.yaml"})
+	tool.SetSupportedExtensions([]string{".proto"})
 
 	return tool
 }
@@ -31,22 +34,25 @@ func (t *BufTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd
 	var args []string
 
 	// Determine operation mode
-	if options.FormatOnly {
+	switch {
+	case options.BuildOutput != "":
+		args = append(args, "build", "-o", options.BuildOutput)
+	case options.FormatOnly:
 		args = append(args, "format", "-w") // Write changes
-	} else {
+	default:
 		args = append(args, "lint", "--error-format", "json")
 	}
 
 	// Add extra flags if provided
 	args = append(args, options.ExtraArgs...)
 
-	// buf works on directories, not individual files
-	if len(files) > 0 {
-		// Get directory from first proto file
-		protoFiles := FilterFilesByExtensions(files, []string{".proto"})
-		if len(protoFiles) > 0 {
-			args = append(args, protoFiles[0])
-		}
+	// buf lints/formats the whole module by default; --path restricts it
+	// to specific files within that module, which is how a shard gets its
+	// slice of the proto file set without needing a separate invocation
+	// per file.
+	protoFiles := ShardFiles(FilterFilesByExtensions(files, []string{".proto"}), options.Shard, options.TotalShards)
+	for _, protoFile := range protoFiles {
+		args = append(args, "--path", protoFile)
 	}
 
 	cmd := exec.Command(t.executable, args...)
@@ -60,6 +66,15 @@ func (t *BufTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd
 
 // ParseOutput parses buf JSON output.
 func (t *BufTool) ParseOutput(output string) []Issue {
+	return parseBufDiagnostics(output, "")
+}
+
+// parseBufDiagnostics parses buf's JSON-lines diagnostic output (shared by
+// lint and breaking, whose output shapes are identical). rulePrefix is
+// prepended to each diagnostic's type, e.g. "BREAKING/" for `buf breaking`
+// results, so downstream reporting can tell a wire-compatibility break
+// apart from ordinary lint noise.
+func parseBufDiagnostics(output, rulePrefix string) []Issue {
 	if strings.TrimSpace(output) == "" {
 		return []Issue{}
 	}
@@ -91,7 +106,7 @@ func (t *BufTool) ParseOutput(output string) []Issue {
 			Line:     item.StartLine,
 			Column:   item.StartColumn,
 			Severity: "error",
-			Rule:     item.Type,
+			Rule:     rulePrefix + item.Type,
 			Message:  item.Message,
 		})
 	}
@@ -99,7 +114,43 @@ func (t *BufTool) ParseOutput(output string) []Issue {
 	return issues
 }
 
+// CheckBreaking runs `buf breaking` to check files for schema
+// compatibility against a prior ref or image, implementing
+// BreakingCapable. Diagnostics are parsed into the same Issue shape as
+// ParseOutput, but with their Rule namespaced under "BREAKING/" so a
+// wire-compatibility break can be told apart from ordinary lint findings.
+func (t *BufTool) CheckBreaking(ctx context.Context, files []string, against string, options ExecuteOptions) (*Result, error) {
+	if against == "" {
+		return nil, fmt.Errorf("buf breaking requires a ref or image to compare against")
+	}
+
+	args := []string{"breaking", "--against", against, "--error-format", "json"}
+	args = append(args, options.ExtraArgs...)
+
+	protoFiles := ShardFiles(FilterFilesByExtensions(files, []string{".proto"}), options.Shard, options.TotalShards)
+	for _, protoFile := range protoFiles {
+		args = append(args, "--path", protoFile)
+	}
+
+	cmd := exec.Command(t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	result, err := t.ExecuteCommand(ctx, cmd, files)
+	if err != nil {
+		return result, err
+	}
+
+	if !result.Success {
+		result.Issues = parseBufDiagnostics(result.Output, "BREAKING/")
+	}
+
+	return result, nil
+}
+
 // Ensure Protobuf tools implement QualityTool interface.
 var (
-	_ QualityTool = (*BufTool)(nil)
+	_ QualityTool     = (*BufTool)(nil)
+	_ BreakingCapable = (*BufTool)(nil)
 )
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// PytestTool runs a Python project's pytest suite and turns failed tests
+// into Issues, the Python analogue of GoTestTool.
+type PytestTool struct {
+	*BaseTool
+}
+
+// NewPytestTool creates a new pytest tool.
+func NewPytestTool() *PytestTool {
+	tool := &PytestTool{
+		BaseTool: NewBaseTool("pytest", "Python", "pytest", TEST),
+	}
+
+	tool.SetInstallCommand([]string{"pip", "install", "pytest"})
+	tool.SetConfigPatterns([]string{"pytest.ini", "pyproject.toml", "setup.cfg", "tox.ini"})
+	tool.SetSupportedExtensions([]string{".py"})
+
+	return tool
+}
+
+// Execute runs `pytest --report-log=<tmp>`, pytest's own built-in JSON
+// Lines test-event log (no plugin required, unlike pytest-json-report),
+// then parses it into Issues. files, if given, are passed on pytest's
+// argv to scope the run to those test files; an empty list runs pytest's
+// normal full-suite discovery.
+func (t *PytestTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	reportLog, err := os.CreateTemp("", "pytest-report-log-*.jsonl")
+	if err != nil {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("failed to create pytest report-log file: %w", err),
+		}, nil
+	}
+	reportLogPath := reportLog.Name()
+	reportLog.Close()
+	defer os.Remove(reportLogPath)
+
+	args := []string{"--report-log=" + reportLogPath}
+	args = append(args, options.ExtraArgs...)
+	args = append(args, FilterFilesByExtensions(files, []string{".py"})...)
+
+	cmd := exec.CommandContext(ctx, t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	runErr := cmd.Run()
+
+	data, readErr := os.ReadFile(reportLogPath)
+	if readErr != nil {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("failed to read pytest report-log: %w", readErr),
+		}, nil
+	}
+
+	issues := parsePytestReportLog(data)
+
+	return &Result{
+		Tool:           t.name,
+		Language:       t.language,
+		Success:        runErr == nil,
+		FilesProcessed: len(files),
+		Issues:         issues,
+	}, nil
+}
+
+// pytestReportLogLine is one line of `pytest --report-log`'s JSON Lines
+// output. Only the "TestReport" lines for the "call" phase (as opposed
+// to "setup"/"teardown", which pytest also emits one TestReport for)
+// carry a test's actual pass/fail outcome.
+type pytestReportLogLine struct {
+	ReportType string `json:"$report_type"`
+	When       string `json:"when"`
+	Outcome    string `json:"outcome"`
+	NodeID     string `json:"nodeid"`
+	LongRepr   string `json:"longreprtext"`
+}
+
+// parsePytestReportLog turns every failed "call"-phase TestReport line
+// into an Issue.
+func parsePytestReportLog(data []byte) []Issue {
+	var issues []Issue
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var line pytestReportLogLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+
+		if line.ReportType != "TestReport" || line.When != "call" || line.Outcome != "failed" {
+			continue
+		}
+
+		file := line.NodeID
+		if idx := strings.Index(file, "::"); idx >= 0 {
+			file = file[:idx]
+		}
+
+		issues = append(issues, Issue{
+			File:     file,
+			Severity: "error",
+			Rule:     line.NodeID,
+			Message:  strings.TrimSpace(line.LongRepr),
+		})
+	}
+
+	return issues
+}
+
+// Ensure PytestTool implements QualityTool.
+var _ QualityTool = (*PytestTool)(nil)
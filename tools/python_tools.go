@@ -13,6 +13,17 @@ import (
 	"strings"
 )
 
+// ruffDaemonMinVersion is the lowest ruff version RuffTool will try its
+// daemon fast path against. Older ruff has no persistent server mode, so
+// anything below this falls straight back to the one-shot exec.Command
+// path that's always available.
+const ruffDaemonMinVersion = "0.4.0"
+
+// pylintDaemonMinVersion is the lowest pylint version PylintTool will
+// try its daemon fast path against - the astroid-cache wrapper this pool
+// dials needs a pylint new enough to expose a stable JSON message shape.
+const pylintDaemonMinVersion = "3.0.0"
+
 // BlackTool implements Python formatting using black.
 type BlackTool struct {
 	*BaseTool
@@ -26,6 +37,7 @@ func NewBlackTool() *BlackTool {
 
 	tool.SetInstallCommand([]string{"pip", "install", "black"})
 	tool.SetConfigPatterns([]string{"pyproject.toml", ".black", "black.toml"})
+	tool.SetSupportedExtensions([]string{".py", ".pyi"})
 
 	return tool
 }
@@ -66,6 +78,7 @@ func (t *BlackTool) BuildCommand(files []string, options ExecuteOptions) *exec.C
 // RuffTool implements Python linting and formatting using ruff.
 type RuffTool struct {
 	*BaseTool
+	daemonPool *DaemonPool
 }
 
 // NewRuffTool creates a new ruff tool.
@@ -76,6 +89,11 @@ func NewRuffTool() *RuffTool {
 
 	tool.SetInstallCommand([]string{"pip", "install", "ruff"})
 	tool.SetConfigPatterns([]string{"ruff.toml", ".ruff.toml", "pyproject.toml"})
+	tool.SetSupportedExtensions([]string{".py", ".pyi"})
+
+	tool.daemonPool = NewDaemonPool(func(name, version string) ToolDaemon {
+		return newLineProtocolDaemon(name, []string{tool.executable, "server"})
+	})
 
 	return tool
 }
@@ -106,6 +124,14 @@ func (t *RuffTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cm
 		args = append(args, "--output-format", "json")
 	}
 
+	// Unlike golangci-lint's --new-from-rev, ruff has no flag for
+	// "only report issues since this ref" - its own --diff is a boolean
+	// that previews --fix's changes, not a ref-scoped incremental mode.
+	// Incremental runs instead rely on the file list already being
+	// restricted upstream (--since/--staged/--changed) and, for
+	// line-level scoping, on the generic options.DiffBase/ApplyDiffScope
+	// path applied in executeMode below.
+
 	// Add extra flags if provided
 	args = append(args, options.ExtraArgs...)
 
@@ -181,8 +207,21 @@ func (t *RuffTool) Execute(ctx context.Context, files []string, options ExecuteO
 	return t.executeMode(ctx, files, options)
 }
 
-// executeMode executes ruff in a specific mode.
+// executeMode executes ruff in a specific mode. Lint mode tries the
+// persistent `ruff server` daemon first (see tryDaemonAnalyze), falling
+// back to a fresh interpreter invocation if the daemon isn't usable;
+// format mode always uses the one-shot path since --fix's on-disk
+// rewrite isn't something a pooled daemon call is built to do here.
 func (t *RuffTool) executeMode(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !options.FormatOnly {
+		if result, ok := t.tryDaemonAnalyze(ctx, files, options); ok {
+			if err := ApplyDiffScope(ctx, result, options); err != nil {
+				return result, err
+			}
+			return result, nil
+		}
+	}
+
 	cmd := t.BuildCommand(files, options)
 	result, err := t.ExecuteCommand(ctx, cmd, files)
 	if err != nil {
@@ -194,9 +233,42 @@ func (t *RuffTool) executeMode(ctx context.Context, files []string, options Exec
 		result.Issues = t.ParseOutput(result.Output)
 	}
 
+	if err := ApplyDiffScope(ctx, result, options); err != nil {
+		return result, err
+	}
+
 	return result, nil
 }
 
+// tryDaemonAnalyze serves a lint-mode Execute call from a pooled ruff
+// daemon instead of spawning a fresh interpreter. ok is false if the
+// resolved ruff version is too old, the daemon failed to start, or an
+// Analyze call against an already-running daemon failed (most likely
+// because the process died) - any of which falls back to the normal
+// one-shot BuildCommand/ExecuteCommand path in executeMode.
+func (t *RuffTool) tryDaemonAnalyze(ctx context.Context, files []string, options ExecuteOptions) (*Result, bool) {
+	version, err := t.GetVersion()
+	if err != nil || !versionAtLeast(version, ruffDaemonMinVersion) {
+		return nil, false
+	}
+
+	configHash := daemonConfigFingerprint(t.FindConfigFiles(options.ProjectRoot))
+	daemon, err := t.daemonPool.Get(ctx, t.name, version, configHash)
+	if err != nil {
+		return nil, false
+	}
+
+	result, err := daemon.Analyze(files, options)
+	if err != nil {
+		t.daemonPool.Evict(t.name, version, configHash)
+		return nil, false
+	}
+
+	result.Language = t.language
+	result.FilesProcessed = len(files)
+	return result, true
+}
+
 // ParseOutput parses ruff JSON output.
 func (t *RuffTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
@@ -282,6 +354,7 @@ func (t *RuffTool) parseTextOutput(output string) []Issue {
 // PylintTool implements Python linting using pylint.
 type PylintTool struct {
 	*BaseTool
+	daemonPool *DaemonPool
 }
 
 // NewPylintTool creates a new pylint tool.
@@ -292,10 +365,55 @@ func NewPylintTool() *PylintTool {
 
 	tool.SetInstallCommand([]string{"pip", "install", "pylint"})
 	tool.SetConfigPatterns([]string{".pylintrc", "pylint.cfg", "pyproject.toml"})
+	tool.SetSupportedExtensions([]string{".py"})
+
+	tool.daemonPool = NewDaemonPool(func(name, version string) ToolDaemon {
+		return newLineProtocolDaemon(name, []string{"pylint-daemon"})
+	})
 
 	return tool
 }
 
+// Execute runs pylint, trying a persistent astroid-cache-warmed daemon
+// (see tryDaemonAnalyze) before falling back to BaseTool's one-shot
+// exec.Command path, the same interpreter-startup amortization
+// RuffTool.executeMode gets from `ruff server`.
+func (t *PylintTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if result, ok := t.tryDaemonAnalyze(ctx, files, options); ok {
+		return result, nil
+	}
+
+	return t.BaseTool.Execute(ctx, files, options)
+}
+
+// tryDaemonAnalyze serves an Execute call from a pooled pylint-daemon
+// process instead of spawning a fresh interpreter. ok is false if the
+// resolved pylint version is too old, the daemon failed to start, or an
+// Analyze call failed - any of which falls back to Execute's plain
+// BaseTool.Execute path.
+func (t *PylintTool) tryDaemonAnalyze(ctx context.Context, files []string, options ExecuteOptions) (*Result, bool) {
+	version, err := t.GetVersion()
+	if err != nil || !versionAtLeast(version, pylintDaemonMinVersion) {
+		return nil, false
+	}
+
+	configHash := daemonConfigFingerprint(t.FindConfigFiles(options.ProjectRoot))
+	daemon, err := t.daemonPool.Get(ctx, t.name, version, configHash)
+	if err != nil {
+		return nil, false
+	}
+
+	result, err := daemon.Analyze(files, options)
+	if err != nil {
+		t.daemonPool.Evict(t.name, version, configHash)
+		return nil, false
+	}
+
+	result.Language = t.language
+	result.FilesProcessed = len(files)
+	return result, true
+}
+
 // BuildCommand builds the pylint command.
 func (t *PylintTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
 	args := []string{}
@@ -374,9 +492,162 @@ func (t *PylintTool) ParseOutput(output string) []Issue {
 	return issues
 }
 
+// MypyTool implements Python static type checking using mypy.
+type MypyTool struct {
+	*BaseTool
+}
+
+// NewMypyTool creates a new mypy tool.
+func NewMypyTool() *MypyTool {
+	tool := &MypyTool{
+		BaseTool: NewBaseTool("mypy", "Python", "mypy", LINT),
+	}
+
+	tool.SetInstallCommand([]string{"pip", "install", "mypy"})
+	tool.SetConfigPatterns([]string{"mypy.ini", "pyproject.toml", "setup.cfg"})
+	tool.SetSupportedExtensions([]string{".py", ".pyi"})
+
+	return tool
+}
+
+// BuildCommand builds the mypy command.
+func (t *MypyTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"--output=json"}
+
+	if options.ConfigFile != "" {
+		args = append(args, "--config-file", options.ConfigFile)
+	}
+
+	args = append(args, options.ExtraArgs...)
+
+	pyFiles := FilterFilesByExtensions(files, []string{".py", ".pyi"})
+	if len(pyFiles) == 0 {
+		args = append(args, ".")
+	} else {
+		args = append(args, pyFiles...)
+	}
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// ParseOutput parses mypy's one-JSON-object-per-line --output=json format.
+func (t *MypyTool) ParseOutput(output string) []Issue {
+	var issues []Issue
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var item struct {
+			File     string `json:"file"`
+			Line     int    `json:"line"`
+			Column   int    `json:"column"`
+			Severity string `json:"severity"`
+			Message  string `json:"message"`
+			Code     string `json:"code"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &item); err != nil {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			File:     item.File,
+			Line:     item.Line,
+			Column:   item.Column,
+			Severity: item.Severity,
+			Rule:     item.Code,
+			Message:  item.Message,
+		})
+	}
+
+	return issues
+}
+
+// BanditTool scans Python source for common security issues using bandit.
+type BanditTool struct {
+	*BaseTool
+}
+
+// NewBanditTool creates a new bandit tool.
+func NewBanditTool() *BanditTool {
+	tool := &BanditTool{
+		BaseTool: NewBaseTool("bandit", "Python", "bandit", SECURITY),
+	}
+
+	tool.SetInstallCommand([]string{"pip", "install", "bandit"})
+	tool.SetConfigPatterns([]string{".bandit", "pyproject.toml"})
+	tool.SetSupportedExtensions([]string{".py"})
+
+	return tool
+}
+
+// BuildCommand builds the bandit command.
+func (t *BanditTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-f", "json"}
+
+	args = append(args, options.ExtraArgs...)
+
+	pyFiles := FilterFilesByExtensions(files, []string{".py"})
+	if len(pyFiles) == 0 {
+		args = append(args, "-r", ".")
+	} else {
+		args = append(args, pyFiles...)
+	}
+
+	cmd := exec.Command(t.executable, args...)
+
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+
+	return cmd
+}
+
+// ParseOutput parses bandit's JSON report into Issues.
+func (t *BanditTool) ParseOutput(output string) []Issue {
+	var report struct {
+		Results []struct {
+			Filename      string `json:"filename"`
+			LineNumber    int    `json:"line_number"`
+			IssueSeverity string `json:"issue_severity"`
+			IssueText     string `json:"issue_text"`
+			TestID        string `json:"test_id"`
+		} `json:"results"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return []Issue{}
+	}
+
+	issues := make([]Issue, 0, len(report.Results))
+	for _, r := range report.Results {
+		issues = append(issues, Issue{
+			File:     r.Filename,
+			Line:     r.LineNumber,
+			Severity: strings.ToLower(r.IssueSeverity),
+			Rule:     r.TestID,
+			Message:  r.IssueText,
+			Category: "security",
+		})
+	}
+
+	return issues
+}
+
 // Ensure Python tools implement QualityTool interface.
 var (
 	_ QualityTool = (*BlackTool)(nil)
 	_ QualityTool = (*RuffTool)(nil)
 	_ QualityTool = (*PylintTool)(nil)
+	_ QualityTool = (*MypyTool)(nil)
+	_ QualityTool = (*BanditTool)(nil)
 )
@@ -163,6 +163,18 @@ func TestRuffTool_BuildCommand(t *testing.T) {
 	}
 }
 
+func TestRuffTool_BuildCommand_BaseRefDoesNotAddBogusDiffFlag(t *testing.T) {
+	tool := NewRuffTool()
+
+	cmd := tool.BuildCommand([]string{"main.py"}, ExecuteOptions{BaseRef: "origin/main"})
+	cmdArgs := cmd.Args[1:]
+
+	// ruff's --diff is a boolean (preview --fix's changes); it takes no
+	// ref argument. BaseRef must not be passed to it as one.
+	assert.NotContains(t, cmdArgs, "--diff")
+	assert.NotContains(t, cmdArgs, "origin/main")
+}
+
 func TestRuffTool_ParseOutput(t *testing.T) {
 	tool := NewRuffTool()
 
@@ -3,12 +3,29 @@
 
 package tools
 
-import "sync"
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	toml "github.com/pelletier/go-toml/v2"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/Gizzahub/gzh-cli-quality/tools/classifier"
+)
 
 // DefaultRegistry is the default registry implementation.
 type DefaultRegistry struct {
 	mu    sync.RWMutex
 	tools map[string]QualityTool
+
+	// detectedRoot/detectedFiles cache the last DetectApplicableTools walk
+	// so repeated calls for the same project don't re-stat the tree.
+	detectedRoot  string
+	detectedFiles []string
 }
 
 // NewRegistry creates a new tool registry.
@@ -25,6 +42,41 @@ func (r *DefaultRegistry) Register(tool QualityTool) {
 	r.tools[tool.Name()] = tool
 }
 
+// RegisterFromManifest reads a single third-party tool manifest from
+// path - TOML for a ".toml" extension, JSON for ".json", YAML otherwise
+// - and registers the resulting ManifestTool. This is the entry point
+// for tools a user declares outside the binary (e.g. under
+// ~/.gzquality/tools.d/*.toml), as opposed to RegistryLoader's embedded
+// registry.d, which only covers tools shipped with gz-quality itself.
+func (r *DefaultRegistry) RegisterFromManifest(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest %s: %w", path, err)
+	}
+
+	var manifest ToolManifest
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		err = toml.Unmarshal(data, &manifest)
+	case ".json":
+		err = json.Unmarshal(data, &manifest)
+	default:
+		err = yaml.Unmarshal(data, &manifest)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+
+	tool, err := NewManifestTool(manifest)
+	if err != nil {
+		return fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	tool.SetSourcePath(path)
+
+	r.Register(tool)
+	return nil
+}
+
 // GetTools returns all registered tools.
 func (r *DefaultRegistry) GetTools() []QualityTool {
 	r.mu.RLock()
@@ -72,5 +124,103 @@ func (r *DefaultRegistry) FindTool(name string) QualityTool {
 	return r.tools[name]
 }
 
+// DetectApplicableTools scans projectRoot once and returns only the
+// registered tools that actually apply to the project: those whose
+// SupportedExtensions match a discovered file, or whose FindConfigFiles
+// finds a config file somewhere in the tree. The file walk is cached per
+// projectRoot so repeated calls don't re-stat the tree.
+func (r *DefaultRegistry) DetectApplicableTools(projectRoot string) ([]QualityTool, error) {
+	files, err := r.walkProjectFiles(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var applicable []QualityTool
+	for _, tool := range r.tools {
+		if len(FilterFilesByExtensions(files, tool.SupportedExtensions())) > 0 ||
+			len(tool.FindConfigFiles(projectRoot)) > 0 {
+			applicable = append(applicable, tool)
+		}
+	}
+	return applicable, nil
+}
+
+// GetToolsForFile returns the registered tools applicable to path,
+// classifying its language via the classifier package rather than each
+// tool filtering by its own extension list. Tools are matched by
+// Language() against the classified language; if classification finds no
+// match (or no language at all), it falls back to a plain
+// SupportedExtensions comparison so tools for languages the classifier
+// doesn't know about still work.
+func (r *DefaultRegistry) GetToolsForFile(path string) []QualityTool {
+	lang := classifier.Classify(path)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []QualityTool
+
+	if lang != "" {
+		for _, tool := range r.tools {
+			if tool.Language() == lang {
+				matched = append(matched, tool)
+			}
+		}
+		if len(matched) > 0 {
+			return matched
+		}
+	}
+
+	ext := filepath.Ext(path)
+	for _, tool := range r.tools {
+		for _, supported := range tool.SupportedExtensions() {
+			if strings.EqualFold(supported, ext) {
+				matched = append(matched, tool)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// PartitionFiles classifies each of files and groups it under the name
+// of every tool GetToolsForFile returns for it, so a caller can dispatch
+// each tool its own pre-filtered file list instead of handing every tool
+// the full set and relying on it to filter internally.
+func (r *DefaultRegistry) PartitionFiles(files []string) map[string][]string {
+	partitions := make(map[string][]string)
+
+	for _, file := range files {
+		for _, tool := range r.GetToolsForFile(file) {
+			partitions[tool.Name()] = append(partitions[tool.Name()], file)
+		}
+	}
+
+	return partitions
+}
+
+// walkProjectFiles returns the cached file listing for projectRoot,
+// discovering it first if this is the first call or the root changed.
+func (r *DefaultRegistry) walkProjectFiles(projectRoot string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.detectedRoot == projectRoot && r.detectedFiles != nil {
+		return r.detectedFiles, nil
+	}
+
+	files, err := discoverFiles(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	r.detectedRoot = projectRoot
+	r.detectedFiles = files
+	return files, nil
+}
+
 // Ensure DefaultRegistry implements ToolRegistry.
 var _ ToolRegistry = (*DefaultRegistry)(nil)
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strings"
+	"sync"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+//go:embed registry.d/*.yaml
+var builtinManifestFS embed.FS
+
+// RegistryLoader reads tool manifests from YAML files and turns them into
+// ManifestTool instances, so a new linter's metadata and output parsing
+// can be added as data under registry.d/ instead of a hand-written
+// BuildCommand/ParseOutput pair.
+type RegistryLoader struct{}
+
+// NewRegistryLoader creates a new manifest loader.
+func NewRegistryLoader() *RegistryLoader {
+	return &RegistryLoader{}
+}
+
+// LoadBuiltin reads every manifest embedded from tools/registry.d at
+// compile time.
+func (l *RegistryLoader) LoadBuiltin() ([]*ManifestTool, error) {
+	manifests, err := l.LoadManifestsFS(builtinManifestFS, "registry.d")
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := make([]*ManifestTool, 0, len(manifests))
+	for _, manifest := range manifests {
+		tool, err := NewManifestTool(manifest)
+		if err != nil {
+			return nil, err
+		}
+		loaded = append(loaded, tool)
+	}
+	return loaded, nil
+}
+
+// LoadManifestsFS reads every *.yaml manifest directly under dir in
+// fsys, letting callers load manifests from an on-disk directory (e.g. a
+// project's own tools/registry.d override) in addition to the embedded
+// defaults. It returns the raw ToolManifest values rather than
+// ManifestTool instances, so callers that need a fresh *BaseTool per
+// instantiation (e.g. one NewKtlintTool() call per test) can build their
+// own ManifestTool from the cached data instead of sharing one.
+func (l *RegistryLoader) LoadManifestsFS(fsys fs.FS, dir string) ([]ToolManifest, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest directory %s: %w", dir, err)
+	}
+
+	var manifests []ToolManifest
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, dir+"/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %s: %w", entry.Name(), err)
+		}
+
+		var manifest ToolManifest
+		if err := yaml.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse manifest %s: %w", entry.Name(), err)
+		}
+
+		manifests = append(manifests, manifest)
+	}
+
+	return manifests, nil
+}
+
+var (
+	builtinManifestsOnce sync.Once
+	builtinManifests     map[string]ToolManifest
+	builtinManifestErr   error
+)
+
+// mustLoadBuiltinManifest returns the embedded registry.d manifest data
+// for name, panicking if it's missing or malformed. The manifests are
+// compiled into the binary via go:embed, so a failure here means the
+// build itself is broken, not a runtime condition - the same contract as
+// regexp.MustCompile. It returns the manifest rather than a shared
+// *ManifestTool so every NewKtlintTool()/NewDetektTool() call builds its
+// own *BaseTool, matching every other tool constructor's semantics.
+func mustLoadBuiltinManifest(name string) ToolManifest {
+	builtinManifestsOnce.Do(func() {
+		loaded, err := NewRegistryLoader().LoadManifestsFS(builtinManifestFS, "registry.d")
+		if err != nil {
+			builtinManifestErr = err
+			return
+		}
+		builtinManifests = make(map[string]ToolManifest, len(loaded))
+		for _, manifest := range loaded {
+			builtinManifests[manifest.Name] = manifest
+		}
+	})
+	if builtinManifestErr != nil {
+		panic(fmt.Sprintf("tools: failed to load builtin registry.d manifests: %v", builtinManifestErr))
+	}
+	manifest, ok := builtinManifests[name]
+	if !ok {
+		panic(fmt.Sprintf("tools: no registry.d manifest found for %q", name))
+	}
+	return manifest
+}
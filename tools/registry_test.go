@@ -5,6 +5,8 @@ package tools
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,19 +15,22 @@ import (
 
 // Mock tool for testing
 type mockTool struct {
-	name     string
-	language string
-	toolType ToolType
+	name        string
+	language    string
+	toolType    ToolType
+	extensions  []string
+	configFiles []string
 }
 
 func (m *mockTool) Name() string       { return m.name }
 func (m *mockTool) Language() string   { return m.language }
 func (m *mockTool) Type() ToolType     { return m.toolType }
 func (m *mockTool) IsAvailable() bool  { return true }
-func (m *mockTool) Install() error     { return nil }
+func (m *mockTool) Install(ctx context.Context) error { return nil }
 func (m *mockTool) GetVersion() (string, error) { return "1.0.0", nil }
-func (m *mockTool) Upgrade() error     { return nil }
-func (m *mockTool) FindConfigFiles(projectRoot string) []string { return nil }
+func (m *mockTool) Upgrade(ctx context.Context) error { return nil }
+func (m *mockTool) SupportedExtensions() []string { return m.extensions }
+func (m *mockTool) FindConfigFiles(projectRoot string) []string { return m.configFiles }
 func (m *mockTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
 	return &Result{Tool: m.name, Success: true}, nil
 }
@@ -249,6 +254,63 @@ func TestRegistry_ConcurrentAccess(t *testing.T) {
 	assert.Equal(t, 5, len(tools))
 }
 
+func TestRegistry_DetectApplicableTools_ByExtension(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main"), 0o644))
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT, extensions: []string{".go"}})
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT, extensions: []string{".py"}})
+
+	applicable, err := registry.DetectApplicableTools(dir)
+	require.NoError(t, err)
+	require.Len(t, applicable, 1)
+	assert.Equal(t, "gofmt", applicable[0].Name())
+}
+
+func TestRegistry_DetectApplicableTools_ByConfigFile(t *testing.T) {
+	dir := t.TempDir()
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{
+		name: "hadolint", language: "Docker", toolType: LINT,
+		configFiles: []string{filepath.Join(dir, ".hadolint.yaml")},
+	})
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT, extensions: []string{".py"}})
+
+	applicable, err := registry.DetectApplicableTools(dir)
+	require.NoError(t, err)
+	require.Len(t, applicable, 1)
+	assert.Equal(t, "hadolint", applicable[0].Name())
+}
+
+func TestRegistry_DetectApplicableTools_CachesWalk(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main"), 0o644))
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT, extensions: []string{".go"}})
+
+	_, err := registry.DetectApplicableTools(dir)
+	require.NoError(t, err)
+	cached := registry.detectedFiles
+	require.NotEmpty(t, cached)
+
+	// Adding a file after the first walk should not be picked up: the
+	// cached listing is reused rather than re-stating the tree.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "extra.py"), []byte("x = 1"), 0o644))
+
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT, extensions: []string{".py"}})
+	applicable, err := registry.DetectApplicableTools(dir)
+	require.NoError(t, err)
+
+	toolNames := make(map[string]bool)
+	for _, tool := range applicable {
+		toolNames[tool.Name()] = true
+	}
+	assert.False(t, toolNames["black"])
+}
+
 func TestToolType_String(t *testing.T) {
 	tests := []struct {
 		toolType ToolType
@@ -266,3 +328,46 @@ func TestToolType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_GetToolsForFile_ClassifiesByLanguage(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("package main"), 0o644))
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT, extensions: []string{".go"}})
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT, extensions: []string{".py"}})
+
+	matched := registry.GetToolsForFile(path)
+	require.Len(t, matched, 1)
+	assert.Equal(t, "gofmt", matched[0].Name())
+}
+
+func TestRegistry_GetToolsForFile_FallsBackToExtension(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.weird")
+	require.NoError(t, os.WriteFile(path, []byte("x"), 0o644))
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "weirdlint", language: "Weird", toolType: LINT, extensions: []string{".weird"}})
+
+	matched := registry.GetToolsForFile(path)
+	require.Len(t, matched, 1)
+	assert.Equal(t, "weirdlint", matched[0].Name())
+}
+
+func TestRegistry_PartitionFiles(t *testing.T) {
+	dir := t.TempDir()
+	goFile := filepath.Join(dir, "main.go")
+	pyFile := filepath.Join(dir, "main.py")
+	require.NoError(t, os.WriteFile(goFile, []byte("package main"), 0o644))
+	require.NoError(t, os.WriteFile(pyFile, []byte("x = 1"), 0o644))
+
+	registry := NewRegistry()
+	registry.Register(&mockTool{name: "gofmt", language: "Go", toolType: FORMAT, extensions: []string{".go"}})
+	registry.Register(&mockTool{name: "black", language: "Python", toolType: FORMAT, extensions: []string{".py"}})
+
+	partitions := registry.PartitionFiles([]string{goFile, pyFile})
+	assert.Equal(t, []string{goFile}, partitions["gofmt"])
+	assert.Equal(t, []string{pyFile}, partitions["black"])
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import "sync"
+
+// RuleMeta describes a single lint rule, independent of any particular
+// report's findings - the catalog-side counterpart to report.RuleStat,
+// which tracks how often the rule actually fired.
+type RuleMeta struct {
+	// Title is a short human-readable name for the rule, e.g.
+	// "unused-variable". Falls back to the rule ID itself when unset.
+	Title string
+
+	// Description is a one- or two-sentence explanation of what the rule
+	// checks for.
+	Description string
+
+	// HelpURI links to the rule's documentation, e.g.
+	// "https://staticcheck.dev/docs/checks#SA1000". Used by report
+	// rendering to make a rule ID clickable and by the SARIF exporter's
+	// per-rule helpUri.
+	HelpURI string
+
+	// DefaultSeverity is the severity this rule normally reports at
+	// (e.g. "error", "warning"), for a tool whose own output doesn't
+	// carry severity per-issue.
+	DefaultSeverity string
+}
+
+// ruleCatalogKey identifies one rule within the catalog: a rule ID alone
+// isn't unique across tools (e.g. two linters both defining "unused").
+type ruleCatalogKey struct {
+	tool string
+	rule string
+}
+
+// ruleCatalog holds metadata registered via RegisterRule, keyed by
+// (tool, rule) - populated the same way the tool factory registry is:
+// tool adapters call RegisterRule from their own init().
+var (
+	ruleCatalogMu sync.RWMutex
+	ruleCatalog   = make(map[ruleCatalogKey]RuleMeta)
+)
+
+// RegisterRule records meta for tool's rule, for later lookup via
+// LookupRule. A tool adapter with a known, finite rule set (e.g. a
+// formatter with a handful of documented checks) calls this from its own
+// init(), e.g.:
+//
+//	func init() {
+//		RegisterRule("gofumpt", "extra-blank", RuleMeta{Description: "..."})
+//	}
+//
+// Tools whose rules come from a user-editable linter config (golangci-lint,
+// eslint) aren't expected to populate this exhaustively; LookupRule simply
+// reports ok=false for anything unregistered.
+func RegisterRule(tool, rule string, meta RuleMeta) {
+	ruleCatalogMu.Lock()
+	defer ruleCatalogMu.Unlock()
+	ruleCatalog[ruleCatalogKey{tool: tool, rule: rule}] = meta
+}
+
+// LookupRule returns the metadata registered for tool's rule, if any.
+func LookupRule(tool, rule string) (RuleMeta, bool) {
+	ruleCatalogMu.RLock()
+	defer ruleCatalogMu.RUnlock()
+	meta, ok := ruleCatalog[ruleCatalogKey{tool: tool, rule: rule}]
+	return meta, ok
+}
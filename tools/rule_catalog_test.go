@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterRule_LookupRule(t *testing.T) {
+	RegisterRule("catalogtest", "no-foo", RuleMeta{
+		Title:           "no-foo",
+		Description:     "disallows the identifier foo",
+		HelpURI:         "https://example.com/rules/no-foo",
+		DefaultSeverity: "warning",
+	})
+
+	meta, ok := LookupRule("catalogtest", "no-foo")
+	assert.True(t, ok)
+	assert.Equal(t, "https://example.com/rules/no-foo", meta.HelpURI)
+	assert.Equal(t, "warning", meta.DefaultSeverity)
+}
+
+func TestLookupRule_Unregistered(t *testing.T) {
+	_, ok := LookupRule("catalogtest", "does-not-exist")
+	assert.False(t, ok)
+}
+
+func TestRegisterRule_SameRuleDifferentTools(t *testing.T) {
+	RegisterRule("tool-a", "shared-rule", RuleMeta{HelpURI: "https://a.example.com"})
+	RegisterRule("tool-b", "shared-rule", RuleMeta{HelpURI: "https://b.example.com"})
+
+	metaA, _ := LookupRule("tool-a", "shared-rule")
+	metaB, _ := LookupRule("tool-b", "shared-rule")
+	assert.Equal(t, "https://a.example.com", metaA.HelpURI)
+	assert.Equal(t, "https://b.example.com", metaB.HelpURI)
+}
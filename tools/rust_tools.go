@@ -5,7 +5,12 @@ package tools
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -22,6 +27,7 @@ func NewRustfmtTool() *RustfmtTool {
 
 	tool.SetInstallCommand([]string{"rustup", "component", "add", "rustfmt"})
 	tool.SetConfigPatterns([]string{"rustfmt.toml", ".rustfmt.toml"})
+	tool.SetSupportedExtensions([]string{".rs"})
 
 	return tool
 }
@@ -64,17 +70,33 @@ func NewClippyTool() *ClippyTool {
 
 	tool.SetInstallCommand([]string{"rustup", "component", "add", "clippy"})
 	tool.SetConfigPatterns([]string{"clippy.toml", ".clippy.toml", "Cargo.toml"})
+	tool.SetSupportedExtensions([]string{".rs"})
 
 	return tool
 }
 
+// AcceptsFileList reports that clippy must not be split across several
+// ARG_MAX-chunked invocations: BuildCommand ignores the Files it's given
+// entirely, linting the whole workspace (or its sharded packages) in one
+// shot, so running it once per chunk would just repeat the same full
+// lint pass several times over.
+func (t *ClippyTool) AcceptsFileList() bool {
+	return false
+}
+
+var _ FileListLimited = (*ClippyTool)(nil)
+
 // BuildCommand builds the clippy command.
 func (t *ClippyTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
 	args := []string{"clippy"}
 
-	// Add fix flag if requested
+	// Add fix flag if requested. clippy --fix refuses to run against a
+	// dirty or staged git tree by default (it wants a clean rollback
+	// point before rewriting files); --allow-dirty/--allow-staged opt
+	// back into the normal "I already have my changes staged/uncommitted"
+	// developer workflow this wrapper runs under.
 	if options.Fix {
-		args = append(args, "--fix")
+		args = append(args, "--fix", "--allow-dirty", "--allow-staged")
 	}
 
 	// Output format for parsing
@@ -83,7 +105,17 @@ func (t *ClippyTool) BuildCommand(files []string, options ExecuteOptions) *exec.
 	// Add extra flags
 	args = append(args, options.ExtraArgs...)
 
-	// Clippy works on the entire project, not individual files
+	// In a cargo workspace, restrict this invocation to the packages
+	// assigned to this shard with -p instead of linting everything; a
+	// non-workspace project (or an unsharded run) has no members to
+	// select, so clippy falls back to its usual whole-project behavior.
+	members := cargoWorkspaceMembers(options.ProjectRoot)
+	for _, pkg := range ShardFiles(members, options.Shard, options.TotalShards) {
+		args = append(args, "-p", pkg)
+	}
+
+	// Clippy works on the entire project (or selected packages above),
+	// not individual files.
 	args = append(args, "--", "-D", "warnings")
 
 	cmd := exec.Command(t.executable, args...)
@@ -95,6 +127,105 @@ func (t *ClippyTool) BuildCommand(files []string, options ExecuteOptions) *exec.
 	return cmd
 }
 
+var (
+	cargoWorkspaceMembersRe = regexp.MustCompile(`(?s)\[workspace\].*?members\s*=\s*\[(.*?)\]`)
+	cargoPackageNameRe      = regexp.MustCompile(`(?m)^\s*name\s*=\s*"([^"]+)"`)
+	cargoMemberEntryRe      = regexp.MustCompile(`"([^"]+)"`)
+)
+
+// cargoWorkspaceMembers reads projectRoot/Cargo.toml and returns the
+// package name of each workspace member, so clippy can be sharded with
+// -p <package> instead of always linting the whole workspace. Returns
+// nil if projectRoot isn't a cargo workspace (no [workspace] members
+// list), in which case there's nothing to shard.
+func cargoWorkspaceMembers(projectRoot string) []string {
+	data, err := os.ReadFile(filepath.Join(projectRoot, "Cargo.toml"))
+	if err != nil {
+		return nil
+	}
+
+	match := cargoWorkspaceMembersRe.FindStringSubmatch(string(data))
+	if match == nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range cargoMemberEntryRe.FindAllStringSubmatch(match[1], -1) {
+		for _, dir := range expandCargoMemberGlob(projectRoot, entry[1]) {
+			if name := cargoPackageName(dir); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// expandCargoMemberGlob resolves a single workspace members entry (which
+// may be a glob like "crates/*") to the member directories it matches.
+func expandCargoMemberGlob(projectRoot, pattern string) []string {
+	matches, err := filepath.Glob(filepath.Join(projectRoot, pattern))
+	if err != nil {
+		return nil
+	}
+	return matches
+}
+
+// cargoPackageName reads the [package] name out of memberDir/Cargo.toml.
+func cargoPackageName(memberDir string) string {
+	data, err := os.ReadFile(filepath.Join(memberDir, "Cargo.toml"))
+	if err != nil {
+		return ""
+	}
+
+	match := cargoPackageNameRe.FindStringSubmatch(string(data))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// clippySpan mirrors one entry of cargo's JSON "spans" array, covering
+// both a top-level message's own spans and the spans attached to its
+// children (notes/help, including MachineApplicable suggestions).
+type clippySpan struct {
+	FileName                string  `json:"file_name"`
+	LineStart               int     `json:"line_start"`
+	ColumnStart             int     `json:"column_start"`
+	IsPrimary               bool    `json:"is_primary"`
+	ByteStart               int     `json:"byte_start"`
+	ByteEnd                 int     `json:"byte_end"`
+	SuggestedReplacement    *string `json:"suggested_replacement"`
+	SuggestionApplicability string  `json:"suggestion_applicability"`
+}
+
+// clippyChild mirrors one entry of cargo's JSON "children" array (notes,
+// help text, and suggestions attached to a top-level diagnostic).
+type clippyChild struct {
+	Spans []clippySpan `json:"spans"`
+}
+
+// clippyMessage mirrors one line of cargo's `--message-format json` output.
+type clippyMessage struct {
+	Message struct {
+		Message string `json:"message"`
+		Code    *struct {
+			Code string `json:"code"`
+		} `json:"code"`
+		Level    string        `json:"level"`
+		Spans    []clippySpan  `json:"spans"`
+		Children []clippyChild `json:"children"`
+		Rendered string        `json:"rendered"`
+	} `json:"message"`
+	Target struct {
+		Name string `json:"name"`
+	} `json:"target"`
+}
+
+// ansiEscapeRe strips terminal color codes from clippy's `rendered` field.
+var ansiEscapeRe = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
 // ParseOutput parses clippy JSON output.
 func (t *ClippyTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
@@ -110,29 +241,12 @@ func (t *ClippyTool) ParseOutput(output string) []Issue {
 			continue
 		}
 
-		var clippyMessage struct {
-			Message struct {
-				Message string `json:"message"`
-				Code    *struct {
-					Code string `json:"code"`
-				} `json:"code"`
-				Level string `json:"level"`
-				Spans []struct {
-					FileName    string `json:"file_name"`
-					LineStart   int    `json:"line_start"`
-					ColumnStart int    `json:"column_start"`
-				} `json:"spans"`
-			} `json:"message"`
-			Target struct {
-				Name string `json:"name"`
-			} `json:"target"`
-		}
-
-		if err := json.Unmarshal([]byte(line), &clippyMessage); err != nil {
+		var parsed clippyMessage
+		if err := json.Unmarshal([]byte(line), &parsed); err != nil {
 			continue
 		}
 
-		msg := clippyMessage.Message
+		msg := parsed.Message
 		if len(msg.Spans) == 0 {
 			continue
 		}
@@ -150,20 +264,104 @@ func (t *ClippyTool) ParseOutput(output string) []Issue {
 			rule = msg.Code.Code
 		}
 
-		span := msg.Spans[0]
-		issues = append(issues, Issue{
-			File:     span.FileName,
-			Line:     span.LineStart,
-			Column:   span.ColumnStart,
+		primary := msg.Spans[0]
+		spans := make([]IssueSpan, 0, len(msg.Spans))
+		for _, span := range msg.Spans {
+			if span.IsPrimary {
+				primary = span
+			}
+			spans = append(spans, IssueSpan{
+				File:      span.FileName,
+				Line:      span.LineStart,
+				Column:    span.ColumnStart,
+				IsPrimary: span.IsPrimary,
+			})
+		}
+
+		issue := Issue{
+			File:     primary.FileName,
+			Line:     primary.LineStart,
+			Column:   primary.ColumnStart,
 			Severity: severity,
 			Rule:     rule,
 			Message:  msg.Message,
-		})
+			Spans:    spans,
+			Rendered: ansiEscapeRe.ReplaceAllString(msg.Rendered, ""),
+		}
+
+		if fix := machineApplicableFix(msg.Children); fix != nil {
+			issue.FixReplacement = *fix.SuggestedReplacement
+			issue.FixByteStart = fix.ByteStart
+			issue.FixByteEnd = fix.ByteEnd
+		}
+
+		issues = append(issues, issue)
 	}
 
 	return issues
 }
 
+// machineApplicableFix returns the first child span carrying a
+// suggestion cargo marked safe to apply automatically, or nil if none of
+// children's spans do.
+func machineApplicableFix(children []clippyChild) *clippySpan {
+	for _, child := range children {
+		for _, span := range child.Spans {
+			if span.SuggestedReplacement != nil && span.SuggestionApplicability == "MachineApplicable" {
+				span := span
+				return &span
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyFixes writes each issue's machine-applicable suggested replacement
+// directly to its file, implementing FixApplier. This is used instead of
+// re-invoking clippy with --fix when the caller wants fixes applied
+// without clippy's own rebuild-from-scratch --fix pass. Issues without a
+// FixReplacement are left untouched.
+func (t *ClippyTool) ApplyFixes(issues []Issue) error {
+	byFile := make(map[string][]Issue)
+	for _, issue := range issues {
+		if issue.FixReplacement == "" {
+			continue
+		}
+		byFile[issue.File] = append(byFile[issue.File], issue)
+	}
+
+	for file, fileIssues := range byFile {
+		// Apply from the end of the file backwards so an earlier fix's
+		// byte offsets aren't shifted by a later one applied first.
+		sort.Slice(fileIssues, func(i, j int) bool {
+			return fileIssues[i].FixByteStart > fileIssues[j].FixByteStart
+		})
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s to apply fix: %w", file, err)
+		}
+
+		for _, issue := range fileIssues {
+			if issue.FixByteStart < 0 || issue.FixByteEnd > len(data) || issue.FixByteStart > issue.FixByteEnd {
+				continue
+			}
+
+			fixed := make([]byte, 0, len(data)-(issue.FixByteEnd-issue.FixByteStart)+len(issue.FixReplacement))
+			fixed = append(fixed, data[:issue.FixByteStart]...)
+			fixed = append(fixed, issue.FixReplacement...)
+			fixed = append(fixed, data[issue.FixByteEnd:]...)
+			data = fixed
+		}
+
+		if err := os.WriteFile(file, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write fix to %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
 // CargoFmtTool implements Rust formatting using cargo fmt.
 type CargoFmtTool struct {
 	*BaseTool
@@ -177,6 +375,7 @@ func NewCargoFmtTool() *CargoFmtTool {
 
 	tool.SetInstallCommand([]string{"rustup", "component", "add", "rustfmt"})
 	tool.SetConfigPatterns([]string{"rustfmt.toml", ".rustfmt.toml"})
+	tool.SetSupportedExtensions([]string{".rs"})
 
 	return tool
 }
@@ -203,4 +402,5 @@ var (
 	_ QualityTool = (*RustfmtTool)(nil)
 	_ QualityTool = (*ClippyTool)(nil)
 	_ QualityTool = (*CargoFmtTool)(nil)
+	_ FixApplier  = (*ClippyTool)(nil)
 )
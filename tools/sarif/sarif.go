@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+// Package sarif provides a minimal SARIF 2.1.0 *ingestion* helper so any
+// tool in tools/ can opt into parsing its own `--sarif`-style output
+// into findings, without importing the report package's SARIF
+// *generation* path - report already imports tools, so tools importing
+// report would be a cycle. Parse only reads the handful of fields a
+// QualityTool.ParseOutput needs; it is not a general SARIF decoder.
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Finding is one SARIF result, reduced to what a QualityTool needs to
+// build an Issue from it.
+type Finding struct {
+	RuleID  string
+	Level   string // SARIF "error", "warning", or "note"
+	Message string
+
+	File        string
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+
+	// Fix is the result's first suggested fix, if any.
+	Fix *Fix
+}
+
+// Fix is a single text replacement attached to a Finding.
+type Fix struct {
+	File        string
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+	InsertText  string
+}
+
+// sarifLog mirrors the subset of the SARIF 2.1.0 log schema Parse reads.
+type sarifLog struct {
+	Runs []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Results []sarifResult `json:"results"`
+}
+
+type sarifResult struct {
+	RuleID  string `json:"ruleId"`
+	Level   string `json:"level"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Locations []struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region struct {
+				StartLine   int `json:"startLine"`
+				StartColumn int `json:"startColumn"`
+				EndLine     int `json:"endLine"`
+				EndColumn   int `json:"endColumn"`
+			} `json:"region"`
+		} `json:"physicalLocation"`
+	} `json:"locations"`
+	Fixes []struct {
+		ArtifactChanges []struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Replacements []struct {
+				DeletedRegion struct {
+					StartLine   int `json:"startLine"`
+					StartColumn int `json:"startColumn"`
+					EndLine     int `json:"endLine"`
+					EndColumn   int `json:"endColumn"`
+				} `json:"deletedRegion"`
+				InsertedContent struct {
+					Text string `json:"text"`
+				} `json:"insertedContent"`
+			} `json:"replacements"`
+		} `json:"artifactChanges"`
+	} `json:"fixes"`
+}
+
+// Parse decodes a SARIF 2.1.0 log and flattens every run's results into
+// Findings, in report order. Only the first location and the first fix
+// replacement of each result are read - the fields a QualityTool.Issue
+// has room for - not every location/fix a multi-location diagnostic may
+// carry.
+func Parse(data []byte) ([]Finding, error) {
+	var log sarifLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("sarif: parse log: %w", err)
+	}
+
+	var findings []Finding
+	for _, run := range log.Runs {
+		for _, r := range run.Results {
+			f := Finding{
+				RuleID:  r.RuleID,
+				Level:   r.Level,
+				Message: r.Message.Text,
+			}
+
+			if len(r.Locations) > 0 {
+				loc := r.Locations[0].PhysicalLocation
+				f.File = loc.ArtifactLocation.URI
+				f.StartLine = loc.Region.StartLine
+				f.StartColumn = loc.Region.StartColumn
+				f.EndLine = loc.Region.EndLine
+				f.EndColumn = loc.Region.EndColumn
+			}
+
+			if len(r.Fixes) > 0 && len(r.Fixes[0].ArtifactChanges) > 0 {
+				change := r.Fixes[0].ArtifactChanges[0]
+				if len(change.Replacements) > 0 {
+					rep := change.Replacements[0]
+					f.Fix = &Fix{
+						File:        change.ArtifactLocation.URI,
+						StartLine:   rep.DeletedRegion.StartLine,
+						StartColumn: rep.DeletedRegion.StartColumn,
+						EndLine:     rep.DeletedRegion.EndLine,
+						EndColumn:   rep.DeletedRegion.EndColumn,
+						InsertText:  rep.InsertedContent.Text,
+					}
+				}
+			}
+
+			findings = append(findings, f)
+		}
+	}
+
+	return findings, nil
+}
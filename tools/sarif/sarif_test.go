@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package sarif
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_LocationAndFix(t *testing.T) {
+	data := []byte(`{
+		"runs": [{
+			"results": [{
+				"ruleId": "MD013",
+				"level": "warning",
+				"message": {"text": "Line length"},
+				"locations": [{
+					"physicalLocation": {
+						"artifactLocation": {"uri": "README.md"},
+						"region": {"startLine": 10, "startColumn": 1, "endLine": 10, "endColumn": 81}
+					}
+				}],
+				"fixes": [{
+					"artifactChanges": [{
+						"artifactLocation": {"uri": "README.md"},
+						"replacements": [{
+							"deletedRegion": {"startLine": 10, "startColumn": 80, "endLine": 10, "endColumn": 81},
+							"insertedContent": {"text": ""}
+						}]
+					}]
+				}]
+			}]
+		}]
+	}`)
+
+	findings, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+
+	f := findings[0]
+	assert.Equal(t, "MD013", f.RuleID)
+	assert.Equal(t, "warning", f.Level)
+	assert.Equal(t, "Line length", f.Message)
+	assert.Equal(t, "README.md", f.File)
+	assert.Equal(t, 10, f.StartLine)
+	assert.Equal(t, 81, f.EndColumn)
+
+	require.NotNil(t, f.Fix)
+	assert.Equal(t, "README.md", f.Fix.File)
+	assert.Equal(t, 80, f.Fix.StartColumn)
+	assert.Equal(t, "", f.Fix.InsertText)
+}
+
+func TestParse_NoLocationsOrFixes(t *testing.T) {
+	data := []byte(`{"runs": [{"results": [{"ruleId": "R1", "level": "note", "message": {"text": "hi"}}]}]}`)
+
+	findings, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "", findings[0].File)
+	assert.Nil(t, findings[0].Fix)
+}
+
+func TestParse_MultipleRuns(t *testing.T) {
+	data := []byte(`{"runs": [
+		{"results": [{"ruleId": "A", "level": "error", "message": {"text": "a"}}]},
+		{"results": [{"ruleId": "B", "level": "warning", "message": {"text": "b"}}]}
+	]}`)
+
+	findings, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "A", findings[0].RuleID)
+	assert.Equal(t, "B", findings[1].RuleID)
+}
+
+func TestParse_InvalidJSON(t *testing.T) {
+	_, err := Parse([]byte("not json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,362 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"encoding/json"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// GovulncheckTool scans Go modules for known vulnerabilities using
+// govulncheck.
+type GovulncheckTool struct {
+	*BaseTool
+}
+
+// NewGovulncheckTool creates a new govulncheck tool.
+func NewGovulncheckTool() *GovulncheckTool {
+	tool := &GovulncheckTool{
+		BaseTool: NewBaseTool("govulncheck", "Go", "govulncheck", SECURITY),
+	}
+
+	tool.SetInstallCommand([]string{"go", "install", "golang.org/x/vuln/cmd/govulncheck@latest"})
+	tool.SetSupportedExtensions([]string{".go"})
+
+	return tool
+}
+
+// BuildCommand builds the govulncheck command.
+func (t *GovulncheckTool) BuildCommand(_ []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-json"}
+	args = append(args, options.ExtraArgs...)
+	args = append(args, "./...")
+
+	cmd := exec.Command(t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// ParseOutput parses govulncheck's streamed JSON output into Issues.
+func (t *GovulncheckTool) ParseOutput(output string) []Issue {
+	var issues []Issue
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var msg struct {
+			Finding *struct {
+				OSV          string `json:"osv"`
+				FixedVersion string `json:"fixed_version"`
+				Trace        []struct {
+					Module   string `json:"module"`
+					Function string `json:"function"`
+					Position *struct {
+						Filename string `json:"filename"`
+						Line     int    `json:"line"`
+						Column   int    `json:"column"`
+					} `json:"position"`
+				} `json:"trace"`
+			} `json:"finding"`
+			OSV *struct {
+				ID       string `json:"id"`
+				Summary  string `json:"summary"`
+				Severity []struct {
+					Score string `json:"score"`
+				} `json:"severity"`
+			} `json:"osv"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			continue
+		}
+
+		if msg.Finding == nil || len(msg.Finding.Trace) == 0 {
+			continue
+		}
+
+		frame := msg.Finding.Trace[0]
+		issue := Issue{
+			Rule:     msg.Finding.OSV,
+			CVE:      msg.Finding.OSV,
+			Severity: "high",
+			Message:  "known vulnerability in " + frame.Module,
+		}
+		if frame.Position != nil {
+			issue.File = frame.Position.Filename
+			issue.Line = frame.Position.Line
+			issue.Column = frame.Position.Column
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// PipAuditTool scans Python dependencies for known vulnerabilities using
+// pip-audit.
+type PipAuditTool struct {
+	*BaseTool
+}
+
+// NewPipAuditTool creates a new pip-audit tool.
+func NewPipAuditTool() *PipAuditTool {
+	tool := &PipAuditTool{
+		BaseTool: NewBaseTool("pip-audit", "Python", "pip-audit", SECURITY),
+	}
+
+	tool.SetInstallCommand([]string{"pip", "install", "pip-audit"})
+	tool.SetSupportedExtensions([]string{".py"})
+
+	return tool
+}
+
+// BuildCommand builds the pip-audit command.
+func (t *PipAuditTool) BuildCommand(_ []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"--format", "json"}
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// ParseOutput parses pip-audit's JSON output into Issues.
+func (t *PipAuditTool) ParseOutput(output string) []Issue {
+	var report struct {
+		Dependencies []struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+			Vulns   []struct {
+				ID          string   `json:"id"`
+				FixVersions []string `json:"fix_versions"`
+				Description string   `json:"description"`
+			} `json:"vulns"`
+		} `json:"dependencies"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for _, dep := range report.Dependencies {
+		for _, vuln := range dep.Vulns {
+			issues = append(issues, Issue{
+				Rule:     vuln.ID,
+				CVE:      vuln.ID,
+				Severity: "high",
+				Message:  dep.Name + "@" + dep.Version + ": " + vuln.Description,
+			})
+		}
+	}
+
+	return issues
+}
+
+// NpmAuditTool scans JavaScript/TypeScript dependencies for known
+// vulnerabilities using npm audit.
+type NpmAuditTool struct {
+	*BaseTool
+}
+
+// NewNpmAuditTool creates a new npm audit tool.
+func NewNpmAuditTool() *NpmAuditTool {
+	tool := &NpmAuditTool{
+		BaseTool: NewBaseTool("npm-audit", "JavaScript", "npm", SECURITY),
+	}
+
+	tool.SetSupportedExtensions([]string{".js", ".jsx", ".ts", ".tsx"})
+
+	return tool
+}
+
+// BuildCommand builds the npm audit command.
+func (t *NpmAuditTool) BuildCommand(_ []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"audit", "--json"}
+	args = append(args, options.ExtraArgs...)
+
+	cmd := exec.Command(t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// ParseOutput parses `npm audit --json` output into Issues.
+func (t *NpmAuditTool) ParseOutput(output string) []Issue {
+	var report struct {
+		Vulnerabilities map[string]struct {
+			Severity string            `json:"severity"`
+			Name     string            `json:"name"`
+			Via      []json.RawMessage `json:"via"`
+		} `json:"vulnerabilities"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for name, vuln := range report.Vulnerabilities {
+		issues = append(issues, Issue{
+			Rule:     name,
+			Severity: strings.ToLower(vuln.Severity),
+			Message:  "vulnerable dependency: " + name,
+		})
+	}
+
+	return issues
+}
+
+// TrivyTool runs Trivy filesystem/SBOM scans for vulnerabilities and
+// misconfigurations across the whole project, independent of language.
+type TrivyTool struct {
+	*BaseTool
+}
+
+// NewTrivyTool creates a new Trivy filesystem-scan tool.
+func NewTrivyTool() *TrivyTool {
+	tool := &TrivyTool{
+		BaseTool: NewBaseTool("trivy", "Any", "trivy", SECURITY),
+	}
+
+	return tool
+}
+
+// BuildCommand builds the `trivy fs` command.
+func (t *TrivyTool) BuildCommand(_ []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"fs", "--format", "json", "--quiet"}
+	args = append(args, options.ExtraArgs...)
+	args = append(args, ".")
+
+	cmd := exec.Command(t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// ParseOutput parses Trivy's JSON output into Issues.
+func (t *TrivyTool) ParseOutput(output string) []Issue {
+	var report struct {
+		Results []struct {
+			Target          string `json:"Target"`
+			Vulnerabilities []struct {
+				VulnerabilityID string `json:"VulnerabilityID"`
+				Severity        string `json:"Severity"`
+				Title           string `json:"Title"`
+				CVSS            map[string]struct {
+					V3Score float64 `json:"V3Score"`
+				} `json:"CVSS"`
+			} `json:"Vulnerabilities"`
+		} `json:"Results"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return []Issue{}
+	}
+
+	var issues []Issue
+	for _, result := range report.Results {
+		for _, vuln := range result.Vulnerabilities {
+			issue := Issue{
+				File:     result.Target,
+				Rule:     vuln.VulnerabilityID,
+				CVE:      vuln.VulnerabilityID,
+				Severity: strings.ToLower(vuln.Severity),
+				Message:  vuln.Title,
+			}
+			for _, cvss := range vuln.CVSS {
+				if cvss.V3Score > issue.CVSSScore {
+					issue.CVSSScore = cvss.V3Score
+				}
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// GosecTool scans Go source for common security issues (hardcoded
+// credentials, SQL injection, weak crypto, ...) using gosec. Unlike
+// golangci-lint's bundled "gosec" linter (see golangciLinterCategoryMap
+// in go_tools.go), this runs the standalone gosec binary directly, for
+// projects that want a security scan without pulling in the whole
+// golangci-lint aggregator.
+type GosecTool struct {
+	*BaseTool
+}
+
+// NewGosecTool creates a new gosec tool.
+func NewGosecTool() *GosecTool {
+	tool := &GosecTool{
+		BaseTool: NewBaseTool("gosec", "Go", "gosec", SECURITY),
+	}
+
+	tool.SetInstallCommand([]string{"go", "install", "github.com/securego/gosec/v2/cmd/gosec@latest"})
+	tool.SetSupportedExtensions([]string{".go"})
+
+	return tool
+}
+
+// BuildCommand builds the gosec command.
+func (t *GosecTool) BuildCommand(_ []string, options ExecuteOptions) *exec.Cmd {
+	args := []string{"-fmt=json"}
+	args = append(args, options.ExtraArgs...)
+	args = append(args, "./...")
+
+	cmd := exec.Command(t.executable, args...)
+	if options.ProjectRoot != "" {
+		cmd.Dir = options.ProjectRoot
+	}
+	return cmd
+}
+
+// ParseOutput parses gosec's JSON report into Issues.
+func (t *GosecTool) ParseOutput(output string) []Issue {
+	var report struct {
+		Issues []struct {
+			Severity   string `json:"severity"`
+			Confidence string `json:"confidence"`
+			RuleID     string `json:"rule_id"`
+			Details    string `json:"details"`
+			File       string `json:"file"`
+			Line       string `json:"line"`
+			Column     string `json:"column"`
+		} `json:"Issues"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return []Issue{}
+	}
+
+	issues := make([]Issue, 0, len(report.Issues))
+	for _, gi := range report.Issues {
+		line, _ := strconv.Atoi(gi.Line)
+		col, _ := strconv.Atoi(gi.Column)
+
+		issues = append(issues, Issue{
+			File:     gi.File,
+			Line:     line,
+			Column:   col,
+			Severity: strings.ToLower(gi.Severity),
+			Rule:     gi.RuleID,
+			Message:  gi.Details,
+			Category: "security",
+		})
+	}
+
+	return issues
+}
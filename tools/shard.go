@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ShardStrategy selects how ShardFilesWithStrategy groups files into
+// shards. The zero value ("") behaves like ShardStrategyFileHash.
+type ShardStrategy string
+
+const (
+	// ShardStrategyFileHash shards each file independently by FNV-1a of
+	// its own path - ShardFiles' original behavior, borrowed from Go's
+	// test/run.go "-shard N -shards M" idiom.
+	ShardStrategyFileHash ShardStrategy = "file-hash"
+
+	// ShardStrategyPackage shards by a file's containing directory, so
+	// every file in the same package/directory always lands on the same
+	// shard - useful for linters (e.g. golangci-lint) whose diagnostics
+	// are more precise with a whole package in view.
+	ShardStrategyPackage ShardStrategy = "package"
+
+	// ShardStrategyLanguage shards by the language a file set was
+	// already grouped under (see ShardFilesWithStrategy's language
+	// parameter) rather than by individual file, keeping one language's
+	// files together on a single shard.
+	ShardStrategyLanguage ShardStrategy = "language"
+
+	// ShardStrategySizeBalanced sorts files by byte size descending and
+	// greedily assigns each to whichever shard currently has the least
+	// total size, for even wall-clock time across shards rather than an
+	// even file count.
+	ShardStrategySizeBalanced ShardStrategy = "size-balanced"
+)
+
+// ShardIndex deterministically maps path to an integer in [0, shards),
+// stable across runs and across machines - the same path always hashes
+// to the same shard, so a file/package/module never gets skipped by all
+// shards or processed by more than one. shards <= 1 always maps to 0.
+func ShardIndex(path string, shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(path))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// InShard reports whether path belongs to shard (0-based) out of a total
+// of shards shards.
+func InShard(path string, shard, shards int) bool {
+	return shards <= 1 || ShardIndex(path, shards) == shard
+}
+
+// ShardFiles filters items (file paths, cargo package names, buf module
+// directories, anything identified by a stable string key) down to those
+// assigned to shard out of shards total, preserving order. shards <= 1
+// returns items unchanged.
+func ShardFiles(items []string, shard, shards int) []string {
+	if shards <= 1 {
+		return items
+	}
+
+	filtered := make([]string, 0, len(items)/shards+1)
+	for _, item := range items {
+		if InShard(item, shard, shards) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// ShardFilesWithStrategy is ShardFiles generalized over strategy:
+//   - ShardStrategyFileHash (or "", the default): identical to ShardFiles.
+//   - ShardStrategyPackage: hashes filepath.Dir(file) instead of file, so
+//     a package's files never split across shards.
+//   - ShardStrategyLanguage: hashes language instead of any per-file key,
+//     so every file passed in belongs to the same shard together -
+//     callers shard one language's file list at a time (see
+//     executor.ExecutionPlanner), so this keeps that whole language on
+//     one shard rather than splitting it.
+//   - ShardStrategySizeBalanced: ignores hashing entirely and greedily
+//     assigns files (sorted by descending size) to the currently-
+//     smallest shard by cumulative byte size, for even wall-clock time;
+//     a file that can't be stat'd counts as size 0.
+//
+// shards <= 1 returns files unchanged for every strategy.
+func ShardFilesWithStrategy(files []string, shard, shards int, strategy ShardStrategy, language string) []string {
+	if shards <= 1 {
+		return files
+	}
+
+	switch strategy {
+	case ShardStrategyPackage:
+		filtered := make([]string, 0, len(files)/shards+1)
+		for _, f := range files {
+			if InShard(filepath.Dir(f), shard, shards) {
+				filtered = append(filtered, f)
+			}
+		}
+		return filtered
+
+	case ShardStrategyLanguage:
+		if InShard(language, shard, shards) {
+			return files
+		}
+		return nil
+
+	case ShardStrategySizeBalanced:
+		return sizeBalancedShard(files, shard, shards)
+
+	default:
+		return ShardFiles(files, shard, shards)
+	}
+}
+
+// sizeBalancedShard implements ShardStrategySizeBalanced: files sorted by
+// descending byte size, each greedily placed onto whichever shard
+// currently has the smallest running total, so every shard ends up with
+// roughly the same total bytes rather than the same file count.
+func sizeBalancedShard(files []string, shard, shards int) []string {
+	type sizedFile struct {
+		path string
+		size int64
+	}
+
+	sized := make([]sizedFile, len(files))
+	for i, f := range files {
+		info, err := os.Stat(f)
+		size := int64(0)
+		if err == nil {
+			size = info.Size()
+		}
+		sized[i] = sizedFile{path: f, size: size}
+	}
+
+	sort.SliceStable(sized, func(i, j int) bool { return sized[i].size > sized[j].size })
+
+	totals := make([]int64, shards)
+	result := make([][]string, shards)
+	for _, sf := range sized {
+		smallest := 0
+		for i := 1; i < shards; i++ {
+			if totals[i] < totals[smallest] {
+				smallest = i
+			}
+		}
+		totals[smallest] += sf.size
+		result[smallest] = append(result[smallest], sf.path)
+	}
+
+	return result[shard]
+}
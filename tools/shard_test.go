@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardFilesWithStrategy_FileHashMatchesShardFiles(t *testing.T) {
+	files := []string{"a.go", "b.go", "c.go", "d.go"}
+
+	for shard := 0; shard < 3; shard++ {
+		assert.Equal(t,
+			ShardFiles(files, shard, 3),
+			ShardFilesWithStrategy(files, shard, 3, ShardStrategyFileHash, ""),
+		)
+	}
+}
+
+func TestShardFilesWithStrategy_PackageKeepsDirectoryTogether(t *testing.T) {
+	files := []string{"pkg/a/one.go", "pkg/a/two.go", "pkg/b/three.go"}
+
+	var shardOf = make(map[string]int)
+	for shard := 0; shard < 3; shard++ {
+		for _, f := range ShardFilesWithStrategy(files, shard, 3, ShardStrategyPackage, "") {
+			shardOf[f] = shard
+		}
+	}
+
+	assert.Equal(t, shardOf["pkg/a/one.go"], shardOf["pkg/a/two.go"])
+}
+
+func TestShardFilesWithStrategy_LanguageAssignsWholeSetToOneShard(t *testing.T) {
+	files := []string{"a.py", "b.py", "c.py"}
+
+	total := 0
+	for shard := 0; shard < 4; shard++ {
+		result := ShardFilesWithStrategy(files, shard, 4, ShardStrategyLanguage, "Python")
+		if len(result) > 0 {
+			assert.Equal(t, files, result)
+			total += len(result)
+		}
+	}
+	assert.Equal(t, len(files), total)
+}
+
+func TestShardFilesWithStrategy_SizeBalancedEvensOutTotalBytes(t *testing.T) {
+	dir := t.TempDir()
+
+	sizes := []int{100, 10, 80, 20, 50}
+	files := make([]string, len(sizes))
+	for i, size := range sizes {
+		path := filepath.Join(dir, string(rune('a'+i))+".txt")
+		require.NoError(t, os.WriteFile(path, make([]byte, size), 0o644))
+		files[i] = path
+	}
+
+	var allAssigned []string
+	shardTotals := make([]int64, 2)
+	for shard := 0; shard < 2; shard++ {
+		result := ShardFilesWithStrategy(files, shard, 2, ShardStrategySizeBalanced, "")
+		allAssigned = append(allAssigned, result...)
+		for _, f := range result {
+			info, err := os.Stat(f)
+			require.NoError(t, err)
+			shardTotals[shard] += info.Size()
+		}
+	}
+
+	assert.ElementsMatch(t, files, allAssigned)
+	// Greedy largest-first assignment across 2 shards shouldn't ever let
+	// one shard's total exceed the other's by more than the single
+	// largest file's size.
+	diff := shardTotals[0] - shardTotals[1]
+	if diff < 0 {
+		diff = -diff
+	}
+	assert.LessOrEqual(t, diff, int64(100))
+}
+
+func TestShardFilesWithStrategy_SingleShardReturnsAllFiles(t *testing.T) {
+	files := []string{"a.go", "b.go"}
+	assert.Equal(t, files, ShardFilesWithStrategy(files, 0, 1, ShardStrategySizeBalanced, ""))
+}
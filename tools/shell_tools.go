@@ -22,6 +22,7 @@ func NewShellcheckTool() *ShellcheckTool {
 
 	tool.SetInstallCommand([]string{"pacman", "-S", "--noconfirm", "shellcheck"})
 	tool.SetConfigPatterns([]string{".shellcheckrc"})
+	tool.SetSupportedExtensions([]string{".sh", ".bash", ".zsh", ".ksh"})
 
 	return tool
 }
@@ -121,6 +122,7 @@ func NewShfmtTool() *ShfmtTool {
 
 	tool.SetInstallCommand([]string{"go", "install", "mvdan.cc/sh/v3/cmd/shfmt@latest"})
 	tool.SetConfigPatterns([]string{".editorconfig"})
+	tool.SetSupportedExtensions([]string{".sh", ".bash", ".zsh", ".ksh"})
 
 	return tool
 }
@@ -4,9 +4,15 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+
+	toml "github.com/pelletier/go-toml/v2"
 )
 
 // SqlfluffTool implements SQL linting and formatting using sqlfluff.
@@ -22,11 +28,16 @@ func NewSqlfluffTool() *SqlfluffTool {
 
 	tool.SetInstallCommand([]string{"uv", "tool", "install", "sqlfluff"})
 	tool.SetConfigPatterns([]string{".sqlfluff", "setup.cfg", "pyproject.toml"})
+	tool.SetSupportedExtensions([]string{".sql"})
 
 	return tool
 }
 
-// BuildCommand builds the sqlfluff command.
+// BuildCommand builds the sqlfluff command. Dialect/templater come from
+// options.SQLDialect/SQLTemplater - Execute populates these per file
+// group before calling BuildCommand, so a direct caller that skips
+// Execute's grouping (e.g. a test) still gets sqlfluff's historical
+// "ansi, no --templater" behavior when it leaves them unset.
 func (t *SqlfluffTool) BuildCommand(files []string, options ExecuteOptions) *exec.Cmd {
 	var args []string
 
@@ -42,8 +53,15 @@ func (t *SqlfluffTool) BuildCommand(files []string, options ExecuteOptions) *exe
 		args = append(args, "--format", "json")
 	}
 
-	// Add dialect (default to ansi)
-	args = append(args, "--dialect", "ansi")
+	dialect := options.SQLDialect
+	if dialect == "" {
+		dialect = "ansi"
+	}
+	args = append(args, "--dialect", dialect)
+
+	if options.SQLTemplater != "" {
+		args = append(args, "--templater", options.SQLTemplater)
+	}
 
 	// Add extra flags if provided
 	args = append(args, options.ExtraArgs...)
@@ -65,6 +83,235 @@ func (t *SqlfluffTool) BuildCommand(files []string, options ExecuteOptions) *exe
 	return cmd
 }
 
+// Execute groups files by their nearest sqlfluff config - .sqlfluff,
+// pyproject.toml's [tool.sqlfluff.core], or dbt_project.yml - falling
+// back to sniffing the SQL itself, then runs BuildCommand once per
+// distinct dialect/templater instead of once for the whole file list.
+// This lets a run spanning several subtrees (e.g. a "legacy" postgres
+// directory next to a "warehouse" bigquery one, each with its own
+// .sqlfluff) lint each with its own --dialect in a single Execute call.
+// An explicit options.SQLDialect/SQLTemplater short-circuits detection
+// entirely, matching every other tool's "options win" convention.
+func (t *SqlfluffTool) Execute(ctx context.Context, files []string, options ExecuteOptions) (*Result, error) {
+	if !t.IsAvailable() {
+		return &Result{
+			Tool:     t.name,
+			Language: t.language,
+			Success:  false,
+			Error:    fmt.Errorf("tool %s is not available", t.name),
+		}, nil
+	}
+
+	sqlFiles := FilterFilesByExtensions(files, []string{".sql"})
+	if len(sqlFiles) == 0 {
+		sqlFiles = files
+	}
+
+	groups := groupFilesBySqlfluffConfig(sqlFiles, options)
+	results := make([]*Result, 0, len(groups))
+
+	for _, group := range groups {
+		groupOptions := options
+		groupOptions.SQLDialect = group.config.Dialect
+		groupOptions.SQLTemplater = group.config.Templater
+
+		cmd := t.BuildCommand(group.files, groupOptions)
+
+		result, err := t.ExecuteCommand(ctx, cmd, group.files)
+		if err != nil {
+			return result, err
+		}
+
+		if !result.Success {
+			result.Issues = t.ParseOutput(result.Output)
+		}
+
+		results = append(results, result)
+	}
+
+	result := mergeResults(results)
+
+	if err := ApplyDiffScope(ctx, result, options); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// sqlfluffConfig is the dialect/templater sqlfluff would use for a given
+// file, resolved from its nearest config file (or content sniffing).
+// Comparable so it can key the grouping map in groupFilesBySqlfluffConfig.
+type sqlfluffConfig struct {
+	Dialect   string
+	Templater string
+}
+
+// sqlfluffFileGroup is one BuildCommand invocation's worth of files,
+// all sharing the same detected (or overridden) config.
+type sqlfluffFileGroup struct {
+	config sqlfluffConfig
+	files  []string
+}
+
+// groupFilesBySqlfluffConfig partitions files by the sqlfluff config
+// that applies to each one, preserving first-seen order so output stays
+// deterministic across runs.
+func groupFilesBySqlfluffConfig(files []string, options ExecuteOptions) []sqlfluffFileGroup {
+	if options.SQLDialect != "" || options.SQLTemplater != "" {
+		return []sqlfluffFileGroup{{
+			config: sqlfluffConfig{Dialect: options.SQLDialect, Templater: options.SQLTemplater},
+			files:  files,
+		}}
+	}
+
+	order := make([]sqlfluffConfig, 0, len(files))
+	byConfig := make(map[sqlfluffConfig][]string, len(files))
+
+	for _, f := range files {
+		var sniff string
+		if data, err := os.ReadFile(f); err == nil {
+			sniff = string(data)
+		}
+
+		cfg := detectSqlfluffConfig(options.ProjectRoot, filepath.Dir(f), sniff)
+		if _, seen := byConfig[cfg]; !seen {
+			order = append(order, cfg)
+		}
+
+		byConfig[cfg] = append(byConfig[cfg], f)
+	}
+
+	if len(order) == 0 {
+		return []sqlfluffFileGroup{{config: sqlfluffConfig{Dialect: "ansi"}, files: files}}
+	}
+
+	groups := make([]sqlfluffFileGroup, 0, len(order))
+	for _, cfg := range order {
+		groups = append(groups, sqlfluffFileGroup{config: cfg, files: byConfig[cfg]})
+	}
+
+	return groups
+}
+
+// detectSqlfluffConfig walks up from dir to projectRoot looking for a
+// .sqlfluff, pyproject.toml, or dbt_project.yml that declares a dialect
+// or templater, stopping at the first directory that has one - so a
+// subtree's own .sqlfluff wins over one closer to the project root.
+// Falls back to sniffing sniffContent by keyword when nothing declares
+// either.
+func detectSqlfluffConfig(projectRoot, dir, sniffContent string) sqlfluffConfig {
+	for cur := dir; ; {
+		if cfg, ok := readSqlfluffConfigDir(cur); ok {
+			return cfg
+		}
+
+		if cur == projectRoot || cur == filepath.Dir(cur) {
+			break
+		}
+
+		cur = filepath.Dir(cur)
+	}
+
+	return sqlfluffConfig{Dialect: sniffSQLDialect(sniffContent)}
+}
+
+// readSqlfluffConfigDir looks for sqlfluff config directly inside dir
+// (not recursively), reporting ok=false when none of the three files
+// exist there or none of them declare a dialect/templater.
+func readSqlfluffConfigDir(dir string) (sqlfluffConfig, bool) {
+	if data, err := os.ReadFile(filepath.Join(dir, ".sqlfluff")); err == nil {
+		dialect, templater := parseSqlfluffINI(data)
+		if dialect != "" || templater != "" {
+			return sqlfluffConfig{Dialect: dialect, Templater: templater}, true
+		}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "pyproject.toml")); err == nil {
+		var parsed struct {
+			Tool struct {
+				Sqlfluff struct {
+					Core struct {
+						Dialect   string `toml:"dialect"`
+						Templater string `toml:"templater"`
+					} `toml:"core"`
+				} `toml:"sqlfluff"`
+			} `toml:"tool"`
+		}
+
+		if err := toml.Unmarshal(data, &parsed); err == nil {
+			core := parsed.Tool.Sqlfluff.Core
+			if core.Dialect != "" || core.Templater != "" {
+				return sqlfluffConfig{Dialect: core.Dialect, Templater: core.Templater}, true
+			}
+		}
+	}
+
+	// dbt_project.yml doesn't usually declare a dialect (that comes from
+	// the target's profile), but its mere presence means the SQL is
+	// Jinja/dbt-templated, which sqlfluff can't parse as plain SQL.
+	if _, err := os.Stat(filepath.Join(dir, "dbt_project.yml")); err == nil {
+		return sqlfluffConfig{Templater: "dbt"}, true
+	}
+
+	return sqlfluffConfig{}, false
+}
+
+// parseSqlfluffINI extracts dialect/templater from a .sqlfluff file's
+// [sqlfluff] section. .sqlfluff is a plain INI file; sqlfluff itself
+// parses it with Python's configparser, so this only needs to handle
+// "key = value" lines and "[section]" headers, not INI's fancier
+// features (interpolation, multi-line values) that sqlfluff.cfg never
+// exercises for these two keys.
+func parseSqlfluffINI(data []byte) (dialect, templater string) {
+	section := ""
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(strings.Trim(line, "[]"))
+			continue
+		}
+
+		if section != "sqlfluff" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "dialect":
+			dialect = strings.TrimSpace(value)
+		case "templater":
+			templater = strings.TrimSpace(value)
+		}
+	}
+
+	return dialect, templater
+}
+
+// sniffSQLDialect is the last resort when no config declares a dialect:
+// a handful of keywords that only exist in specific dialects. Ambiguous
+// or plain-ANSI SQL still resolves to "ansi", sqlfluff's own default.
+func sniffSQLDialect(content string) string {
+	upper := strings.ToUpper(content)
+
+	switch {
+	case strings.Contains(upper, "QUALIFY"):
+		return "bigquery"
+	case strings.Contains(upper, "RETURNING"), strings.Contains(upper, "LATERAL"):
+		return "postgres"
+	default:
+		return "ansi"
+	}
+}
+
 // ParseOutput parses sqlfluff JSON output.
 func (t *SqlfluffTool) ParseOutput(output string) []Issue {
 	if strings.TrimSpace(output) == "" {
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Archmagece
+// SPDX-License-Identifier: MIT
+
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSqlfluffTool_BuildCommand_DefaultsToAnsiWhenNoDialectSet(t *testing.T) {
+	tool := NewSqlfluffTool()
+
+	cmd := tool.BuildCommand([]string{"query.sql"}, ExecuteOptions{})
+
+	assert.Contains(t, cmd.Args, "--dialect")
+	idx := indexOf(cmd.Args, "--dialect")
+	assert.Equal(t, "ansi", cmd.Args[idx+1])
+	assert.NotContains(t, cmd.Args, "--templater")
+}
+
+func TestSqlfluffTool_BuildCommand_HonorsDialectAndTemplaterOptions(t *testing.T) {
+	tool := NewSqlfluffTool()
+
+	cmd := tool.BuildCommand([]string{"query.sql"}, ExecuteOptions{SQLDialect: "snowflake", SQLTemplater: "dbt"})
+
+	idx := indexOf(cmd.Args, "--dialect")
+	assert.Equal(t, "snowflake", cmd.Args[idx+1])
+
+	tIdx := indexOf(cmd.Args, "--templater")
+	assert.Equal(t, "dbt", cmd.Args[tIdx+1])
+}
+
+func TestParseSqlfluffINI_ReadsDialectAndTemplaterFromSqlfluffSection(t *testing.T) {
+	data := []byte("[sqlfluff]\ndialect = postgres\ntemplater = jinja\n\n[sqlfluff:rules]\nmax_line_length = 120\n")
+
+	dialect, templater := parseSqlfluffINI(data)
+
+	assert.Equal(t, "postgres", dialect)
+	assert.Equal(t, "jinja", templater)
+}
+
+func TestDetectSqlfluffConfig_PrefersNearestDotSqlfluffOverProjectRoot(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "warehouse")
+	assert.NoError(t, os.MkdirAll(sub, 0o755))
+
+	assert.NoError(t, os.WriteFile(filepath.Join(root, ".sqlfluff"), []byte("[sqlfluff]\ndialect = postgres\n"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(sub, ".sqlfluff"), []byte("[sqlfluff]\ndialect = bigquery\n"), 0o644))
+
+	cfg := detectSqlfluffConfig(root, sub, "")
+
+	assert.Equal(t, "bigquery", cfg.Dialect)
+}
+
+func TestDetectSqlfluffConfig_FallsBackToDbtProjectYmlForTemplater(t *testing.T) {
+	root := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "dbt_project.yml"), []byte("name: 'warehouse'\n"), 0o644))
+
+	cfg := detectSqlfluffConfig(root, root, "")
+
+	assert.Equal(t, "dbt", cfg.Templater)
+}
+
+func TestDetectSqlfluffConfig_SniffsDialectFromSQLKeywordsWhenNoConfig(t *testing.T) {
+	root := t.TempDir()
+
+	cfg := detectSqlfluffConfig(root, root, "INSERT INTO t (a) VALUES (1) RETURNING a;")
+
+	assert.Equal(t, "postgres", cfg.Dialect)
+}
+
+func TestGroupFilesBySqlfluffConfig_OptionOverrideShortCircuitsIntoOneGroup(t *testing.T) {
+	root := t.TempDir()
+	a := filepath.Join(root, "a.sql")
+	b := filepath.Join(root, "b.sql")
+	assert.NoError(t, os.WriteFile(a, []byte("select 1;"), 0o644))
+	assert.NoError(t, os.WriteFile(b, []byte("select 1;"), 0o644))
+
+	groups := groupFilesBySqlfluffConfig([]string{a, b}, ExecuteOptions{ProjectRoot: root, SQLDialect: "snowflake"})
+
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "snowflake", groups[0].config.Dialect)
+	assert.ElementsMatch(t, []string{a, b}, groups[0].files)
+}
+
+func TestGroupFilesBySqlfluffConfig_SplitsFilesAcrossDifferentSubtreeDialects(t *testing.T) {
+	root := t.TempDir()
+	legacy := filepath.Join(root, "legacy")
+	warehouse := filepath.Join(root, "warehouse")
+	assert.NoError(t, os.MkdirAll(legacy, 0o755))
+	assert.NoError(t, os.MkdirAll(warehouse, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(legacy, ".sqlfluff"), []byte("[sqlfluff]\ndialect = postgres\n"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(warehouse, ".sqlfluff"), []byte("[sqlfluff]\ndialect = bigquery\n"), 0o644))
+
+	legacyFile := filepath.Join(legacy, "query.sql")
+	warehouseFile := filepath.Join(warehouse, "query.sql")
+	assert.NoError(t, os.WriteFile(legacyFile, []byte("select 1;"), 0o644))
+	assert.NoError(t, os.WriteFile(warehouseFile, []byte("select 1;"), 0o644))
+
+	groups := groupFilesBySqlfluffConfig([]string{legacyFile, warehouseFile}, ExecuteOptions{ProjectRoot: root})
+
+	assert.Len(t, groups, 2)
+
+	byDialect := map[string][]string{}
+	for _, g := range groups {
+		byDialect[g.config.Dialect] = g.files
+	}
+
+	assert.Equal(t, []string{legacyFile}, byDialect["postgres"])
+	assert.Equal(t, []string{warehouseFile}, byDialect["bigquery"])
+}
+
+func indexOf(args []string, needle string) int {
+	for i, a := range args {
+		if a == needle {
+			return i
+		}
+	}
+	return -1
+}
@@ -23,6 +23,7 @@ func NewTaploTool() *TaploTool {
 
 	tool.SetInstallCommand([]string{"cargo", "install", "taplo-cli"})
 	tool.SetConfigPatterns([]string{"taplo.toml", ".taplo.toml"})
+	tool.SetSupportedExtensions([]string{".toml"})
 
 	return tool
 }
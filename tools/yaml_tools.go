@@ -23,6 +23,7 @@ func NewYamllintTool() *YamllintTool {
 
 	tool.SetInstallCommand([]string{"uv", "tool", "install", "yamllint"})
 	tool.SetConfigPatterns([]string{".yamllint", ".yamllint.yaml", ".yamllint.yml"})
+	tool.SetSupportedExtensions([]string{".yaml", ".yml"})
 
 	return tool
 }